@@ -596,6 +596,7 @@ func NewOsmosisApp(
 		app.SmartAccountKeeper,
 		app.BankKeeper,
 		app.TxFeesKeeper,
+		app.FeeGrantKeeper,
 		app.GAMMKeeper,
 		ante.DefaultSigVerificationGasConsumer,
 		encodingConfig.TxConfig.SignModeHandler(),
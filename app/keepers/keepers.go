@@ -3,6 +3,8 @@ package keepers
 import (
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 	evidencetypes "cosmossdk.io/x/evidence/types"
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	"github.com/CosmWasm/wasmd/x/wasm"
@@ -146,6 +148,7 @@ type AppKeepers struct {
 	AccountKeeper                *authkeeper.AccountKeeper
 	BankKeeper                   *bankkeeper.BaseKeeper
 	AuthzKeeper                  *authzkeeper.Keeper
+	FeeGrantKeeper               *feegrantkeeper.Keeper
 	StakingKeeper                *stakingkeeper.Keeper
 	DistrKeeper                  *distrkeeper.Keeper
 	DowntimeKeeper               *downtimedetector.Keeper
@@ -241,6 +244,9 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		authenticator.NewAnyOf(appKeepers.AuthenticatorManager),
 		authenticator.NewPartitionedAnyOf(appKeepers.AuthenticatorManager),
 		authenticator.NewPartitionedAllOf(appKeepers.AuthenticatorManager),
+		authenticator.NewThreshold(appKeepers.AuthenticatorManager),
+		authenticator.NewSessionKey(appKeepers.keys[smartaccounttypes.StoreKey], appKeepers.AccountKeeper),
+		authenticator.NewRecovery(appKeepers.keys[smartaccounttypes.StoreKey]),
 	})
 	govModuleAddr := appKeepers.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
 
@@ -250,6 +256,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		govModuleAddr,
 		appKeepers.GetSubspace(smartaccounttypes.ModuleName),
 		appKeepers.AuthenticatorManager,
+		appKeepers.AccountKeeper,
+		encodingConfig.TxConfig,
 	)
 	appKeepers.SmartAccountKeeper = &smartAccountKeeper
 
@@ -261,6 +269,13 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	)
 	appKeepers.AuthzKeeper = &authzKeeper
 
+	feeGrantKeeper := feegrantkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[feegrant.StoreKey]),
+		appKeepers.AccountKeeper,
+	)
+	appKeepers.FeeGrantKeeper = &feeGrantKeeper
+
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[stakingtypes.StoreKey]),
@@ -457,8 +472,14 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.tkeys[twaptypes.TransientStoreKey],
 		appKeepers.GetSubspace(twaptypes.ModuleName),
 		appKeepers.PoolManagerKeeper)
+	appKeepers.PoolManagerKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
+
+	// register the native spend limit authenticator, which prices outflows using twap
+	appKeepers.AuthenticatorManager.RegisterAuthenticator(
+		authenticator.NewSpendLimit(appKeepers.keys[smartaccounttypes.StoreKey], appKeepers.BankKeeper, appKeepers.TwapKeeper))
 
 	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appKeepers.keys[epochstypes.StoreKey])
+	appKeepers.ConcentratedLiquidityKeeper.SetEpochKeeper(appKeepers.EpochsKeeper)
 
 	protorevKeeper := protorevkeeper.NewKeeper(
 		appCodec, appKeepers.keys[protorevtypes.StoreKey],
@@ -484,6 +505,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.DistrKeeper,
 		appKeepers.ConsensusParamsKeeper,
 		dataDir,
+		encodingConfig.TxConfig,
 		appKeepers.GetSubspace(txfeestypes.ModuleName),
 	)
 	appKeepers.TxFeesKeeper = &txFeesKeeper
@@ -888,6 +910,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.IncentivesKeeper.Hooks(),
 			appKeepers.MintKeeper.Hooks(),
 			appKeepers.ProtoRevKeeper.EpochHooks(),
+			appKeepers.ConcentratedLiquidityKeeper.EpochHooks(),
 		),
 	)
 
@@ -929,6 +952,7 @@ func KVStoreKeys() []string {
 		concentratedliquiditytypes.StoreKey,
 		poolmanagertypes.StoreKey,
 		authzkeeper.StoreKey,
+		feegrant.StoreKey,
 		txfeestypes.StoreKey,
 		superfluidtypes.StoreKey,
 		wasmtypes.StoreKey,
@@ -39,6 +39,8 @@ import (
 
 	"cosmossdk.io/x/evidence"
 	evidencetypes "cosmossdk.io/x/evidence/types"
+	"cosmossdk.io/x/feegrant"
+	feegrantmodule "cosmossdk.io/x/feegrant/module"
 	"cosmossdk.io/x/upgrade"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -113,37 +115,38 @@ import (
 // moduleAccountPermissions defines module account permissions
 // TODO: Having to input nil's here is unacceptable, we need a way to automatically derive this.
 var moduleAccountPermissions = map[string][]string{
-	authtypes.FeeCollectorName:               nil,
-	distrtypes.ModuleName:                    nil,
-	ibchookstypes.ModuleName:                 nil,
-	icatypes.ModuleName:                      nil,
-	icqtypes.ModuleName:                      nil,
-	minttypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
-	minttypes.DeveloperVestingModuleAcctName: nil,
-	stakingtypes.BondedPoolName:              {authtypes.Burner, authtypes.Staking},
-	stakingtypes.NotBondedPoolName:           {authtypes.Burner, authtypes.Staking},
-	govtypes.ModuleName:                      {authtypes.Burner},
-	ibctransfertypes.ModuleName:              {authtypes.Minter, authtypes.Burner},
-	gammtypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
-	incentivestypes.ModuleName:               {authtypes.Minter, authtypes.Burner},
-	protorevtypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
-	lockuptypes.ModuleName:                   {authtypes.Minter, authtypes.Burner},
-	poolincentivestypes.ModuleName:           nil,
-	superfluidtypes.ModuleName:               {authtypes.Minter, authtypes.Burner},
-	txfeestypes.ModuleName:                   nil,
-	txfeestypes.NonNativeTxFeeCollectorName:  nil,
-	txfeestypes.TakerFeeStakersName:          nil,
-	txfeestypes.TakerFeeCommunityPoolName:    nil,
-	txfeestypes.TakerFeeBurnName:             nil,
-	txfeestypes.TakerFeeCollectorName:        nil,
-	txfeestypes.TakerFeeStakingRewardsBuffer: nil,
-	wasmtypes.ModuleName:                     {authtypes.Burner},
-	tokenfactorytypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
-	valsetpreftypes.ModuleName:               {authtypes.Staking},
-	poolmanagertypes.ModuleName:              nil,
-	cosmwasmpooltypes.ModuleName:             nil,
-	auctiontypes.ModuleName:                  nil,
-	smartaccounttypes.ModuleName:             nil,
+	authtypes.FeeCollectorName:                 nil,
+	distrtypes.ModuleName:                      nil,
+	ibchookstypes.ModuleName:                   nil,
+	icatypes.ModuleName:                        nil,
+	icqtypes.ModuleName:                        nil,
+	minttypes.ModuleName:                       {authtypes.Minter, authtypes.Burner},
+	minttypes.DeveloperVestingModuleAcctName:   nil,
+	stakingtypes.BondedPoolName:                {authtypes.Burner, authtypes.Staking},
+	stakingtypes.NotBondedPoolName:             {authtypes.Burner, authtypes.Staking},
+	govtypes.ModuleName:                        {authtypes.Burner},
+	ibctransfertypes.ModuleName:                {authtypes.Minter, authtypes.Burner},
+	gammtypes.ModuleName:                       {authtypes.Minter, authtypes.Burner},
+	incentivestypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+	protorevtypes.ModuleName:                   {authtypes.Minter, authtypes.Burner},
+	lockuptypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
+	poolincentivestypes.ModuleName:             nil,
+	superfluidtypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+	txfeestypes.ModuleName:                     nil,
+	txfeestypes.NonNativeTxFeeCollectorName:    nil,
+	txfeestypes.TakerFeeStakersName:            nil,
+	txfeestypes.TakerFeeCommunityPoolName:      nil,
+	txfeestypes.TakerFeeBurnName:               nil,
+	txfeestypes.TakerFeeCollectorName:          nil,
+	txfeestypes.TakerFeeStakingRewardsBuffer:   nil,
+	wasmtypes.ModuleName:                       {authtypes.Burner},
+	tokenfactorytypes.ModuleName:               {authtypes.Minter, authtypes.Burner},
+	valsetpreftypes.ModuleName:                 {authtypes.Staking},
+	poolmanagertypes.ModuleName:                nil,
+	poolmanagertypes.ConditionalSwapEscrowName: nil,
+	cosmwasmpooltypes.ModuleName:               nil,
+	auctiontypes.ModuleName:                    nil,
+	smartaccounttypes.ModuleName:               nil,
 }
 
 // appModules return modules to initialize module manager.
@@ -175,6 +178,7 @@ func appModules(
 		wasm.NewAppModule(appCodec, app.WasmKeeper, app.StakingKeeper, *app.AccountKeeper, app.BankKeeper, app.BaseApp.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
 		evidence.NewAppModule(*app.EvidenceKeeper),
 		authzmodule.NewAppModule(appCodec, *app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, *app.FeeGrantKeeper, app.interfaceRegistry),
 		ibc.NewAppModule(app.IBCKeeper),
 		ibcwasm.NewAppModule(*app.IBCWasmClientKeeper),
 		ica.NewAppModule(app.ICAControllerKeeper, app.ICAHostKeeper),
@@ -294,6 +298,7 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		epochstypes.ModuleName,
 		lockuptypes.ModuleName,
 		authz.ModuleName,
+		feegrant.ModuleName,
 		concentratedliquiditytypes.ModuleName,
 		ibcratelimittypes.ModuleName,
 		// wasm after ibc transfer
@@ -4,6 +4,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v31/app/upgrades"
 
 	store "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
 )
 
 // UpgradeName defines the on-chain upgrade name for the Osmosis v31 upgrade.
@@ -13,7 +14,7 @@ var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateUpgradeHandler,
 	StoreUpgrades: store.StoreUpgrades{
-		Added:   []string{},
+		Added:   []string{feegrant.StoreKey},
 		Deleted: []string{},
 	},
 }
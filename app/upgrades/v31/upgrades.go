@@ -13,8 +13,11 @@ import (
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v31/app/keepers"
 	"github.com/osmosis-labs/osmosis/v31/app/upgrades"
+	cltypes "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
 	poolmanager "github.com/osmosis-labs/osmosis/v31/x/poolmanager"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v31/x/twap/types"
 	txfeeskeeper "github.com/osmosis-labs/osmosis/v31/x/txfees/keeper"
 	txfeestypes "github.com/osmosis-labs/osmosis/v31/x/txfees/types"
 )
@@ -42,6 +45,44 @@ func CreateUpgradeHandler(
 
 		setIntermediaryDenomList(sdkCtx, keepers.TxFeesKeeper)
 
+		// Remove up to the default number of expired session keys each end block.
+		keepers.SmartAccountKeeper.SetParam(sdkCtx, smartaccounttypes.KeyMaxExpiredSessionKeysPerBlock, smartaccounttypes.DefaultMaxExpiredSessionKeysPerBlock)
+
+		// Execute up to the default number of pending recoveries each end block.
+		keepers.SmartAccountKeeper.SetParam(sdkCtx, smartaccounttypes.KeyMaxRecoveriesPerBlock, smartaccounttypes.DefaultMaxRecoveriesPerBlock)
+
+		// The base fee stays node local until governance enables the consensus base fee.
+		keepers.TxFeesKeeper.SetParam(sdkCtx, txfeestypes.KeyBaseFeeParams, txfeestypes.DefaultBaseFeeParams)
+
+		// No tx fee filter rule is registered at first.
+		keepers.TxFeesKeeper.SetParam(sdkCtx, txfeestypes.KeyTxFeeFilterRules, []txfeestypes.TxFeeFilterRule{})
+
+		// No pool overrides the twap record history keep period at first.
+		keepers.TwapKeeper.SetParam(sdkCtx, twaptypes.KeyRecordHistoryKeepPeriodOverrides, []twaptypes.RecordHistoryKeepPeriodOverride{})
+
+		// Keep candles of swaps for the default intervals.
+		keepers.TwapKeeper.SetParam(sdkCtx, twaptypes.KeyCandleIntervals, twaptypes.DefaultCandleIntervals)
+
+		// No taker fee tier discounts the taker fee at first.
+		keepers.PoolManagerKeeper.SetParam(sdkCtx, poolmanagertypes.KeyTakerFeeTiers, []poolmanagertypes.TakerFeeTier{})
+
+		// No frontend is whitelisted for taker fee rebates at first.
+		keepers.PoolManagerKeeper.SetParam(sdkCtx, poolmanagertypes.KeyTakerFeeAffiliates, []poolmanagertypes.TakerFeeAffiliate{})
+
+		err = setupConditionalSwaps(sdkCtx, keepers.PoolManagerKeeper, keepers.AccountKeeper)
+		if err != nil {
+			return nil, err
+		}
+
+		// Withdraw up to the default number of filled range orders each end block.
+		keepers.ConcentratedLiquidityKeeper.SetParam(sdkCtx, cltypes.KeyMaxRangeOrderFillsPerBlock, cltypes.DefaultMaxRangeOrderFillsPerBlock)
+
+		// Compound the rewards of up to the default number of positions at the end of each epoch identifier.
+		keepers.ConcentratedLiquidityKeeper.SetParam(sdkCtx, cltypes.KeyMaxAutoCompoundsPerEpoch, cltypes.DefaultMaxAutoCompoundsPerEpoch)
+
+		// No pool is in the dynamic spread factor mode at first.
+		keepers.ConcentratedLiquidityKeeper.SetParam(sdkCtx, cltypes.KeyDynamicSpreadFactors, []cltypes.DynamicSpreadFactor{})
+
 		return migrations, nil
 	}
 }
@@ -86,6 +127,15 @@ func updateTakerFeeDistribution(ctx sdk.Context, poolManagerKeeper *poolmanager.
 	return nil
 }
 
+// setupConditionalSwaps sets the maximum number of conditional swaps processed per block and their placement fee,
+// and creates the module account escrowing the tokens in of pending conditional swaps.
+func setupConditionalSwaps(ctx sdk.Context, poolManagerKeeper *poolmanager.Keeper, accountKeeper *authkeeper.AccountKeeper) error {
+	poolManagerKeeper.SetParam(ctx, poolmanagertypes.KeyMaxConditionalSwapsPerBlock, poolmanagertypes.DefaultMaxConditionalSwapsPerBlock)
+	poolManagerKeeper.SetParam(ctx, poolmanagertypes.KeyConditionalSwapPlacementFee, poolmanagertypes.DefaultConditionalSwapPlacementFee)
+
+	return osmoutils.CreateModuleAccountByName(ctx, accountKeeper, poolmanagertypes.ConditionalSwapEscrowName)
+}
+
 // setIntermediaryDenomList sets the default intermediary denoms for multi-hop swaps in txfees
 func setIntermediaryDenomList(ctx sdk.Context, txFeesKeeper *txfeeskeeper.Keeper) {
 	// Set the fee swap intermediary denom list to the specified denoms
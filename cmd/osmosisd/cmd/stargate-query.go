@@ -500,6 +500,54 @@ func GetStructAndFill(queryPath, module, structName string, structArguments ...s
 			}
 			v.StartTime = startTime
 			return v, nil
+		case "RealizedVolatilityRequest":
+			v := &twapquerytypes.RealizedVolatilityRequest{}
+			poolId, err := strconv.ParseUint(structArguments[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			v.PoolId = poolId
+			v.BaseAsset = structArguments[1]
+			v.QuoteAsset = structArguments[2]
+			startTime, err := osmoutils.ParseTimeString(structArguments[3])
+			if err != nil {
+				return nil, err
+			}
+			endTime, err := osmoutils.ParseTimeString(structArguments[4])
+			if err != nil {
+				return nil, err
+			}
+			v.StartTime = startTime
+			v.EndTime = &endTime
+			return v, nil
+		case "TimeWeightedMedianRequest":
+			v := &twapquerytypes.TimeWeightedMedianRequest{}
+			poolId, err := strconv.ParseUint(structArguments[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			v.PoolId = poolId
+			v.BaseAsset = structArguments[1]
+			v.QuoteAsset = structArguments[2]
+			startTime, err := osmoutils.ParseTimeString(structArguments[3])
+			if err != nil {
+				return nil, err
+			}
+			endTime, err := osmoutils.ParseTimeString(structArguments[4])
+			if err != nil {
+				return nil, err
+			}
+			v.StartTime = startTime
+			v.EndTime = &endTime
+			return v, nil
+		case "RecordHistoryKeepPeriodRequest":
+			v := &twapquerytypes.RecordHistoryKeepPeriodRequest{}
+			poolId, err := strconv.ParseUint(structArguments[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			v.PoolId = poolId
+			return v, nil
 		case "ParamsRequest":
 			v := &twapquerytypes.ParamsRequest{}
 			return v, nil
@@ -625,6 +673,40 @@ func GetStructAndFill(queryPath, module, structName string, structArguments ...s
 			}
 			v.ConcentratedPoolId = poolId
 			return v, nil
+		case "EffectiveSpreadFactorRequest":
+			v := &concentratedliquidityquery.EffectiveSpreadFactorRequest{}
+			poolId, err := strconv.ParseUint(structArguments[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			v.PoolId = poolId
+			return v, nil
+		case "LiquidityDepthRequest":
+			v := &concentratedliquidityquery.LiquidityDepthRequest{}
+			poolId, err := strconv.ParseUint(structArguments[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			v.PoolId = poolId
+			bucketWidth, err := osmomath.NewDecFromStr(structArguments[1])
+			if err != nil {
+				return nil, err
+			}
+			v.BucketWidth = bucketWidth
+			maxPriceImpact, err := osmomath.NewDecFromStr(structArguments[2])
+			if err != nil {
+				return nil, err
+			}
+			v.MaxPriceImpact = maxPriceImpact
+			return v, nil
+		case "PositionPerformanceRequest":
+			v := &concentratedliquidityquery.PositionPerformanceRequest{}
+			positionId, err := strconv.ParseUint(structArguments[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			v.PositionId = positionId
+			return v, nil
 		}
 	}
 
@@ -0,0 +1,537 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = &epochhook{}
+
+// autoCompoundSwap is the swap of a reward that is not a pool token into a pool token, before compounding it.
+type autoCompoundSwap struct {
+	route             []poolmanagertypes.SwapAmountInRoute
+	tokenIn           sdk.Coin
+	tokenOutMinAmount osmomath.Int
+}
+
+type epochhook struct {
+	k Keeper
+}
+
+// EpochHooks returns the epoch hooks compounding the rewards of the positions opted in to auto-compounding.
+func (k Keeper) EpochHooks() epochstypes.EpochHooks {
+	return &epochhook{k}
+}
+
+// GetModuleName implements types.EpochHooks.
+func (*epochhook) GetModuleName() string {
+	return types.ModuleName
+}
+
+// AfterEpochEnd compounds the rewards of the positions opted in to auto-compounding at the end of the epoch.
+func (hook *epochhook) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	hook.k.autoCompoundPositions(ctx, epochIdentifier)
+	return nil
+}
+
+func (hook *epochhook) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// SetPositionAutoCompound opts the position in to the auto-compounding of its rewards at the end of each epoch of the
+// given identifier, replacing any previous opt-in of the position. An empty epoch identifier opts the position out.
+// Returns error if
+// - the provided owner does not own the position
+// - the max slippage is not in the range [0, 1)
+// - the epoch identifier does not exist
+func (k Keeper) SetPositionAutoCompound(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, epochIdentifier string, maxSlippage osmomath.Dec) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	if owner.String() != position.Address {
+		return types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	if err := k.deletePositionAutoCompound(ctx, positionId); err != nil {
+		return err
+	}
+	if epochIdentifier == "" {
+		return nil
+	}
+
+	if maxSlippage.IsNil() || maxSlippage.IsNegative() || maxSlippage.GTE(osmomath.OneDec()) {
+		return types.InvalidMaxSlippageError{MaxSlippage: maxSlippage}
+	}
+
+	if k.epochKeeper.GetEpochInfo(ctx, epochIdentifier).Identifier != epochIdentifier {
+		return types.EpochIdentifierNotFoundError{EpochIdentifier: epochIdentifier}
+	}
+
+	k.setPositionAutoCompound(ctx, types.PositionAutoCompound{PositionId: positionId, EpochIdentifier: epochIdentifier, MaxSlippage: maxSlippage})
+	return nil
+}
+
+// GetPositionAutoCompound returns the auto-compounding opt-in of the given position.
+// Returns false if the position is not opted in to auto-compounding.
+func (k Keeper) GetPositionAutoCompound(ctx sdk.Context, positionId uint64) (types.PositionAutoCompound, bool, error) {
+	autoCompound := types.PositionAutoCompound{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPositionAutoCompound(positionId), &autoCompound)
+	if err != nil {
+		return types.PositionAutoCompound{}, false, err
+	}
+	return autoCompound, found, nil
+}
+
+// setPositionAutoCompound stores the auto-compounding opt-in of the position, indexed by its epoch identifier.
+func (k Keeper) setPositionAutoCompound(ctx sdk.Context, autoCompound types.PositionAutoCompound) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyPositionAutoCompound(autoCompound.PositionId), &autoCompound)
+	store.Set(types.KeyPositionAutoCompoundByEpoch(autoCompound.EpochIdentifier, autoCompound.PositionId), []byte{})
+}
+
+// deletePositionAutoCompound deletes the auto-compounding opt-in of the given position, if any, along with its epoch index.
+func (k Keeper) deletePositionAutoCompound(ctx sdk.Context, positionId uint64) error {
+	autoCompound, found, err := k.GetPositionAutoCompound(ctx, positionId)
+	if err != nil || !found {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPositionAutoCompound(positionId))
+	store.Delete(types.KeyPositionAutoCompoundByEpoch(autoCompound.EpochIdentifier, positionId))
+	return nil
+}
+
+// getNextAutoCompoundedPositionIds returns the ids of up to limit positions opted in to auto-compounding at the end of each
+// epoch of the identifier, in the order of their position ids, starting from where the previous call stopped.
+// Once the last position opted in is returned, the next call starts again from the first one.
+func (k Keeper) getNextAutoCompoundedPositionIds(ctx sdk.Context, epochIdentifier string, limit uint64) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyPositionAutoCompoundsByEpoch(epochIdentifier)
+	cursorKey := types.KeyAutoCompoundCursor(epochIdentifier)
+	start := store.Get(cursorKey)
+	if start == nil {
+		start = prefix
+	}
+
+	positionIds := []uint64{}
+	var nextKey []byte
+
+	iterator := store.Iterator(start, storetypes.PrefixEndBytes(prefix))
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(positionIds)) == limit {
+			nextKey = iterator.Key()
+			break
+		}
+		positionIds = append(positionIds, sdk.BigEndianToUint64(iterator.Key()[len(prefix):]))
+	}
+	iterator.Close()
+
+	if nextKey == nil {
+		store.Delete(cursorKey)
+	} else {
+		store.Set(cursorKey, nextKey)
+	}
+	return positionIds
+}
+
+// autoCompoundPositions compounds the rewards of up to MaxAutoCompoundsPerEpoch positions opted in to auto-compounding
+// at the end of each epoch of the identifier, in the order of their position ids. The next epoch of the identifier resumes
+// with the following positions, so that all the positions opted in are compounded in turn, however many they are.
+// Each position is compounded with at most AutoCompoundGasLimit gas. A position whose compounding fails or runs out of gas
+// is left as is, and compounded again on its next turn.
+func (k Keeper) autoCompoundPositions(ctx sdk.Context, epochIdentifier string) {
+	var maxAutoCompoundsPerEpoch uint64
+	k.paramSpace.Get(ctx, types.KeyMaxAutoCompoundsPerEpoch, &maxAutoCompoundsPerEpoch)
+	if maxAutoCompoundsPerEpoch == 0 {
+		return
+	}
+
+	for _, positionId := range k.getNextAutoCompoundedPositionIds(ctx, epochIdentifier, maxAutoCompoundsPerEpoch) {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) (err error) {
+			// Out of gas panics are turned into an error, so that only this position is left as is.
+			defer func() {
+				if r := recover(); r != nil {
+					if isOutOfGas, _ := osmoutils.IsOutOfGasError(r); !isOutOfGas {
+						panic(r)
+					}
+					err = types.AutoCompoundOutOfGasError{PositionId: positionId, GasLimit: types.AutoCompoundGasLimit}
+				}
+			}()
+			return k.autoCompoundPosition(cacheCtx.WithGasMeter(storetypes.NewGasMeter(types.AutoCompoundGasLimit)), positionId)
+		})
+		if err != nil {
+			ctx.Logger().Error(fmt.Errorf("unable to auto-compound the rewards of position %d: %w", positionId, err).Error())
+		}
+	}
+}
+
+// autoCompoundPosition claims the spread rewards of the position and the incentives of the uptimes met by its age,
+// converts those that are not pool tokens into a pool token, swaps the surplus of one pool token into the other so that
+// they are in the proportion of the position, and adds them back to the liquidity of the position through compoundIntoPosition,
+// keeping its position id and join time. The incentives of the longer uptimes are left accruing, not forfeited.
+//
+// The routes converting the rewards are found before claiming anything. The rewards that cannot be converted within
+// the max slippage of the position are put back as unclaimed rewards of the position, and the others are compounded.
+// Any amount that cannot be added in the proportion of the position's range stays with the owner.
+func (k Keeper) autoCompoundPosition(ctx sdk.Context, positionId uint64) error {
+	autoCompound, found, err := k.GetPositionAutoCompound(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("auto-compounding opt-in of position (%d) not found", positionId)
+	}
+
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return err
+	}
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+
+	spreadRewards, err := k.GetClaimableSpreadRewards(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	incentives, _, err := k.GetClaimableIncentives(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	rewards := spreadRewards.Add(incentives...)
+	if rewards.IsZero() {
+		return nil
+	}
+
+	swaps := []autoCompoundSwap{}
+	unroutableDenoms := map[string]bool{}
+	for _, reward := range rewards {
+		if reward.Denom == pool.GetToken0() || reward.Denom == pool.GetToken1() {
+			continue
+		}
+		swap, err := k.findAutoCompoundSwap(ctx, pool, reward, autoCompound.MaxSlippage)
+		if err != nil {
+			ctx.Logger().Debug(err.Error())
+			unroutableDenoms[reward.Denom] = true
+			continue
+		}
+		swaps = append(swaps, swap)
+	}
+	if len(unroutableDenoms) == len(rewards) {
+		return nil
+	}
+
+	collectedSpreadRewards, err := k.collectSpreadRewards(ctx, owner, positionId)
+	if err != nil {
+		return err
+	}
+	// The incentives of the uptimes not met yet by the position are left accruing, as collecting them would forfeit them.
+	collectedIncentives, _, _, err := k.collectIncentivesForUptimes(ctx, owner, positionId, true)
+	if err != nil {
+		return err
+	}
+
+	unroutableSpreadRewards, unroutableIncentives := sdk.Coins{}, sdk.Coins{}
+	for _, coin := range collectedSpreadRewards {
+		if unroutableDenoms[coin.Denom] {
+			unroutableSpreadRewards = unroutableSpreadRewards.Add(coin)
+		}
+	}
+	for _, coin := range collectedIncentives {
+		if unroutableDenoms[coin.Denom] {
+			unroutableIncentives = unroutableIncentives.Add(coin)
+		}
+	}
+	if err := k.leaveRewardsUnclaimed(ctx, owner, pool, positionId, unroutableSpreadRewards, unroutableIncentives); err != nil {
+		return err
+	}
+
+	collectedRewards := collectedSpreadRewards.Add(collectedIncentives...)
+	amount0, amount1 := collectedRewards.AmountOf(pool.GetToken0()), collectedRewards.AmountOf(pool.GetToken1())
+	for _, swap := range swaps {
+		tokenOutAmount, err := k.poolmanagerKeeper.RouteExactAmountIn(ctx, owner, swap.route, swap.tokenIn, swap.tokenOutMinAmount)
+		if err != nil {
+			return err
+		}
+		if swap.route[len(swap.route)-1].TokenOutDenom == pool.GetToken0() {
+			amount0 = amount0.Add(tokenOutAmount)
+		} else {
+			amount1 = amount1.Add(tokenOutAmount)
+		}
+	}
+
+	amount0, amount1, err = k.balanceAutoCompoundAmounts(ctx, owner, position, amount0, amount1, autoCompound.MaxSlippage)
+	if err != nil {
+		return err
+	}
+
+	amount0Added, amount1Added, liquidityDelta, err := k.compoundIntoPosition(ctx, owner, position, amount0, amount1)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtAutoCompoundPosition,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeLiquidity, liquidityDelta.String()),
+		sdk.NewAttribute(types.AttributeAmount0, amount0Added.String()),
+		sdk.NewAttribute(types.AttributeAmount1, amount1Added.String()),
+	))
+	return nil
+}
+
+// compoundIntoPosition adds the given amounts of the pool tokens to the liquidity of the position, in place.
+// Unlike addToPosition, the position is not withdrawn and replaced by a new one, so it keeps its position id and join time,
+// and the rewards accrued by the position that were not claimed stay claimable. addToPosition would forfeit the incentives
+// of the uptimes not met by the position and reset its age, so that they would never be met by a position compounded every epoch.
+// Any amount that cannot be added in the proportion of the position's range stays with the owner.
+// Returns the amounts added and the liquidity added.
+// Returns error if
+// - the position is superfluid staked or has an unmatured underlying lock
+// - the amounts add no liquidity
+func (k Keeper) compoundIntoPosition(ctx sdk.Context, owner sdk.AccAddress, position model.Position, amount0, amount1 osmomath.Int) (osmomath.Int, osmomath.Int, osmomath.Dec, error) {
+	// This path is handled separately in the superfluid module.
+	positionHasUnderlyingLock, _, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, position.PositionId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, osmomath.Dec{}, err
+	}
+	if positionHasUnderlyingLock {
+		return osmomath.Int{}, osmomath.Int{}, osmomath.Dec{}, types.PositionSuperfluidStakedError{PositionId: position.PositionId}
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, osmomath.Dec{}, err
+	}
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(position.LowerTick, position.UpperTick)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, osmomath.Dec{}, err
+	}
+
+	tokensProvided := sdk.Coins{}
+	if amount0.IsPositive() {
+		tokensProvided = tokensProvided.Add(sdk.NewCoin(pool.GetToken0(), amount0))
+	}
+	if amount1.IsPositive() {
+		tokensProvided = tokensProvided.Add(sdk.NewCoin(pool.GetToken1(), amount1))
+	}
+
+	// Trigger before hook for CreatePosition prior to providing the liquidity.
+	// If no contract is set, this will be a no-op.
+	err = k.BeforeCreatePosition(ctx, position.PoolId, owner, tokensProvided, osmomath.ZeroInt(), osmomath.ZeroInt(), position.LowerTick, position.UpperTick)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, osmomath.Dec{}, err
+	}
+
+	liquidityDelta := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0, amount1)
+	if liquidityDelta.IsZero() {
+		return osmomath.Int{}, osmomath.Int{}, osmomath.Dec{}, types.ErrZeroLiquidity
+	}
+
+	updateData, err := k.UpdatePosition(ctx, position.PoolId, owner, position.LowerTick, position.UpperTick, liquidityDelta, position.JoinTime, position.PositionId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, osmomath.Dec{}, err
+	}
+
+	err = k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), updateData.Amount0, updateData.Amount1, owner, pool.GetAddress())
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, osmomath.Dec{}, err
+	}
+
+	tokensAdded := sdk.Coins{}
+	if updateData.Amount0.IsPositive() {
+		tokensAdded = tokensAdded.Add(sdk.NewCoin(pool.GetToken0(), updateData.Amount0))
+	}
+	if updateData.Amount1.IsPositive() {
+		tokensAdded = tokensAdded.Add(sdk.NewCoin(pool.GetToken1(), updateData.Amount1))
+	}
+	k.RecordTotalLiquidityIncrease(ctx, tokensAdded)
+
+	err = k.updatePositionPerformance(ctx, position.PositionId, func(performance *types.PositionPerformance) {
+		performance.InitialAsset0 = performance.InitialAsset0.AddAmount(updateData.Amount0)
+		performance.InitialAsset1 = performance.InitialAsset1.AddAmount(updateData.Amount1)
+	})
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, osmomath.Dec{}, err
+	}
+
+	// Trigger after hook for CreatePosition.
+	// If no contract is set, this will be a no-op.
+	err = k.AfterCreatePosition(ctx, position.PoolId, owner, tokensProvided, osmomath.ZeroInt(), osmomath.ZeroInt(), position.LowerTick, position.UpperTick)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, osmomath.Dec{}, err
+	}
+
+	return updateData.Amount0, updateData.Amount1, liquidityDelta, nil
+}
+
+// leaveRewardsUnclaimed puts the given spread rewards and incentives, claimed by the owner of the position, back as
+// unclaimed rewards of the position, to be claimed later. They are sent back to the pool and added, scaled up, to the
+// position in the spread reward accumulator and in the accumulator of the shortest uptime, as the incentives are
+// already earned. The claimed rewards of the performance record of the position are reduced accordingly.
+func (k Keeper) leaveRewardsUnclaimed(ctx sdk.Context, owner sdk.AccAddress, pool types.ConcentratedPoolExtension, positionId uint64, spreadRewards, incentives sdk.Coins) error {
+	if !spreadRewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, owner, pool.GetSpreadRewardsAddress(), spreadRewards); err != nil {
+			return err
+		}
+		spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, pool.GetId())
+		if err != nil {
+			return err
+		}
+		spreadFactorScalingFactor, err := k.getSpreadFactorScalingFactorForPool(ctx, pool.GetId())
+		if err != nil {
+			return err
+		}
+		err = spreadRewardAccumulator.AddToUnclaimedRewards(types.KeySpreadRewardPositionAccumulator(positionId), sdk.NewDecCoinsFromCoins(spreadRewards...).MulDec(spreadFactorScalingFactor))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !incentives.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, owner, pool.GetIncentivesAddress(), incentives); err != nil {
+			return err
+		}
+		uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, pool.GetId())
+		if err != nil {
+			return err
+		}
+		incentiveScalingFactor, err := k.getIncentiveScalingFactorForPool(ctx, pool.GetId())
+		if err != nil {
+			return err
+		}
+		err = uptimeAccumulators[0].AddToUnclaimedRewards(string(types.KeyPositionId(positionId)), sdk.NewDecCoinsFromCoins(incentives...).MulDec(incentiveScalingFactor))
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.updatePositionPerformance(ctx, positionId, func(performance *types.PositionPerformance) {
+		performance.ClaimedSpreadRewards = performance.ClaimedSpreadRewards.Sub(spreadRewards...)
+		performance.ClaimedIncentives = performance.ClaimedIncentives.Sub(incentives...)
+	})
+}
+
+// balanceAutoCompoundAmounts swaps the surplus of one of the given amounts of the pool tokens into the other through the pool,
+// so that the amounts are in the proportion of the tokens of the position at the current price, and returns the new amounts.
+// The token out of the swap must be within the max slippage of the amount expected at the current price, spread and taker
+// fees included. As the swap moves the price and charges fees, the amounts returned are close to the proportion, not at it.
+func (k Keeper) balanceAutoCompoundAmounts(ctx sdk.Context, owner sdk.AccAddress, position model.Position, amount0, amount1 osmomath.Int, maxSlippage osmomath.Dec) (osmomath.Int, osmomath.Int, error) {
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	positionAmount0, positionAmount1, err := pool.CalcActualAmounts(ctx, position.LowerTick, position.UpperTick, position.Liquidity)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	// The values are in units of token1, at the current price of token0.
+	currentSqrtPrice := pool.GetCurrentSqrtPrice()
+	price := currentSqrtPrice.Mul(currentSqrtPrice)
+	positionValue0 := osmomath.BigDecFromDec(positionAmount0).Mul(price)
+	positionValue := positionValue0.Add(osmomath.BigDecFromDec(positionAmount1))
+	if !positionValue.IsPositive() {
+		return amount0, amount1, nil
+	}
+	value0 := osmomath.BigDecFromSDKInt(amount0).Mul(price)
+	targetValue0 := value0.Add(osmomath.BigDecFromSDKInt(amount1)).Mul(positionValue0).Quo(positionValue)
+
+	var tokenIn sdk.Coin
+	var tokenOutDenom string
+	var expectedTokenOut osmomath.BigDec
+	if value0.GT(targetValue0) {
+		tokenIn = sdk.NewCoin(pool.GetToken0(), value0.Sub(targetValue0).Quo(price).Dec().TruncateInt())
+		tokenOutDenom = pool.GetToken1()
+		expectedTokenOut = osmomath.BigDecFromSDKInt(tokenIn.Amount).Mul(price)
+	} else {
+		tokenIn = sdk.NewCoin(pool.GetToken1(), targetValue0.Sub(value0).Dec().TruncateInt())
+		tokenOutDenom = pool.GetToken0()
+		expectedTokenOut = osmomath.BigDecFromSDKInt(tokenIn.Amount).Quo(price)
+	}
+	if tokenIn.IsZero() {
+		return amount0, amount1, nil
+	}
+
+	tokenOutMinAmount := expectedTokenOut.MulDec(osmomath.OneDec().Sub(maxSlippage)).Dec().TruncateInt()
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: pool.GetId(), TokenOutDenom: tokenOutDenom}}
+	tokenOutAmount, err := k.poolmanagerKeeper.RouteExactAmountIn(ctx, owner, route, tokenIn, tokenOutMinAmount)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	if tokenOutDenom == pool.GetToken1() {
+		return amount0.Sub(tokenIn.Amount), amount1.Add(tokenOutAmount), nil
+	}
+	return amount0.Add(tokenOutAmount), amount1.Sub(tokenIn.Amount), nil
+}
+
+// findAutoCompoundSwap returns the swap converting the reward into token0 of the pool, or into token1 if token0 cannot be reached.
+// It swaps through the best single route estimated by the poolmanager, whose token out must be within the max slippage of
+// the amount expected at the spot price of the route, spread and taker fees included.
+func (k Keeper) findAutoCompoundSwap(ctx sdk.Context, pool types.ConcentratedPoolExtension, reward sdk.Coin, maxSlippage osmomath.Dec) (autoCompoundSwap, error) {
+	for _, tokenOutDenom := range []string{pool.GetToken0(), pool.GetToken1()} {
+		splitRoutes, tokenOutAmount, err := k.poolmanagerKeeper.EstimateBestSplitSwapExactAmountIn(ctx, reward, tokenOutDenom, 1)
+		if err != nil || len(splitRoutes) != 1 {
+			continue
+		}
+		route := splitRoutes[0].Pools
+
+		// The spot price of the route is the product of the spot prices of its hops, in units of the token in.
+		spotPrice := osmomath.OneBigDec()
+		hopTokenInDenom := reward.Denom
+		for _, hop := range route {
+			hopSpotPrice, err := k.poolmanagerKeeper.RouteCalculateSpotPrice(ctx, hop.PoolId, hopTokenInDenom, hop.TokenOutDenom)
+			if err != nil {
+				break
+			}
+			spotPrice = spotPrice.Mul(hopSpotPrice)
+			hopTokenInDenom = hop.TokenOutDenom
+		}
+		if hopTokenInDenom != tokenOutDenom || !spotPrice.IsPositive() {
+			continue
+		}
+
+		tokenOutMinAmount := osmomath.BigDecFromSDKInt(reward.Amount).Quo(spotPrice).MulDec(osmomath.OneDec().Sub(maxSlippage)).Dec().TruncateInt()
+		if tokenOutAmount.LT(tokenOutMinAmount) {
+			continue
+		}
+		return autoCompoundSwap{route: route, tokenIn: reward, tokenOutMinAmount: tokenOutMinAmount}, nil
+	}
+	return autoCompoundSwap{}, types.NoAutoCompoundRouteError{PoolId: pool.GetId(), TokenIn: reward, MaxSlippage: maxSlippage}
+}
+
+// getAllPositionAutoCompounds returns the auto-compounding opt-ins of all the positions.
+func (k Keeper) getAllPositionAutoCompounds(ctx sdk.Context) ([]types.PositionAutoCompound, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.PositionAutoCompoundPrefix, parsePositionAutoCompound)
+}
+
+func parsePositionAutoCompound(bz []byte) (types.PositionAutoCompound, error) {
+	autoCompound := types.PositionAutoCompound{}
+	if err := autoCompound.Unmarshal(bz); err != nil {
+		return types.PositionAutoCompound{}, err
+	}
+	return autoCompound, nil
+}
@@ -0,0 +1,356 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+	protorevtypes "github.com/osmosis-labs/osmosis/v31/x/protorev/types"
+)
+
+const (
+	autoCompoundEpoch      = "day"
+	autoCompoundOtherEpoch = "week"
+	nonPoolIncentiveDenom  = "foo"
+)
+
+var (
+	autoCompoundCoinAmount  = osmomath.NewInt(1_000_000_000_000_000)
+	autoCompoundMaxSlippage = osmomath.NewDecWithPrec(5, 2)
+)
+
+// createAutoCompoundPosition funds the owner and creates a position of both tokens of the pool in the range [-1000, 1000).
+func (s *KeeperTestSuite) createAutoCompoundPosition(poolId uint64, owner sdk.AccAddress) uint64 {
+	positionCoins := sdk.NewCoins(sdk.NewCoin(ETH, autoCompoundCoinAmount), sdk.NewCoin(USDC, autoCompoundCoinAmount))
+	s.FundAcc(owner, positionCoins)
+	positionData, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, poolId, owner, positionCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), -1000, 1000)
+	s.Require().NoError(err)
+	return positionData.ID
+}
+
+func (s *KeeperTestSuite) TestSetPositionAutoCompound() {
+	tests := map[string]struct {
+		sender          sdk.AccAddress
+		epochIdentifier string
+		maxSlippage     osmomath.Dec
+
+		expectedError error
+	}{
+		"opt in": {
+			epochIdentifier: autoCompoundEpoch,
+			maxSlippage:     autoCompoundMaxSlippage,
+		},
+		"opt out": {
+			epochIdentifier: "",
+		},
+		"error: not the position owner": {
+			sender:          s.TestAccs[2],
+			epochIdentifier: autoCompoundEpoch,
+			maxSlippage:     autoCompoundMaxSlippage,
+
+			expectedError: types.NotPositionOwnerError{PositionId: 2, Address: s.TestAccs[2].String()},
+		},
+		"error: max slippage of one": {
+			epochIdentifier: autoCompoundEpoch,
+			maxSlippage:     osmomath.OneDec(),
+
+			expectedError: types.InvalidMaxSlippageError{MaxSlippage: osmomath.OneDec()},
+		},
+		"error: epoch identifier not found": {
+			epochIdentifier: "fortnight",
+			maxSlippage:     autoCompoundMaxSlippage,
+
+			expectedError: types.EpochIdentifierNotFoundError{EpochIdentifier: "fortnight"},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+			owner := s.TestAccs[1]
+
+			// The position is opted in to another epoch identifier before, and the full range position to the epoch identifier.
+			positionId := s.createAutoCompoundPosition(pool.GetId(), owner)
+			err := clKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, autoCompoundOtherEpoch, autoCompoundMaxSlippage)
+			s.Require().NoError(err)
+			err = clKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[0], 1, autoCompoundEpoch, autoCompoundMaxSlippage)
+			s.Require().NoError(err)
+
+			sender := owner
+			if tc.sender != nil {
+				sender = tc.sender
+			}
+
+			// System under test.
+			err = clKeeper.SetPositionAutoCompound(s.Ctx, sender, positionId, tc.epochIdentifier, tc.maxSlippage)
+			if tc.expectedError != nil {
+				s.Require().ErrorContains(err, tc.expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			autoCompound, found, err := clKeeper.GetPositionAutoCompound(s.Ctx, positionId)
+			s.Require().NoError(err)
+			if tc.epochIdentifier == "" {
+				s.Require().False(found)
+				return
+			}
+			s.Require().True(found)
+			s.Require().Equal(types.PositionAutoCompound{PositionId: positionId, EpochIdentifier: tc.epochIdentifier, MaxSlippage: tc.maxSlippage}, autoCompound)
+		})
+	}
+}
+
+// validates that the rewards of the positions opted in to auto-compounding are added back to them at the end of their epoch,
+// converting the rewards that are not pool tokens, and that those that cannot be converted are left unclaimed while the
+// others are still compounded.
+func (s *KeeperTestSuite) TestAutoCompoundPositions() {
+	tests := map[string]struct {
+		nonPoolIncentive bool
+		routeCoinAmount  osmomath.Int
+		maxSlippage      osmomath.Dec
+		epochIdentifier  string
+
+		expectCompounded          bool
+		expectIncentivesUnclaimed bool
+	}{
+		"spread rewards and incentives of pool tokens": {
+			maxSlippage:     autoCompoundMaxSlippage,
+			epochIdentifier: autoCompoundEpoch,
+
+			expectCompounded: true,
+		},
+		"incentives of a non-pool token converted through a route": {
+			nonPoolIncentive: true,
+			routeCoinAmount:  autoCompoundCoinAmount,
+			maxSlippage:      autoCompoundMaxSlippage,
+			epochIdentifier:  autoCompoundEpoch,
+
+			expectCompounded: true,
+		},
+		"other epoch identifier: not compounded": {
+			maxSlippage:     autoCompoundMaxSlippage,
+			epochIdentifier: autoCompoundOtherEpoch,
+		},
+		"incentives of a non-pool token without route: left unclaimed, spread rewards compounded": {
+			nonPoolIncentive: true,
+			maxSlippage:      autoCompoundMaxSlippage,
+			epochIdentifier:  autoCompoundEpoch,
+
+			expectCompounded:          true,
+			expectIncentivesUnclaimed: true,
+		},
+		"incentives of a non-pool token with a route above the max slippage: left unclaimed, spread rewards compounded": {
+			nonPoolIncentive: true,
+			routeCoinAmount:  osmomath.NewInt(10_000),
+			maxSlippage:      autoCompoundMaxSlippage,
+			epochIdentifier:  autoCompoundEpoch,
+
+			expectCompounded:          true,
+			expectIncentivesUnclaimed: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+			owner := s.TestAccs[1]
+
+			incentiveCoin := sdk.NewCoin(ETH, osmomath.NewInt(1_000_000))
+			if tc.nonPoolIncentive {
+				incentiveCoin.Denom = nonPoolIncentiveDenom
+			}
+			if !tc.routeCoinAmount.IsNil() {
+				// The routes are found through the pools tracked by protorev, which only tracks the pairs with one of its base denoms.
+				err := s.App.ProtoRevKeeper.SetBaseDenoms(s.Ctx, []protorevtypes.BaseDenom{{Denom: ETH, StepSize: osmomath.NewInt(1_000_000)}})
+				s.Require().NoError(err)
+				s.PrepareBalancerPoolWithCoins(sdk.NewCoin(nonPoolIncentiveDenom, tc.routeCoinAmount), sdk.NewCoin(ETH, tc.routeCoinAmount))
+			}
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(incentiveCoin))
+			_, err := clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[0], incentiveCoin, osmomath.NewDec(100), s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+			s.Require().NoError(err)
+
+			positionId := s.createAutoCompoundPosition(pool.GetId(), owner)
+			err = clKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, tc.epochIdentifier, tc.maxSlippage)
+			s.Require().NoError(err)
+			positionBefore, err := clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+
+			// Accrue spread rewards and incentives to the position.
+			s.AddToSpreadRewardAccumulator(pool.GetId(), sdk.NewDecCoinFromDec(ETH, osmomath.NewDecWithPrec(1, 6)))
+			s.AddBlockTime(time.Minute)
+			spreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.FundAcc(pool.GetSpreadRewardsAddress(), spreadRewards)
+			incentives, _, err := clKeeper.GetClaimableIncentives(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().False(incentives.IsZero())
+
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test.
+			err = clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, autoCompoundEpoch, 1)
+			s.Require().NoError(err)
+
+			ownerBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			s.Require().Equal(ownerBalanceBefore.AmountOf(nonPoolIncentiveDenom), ownerBalanceAfter.AmountOf(nonPoolIncentiveDenom))
+
+			if !tc.expectCompounded {
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtAutoCompoundPosition, 0)
+				position, err := clKeeper.GetPosition(s.Ctx, positionId)
+				s.Require().NoError(err)
+				s.Require().Equal(positionBefore, position)
+				claimableIncentives, _, err := clKeeper.GetClaimableIncentives(s.Ctx, positionId)
+				s.Require().NoError(err)
+				s.Require().Equal(incentives, claimableIncentives)
+				return
+			}
+
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtAutoCompoundPosition, 1)
+
+			claimableIncentives, _, err := clKeeper.GetClaimableIncentives(s.Ctx, positionId)
+			s.Require().NoError(err)
+			if tc.expectIncentivesUnclaimed {
+				s.Require().Equal(incentives, claimableIncentives)
+			} else {
+				s.Require().True(claimableIncentives.IsZero())
+			}
+
+			// The liquidity is added to the position in place, which keeps its join time and opt-in.
+			position, err := clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(positionBefore.LowerTick, position.LowerTick)
+			s.Require().Equal(positionBefore.UpperTick, position.UpperTick)
+			s.Require().Equal(positionBefore.JoinTime, position.JoinTime)
+			s.Require().True(position.Liquidity.GT(positionBefore.Liquidity))
+
+			autoCompound, found, err := clKeeper.GetPositionAutoCompound(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().True(found)
+			s.Require().Equal(types.PositionAutoCompound{PositionId: positionId, EpochIdentifier: tc.epochIdentifier, MaxSlippage: tc.maxSlippage}, autoCompound)
+		})
+	}
+}
+
+// validates that compounding a position younger than an authorized uptime leaves the incentives of that uptime accruing
+// to the position, instead of forfeiting them, so that they are claimable once the age of the position meets the uptime.
+func (s *KeeperTestSuite) TestAutoCompoundUnmetUptimeIncentives() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	params := clKeeper.GetParams(s.Ctx)
+	params.AuthorizedUptimes = []time.Duration{time.Nanosecond, time.Hour}
+	clKeeper.SetParams(s.Ctx, params)
+	pool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+	owner := s.TestAccs[1]
+
+	metUptimeIncentive := sdk.NewCoin(ETH, osmomath.NewInt(1_000_000))
+	unmetUptimeIncentive := sdk.NewCoin(USDC, osmomath.NewInt(1_000_000))
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(metUptimeIncentive, unmetUptimeIncentive))
+	_, err := clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[0], metUptimeIncentive, osmomath.NewDec(100), s.Ctx.BlockTime(), time.Nanosecond)
+	s.Require().NoError(err)
+	_, err = clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[0], unmetUptimeIncentive, osmomath.NewDec(100), s.Ctx.BlockTime(), time.Hour)
+	s.Require().NoError(err)
+
+	positionId := s.createAutoCompoundPosition(pool.GetId(), owner)
+	err = clKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, autoCompoundEpoch, autoCompoundMaxSlippage)
+	s.Require().NoError(err)
+
+	s.AddBlockTime(time.Minute)
+	_, forfeitedIncentives, err := clKeeper.GetClaimableIncentives(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(forfeitedIncentives.AmountOf(USDC).IsPositive())
+
+	// System under test.
+	err = clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, autoCompoundEpoch, 1)
+	s.Require().NoError(err)
+
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtAutoCompoundPosition, 1)
+
+	// The incentives of the met uptime are compounded, those of the unmet uptime are left accruing.
+	claimableIncentives, forfeitedIncentivesAfter, err := clKeeper.GetClaimableIncentives(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(claimableIncentives.IsZero())
+	s.Require().Equal(forfeitedIncentives, forfeitedIncentivesAfter)
+
+	// Once the position meets the uptime, the incentives accrued before compounding are claimable.
+	s.AddBlockTime(time.Hour)
+	claimableIncentives, _, err = clKeeper.GetClaimableIncentives(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(claimableIncentives.AmountOf(USDC).GT(forfeitedIncentives.AmountOf(USDC)))
+}
+
+// validates that the auto-compounding opt-in of a position is deleted along with the position.
+func (s *KeeperTestSuite) TestWithdrawAutoCompoundedPosition() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+	owner := s.TestAccs[1]
+
+	positionId := s.createAutoCompoundPosition(pool.GetId(), owner)
+	err := clKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, autoCompoundEpoch, autoCompoundMaxSlippage)
+	s.Require().NoError(err)
+	position, err := clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+
+	// System under test.
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionId, position.Liquidity)
+	s.Require().NoError(err)
+
+	_, found, err := clKeeper.GetPositionAutoCompound(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+// validates that no more than MaxAutoCompoundsPerEpoch positions are compounded at the end of each epoch, and that the
+// next epoch resumes with the following positions.
+func (s *KeeperTestSuite) TestAutoCompoundPositionsInTurn() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	clKeeper.SetParam(s.Ctx, types.KeyMaxAutoCompoundsPerEpoch, uint64(1))
+	pool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+	owner := s.TestAccs[1]
+
+	// More positions than the maximum can opt in to the same epoch identifier.
+	positionIds := []uint64{s.createAutoCompoundPosition(pool.GetId(), owner), s.createAutoCompoundPosition(pool.GetId(), owner)}
+	for _, positionId := range positionIds {
+		err := clKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, autoCompoundEpoch, autoCompoundMaxSlippage)
+		s.Require().NoError(err)
+	}
+
+	// Accrues spread rewards to the positions and returns their liquidity.
+	accrueAndGetLiquidity := func() []osmomath.Dec {
+		s.AddToSpreadRewardAccumulator(pool.GetId(), sdk.NewDecCoinFromDec(ETH, osmomath.NewDecWithPrec(1, 6)))
+		liquidity := []osmomath.Dec{}
+		for _, positionId := range positionIds {
+			spreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.FundAcc(pool.GetSpreadRewardsAddress(), spreadRewards)
+			position, err := clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			liquidity = append(liquidity, position.Liquidity)
+		}
+		return liquidity
+	}
+
+	// System under test.
+	// Each epoch compounds the next position in turn, starting again from the first one.
+	for epoch, expectedCompounded := range []int{0, 1, 0} {
+		liquidityBefore := accrueAndGetLiquidity()
+
+		err := clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, autoCompoundEpoch, int64(epoch+1))
+		s.Require().NoError(err)
+
+		for i, positionId := range positionIds {
+			position, err := clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(i == expectedCompounded, position.Liquidity.GT(liquidityBefore[i]), "epoch %d, position %d", epoch+1, positionId)
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 const (
 	FlagPoolId                     = "pool-id"
 	FlagPoolIdToTickSpacingRecords = "pool-tick-spacing-records"
+	// Will be parsed to bool.
+	FlagRangeOrder = "range-order"
 )
 
 func FlagSetJustPoolId() *flag.FlagSet {
@@ -14,3 +16,9 @@ func FlagSetJustPoolId() *flag.FlagSet {
 	fs.Uint64(FlagPoolId, 0, "The id of pool")
 	return fs
 }
+
+func FlagSetRangeOrder() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagRangeOrder, "false", "Make the position a range order, withdrawn once the swaps of the pool fully cross its range. The position must be single-sided")
+	return fs
+}
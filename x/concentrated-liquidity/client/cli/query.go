@@ -23,6 +23,9 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPoolAccumulatorRewards)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTickAccumulatorTrackers)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetLiquidityPerTickRange)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetEffectiveSpreadFactor)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetLiquidityDepth)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPositionPerformance)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -146,3 +149,30 @@ func GetTickAccumulatorTrackers() (*osmocli.QueryDescriptor, *queryproto.TickAcc
 {{.CommandPrefix}} tick-accumulator-trackers 1 "[-18000000]"`,
 	}, &queryproto.TickAccumulatorTrackersRequest{}
 }
+
+func GetEffectiveSpreadFactor() (*osmocli.QueryDescriptor, *queryproto.EffectiveSpreadFactorRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "effective-spread-factor",
+		Short: "Query the spread factor currently charged by the swaps of a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} effective-spread-factor 1`,
+	}, &queryproto.EffectiveSpreadFactorRequest{}
+}
+
+func GetLiquidityDepth() (*osmocli.QueryDescriptor, *queryproto.LiquidityDepthRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "liquidity-depth",
+		Short: "Query the bid and ask ladders of a pool, up to a max price impact",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} liquidity-depth 1 0.01 0.05`,
+	}, &queryproto.LiquidityDepthRequest{}
+}
+
+func GetPositionPerformance() (*osmocli.QueryDescriptor, *queryproto.PositionPerformanceRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "position-performance",
+		Short: "Query the deposits, claimed rewards and time in range of a position since its creation",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} position-performance 53`,
+	}, &queryproto.PositionPerformanceRequest{}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
@@ -30,6 +31,8 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
 	osmocli.AddTxCmd(txCmd, NewFungifyChargedPositionsCmd)
 	osmocli.AddTxCmd(txCmd, NewTransferPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewRebalancePositionCmd)
+	osmocli.AddTxCmd(txCmd, NewSetPositionAutoCompoundCmd)
 	return txCmd
 }
 
@@ -50,7 +53,12 @@ func NewCreatePositionCmd() (*osmocli.TxCliDesc, *types.MsgCreatePosition) {
 	return &osmocli.TxCliDesc{
 		Use:     "create-position",
 		Short:   "create or add to existing concentrated liquidity position",
+		Long:    "With --range-order, the single-sided position is withdrawn to the sender once the swaps of the pool fully cross its range.",
 		Example: "osmosisd tx concentratedliquidity create-position 1 \"[-69082]\" 69082 10000uosmo,10000uion 0 0 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+		CustomFlagOverrides: map[string]string{
+			"rangeorder": FlagRangeOrder,
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetRangeOrder()}},
 	}, &types.MsgCreatePosition{}
 }
 
@@ -102,6 +110,24 @@ func NewTransferPositionsCmd() (*osmocli.TxCliDesc, *types.MsgTransferPositions)
 	}, &types.MsgTransferPositions{}
 }
 
+func NewRebalancePositionCmd() (*osmocli.TxCliDesc, *types.MsgRebalancePosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "rebalance-position",
+		Short:   "move the liquidity of a concentrated liquidity position to a new tick range, keeping its position id and unclaimed rewards",
+		Long:    "The token swapped in is swapped through the same pool into the other token before providing liquidity in the new range. Use a zero amount, such as 0uosmo, to skip the swap.",
+		Example: "osmosisd tx concentratedliquidity rebalance-position 10 \"[-69082]\" 69082 1000uosmo 900 0 0 --from val --chain-id localosmosis -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgRebalancePosition{}
+}
+
+func NewSetPositionAutoCompoundCmd() (*osmocli.TxCliDesc, *types.MsgSetPositionAutoCompound) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-position-auto-compound",
+		Short:   "opt a concentrated liquidity position in to the auto-compounding of its rewards at the end of each epoch of the given identifier",
+		Long:    "The rewards that are not pool tokens are swapped into a pool token within the max slippage, relative to the spot price of the route. Use an empty epoch identifier, \"\", to opt the position out.",
+		Example: "osmosisd tx concentratedliquidity set-position-auto-compound 10 day 0.01 --from val --chain-id localosmosis -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgSetPositionAutoCompound{}
+}
+
 func NewTickSpacingDecreaseProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "tick-spacing-decrease-proposal [flags]",
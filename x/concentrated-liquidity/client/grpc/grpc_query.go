@@ -51,6 +51,16 @@ func (q Querier) TickAccumulatorTrackers(grpcCtx context.Context,
 	return q.Q.TickAccumulatorTrackers(ctx, *req)
 }
 
+func (q Querier) PositionPerformance(grpcCtx context.Context,
+	req *queryproto.PositionPerformanceRequest,
+) (*queryproto.PositionPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PositionPerformance(ctx, *req)
+}
+
 func (q Querier) PositionById(grpcCtx context.Context,
 	req *queryproto.PositionByIdRequest,
 ) (*queryproto.PositionByIdResponse, error) {
@@ -131,6 +141,16 @@ func (q Querier) LiquidityNetInDirection(grpcCtx context.Context,
 	return q.Q.LiquidityNetInDirection(ctx, *req)
 }
 
+func (q Querier) LiquidityDepth(grpcCtx context.Context,
+	req *queryproto.LiquidityDepthRequest,
+) (*queryproto.LiquidityDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.LiquidityDepth(ctx, *req)
+}
+
 func (q Querier) IncentiveRecords(grpcCtx context.Context,
 	req *queryproto.IncentiveRecordsRequest,
 ) (*queryproto.IncentiveRecordsResponse, error) {
@@ -151,6 +171,16 @@ func (q Querier) GetTotalLiquidity(grpcCtx context.Context,
 	return q.Q.GetTotalLiquidity(ctx, *req)
 }
 
+func (q Querier) EffectiveSpreadFactor(grpcCtx context.Context,
+	req *queryproto.EffectiveSpreadFactorRequest,
+) (*queryproto.EffectiveSpreadFactorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EffectiveSpreadFactor(ctx, *req)
+}
+
 func (q Querier) ClaimableSpreadRewards(grpcCtx context.Context,
 	req *queryproto.ClaimableSpreadRewardsRequest,
 ) (*queryproto.ClaimableSpreadRewardsResponse, error) {
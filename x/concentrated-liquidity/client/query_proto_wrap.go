@@ -10,6 +10,7 @@ import (
 	cl "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity"
 	clquery "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
 )
 
 // Querier defines a wrapper around the x/concentrated-liquidity keeper providing gRPC method
@@ -89,6 +90,41 @@ func (q Querier) PositionById(ctx sdk.Context, req clquery.PositionByIdRequest)
 	}, nil
 }
 
+// PositionPerformance returns the lifetime performance of the position with the specified id.
+// Besides the current breakdown of the position, it returns the amounts initially deposited and partially withdrawn,
+// the spread rewards and incentives claimed so far and the time the position spent in range.
+// Returns a NotFound error if the position was created before its performance was recorded.
+func (q Querier) PositionPerformance(ctx sdk.Context, req clquery.PositionPerformanceRequest) (*clquery.PositionPerformanceResponse, error) {
+	performance, found, err := q.Keeper.GetPositionPerformance(ctx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, types.PositionPerformanceNotFoundError{PositionId: req.PositionId}.Error())
+	}
+
+	positionResponse, err := q.PositionById(ctx, clquery.PositionByIdRequest{PositionId: req.PositionId})
+	if err != nil {
+		return nil, err
+	}
+
+	timeInRange, err := q.Keeper.GetPositionTimeInRange(ctx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.PositionPerformanceResponse{
+		Position:             positionResponse.Position,
+		InitialAsset0:        performance.InitialAsset0,
+		InitialAsset1:        performance.InitialAsset1,
+		ClaimedSpreadRewards: performance.ClaimedSpreadRewards,
+		ClaimedIncentives:    performance.ClaimedIncentives,
+		TimeInRange:          timeInRange,
+		WithdrawnAsset0:      performance.WithdrawnAsset0,
+		WithdrawnAsset1:      performance.WithdrawnAsset1,
+	}, nil
+}
+
 // Pools returns all concentrated pools in existence.
 func (q Querier) Pools(
 	ctx sdk.Context,
@@ -335,3 +371,36 @@ func (q Querier) NumPoolPositions(ctx sdk.Context, req clquery.NumPoolPositionsR
 		PositionCount: uint64(len(positionIDs)),
 	}, nil
 }
+
+// EffectiveSpreadFactor returns the spread factor currently charged by the swaps of the pool, along with its
+// volatility if the pool is in the dynamic spread factor mode.
+func (q Querier) EffectiveSpreadFactor(ctx sdk.Context, req clquery.EffectiveSpreadFactorRequest) (*clquery.EffectiveSpreadFactorResponse, error) {
+	pool, err := q.Keeper.GetConcentratedPoolById(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	state, isDynamic, err := q.Keeper.GetDynamicSpreadFactorState(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	volatility := osmomath.ZeroDec()
+	if isDynamic {
+		volatility = state.Volatility
+	}
+
+	return &clquery.EffectiveSpreadFactorResponse{
+		SpreadFactor: pool.GetSpreadFactor(ctx),
+		IsDynamic:    isDynamic,
+		Volatility:   volatility,
+	}, nil
+}
+
+// LiquidityDepth returns the bid and ask ladders of the pool, with the token amounts its liquidity trades at each
+// price level, up to the given price impact.
+func (q Querier) LiquidityDepth(ctx sdk.Context, req clquery.LiquidityDepthRequest) (*clquery.LiquidityDepthResponse, error) {
+	if req.Pagination != nil && len(req.Pagination.Key) > 0 && len(req.Pagination.Key) != 8 {
+		return nil, status.Error(codes.InvalidArgument, "pagination key must be 8 bytes long")
+	}
+	return q.Keeper.GetLiquidityDepth(ctx, req.PoolId, req.BucketWidth, req.MaxPriceImpact, req.Pagination)
+}
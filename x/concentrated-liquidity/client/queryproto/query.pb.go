@@ -15,6 +15,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	github_com_osmosis_labs_osmosis_osmomath "github.com/osmosis-labs/osmosis/osmomath"
 	model "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/model"
 	types1 "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
@@ -22,15 +23,18 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1717,6 +1721,428 @@ func (m *NumNextInitializedTicksResponse) GetCurrentTick() int64 {
 	return 0
 }
 
+// =============================== EffectiveSpreadFactor
+type EffectiveSpreadFactorRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *EffectiveSpreadFactorRequest) Reset()         { *m = EffectiveSpreadFactorRequest{} }
+func (m *EffectiveSpreadFactorRequest) String() string { return proto.CompactTextString(m) }
+func (*EffectiveSpreadFactorRequest) ProtoMessage()    {}
+func (*EffectiveSpreadFactorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{34}
+}
+func (m *EffectiveSpreadFactorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveSpreadFactorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveSpreadFactorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EffectiveSpreadFactorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveSpreadFactorRequest.Merge(m, src)
+}
+func (m *EffectiveSpreadFactorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveSpreadFactorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveSpreadFactorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveSpreadFactorRequest proto.InternalMessageInfo
+
+func (m *EffectiveSpreadFactorRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type EffectiveSpreadFactorResponse struct {
+	SpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=spread_factor,json=spreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spread_factor" yaml:"spread_factor"`
+	IsDynamic    bool                        `protobuf:"varint,2,opt,name=is_dynamic,json=isDynamic,proto3" json:"is_dynamic,omitempty" yaml:"is_dynamic"`
+	// volatility is the volatility of the pool in basis points of price movement
+	// per block, zero if the pool is not in the dynamic spread factor mode.
+	Volatility cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=volatility,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volatility" yaml:"volatility"`
+}
+
+func (m *EffectiveSpreadFactorResponse) Reset()         { *m = EffectiveSpreadFactorResponse{} }
+func (m *EffectiveSpreadFactorResponse) String() string { return proto.CompactTextString(m) }
+func (*EffectiveSpreadFactorResponse) ProtoMessage()    {}
+func (*EffectiveSpreadFactorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{35}
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveSpreadFactorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveSpreadFactorResponse.Merge(m, src)
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveSpreadFactorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveSpreadFactorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveSpreadFactorResponse proto.InternalMessageInfo
+
+func (m *EffectiveSpreadFactorResponse) GetIsDynamic() bool {
+	if m != nil {
+		return m.IsDynamic
+	}
+	return false
+}
+
+// =============================== LiquidityDepth
+type LiquidityDepthRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// bucket_width is the width of the price levels, in units of token1 per
+	// token0. The price levels are bounded by its multiples.
+	BucketWidth cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=bucket_width,json=bucketWidth,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bucket_width" yaml:"bucket_width"`
+	// max_price_impact bounds the ladders to the prices within max_price_impact
+	// of the spot price. It ranges from (0,1).
+	MaxPriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact" yaml:"max_price_impact"`
+	// pagination paginates the price levels of both ladders, from the spot
+	// price outwards.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *LiquidityDepthRequest) Reset()         { *m = LiquidityDepthRequest{} }
+func (m *LiquidityDepthRequest) String() string { return proto.CompactTextString(m) }
+func (*LiquidityDepthRequest) ProtoMessage()    {}
+func (*LiquidityDepthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{36}
+}
+func (m *LiquidityDepthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityDepthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityDepthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityDepthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityDepthRequest.Merge(m, src)
+}
+func (m *LiquidityDepthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityDepthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityDepthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityDepthRequest proto.InternalMessageInfo
+
+func (m *LiquidityDepthRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *LiquidityDepthRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// LiquidityDepthLevel is a price level of a ladder. The amounts exclude the
+// spread factor and the taker fee.
+type LiquidityDepthLevel struct {
+	// price is the price of token0 in units of token1 bounding the level away
+	// from the spot price.
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price" yaml:"price"`
+	// amount0 and amount1 are the amounts of token0 and token1 traded by the
+	// pool between the previous level and this one.
+	Amount0 cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount0,proto3,customtype=cosmossdk.io/math.Int" json:"amount0" yaml:"amount0"`
+	Amount1 cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount1,proto3,customtype=cosmossdk.io/math.Int" json:"amount1" yaml:"amount1"`
+	// cumulative_amount0 and cumulative_amount1 are the amounts of token0 and
+	// token1 traded by the pool between the spot price and this level.
+	CumulativeAmount0 cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=cumulative_amount0,json=cumulativeAmount0,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_amount0" yaml:"cumulative_amount0"`
+	CumulativeAmount1 cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=cumulative_amount1,json=cumulativeAmount1,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_amount1" yaml:"cumulative_amount1"`
+}
+
+func (m *LiquidityDepthLevel) Reset()         { *m = LiquidityDepthLevel{} }
+func (m *LiquidityDepthLevel) String() string { return proto.CompactTextString(m) }
+func (*LiquidityDepthLevel) ProtoMessage()    {}
+func (*LiquidityDepthLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{37}
+}
+func (m *LiquidityDepthLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityDepthLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityDepthLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityDepthLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityDepthLevel.Merge(m, src)
+}
+func (m *LiquidityDepthLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityDepthLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityDepthLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityDepthLevel proto.InternalMessageInfo
+
+type LiquidityDepthResponse struct {
+	SpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=spot_price,json=spotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spot_price" yaml:"spot_price"`
+	// bids are the price levels below the spot price, at which the pool buys
+	// token0 in for token1 out.
+	Bids []LiquidityDepthLevel `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids"`
+	// asks are the price levels above the spot price, at which the pool sells
+	// token0 out for token1 in.
+	Asks       []LiquidityDepthLevel `protobuf:"bytes,3,rep,name=asks,proto3" json:"asks"`
+	Pagination *query.PageResponse   `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *LiquidityDepthResponse) Reset()         { *m = LiquidityDepthResponse{} }
+func (m *LiquidityDepthResponse) String() string { return proto.CompactTextString(m) }
+func (*LiquidityDepthResponse) ProtoMessage()    {}
+func (*LiquidityDepthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{38}
+}
+func (m *LiquidityDepthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityDepthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityDepthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityDepthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityDepthResponse.Merge(m, src)
+}
+func (m *LiquidityDepthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityDepthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityDepthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityDepthResponse proto.InternalMessageInfo
+
+func (m *LiquidityDepthResponse) GetBids() []LiquidityDepthLevel {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *LiquidityDepthResponse) GetAsks() []LiquidityDepthLevel {
+	if m != nil {
+		return m.Asks
+	}
+	return nil
+}
+
+func (m *LiquidityDepthResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// =============================== PositionPerformance
+type PositionPerformanceRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+}
+
+func (m *PositionPerformanceRequest) Reset()         { *m = PositionPerformanceRequest{} }
+func (m *PositionPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*PositionPerformanceRequest) ProtoMessage()    {}
+func (*PositionPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{39}
+}
+func (m *PositionPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionPerformanceRequest.Merge(m, src)
+}
+func (m *PositionPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionPerformanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionPerformanceRequest proto.InternalMessageInfo
+
+func (m *PositionPerformanceRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+type PositionPerformanceResponse struct {
+	// position is the current state of the position.
+	Position model.FullPositionBreakdown `protobuf:"bytes,1,opt,name=position,proto3" json:"position"`
+	// initial_asset0 and initial_asset1 are the amounts of tokens deposited in
+	// the position, at its creation and when adding to it.
+	InitialAsset0 types2.Coin `protobuf:"bytes,2,opt,name=initial_asset0,json=initialAsset0,proto3" json:"initial_asset0" yaml:"initial_asset0"`
+	InitialAsset1 types2.Coin `protobuf:"bytes,3,opt,name=initial_asset1,json=initialAsset1,proto3" json:"initial_asset1" yaml:"initial_asset1"`
+	// claimed_spread_rewards and claimed_incentives are the rewards claimed by
+	// the position to date, excluding the claimable ones.
+	ClaimedSpreadRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=claimed_spread_rewards,json=claimedSpreadRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_spread_rewards" yaml:"claimed_spread_rewards"`
+	ClaimedIncentives    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=claimed_incentives,json=claimedIncentives,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_incentives" yaml:"claimed_incentives"`
+	// time_in_range is the time the current tick of the pool spent in the range
+	// of the position since its creation.
+	TimeInRange time.Duration `protobuf:"bytes,6,opt,name=time_in_range,json=timeInRange,proto3,stdduration" json:"time_in_range" yaml:"time_in_range"`
+	// withdrawn_asset0 and withdrawn_asset1 are the amounts of tokens withdrawn
+	// from the position by its partial withdrawals.
+	WithdrawnAsset0 types2.Coin `protobuf:"bytes,7,opt,name=withdrawn_asset0,json=withdrawnAsset0,proto3" json:"withdrawn_asset0" yaml:"withdrawn_asset0"`
+	WithdrawnAsset1 types2.Coin `protobuf:"bytes,8,opt,name=withdrawn_asset1,json=withdrawnAsset1,proto3" json:"withdrawn_asset1" yaml:"withdrawn_asset1"`
+}
+
+func (m *PositionPerformanceResponse) Reset()         { *m = PositionPerformanceResponse{} }
+func (m *PositionPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*PositionPerformanceResponse) ProtoMessage()    {}
+func (*PositionPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{40}
+}
+func (m *PositionPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionPerformanceResponse.Merge(m, src)
+}
+func (m *PositionPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionPerformanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionPerformanceResponse proto.InternalMessageInfo
+
+func (m *PositionPerformanceResponse) GetPosition() model.FullPositionBreakdown {
+	if m != nil {
+		return m.Position
+	}
+	return model.FullPositionBreakdown{}
+}
+
+func (m *PositionPerformanceResponse) GetInitialAsset0() types2.Coin {
+	if m != nil {
+		return m.InitialAsset0
+	}
+	return types2.Coin{}
+}
+
+func (m *PositionPerformanceResponse) GetInitialAsset1() types2.Coin {
+	if m != nil {
+		return m.InitialAsset1
+	}
+	return types2.Coin{}
+}
+
+func (m *PositionPerformanceResponse) GetClaimedSpreadRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimedSpreadRewards
+	}
+	return nil
+}
+
+func (m *PositionPerformanceResponse) GetClaimedIncentives() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimedIncentives
+	}
+	return nil
+}
+
+func (m *PositionPerformanceResponse) GetTimeInRange() time.Duration {
+	if m != nil {
+		return m.TimeInRange
+	}
+	return 0
+}
+
+func (m *PositionPerformanceResponse) GetWithdrawnAsset0() types2.Coin {
+	if m != nil {
+		return m.WithdrawnAsset0
+	}
+	return types2.Coin{}
+}
+
+func (m *PositionPerformanceResponse) GetWithdrawnAsset1() types2.Coin {
+	if m != nil {
+		return m.WithdrawnAsset1
+	}
+	return types2.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
 	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
@@ -1752,6 +2178,13 @@ func init() {
 	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
 	proto.RegisterType((*NumNextInitializedTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksRequest")
 	proto.RegisterType((*NumNextInitializedTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksResponse")
+	proto.RegisterType((*EffectiveSpreadFactorRequest)(nil), "osmosis.concentratedliquidity.v1beta1.EffectiveSpreadFactorRequest")
+	proto.RegisterType((*EffectiveSpreadFactorResponse)(nil), "osmosis.concentratedliquidity.v1beta1.EffectiveSpreadFactorResponse")
+	proto.RegisterType((*LiquidityDepthRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthRequest")
+	proto.RegisterType((*LiquidityDepthLevel)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthLevel")
+	proto.RegisterType((*LiquidityDepthResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthResponse")
+	proto.RegisterType((*PositionPerformanceRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionPerformanceRequest")
+	proto.RegisterType((*PositionPerformanceResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionPerformanceResponse")
 }
 
 func init() {
@@ -1759,155 +2192,197 @@ func init() {
 }
 
 var fileDescriptor_5da291368ba4d8e3 = []byte{
-	// 2354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x4e, 0x39, 0x3f, 0x9b, 0x79, 0x71, 0x9c, 0xa4, 0xec, 0xd8, 0xce, 0x24, 0x99, 0xc9, 0x16,
-	0x84, 0xb5, 0x48, 0x32, 0x43, 0xfe, 0x08, 0xf9, 0xdb, 0xc4, 0x63, 0xaf, 0xa3, 0xd1, 0x3a, 0x5e,
-	0xa7, 0x13, 0x03, 0x42, 0x88, 0xde, 0x9e, 0xee, 0xf2, 0xb8, 0x35, 0x3d, 0x5d, 0xe3, 0xee, 0xea,
-	0x24, 0x66, 0x89, 0xb4, 0xda, 0x3d, 0x22, 0xc1, 0x22, 0xae, 0x08, 0x09, 0x71, 0x41, 0x2b, 0x8e,
-	0x5c, 0xe0, 0x82, 0xe0, 0x80, 0x22, 0x0e, 0xab, 0x95, 0x10, 0x12, 0xda, 0xc3, 0x2c, 0x24, 0x1c,
-	0x90, 0x16, 0x90, 0x30, 0x17, 0x8e, 0xa8, 0xab, 0xab, 0x7f, 0x66, 0xa6, 0xc7, 0xee, 0x19, 0x9b,
-	0x13, 0x27, 0x4f, 0xf5, 0xab, 0xf7, 0xde, 0xf7, 0x7e, 0xea, 0x75, 0xbd, 0xd7, 0x86, 0x8b, 0xcc,
-	0x6d, 0x32, 0xd7, 0x74, 0xcb, 0x3a, 0xb3, 0x75, 0x6a, 0x73, 0x47, 0xe3, 0xd4, 0xb0, 0xcc, 0x75,
-	0xcf, 0x34, 0x4c, 0xbe, 0x51, 0x7e, 0x7c, 0xb1, 0x46, 0xb9, 0x76, 0xb1, 0xbc, 0xee, 0x51, 0x67,
-	0xa3, 0xd4, 0x72, 0x18, 0x67, 0xf8, 0xac, 0x64, 0x29, 0xa5, 0xb2, 0x94, 0x24, 0x4b, 0x7e, 0xa2,
-	0xce, 0xea, 0x4c, 0x70, 0x94, 0xfd, 0x5f, 0x01, 0x73, 0xfe, 0x8b, 0x5b, 0xeb, 0x6b, 0x69, 0x8e,
-	0xd6, 0x74, 0xe5, 0xde, 0xab, 0xd9, 0xb0, 0x71, 0x53, 0x6f, 0xa8, 0xa6, 0xbd, 0x1a, 0xaa, 0x28,
-	0xe8, 0x82, 0xaf, 0x5c, 0xd3, 0x5c, 0x1a, 0x6d, 0xd2, 0x99, 0x69, 0x87, 0x10, 0x92, 0x74, 0x61,
-	0x58, 0xb4, 0xab, 0xa5, 0xd5, 0x4d, 0x5b, 0xe3, 0x26, 0x0b, 0xf7, 0x9e, 0xaa, 0x33, 0x56, 0xb7,
-	0x68, 0x59, 0x6b, 0x99, 0x65, 0xcd, 0xb6, 0x19, 0x17, 0xc4, 0x10, 0xe0, 0x09, 0x49, 0x15, 0xab,
-	0x9a, 0xb7, 0x5a, 0xd6, 0xec, 0x8d, 0x90, 0x14, 0x28, 0x51, 0x03, 0x07, 0x04, 0x0b, 0x49, 0xba,
-	0x92, 0xcd, 0xac, 0x16, 0x73, 0xcd, 0x04, 0x92, 0x5b, 0xd9, 0xb8, 0x4c, 0x41, 0x34, 0x1f, 0x53,
-	0xd5, 0xa1, 0x3a, 0x73, 0x8c, 0x80, 0x9b, 0xfc, 0x12, 0xc1, 0xc4, 0x8a, 0x4b, 0x9d, 0x65, 0x29,
-	0xd4, 0x55, 0xe8, 0xba, 0x47, 0x5d, 0x8e, 0xcf, 0xc3, 0x2b, 0x9a, 0x61, 0x38, 0xd4, 0x75, 0xa7,
-	0xd1, 0x19, 0x34, 0x93, 0xab, 0xe0, 0xcd, 0x76, 0x71, 0x6c, 0x43, 0x6b, 0x5a, 0x37, 0x88, 0x24,
-	0x10, 0x25, 0xdc, 0x82, 0xcf, 0xc1, 0x2b, 0x2d, 0xc6, 0x2c, 0xd5, 0x34, 0xa6, 0x47, 0xce, 0xa0,
-	0x99, 0x7d, 0xc9, 0xdd, 0x92, 0x40, 0x94, 0x03, 0xfe, 0xaf, 0xaa, 0x81, 0x17, 0x00, 0x62, 0x7f,
-	0x4e, 0xef, 0x3d, 0x83, 0x66, 0x0e, 0x5d, 0xfa, 0x42, 0x49, 0xba, 0xc2, 0x77, 0x7e, 0x29, 0xc8,
-	0x2a, 0x09, 0xbd, 0xb4, 0xac, 0xd5, 0xa9, 0x84, 0xa5, 0x24, 0x38, 0xc9, 0x6f, 0x11, 0x1c, 0xef,
-	0xc2, 0xee, 0xb6, 0x98, 0xed, 0x52, 0xfc, 0x36, 0xe4, 0x42, 0x2f, 0xf9, 0xf0, 0xf7, 0xce, 0x1c,
-	0xba, 0x74, 0xab, 0x94, 0x29, 0x3b, 0x4b, 0x0b, 0x9e, 0x65, 0x85, 0x02, 0x2b, 0x0e, 0xd5, 0x1a,
-	0x06, 0x7b, 0x62, 0x57, 0xf6, 0x3d, 0x6f, 0x17, 0xf7, 0x28, 0xb1, 0x50, 0x7c, 0xaf, 0xc3, 0x86,
-	0x11, 0x61, 0xc3, 0x6b, 0xdb, 0xda, 0x10, 0xc0, 0xeb, 0x30, 0x62, 0x09, 0xc6, 0x23, 0x75, 0x1b,
-	0x55, 0x23, 0x74, 0xff, 0x35, 0x38, 0x14, 0x2a, 0xf3, 0x9d, 0x8a, 0x84, 0x53, 0x27, 0x37, 0xdb,
-	0x45, 0x1c, 0x3a, 0x35, 0x22, 0x12, 0x05, 0xc2, 0x55, 0xd5, 0x20, 0x8f, 0x61, 0xa2, 0x53, 0x9e,
-	0x74, 0xc9, 0xb7, 0xe0, 0x60, 0xb8, 0x4b, 0x48, 0xdb, 0x1d, 0x8f, 0x44, 0x32, 0xc9, 0x02, 0x4c,
-	0x2d, 0x79, 0xcd, 0x65, 0xc6, 0xac, 0x9e, 0x54, 0x4a, 0x24, 0x07, 0xda, 0x2e, 0x39, 0xc8, 0x37,
-	0x61, 0xba, 0x57, 0x8e, 0xb4, 0xe1, 0x2e, 0x8c, 0x45, 0x76, 0xeb, 0xcc, 0xb3, 0xb9, 0x94, 0x77,
-	0x62, 0xb3, 0x5d, 0x3c, 0xde, 0xe5, 0x17, 0x41, 0x27, 0xca, 0xe1, 0xf0, 0xc1, 0x9c, 0x58, 0x7f,
-	0x15, 0x46, 0x7d, 0xd1, 0x11, 0xb4, 0x85, 0x94, 0x30, 0x0e, 0x93, 0x8a, 0xdf, 0x47, 0x70, 0x58,
-	0x0a, 0x96, 0x58, 0xaf, 0xc2, 0x7e, 0xdf, 0xa2, 0x30, 0xfd, 0x26, 0x4a, 0x41, 0x49, 0x28, 0x85,
-	0x25, 0xa1, 0x34, 0x6b, 0x6f, 0x54, 0x72, 0xbf, 0xff, 0xc5, 0x85, 0xfd, 0x3e, 0x5f, 0x55, 0x09,
-	0x76, 0xef, 0x5e, 0x5e, 0x1d, 0x81, 0xc3, 0xcb, 0xa2, 0x66, 0x4a, 0xb8, 0x64, 0x05, 0xc6, 0xc2,
-	0x07, 0x12, 0xe2, 0x1c, 0x1c, 0x08, 0xca, 0xaa, 0x4c, 0x88, 0xb3, 0xdb, 0x24, 0x44, 0xc0, 0x2e,
-	0x23, 0x2f, 0x59, 0xc9, 0x87, 0x08, 0x8e, 0x3e, 0x32, 0xf5, 0xc6, 0x62, 0xb8, 0x6d, 0x89, 0x72,
-	0xfc, 0x36, 0x1c, 0x8e, 0xd8, 0x54, 0x9b, 0x72, 0x59, 0x42, 0x6e, 0xfa, 0x9c, 0x9f, 0xb4, 0x8b,
-	0x27, 0x03, 0x7b, 0x5c, 0xa3, 0x51, 0x32, 0x59, 0xb9, 0xa9, 0xf1, 0xb5, 0xd2, 0x22, 0xad, 0x6b,
-	0xfa, 0xc6, 0x3c, 0xd5, 0x37, 0xdb, 0xc5, 0x89, 0x20, 0x94, 0x1d, 0x12, 0x88, 0x32, 0x6a, 0x25,
-	0x35, 0x5c, 0x01, 0x90, 0xe5, 0xdd, 0xa0, 0x4f, 0x85, 0x9f, 0xf6, 0x56, 0x8e, 0x6f, 0xb6, 0x8b,
-	0xc7, 0x02, 0xde, 0x98, 0x46, 0x94, 0x9c, 0xbf, 0xa8, 0x8a, 0xdf, 0xff, 0x40, 0x30, 0x15, 0x01,
-	0x9d, 0xa7, 0x2d, 0xbe, 0xf6, 0x35, 0x93, 0xaf, 0x29, 0x9a, 0x5d, 0xa7, 0x78, 0x15, 0x8e, 0xc6,
-	0x1a, 0xb5, 0x66, 0x94, 0x5e, 0x3b, 0x84, 0x7d, 0x24, 0x5a, 0xcf, 0x0a, 0x99, 0x3e, 0x72, 0x8b,
-	0x3d, 0xa1, 0x8e, 0xea, 0xc3, 0xea, 0x45, 0x1e, 0xd3, 0x88, 0x92, 0x13, 0x0b, 0xdf, 0xbb, 0x3e,
-	0x97, 0xd7, 0x6a, 0x85, 0x5c, 0x7b, 0xbb, 0xb9, 0x62, 0x1a, 0x51, 0x72, 0x62, 0xe1, 0x73, 0x91,
-	0x4f, 0x47, 0xa0, 0x90, 0x0c, 0x4c, 0xd5, 0x9e, 0x37, 0x1d, 0xaa, 0xfb, 0x09, 0x32, 0xcc, 0xe1,
-	0xc4, 0x25, 0x38, 0xc8, 0x59, 0x83, 0xda, 0xaa, 0x19, 0xe4, 0x66, 0xae, 0x32, 0xbe, 0xd9, 0x2e,
-	0x1e, 0x91, 0x3e, 0x97, 0x14, 0xa2, 0xbc, 0x22, 0x7e, 0x56, 0x6d, 0x1f, 0xb5, 0xcb, 0x35, 0x87,
-	0xf7, 0x41, 0x1d, 0xd3, 0x88, 0x92, 0x13, 0x0b, 0x61, 0xeb, 0x75, 0x18, 0xf5, 0x5c, 0xaa, 0xea,
-	0x9e, 0xb4, 0x76, 0xdf, 0x19, 0x34, 0x73, 0xb0, 0x32, 0xb5, 0xd9, 0x2e, 0x8e, 0x4b, 0x6b, 0x13,
-	0x54, 0xa2, 0x80, 0xe7, 0xd2, 0x39, 0x2f, 0x72, 0x53, 0x8d, 0x79, 0xb6, 0x11, 0x30, 0xee, 0xef,
-	0x56, 0x18, 0xd3, 0x88, 0x92, 0x13, 0x8b, 0xa4, 0x42, 0x9b, 0xa9, 0xe2, 0xd9, 0xf4, 0x81, 0x34,
-	0x85, 0x21, 0x35, 0x50, 0xb8, 0xc4, 0x2a, 0x62, 0xf1, 0x93, 0xbd, 0x50, 0xec, 0xeb, 0x61, 0x79,
-	0xce, 0xd6, 0x92, 0x99, 0x65, 0xf8, 0x59, 0x17, 0x56, 0x85, 0x6b, 0x19, 0x4b, 0x70, 0xf7, 0x01,
-	0x93, 0x67, 0x30, 0xce, 0x2d, 0x91, 0xcb, 0x2e, 0x7e, 0x15, 0x46, 0x75, 0xcf, 0x71, 0xa8, 0xcd,
-	0x13, 0xd9, 0xa5, 0x1c, 0x92, 0xcf, 0x84, 0xad, 0x16, 0x1c, 0x0b, 0xb7, 0x44, 0xdc, 0x22, 0x32,
-	0xb9, 0xca, 0x9d, 0x6c, 0x79, 0x3e, 0x1d, 0xf8, 0xa4, 0x47, 0x0a, 0x51, 0x8e, 0xca, 0x67, 0x11,
-	0x54, 0xfc, 0x1e, 0x02, 0x1c, 0x6e, 0x74, 0xd7, 0x1d, 0xae, 0xb6, 0x1c, 0x53, 0xa7, 0x22, 0xa2,
-	0xb9, 0xca, 0x23, 0xa9, 0xaf, 0x5c, 0x37, 0xf9, 0x9a, 0x57, 0x2b, 0xe9, 0xac, 0x59, 0x96, 0xfe,
-	0xb8, 0x60, 0x69, 0x35, 0x37, 0x5c, 0x88, 0xbf, 0x02, 0x46, 0xc5, 0xac, 0x07, 0x18, 0x4e, 0x74,
-	0x62, 0x88, 0x45, 0xc7, 0x20, 0x1e, 0xae, 0x3b, 0x7c, 0x59, 0x3c, 0x7a, 0x13, 0x4e, 0x45, 0x88,
-	0x96, 0x83, 0x93, 0x21, 0x8e, 0xfc, 0x50, 0xef, 0xa7, 0x5f, 0x23, 0x38, 0xdd, 0x47, 0x9a, 0x0c,
-	0x77, 0x0d, 0x72, 0xb1, 0x67, 0x83, 0x38, 0xbf, 0x9e, 0x31, 0xce, 0x7d, 0x6a, 0x53, 0x78, 0xfd,
-	0x88, 0x18, 0xf0, 0x0d, 0x18, 0xad, 0x79, 0x7a, 0x83, 0xf2, 0x8e, 0x02, 0x98, 0xc8, 0xd8, 0x24,
-	0x95, 0x28, 0x87, 0x82, 0x65, 0x50, 0x04, 0xbf, 0x0e, 0xa7, 0xe7, 0x2c, 0xcd, 0x6c, 0x6a, 0x35,
-	0x8b, 0x3e, 0x6c, 0x39, 0x54, 0x33, 0x14, 0xfa, 0x44, 0x73, 0x0c, 0x77, 0xc7, 0x77, 0x8f, 0x1f,
-	0x23, 0x28, 0xf4, 0x13, 0x2d, 0x9d, 0xf3, 0x1d, 0x98, 0xd6, 0xc3, 0x1d, 0xaa, 0x2b, 0xb6, 0xa8,
-	0x4e, 0xb0, 0x47, 0xfa, 0xea, 0x44, 0xc7, 0xdb, 0x2e, 0xf4, 0xcc, 0x1c, 0x33, 0xed, 0xca, 0x6b,
-	0xbe, 0x1b, 0x36, 0xdb, 0xc5, 0xa2, 0x8c, 0x7e, 0x1f, 0x41, 0x44, 0x99, 0xd4, 0x53, 0x51, 0x90,
-	0x15, 0xc8, 0x47, 0xf8, 0xaa, 0xe1, 0x85, 0x78, 0xe7, 0x76, 0xbf, 0x3f, 0x02, 0x27, 0x53, 0xe5,
-	0x4a, 0xa3, 0xd7, 0x61, 0x22, 0xc6, 0x1a, 0x5d, 0xc4, 0x33, 0x18, 0xfc, 0x39, 0x69, 0xf0, 0xc9,
-	0x6e, 0x83, 0x63, 0x21, 0x44, 0x19, 0xd7, 0x7b, 0x55, 0xfb, 0x2a, 0x57, 0x99, 0xb3, 0x4a, 0x4d,
-	0x4e, 0x8d, 0xa4, 0xca, 0x91, 0x01, 0x55, 0xa6, 0x09, 0x21, 0xca, 0x78, 0xf4, 0x38, 0x56, 0x49,
-	0x16, 0xe1, 0xb4, 0x7f, 0x95, 0x99, 0xd5, 0x75, 0xaf, 0xe9, 0x59, 0x1a, 0x67, 0x4e, 0x57, 0x5e,
-	0x0d, 0x74, 0xce, 0x7e, 0x33, 0x02, 0x85, 0x7e, 0xe2, 0xa4, 0x5b, 0x3f, 0x40, 0x70, 0xb2, 0x23,
-	0xf2, 0x6a, 0xdd, 0x61, 0x4f, 0xf8, 0x9a, 0x5a, 0xb7, 0x58, 0x4d, 0xb3, 0xa4, 0x7b, 0x4f, 0xa5,
-	0xda, 0x3a, 0x4f, 0x75, 0x61, 0xee, 0x65, 0xdf, 0xdc, 0x0f, 0x3f, 0x2d, 0x9e, 0x4b, 0xd4, 0x20,
-	0xd9, 0x06, 0x06, 0x7f, 0x2e, 0xb8, 0x46, 0xa3, 0xcc, 0x37, 0x5a, 0xd4, 0x0d, 0x79, 0x5c, 0x65,
-	0xda, 0x4d, 0x64, 0xd5, 0x3d, 0xa1, 0xf3, 0x9e, 0x50, 0x89, 0xbf, 0x8b, 0x60, 0xc2, 0x6b, 0x71,
-	0xb3, 0x49, 0xbb, 0xb0, 0x04, 0x7e, 0xbf, 0x92, 0xb1, 0x0e, 0xac, 0x08, 0x11, 0x8f, 0x1c, 0x4d,
-	0x6f, 0x50, 0xa7, 0x3b, 0x24, 0x69, 0xf2, 0x89, 0x82, 0x83, 0xc7, 0x49, 0x34, 0xe4, 0x7d, 0x04,
-	0x05, 0xbf, 0x3e, 0x25, 0x7c, 0x28, 0x65, 0x0e, 0x15, 0x93, 0x21, 0x2f, 0x5d, 0x9f, 0x8d, 0x40,
-	0xb1, 0x2f, 0x0a, 0x19, 0xca, 0xe7, 0x08, 0xae, 0xa7, 0x86, 0x92, 0xb5, 0xc4, 0x39, 0xa3, 0xaa,
-	0x11, 0xbe, 0x56, 0x55, 0xb6, 0xaa, 0x5a, 0x9a, 0xcb, 0x55, 0xee, 0x68, 0x8f, 0xa9, 0xe3, 0xfe,
-	0x2f, 0x03, 0x7d, 0xa9, 0x37, 0xd0, 0x6f, 0x49, 0x40, 0xd1, 0x6b, 0xfe, 0xad, 0xd5, 0x45, 0xcd,
-	0xe5, 0x8f, 0x42, 0x30, 0xf8, 0x19, 0x1c, 0x91, 0x11, 0xe2, 0xd2, 0xca, 0x1d, 0x05, 0xbf, 0x20,
-	0x83, 0x3f, 0xd9, 0x11, 0xfc, 0x50, 0x34, 0x51, 0xc6, 0xbc, 0xe4, 0x76, 0x97, 0x7c, 0x0f, 0xc1,
-	0x54, 0x74, 0x28, 0x15, 0xd1, 0xea, 0x0f, 0x17, 0xec, 0xdd, 0x6a, 0x8d, 0x3e, 0x42, 0x30, 0xdd,
-	0x0b, 0x48, 0xc6, 0xdd, 0x84, 0x63, 0xdd, 0x83, 0x89, 0xb0, 0x2c, 0x7e, 0x39, 0xa3, 0xbb, 0xba,
-	0x64, 0xcb, 0x77, 0xe5, 0x51, 0xb3, 0x4b, 0xe5, 0xee, 0x75, 0x56, 0xef, 0x22, 0x38, 0x37, 0xb7,
-	0x70, 0xff, 0xbe, 0xe8, 0xdb, 0x8c, 0x45, 0xd3, 0x6e, 0x2c, 0x38, 0xac, 0x39, 0x97, 0x00, 0x19,
-	0x50, 0x42, 0xaf, 0x3f, 0x80, 0x89, 0xa4, 0x05, 0x6a, 0x67, 0x08, 0x8a, 0x89, 0xf2, 0x9e, 0xb2,
-	0x8b, 0x28, 0x58, 0xef, 0x91, 0x4c, 0x4c, 0x38, 0x9f, 0x0d, 0x81, 0x74, 0xf3, 0x75, 0x18, 0xd5,
-	0x57, 0x9b, 0xcd, 0x2e, 0xd5, 0x89, 0xeb, 0x42, 0x92, 0x4a, 0x14, 0xf0, 0x97, 0x52, 0xd5, 0x7d,
-	0x38, 0xbd, 0xe2, 0x52, 0x67, 0xc5, 0xae, 0x31, 0xdb, 0x30, 0xed, 0xfa, 0xce, 0x06, 0x45, 0xe4,
-	0xa7, 0x08, 0x0a, 0xfd, 0xe4, 0x49, 0xb0, 0xef, 0x22, 0xc8, 0x47, 0x83, 0x16, 0xf5, 0x89, 0xc9,
-	0xd7, 0xd4, 0x16, 0x75, 0x4c, 0x66, 0xa8, 0x16, 0xd3, 0x1b, 0x32, 0x3b, 0x6e, 0x67, 0xcc, 0x8e,
-	0x50, 0xbc, 0x7f, 0x97, 0x5a, 0x16, 0x52, 0x16, 0x99, 0xde, 0x90, 0x49, 0x32, 0x15, 0xa9, 0xe9,
-	0x24, 0x93, 0x3c, 0x4c, 0xdf, 0xa3, 0xfc, 0x11, 0xe3, 0x9a, 0x15, 0x5d, 0xc9, 0xc2, 0x3e, 0xfa,
-	0x07, 0x08, 0x4e, 0xa4, 0x10, 0x25, 0x78, 0x0e, 0x47, 0xb8, 0x4f, 0x51, 0xbb, 0xaf, 0x80, 0x5b,
-	0xbc, 0x72, 0xbf, 0x24, 0x4b, 0xd3, 0x4c, 0x86, 0xd2, 0x14, 0xd4, 0xa5, 0x31, 0xde, 0xa1, 0x9d,
-	0x6c, 0x22, 0x28, 0x2c, 0x79, 0xcd, 0x25, 0xfa, 0x94, 0x57, 0x6d, 0x93, 0x9b, 0x9a, 0x65, 0x7e,
-	0x9b, 0x8a, 0xde, 0x66, 0xb8, 0xb3, 0x7f, 0x07, 0xc6, 0xc2, 0x6e, 0x4e, 0x35, 0xa8, 0xcd, 0x9a,
-	0xb2, 0xdb, 0x4b, 0x0c, 0x5a, 0x3a, 0xe9, 0x44, 0x19, 0x95, 0x3d, 0xdf, 0xbc, 0xbf, 0xc4, 0x35,
-	0xc8, 0xdb, 0x5e, 0x53, 0xb5, 0xe9, 0x53, 0xff, 0x0e, 0x1a, 0x21, 0x12, 0x5d, 0x89, 0x2b, 0xda,
-	0x8d, 0x7d, 0x95, 0xb3, 0x9b, 0xed, 0xe2, 0xab, 0x81, 0xb0, 0xfe, 0x7b, 0x89, 0x32, 0x65, 0xa7,
-	0x1b, 0x46, 0x7e, 0x34, 0x02, 0xc5, 0xbe, 0x46, 0xff, 0xdf, 0xb7, 0x5e, 0x97, 0xfe, 0x95, 0x87,
-	0xfd, 0x0f, 0xfc, 0x8a, 0x86, 0x7f, 0x86, 0x40, 0x0c, 0x99, 0x5c, 0x7c, 0x39, 0xf3, 0xa9, 0x89,
-	0x67, 0x64, 0xf9, 0x2b, 0x83, 0x31, 0x05, 0x9e, 0x27, 0x57, 0xde, 0xfb, 0xc3, 0x5f, 0x7f, 0x38,
-	0x52, 0xc2, 0xe7, 0xcb, 0x59, 0xa7, 0xda, 0x3e, 0xc0, 0x9f, 0x23, 0x38, 0x10, 0x8c, 0x99, 0x70,
-	0x66, 0xb5, 0xc9, 0x29, 0x57, 0xfe, 0xea, 0x80, 0x5c, 0x12, 0xed, 0x55, 0x81, 0xb6, 0x8c, 0x2f,
-	0x64, 0x45, 0x1b, 0x60, 0xfc, 0x08, 0xc1, 0xe1, 0x8e, 0x09, 0x34, 0xbe, 0x99, 0xf5, 0x25, 0x9f,
-	0x32, 0x73, 0xcf, 0xdf, 0x1a, 0x8e, 0x59, 0xda, 0x50, 0x11, 0x36, 0xdc, 0xc2, 0x37, 0xca, 0x83,
-	0x7d, 0x47, 0x70, 0xcb, 0xef, 0xc8, 0xea, 0xfc, 0x0c, 0x7f, 0x86, 0xe0, 0x78, 0x6a, 0x77, 0x8b,
-	0xe7, 0x06, 0x6d, 0x61, 0x53, 0x3a, 0xed, 0xfc, 0xfc, 0xce, 0x84, 0x48, 0x43, 0xef, 0x09, 0x43,
-	0x67, 0xf1, 0x9d, 0x8c, 0x86, 0xc6, 0x15, 0x20, 0x1c, 0x92, 0xa9, 0x8e, 0xb0, 0xe9, 0xdf, 0xc9,
-	0x71, 0x60, 0xe7, 0xf0, 0x06, 0xbf, 0x31, 0x28, 0xd4, 0xd4, 0xf1, 0x5a, 0x7e, 0x61, 0xa7, 0x62,
-	0xa4, 0xcd, 0x55, 0x61, 0xf3, 0x1c, 0x9e, 0x1d, 0xd8, 0x66, 0x5b, 0x8c, 0x01, 0xe2, 0xfb, 0x33,
-	0xfe, 0x27, 0x82, 0xc9, 0xf4, 0x2e, 0x1d, 0x67, 0x8d, 0xcf, 0x96, 0xf3, 0x83, 0xfc, 0x1b, 0x3b,
-	0x94, 0x32, 0x64, 0x98, 0xfb, 0x8d, 0x03, 0xf0, 0x5f, 0x10, 0x8c, 0xa7, 0xb4, 0xe7, 0x78, 0x76,
-	0x50, 0x9c, 0x3d, 0x23, 0x83, 0x7c, 0x65, 0x27, 0x22, 0xa4, 0x9d, 0x73, 0xc2, 0xce, 0xdb, 0xf8,
-	0xe6, 0xc0, 0x76, 0xc6, 0x2d, 0x39, 0xfe, 0x1d, 0x82, 0xd1, 0xe4, 0x77, 0x1f, 0x7c, 0x63, 0xc0,
-	0x0b, 0x52, 0xe2, 0xe3, 0x53, 0xfe, 0xe6, 0x50, 0xbc, 0xd2, 0x9c, 0xdb, 0xc2, 0x9c, 0x6b, 0xf8,
-	0xea, 0x80, 0x65, 0x48, 0xad, 0x6d, 0xa8, 0xa6, 0x81, 0xff, 0x86, 0x60, 0x32, 0xbd, 0xef, 0xcf,
-	0x9c, 0x9d, 0x5b, 0x4e, 0x21, 0x32, 0x67, 0xe7, 0xd6, 0xc3, 0x07, 0x32, 0x2b, 0xcc, 0xbc, 0x89,
-	0xaf, 0x0f, 0xf0, 0x7e, 0x53, 0x35, 0x5f, 0x5e, 0x94, 0x97, 0x7f, 0x44, 0x70, 0xb4, 0xbb, 0x33,
-	0xc2, 0xaf, 0x0f, 0xd7, 0xf6, 0x44, 0xe6, 0xdd, 0x19, 0x9a, 0x5f, 0x1a, 0x76, 0x57, 0x18, 0x76,
-	0x03, 0x7f, 0xa5, 0x3c, 0xdc, 0x87, 0x65, 0x17, 0xff, 0x1d, 0xc1, 0x54, 0x9f, 0x86, 0x3f, 0x73,
-	0x59, 0xdd, 0x7a, 0x6c, 0x91, 0xb9, 0xac, 0x6e, 0x33, 0x77, 0x18, 0xf8, 0x9d, 0x29, 0x5e, 0x1e,
-	0x41, 0x14, 0xc3, 0x16, 0x1c, 0xff, 0x6a, 0x04, 0x3e, 0x9f, 0xa5, 0x1b, 0xc3, 0x4a, 0xd6, 0x62,
-	0x91, 0xbd, 0xb9, 0xcc, 0x3f, 0xdc, 0x55, 0x99, 0xd2, 0x2b, 0xa6, 0xf0, 0x8a, 0x8e, 0xb5, 0xac,
-	0x15, 0x29, 0xd1, 0x3d, 0xaa, 0x96, 0x69, 0x37, 0xd4, 0x55, 0x87, 0x35, 0xd5, 0x24, 0x53, 0xf9,
-	0x9d, 0xb4, 0xee, 0xf6, 0x19, 0xfe, 0x0f, 0x82, 0xc9, 0xf4, 0x7e, 0x30, 0xf3, 0x71, 0xdf, 0xb2,
-	0x3d, 0xcd, 0x7c, 0xdc, 0xb7, 0x6e, 0x4a, 0xc9, 0x03, 0xe1, 0x92, 0x37, 0x71, 0x35, 0xa3, 0x4b,
-	0x3c, 0x97, 0x3a, 0xaa, 0x17, 0xca, 0x53, 0xd3, 0xee, 0x5a, 0x9f, 0x20, 0x38, 0xd6, 0xd3, 0x48,
-	0xe2, 0xac, 0xe7, 0xb7, 0x5f, 0x7f, 0x9a, 0xbf, 0x3b, 0xbc, 0x80, 0x21, 0x0f, 0x45, 0x9d, 0x72,
-	0xb5, 0xab, 0xe9, 0x15, 0x57, 0xab, 0x3e, 0xcd, 0x59, 0xe6, 0x1a, 0xb0, 0x75, 0x47, 0x9b, 0xb9,
-	0x06, 0x6c, 0xd3, 0x23, 0x0e, 0x7c, 0xb5, 0xea, 0xdf, 0xac, 0x56, 0xd6, 0x9e, 0xbf, 0x28, 0xa0,
-	0x8f, 0x5f, 0x14, 0xd0, 0x9f, 0x5f, 0x14, 0xd0, 0x07, 0x2f, 0x0b, 0x7b, 0x3e, 0x7e, 0x59, 0xd8,
-	0xf3, 0xa7, 0x97, 0x85, 0x3d, 0xdf, 0x58, 0xda, 0xee, 0x1b, 0xd7, 0xe3, 0xcb, 0x17, 0xcb, 0x4f,
-	0x3b, 0x34, 0x5f, 0x88, 0x55, 0xeb, 0x96, 0x49, 0x6d, 0x1e, 0xfc, 0x4f, 0x52, 0xf0, 0x0f, 0x04,
-	0x07, 0xc4, 0x9f, 0xcb, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xc8, 0xb0, 0x89, 0xa7, 0x25,
-	0x00, 0x00,
+	// 3029 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x5d, 0x6c, 0x1c, 0x57,
+	0x15, 0xce, 0x6c, 0x9c, 0x9f, 0x3d, 0xb1, 0x9d, 0xe4, 0xda, 0xf1, 0xcf, 0xa6, 0xde, 0x4d, 0x2f,
+	0x94, 0x46, 0xb4, 0xd9, 0xed, 0xe6, 0x87, 0x36, 0x4e, 0xd2, 0xc4, 0x6b, 0xd7, 0xe9, 0x52, 0xc7,
+	0x75, 0xa6, 0x31, 0xad, 0x10, 0x30, 0x9d, 0x9d, 0xb9, 0xbb, 0x1e, 0x79, 0x76, 0x66, 0x33, 0x3f,
+	0x76, 0x4c, 0x89, 0x54, 0xb5, 0x8f, 0x48, 0xd0, 0x8a, 0x07, 0x78, 0x40, 0x48, 0xc0, 0x0b, 0xaa,
+	0xe0, 0xad, 0x2f, 0xf0, 0x82, 0xe0, 0x01, 0x55, 0x08, 0x55, 0x95, 0x50, 0x05, 0xea, 0x83, 0x0b,
+	0x2d, 0x42, 0x48, 0xe5, 0x47, 0x32, 0x3c, 0xf0, 0x88, 0xe6, 0xce, 0x9d, 0xdf, 0x9d, 0x5d, 0xcf,
+	0xce, 0x06, 0x5e, 0x78, 0xf2, 0xde, 0xb9, 0x73, 0xbe, 0xf3, 0x73, 0xcf, 0x3d, 0xf7, 0xdc, 0x73,
+	0xc6, 0x50, 0xd5, 0xcd, 0xb6, 0x6e, 0x2a, 0x66, 0x45, 0xd2, 0x35, 0x89, 0x68, 0x96, 0x21, 0x5a,
+	0x44, 0x56, 0x95, 0xbb, 0xb6, 0x22, 0x2b, 0xd6, 0x4e, 0x65, 0xab, 0xda, 0x20, 0x96, 0x58, 0xad,
+	0xdc, 0xb5, 0x89, 0xb1, 0x53, 0xee, 0x18, 0xba, 0xa5, 0xa3, 0x47, 0x18, 0x49, 0x39, 0x91, 0xa4,
+	0xcc, 0x48, 0x0a, 0x93, 0x2d, 0xbd, 0xa5, 0x53, 0x8a, 0x8a, 0xf3, 0xcb, 0x25, 0x2e, 0x7c, 0xb6,
+	0x3f, 0xbf, 0x8e, 0x68, 0x88, 0x6d, 0x93, 0xbd, 0x7b, 0x29, 0x9d, 0x6c, 0x96, 0x22, 0x6d, 0x0a,
+	0x8a, 0xd6, 0xf4, 0x58, 0x14, 0x25, 0x4a, 0x57, 0x69, 0x88, 0x26, 0xf1, 0x5f, 0x92, 0x74, 0x45,
+	0xf3, 0x44, 0x08, 0xcf, 0x53, 0xc5, 0xfc, 0xb7, 0x3a, 0x62, 0x4b, 0xd1, 0x44, 0x4b, 0xd1, 0xbd,
+	0x77, 0x1f, 0x6a, 0xe9, 0x7a, 0x4b, 0x25, 0x15, 0xb1, 0xa3, 0x54, 0x44, 0x4d, 0xd3, 0x2d, 0x3a,
+	0xe9, 0x09, 0x38, 0xcb, 0x66, 0xe9, 0xa8, 0x61, 0x37, 0x2b, 0xa2, 0xb6, 0xe3, 0x09, 0x11, 0x9f,
+	0x92, 0x6d, 0x23, 0x0c, 0x3c, 0xeb, 0x0a, 0x21, 0xb8, 0x06, 0x72, 0x07, 0x6c, 0xea, 0x62, 0x3a,
+	0xb5, 0x3b, 0xba, 0xa9, 0x84, 0x00, 0xaf, 0xa6, 0xa3, 0x52, 0xe8, 0xa4, 0xb2, 0x45, 0x04, 0x83,
+	0x48, 0xba, 0x21, 0xbb, 0xd4, 0xf8, 0xa7, 0x1c, 0x4c, 0xae, 0x9b, 0xc4, 0x58, 0x63, 0xa0, 0x26,
+	0x4f, 0xee, 0xda, 0xc4, 0xb4, 0xd0, 0xe3, 0x70, 0x44, 0x94, 0x65, 0x83, 0x98, 0xe6, 0x0c, 0x77,
+	0x86, 0x3b, 0x9b, 0xaf, 0xa1, 0xbd, 0xdd, 0xd2, 0xf8, 0x8e, 0xd8, 0x56, 0xe7, 0x31, 0x9b, 0xc0,
+	0xbc, 0xf7, 0x0a, 0x7a, 0x0c, 0x8e, 0x74, 0x74, 0x5d, 0x15, 0x14, 0x79, 0x26, 0x77, 0x86, 0x3b,
+	0x3b, 0x12, 0x7e, 0x9b, 0x4d, 0x60, 0xfe, 0xb0, 0xf3, 0xab, 0x2e, 0xa3, 0x65, 0x80, 0xc0, 0xde,
+	0x33, 0x07, 0xcf, 0x70, 0x67, 0x8f, 0x9d, 0xff, 0x4c, 0x99, 0x99, 0xc2, 0x59, 0x9c, 0xb2, 0xeb,
+	0x75, 0x4c, 0xf4, 0xf2, 0x9a, 0xd8, 0x22, 0x4c, 0x2c, 0x3e, 0x44, 0x89, 0x7f, 0xc9, 0xc1, 0xa9,
+	0x98, 0xec, 0x66, 0x47, 0xd7, 0x4c, 0x82, 0x5e, 0x86, 0xbc, 0x67, 0x25, 0x47, 0xfc, 0x83, 0x67,
+	0x8f, 0x9d, 0xbf, 0x5a, 0x4e, 0xe5, 0xbd, 0xe5, 0x65, 0x5b, 0x55, 0x3d, 0xc0, 0x9a, 0x41, 0xc4,
+	0x4d, 0x59, 0xdf, 0xd6, 0x6a, 0x23, 0xef, 0xec, 0x96, 0x0e, 0xf0, 0x01, 0x28, 0xba, 0x19, 0xd1,
+	0x21, 0x47, 0x75, 0x78, 0x74, 0x5f, 0x1d, 0x5c, 0xf1, 0x22, 0x4a, 0xac, 0xc2, 0x84, 0xcf, 0x6e,
+	0xa7, 0x2e, 0x7b, 0xe6, 0x7f, 0x12, 0x8e, 0x79, 0xcc, 0x1c, 0xa3, 0x72, 0xd4, 0xa8, 0x53, 0x7b,
+	0xbb, 0x25, 0xe4, 0x19, 0xd5, 0x9f, 0xc4, 0x3c, 0x78, 0xa3, 0xba, 0x8c, 0xb7, 0x60, 0x32, 0x8a,
+	0xc7, 0x4c, 0xf2, 0x15, 0x38, 0xea, 0xbd, 0x45, 0xd1, 0x1e, 0x8c, 0x45, 0x7c, 0x4c, 0xbc, 0x0c,
+	0xd3, 0xab, 0x76, 0x7b, 0x4d, 0xd7, 0xd5, 0x2e, 0x57, 0x0a, 0x39, 0x07, 0xb7, 0x9f, 0x73, 0xe0,
+	0x2f, 0xc1, 0x4c, 0x37, 0x0e, 0xd3, 0xe1, 0x06, 0x8c, 0xfb, 0x7a, 0x4b, 0xba, 0xad, 0x59, 0x0c,
+	0x6f, 0x76, 0x6f, 0xb7, 0x74, 0x2a, 0x66, 0x17, 0x3a, 0x8f, 0xf9, 0x31, 0xef, 0xc1, 0x22, 0x1d,
+	0x7f, 0x01, 0x46, 0x1d, 0x68, 0x5f, 0xb4, 0xe5, 0x84, 0x65, 0xcc, 0xe2, 0x8a, 0xdf, 0xe4, 0x60,
+	0x8c, 0x01, 0x33, 0x59, 0x2f, 0xc1, 0x21, 0x47, 0x23, 0xcf, 0xfd, 0x26, 0xcb, 0x6e, 0x5c, 0x28,
+	0x7b, 0x71, 0xa1, 0xbc, 0xa0, 0xed, 0xd4, 0xf2, 0xbf, 0x7e, 0xfb, 0xdc, 0x21, 0x87, 0xae, 0xce,
+	0xbb, 0x6f, 0x3f, 0x38, 0xbf, 0x3a, 0x0e, 0x63, 0x6b, 0x34, 0xa6, 0x32, 0x71, 0xf1, 0x3a, 0x8c,
+	0x7b, 0x0f, 0x98, 0x88, 0x8b, 0x70, 0xd8, 0x0d, 0xbb, 0xcc, 0x21, 0x1e, 0xd9, 0xc7, 0x21, 0x5c,
+	0x72, 0xb6, 0xf2, 0x8c, 0x14, 0xbf, 0xc5, 0xc1, 0x89, 0x3b, 0x8a, 0xb4, 0xb9, 0xe2, 0xbd, 0xb6,
+	0x4a, 0x2c, 0xf4, 0x32, 0x8c, 0xf9, 0x64, 0x82, 0x46, 0x2c, 0x16, 0x42, 0xae, 0x38, 0x94, 0x1f,
+	0xec, 0x96, 0x4e, 0xbb, 0xfa, 0x98, 0xf2, 0x66, 0x59, 0xd1, 0x2b, 0x6d, 0xd1, 0xda, 0x28, 0xaf,
+	0x90, 0x96, 0x28, 0xed, 0x2c, 0x11, 0x69, 0x6f, 0xb7, 0x34, 0xe9, 0x2e, 0x65, 0x04, 0x01, 0xf3,
+	0xa3, 0x6a, 0x98, 0xc3, 0x45, 0x00, 0x16, 0xfe, 0x65, 0x72, 0x8f, 0xda, 0xe9, 0x60, 0xed, 0xd4,
+	0xde, 0x6e, 0xe9, 0xa4, 0x4b, 0x1b, 0xcc, 0x61, 0x3e, 0xef, 0x0c, 0xea, 0xf4, 0xf7, 0xdf, 0x38,
+	0x98, 0xf6, 0x05, 0x5d, 0x22, 0x1d, 0x6b, 0xe3, 0x45, 0xc5, 0xda, 0xe0, 0x45, 0xad, 0x45, 0x50,
+	0x13, 0x4e, 0x04, 0x1c, 0xc5, 0xb6, 0xef, 0x5e, 0x43, 0x8a, 0x7d, 0xdc, 0x1f, 0x2f, 0x50, 0x4c,
+	0x47, 0x72, 0x55, 0xdf, 0x26, 0x86, 0xe0, 0x88, 0xd5, 0x2d, 0x79, 0x30, 0x87, 0xf9, 0x3c, 0x1d,
+	0x38, 0xd6, 0x75, 0xa8, 0xec, 0x4e, 0xc7, 0xa3, 0x3a, 0x18, 0xa7, 0x0a, 0xe6, 0x30, 0x9f, 0xa7,
+	0x03, 0x87, 0x0a, 0x7f, 0x98, 0x83, 0x62, 0x78, 0x61, 0xea, 0xda, 0x92, 0x62, 0x10, 0xc9, 0x71,
+	0x90, 0x2c, 0x9b, 0x13, 0x95, 0xe1, 0xa8, 0xa5, 0x6f, 0x12, 0x4d, 0x50, 0x5c, 0xdf, 0xcc, 0xd7,
+	0x26, 0xf6, 0x76, 0x4b, 0xc7, 0x99, 0xcd, 0xd9, 0x0c, 0xe6, 0x8f, 0xd0, 0x9f, 0x75, 0xcd, 0x91,
+	0xda, 0xb4, 0x44, 0xc3, 0xea, 0x21, 0x75, 0x30, 0x87, 0xf9, 0x3c, 0x1d, 0x50, 0x5d, 0x2f, 0xc3,
+	0xa8, 0x6d, 0x12, 0x41, 0xb2, 0x99, 0xb6, 0x23, 0x67, 0xb8, 0xb3, 0x47, 0x6b, 0xd3, 0x7b, 0xbb,
+	0xa5, 0x09, 0xa6, 0x6d, 0x68, 0x16, 0xf3, 0x60, 0x9b, 0x64, 0xd1, 0xf6, 0xcd, 0xd4, 0xd0, 0x6d,
+	0x4d, 0x76, 0x09, 0x0f, 0xc5, 0x19, 0x06, 0x73, 0x98, 0xcf, 0xd3, 0x41, 0x98, 0xa1, 0xa6, 0x0b,
+	0xf4, 0xd9, 0xcc, 0xe1, 0x24, 0x86, 0xde, 0xac, 0xcb, 0x70, 0x55, 0xaf, 0xd1, 0xc1, 0xf7, 0x0f,
+	0x42, 0xa9, 0xa7, 0x85, 0xd9, 0x3e, 0xdb, 0x08, 0x7b, 0x96, 0xec, 0x78, 0x9d, 0x17, 0x15, 0x9e,
+	0x4c, 0x19, 0x82, 0xe3, 0x1b, 0x8c, 0xed, 0xc1, 0xc0, 0xb7, 0xa8, 0x2f, 0x9b, 0xe8, 0x61, 0x18,
+	0x95, 0x6c, 0xc3, 0x20, 0x9a, 0x15, 0xf2, 0x2e, 0xfe, 0x18, 0x7b, 0x46, 0x75, 0x55, 0xe1, 0xa4,
+	0xf7, 0x8a, 0x4f, 0x4d, 0x57, 0x26, 0x5f, 0xbb, 0x9e, 0xce, 0xcf, 0x67, 0x5c, 0x9b, 0x74, 0xa1,
+	0x60, 0xfe, 0x04, 0x7b, 0xe6, 0x8b, 0x8a, 0x5e, 0xe3, 0x00, 0x79, 0x2f, 0x9a, 0x77, 0x0d, 0x4b,
+	0xe8, 0x18, 0x8a, 0x44, 0xe8, 0x8a, 0xe6, 0x6b, 0x77, 0x18, 0xbf, 0x4a, 0x4b, 0xb1, 0x36, 0xec,
+	0x46, 0x59, 0xd2, 0xdb, 0x15, 0x66, 0x8f, 0x73, 0xaa, 0xd8, 0x30, 0xbd, 0x01, 0xfd, 0x4b, 0xc5,
+	0xa8, 0x29, 0x2d, 0x57, 0x86, 0xd9, 0xa8, 0x0c, 0x01, 0x74, 0x20, 0xc4, 0x0b, 0x77, 0x0d, 0x6b,
+	0x8d, 0x3e, 0x7a, 0x0e, 0x1e, 0xf2, 0x25, 0x5a, 0x73, 0x77, 0x06, 0xdd, 0xf2, 0x99, 0xce, 0xa7,
+	0x9f, 0x73, 0x30, 0xd7, 0x03, 0x8d, 0x2d, 0x77, 0x03, 0xf2, 0x81, 0x65, 0xdd, 0x75, 0x7e, 0x3a,
+	0xe5, 0x3a, 0xf7, 0x88, 0x4d, 0x5e, 0xfa, 0xe1, 0x13, 0xa0, 0x79, 0x18, 0x6d, 0xd8, 0xd2, 0x26,
+	0xb1, 0x22, 0x01, 0x30, 0xe4, 0xb1, 0xe1, 0x59, 0xcc, 0x1f, 0x73, 0x87, 0x6e, 0x10, 0x7c, 0x09,
+	0xe6, 0x16, 0x55, 0x51, 0x69, 0x8b, 0x0d, 0x95, 0xbc, 0xd0, 0x31, 0x88, 0x28, 0xf3, 0x64, 0x5b,
+	0x34, 0x64, 0x73, 0xe8, 0xdc, 0xe3, 0x7b, 0x1c, 0x14, 0x7b, 0x41, 0x33, 0xe3, 0x7c, 0x0d, 0x66,
+	0x24, 0xef, 0x0d, 0xc1, 0xa4, 0xaf, 0x08, 0x86, 0xfb, 0x0e, 0xb3, 0xd5, 0x6c, 0xe4, 0xb4, 0xf3,
+	0x2c, 0xb3, 0xa8, 0x2b, 0x5a, 0xed, 0x51, 0xc7, 0x0c, 0x7b, 0xbb, 0xa5, 0x12, 0x5b, 0xfd, 0x1e,
+	0x40, 0x98, 0x9f, 0x92, 0x12, 0xa5, 0xc0, 0xeb, 0x50, 0xf0, 0xe5, 0xab, 0x7b, 0x09, 0xf1, 0xf0,
+	0x7a, 0xbf, 0x9e, 0x83, 0xd3, 0x89, 0xb8, 0x4c, 0xe9, 0xbb, 0x30, 0x19, 0xc8, 0xea, 0x27, 0xe2,
+	0x29, 0x14, 0xfe, 0x14, 0x53, 0xf8, 0x74, 0x5c, 0xe1, 0x00, 0x04, 0xf3, 0x13, 0x52, 0x37, 0x6b,
+	0x87, 0x65, 0x53, 0x37, 0x9a, 0x44, 0xb1, 0x88, 0x1c, 0x66, 0x99, 0x1b, 0x90, 0x65, 0x12, 0x08,
+	0xe6, 0x27, 0xfc, 0xc7, 0x01, 0x4b, 0xbc, 0x02, 0x73, 0x4e, 0x2a, 0xb3, 0x20, 0x49, 0x76, 0xdb,
+	0x56, 0x45, 0x4b, 0x37, 0x62, 0x7e, 0x35, 0xd0, 0x3e, 0xfb, 0x45, 0x0e, 0x8a, 0xbd, 0xe0, 0x98,
+	0x59, 0xdf, 0xe0, 0xe0, 0x74, 0x64, 0xe5, 0x85, 0x96, 0xa1, 0x6f, 0x5b, 0x1b, 0x42, 0x4b, 0xd5,
+	0x1b, 0xa2, 0xca, 0xcc, 0xfb, 0x50, 0xa2, 0xae, 0x4b, 0x44, 0xa2, 0xea, 0x5e, 0x70, 0xd4, 0x7d,
+	0xeb, 0xc3, 0xd2, 0x63, 0xa1, 0x18, 0xc4, 0xae, 0x89, 0xee, 0x9f, 0x73, 0xa6, 0xbc, 0x59, 0xb1,
+	0x76, 0x3a, 0xc4, 0xf4, 0x68, 0x4c, 0x7e, 0xc6, 0x0c, 0x79, 0xd5, 0x4d, 0xca, 0xf3, 0x26, 0x65,
+	0x89, 0xbe, 0xce, 0xc1, 0xa4, 0xdd, 0xb1, 0x94, 0x36, 0x89, 0xc9, 0xe2, 0xda, 0xfd, 0x62, 0xca,
+	0x38, 0xb0, 0x4e, 0x21, 0xee, 0x18, 0xa2, 0xb4, 0x49, 0x8c, 0xf8, 0x92, 0x24, 0xe1, 0x63, 0x1e,
+	0xb9, 0x8f, 0xc3, 0xd2, 0xe0, 0xd7, 0x39, 0x28, 0x3a, 0xf1, 0x29, 0x64, 0x43, 0x86, 0x99, 0x69,
+	0x4d, 0x32, 0x26, 0x5d, 0x9f, 0xe4, 0xa0, 0xd4, 0x53, 0x0a, 0xb6, 0x94, 0xef, 0x70, 0x70, 0x39,
+	0x71, 0x29, 0xf5, 0x0e, 0xdd, 0x67, 0x44, 0x90, 0xbd, 0x63, 0x55, 0xd0, 0x9b, 0x82, 0x2a, 0x9a,
+	0x96, 0x60, 0x19, 0xe2, 0x16, 0x31, 0xcc, 0xff, 0xe6, 0x42, 0x9f, 0xef, 0x5e, 0xe8, 0xe7, 0x99,
+	0x40, 0xfe, 0x31, 0xff, 0x7c, 0x73, 0x45, 0x34, 0xad, 0x3b, 0x9e, 0x30, 0xe8, 0x3e, 0x1c, 0x67,
+	0x2b, 0x64, 0x31, 0x2d, 0x87, 0x5a, 0xfc, 0x22, 0x5b, 0xfc, 0xa9, 0xc8, 0xe2, 0x7b, 0xd0, 0x98,
+	0x1f, 0xb7, 0xc3, 0xaf, 0x9b, 0xf8, 0x1b, 0x1c, 0x4c, 0xfb, 0x9b, 0x92, 0xa7, 0x57, 0xfd, 0x6c,
+	0x8b, 0xfd, 0xa0, 0xae, 0x46, 0xef, 0x72, 0x30, 0xd3, 0x2d, 0x10, 0x5b, 0x77, 0x05, 0x4e, 0xc6,
+	0x0b, 0x13, 0x5e, 0x58, 0xfc, 0x5c, 0x4a, 0x73, 0xc5, 0xb0, 0xd9, 0x59, 0x79, 0x42, 0x89, 0xb1,
+	0x7c, 0x70, 0x37, 0xab, 0x57, 0x39, 0x78, 0x6c, 0x71, 0xf9, 0xd6, 0x2d, 0x7a, 0x6f, 0x93, 0x57,
+	0x14, 0x6d, 0x73, 0xd9, 0xd0, 0xdb, 0x8b, 0x21, 0x21, 0xdd, 0x19, 0xcf, 0xea, 0xb7, 0x61, 0x32,
+	0xac, 0x81, 0x10, 0x5d, 0x82, 0x52, 0x28, 0xbc, 0x27, 0xbc, 0x85, 0x79, 0x24, 0x75, 0x21, 0x63,
+	0x05, 0x1e, 0x4f, 0x27, 0x01, 0x33, 0xf3, 0x65, 0x18, 0x95, 0x9a, 0xed, 0x76, 0x8c, 0x75, 0x28,
+	0x5d, 0x08, 0xcf, 0x62, 0x1e, 0x9c, 0x21, 0x63, 0x75, 0x0b, 0xe6, 0xd6, 0x4d, 0x62, 0xac, 0x6b,
+	0x0d, 0x5d, 0x93, 0x15, 0xad, 0x35, 0x5c, 0xa1, 0x08, 0xff, 0x90, 0x83, 0x62, 0x2f, 0x3c, 0x26,
+	0xec, 0xab, 0x1c, 0x14, 0xfc, 0x42, 0x8b, 0xb0, 0xad, 0x58, 0x1b, 0x42, 0x87, 0x18, 0x8a, 0x2e,
+	0x0b, 0xaa, 0x2e, 0x6d, 0x32, 0xef, 0xb8, 0x96, 0xd2, 0x3b, 0x3c, 0x78, 0x27, 0x97, 0x5a, 0xa3,
+	0x28, 0x2b, 0xba, 0xb4, 0xc9, 0x9c, 0x64, 0xda, 0x67, 0x13, 0x9d, 0xc6, 0x05, 0x98, 0xb9, 0x49,
+	0xac, 0x3b, 0xba, 0x25, 0xaa, 0x7e, 0x4a, 0xe6, 0xdd, 0xa3, 0xdf, 0xe4, 0x60, 0x36, 0x61, 0x92,
+	0x09, 0x6f, 0xc1, 0x71, 0xcb, 0x99, 0x11, 0xe2, 0x29, 0x60, 0x9f, 0x23, 0xf7, 0x09, 0x16, 0x9a,
+	0xce, 0xa6, 0x08, 0x4d, 0x6e, 0x5c, 0x1a, 0xb7, 0x22, 0xdc, 0xf1, 0x1e, 0x07, 0xc5, 0x55, 0xbb,
+	0xbd, 0x4a, 0xee, 0x59, 0x75, 0x4d, 0xb1, 0x14, 0x51, 0x55, 0xbe, 0x4a, 0xe8, 0xdd, 0x26, 0xdb,
+	0xde, 0xbf, 0x0e, 0xe3, 0xde, 0x6d, 0x4e, 0x90, 0x89, 0xa6, 0xb7, 0xd9, 0x6d, 0x2f, 0x54, 0x68,
+	0x89, 0xce, 0x63, 0x7e, 0x94, 0xdd, 0xf9, 0x96, 0x9c, 0x21, 0x6a, 0x40, 0x41, 0xb3, 0xdb, 0x82,
+	0x46, 0xee, 0x39, 0x39, 0xa8, 0x2f, 0x11, 0xbd, 0x95, 0x98, 0xf4, 0xba, 0x31, 0x52, 0x7b, 0x64,
+	0x6f, 0xb7, 0xf4, 0xb0, 0x0b, 0xd6, 0xfb, 0x5d, 0xcc, 0x4f, 0x6b, 0xc9, 0x8a, 0xe1, 0xef, 0xe6,
+	0xa0, 0xd4, 0x53, 0xe9, 0xff, 0xfb, 0xab, 0x97, 0x73, 0xeb, 0x79, 0xa6, 0xd9, 0x74, 0xce, 0xa8,
+	0x2d, 0x96, 0x05, 0x2f, 0x8b, 0x12, 0xcd, 0xa2, 0x32, 0x64, 0x63, 0x6f, 0xe6, 0x60, 0xae, 0x07,
+	0x9a, 0x5f, 0x72, 0x1d, 0x63, 0x07, 0x78, 0x93, 0x4e, 0x64, 0xaa, 0x9d, 0x44, 0x10, 0x30, 0x3f,
+	0x6a, 0x86, 0x38, 0x39, 0xd9, 0x87, 0x62, 0x0a, 0xf2, 0x8e, 0x26, 0xb6, 0x15, 0x89, 0xda, 0xf7,
+	0x68, 0x38, 0xfb, 0x08, 0xe6, 0x30, 0x9f, 0x57, 0xcc, 0x25, 0xf7, 0x37, 0x7a, 0x09, 0x60, 0x4b,
+	0x57, 0x45, 0x4b, 0x51, 0x03, 0x6b, 0x3f, 0x95, 0x4e, 0x28, 0x06, 0x1c, 0x90, 0x63, 0x3e, 0x84,
+	0x85, 0xdf, 0xcf, 0xc1, 0xa9, 0xe8, 0x85, 0x2d, 0xd3, 0x5e, 0xfb, 0xb2, 0x7f, 0x95, 0xdb, 0x56,
+	0x64, 0x6b, 0x83, 0xed, 0xb4, 0xf9, 0x74, 0x22, 0x46, 0x6f, 0x7b, 0x14, 0xc0, 0xbf, 0xed, 0xbd,
+	0xe8, 0x8c, 0x9c, 0x1d, 0xd0, 0x16, 0xef, 0xb9, 0x97, 0x63, 0x41, 0x69, 0x77, 0x44, 0xc9, 0x62,
+	0x56, 0x78, 0x3a, 0x1d, 0x8b, 0x69, 0x97, 0x45, 0x1c, 0x04, 0xf3, 0xe3, 0x6d, 0xf1, 0x1e, 0xbd,
+	0x60, 0xd7, 0xe9, 0x83, 0x58, 0xc2, 0x30, 0x92, 0x39, 0x61, 0xf8, 0xcd, 0x41, 0x98, 0x88, 0xda,
+	0x75, 0x85, 0x6c, 0x11, 0x15, 0xd5, 0xe1, 0x90, 0x5b, 0x3d, 0x70, 0x3d, 0xeb, 0x42, 0x3a, 0xf1,
+	0x47, 0x99, 0xd9, 0xdd, 0xe2, 0x80, 0x8b, 0x80, 0xea, 0x70, 0xc4, 0xad, 0xf0, 0x3d, 0xc1, 0xcc,
+	0x5d, 0x61, 0x60, 0xa7, 0xba, 0xc1, 0xea, 0x9a, 0x15, 0x3a, 0xd0, 0x5c, 0x2a, 0xe7, 0x40, 0x73,
+	0x7f, 0x05, 0x50, 0x55, 0x66, 0xd6, 0xc1, 0xa0, 0xaa, 0x3e, 0x54, 0x15, 0x29, 0x80, 0x58, 0x86,
+	0xec, 0x64, 0x43, 0x9e, 0x80, 0x23, 0x11, 0x7f, 0xe8, 0x89, 0xea, 0x57, 0x44, 0xe2, 0x00, 0x98,
+	0x3f, 0x19, 0x3c, 0x5c, 0x60, 0x52, 0x27, 0xb1, 0xaa, 0xd2, 0x7a, 0xd9, 0x10, 0xac, 0xaa, 0x09,
+	0xac, 0xaa, 0xf8, 0xcf, 0x39, 0x98, 0x8a, 0x6f, 0x13, 0x16, 0x33, 0x5e, 0x04, 0x30, 0x3b, 0xba,
+	0x57, 0x14, 0xe2, 0x32, 0xec, 0xcd, 0x80, 0x1c, 0xf3, 0x79, 0x67, 0x40, 0x1d, 0x12, 0xdd, 0x81,
+	0x91, 0x86, 0x22, 0x7b, 0x89, 0xf7, 0x7c, 0xa6, 0xea, 0x0b, 0x75, 0x3a, 0x16, 0xed, 0x29, 0x9a,
+	0x83, 0x2a, 0x9a, 0xf4, 0xf8, 0x7a, 0x40, 0xa8, 0x0e, 0x5a, 0x2c, 0x2f, 0x1d, 0xc9, 0x9e, 0x97,
+	0xae, 0x43, 0xc1, 0xcb, 0x76, 0xd6, 0x88, 0xd1, 0xd4, 0x8d, 0xb6, 0xa8, 0x49, 0x64, 0xe8, 0xe2,
+	0xc6, 0x4f, 0x8e, 0xc0, 0xe9, 0x44, 0xdc, 0xff, 0x4d, 0x63, 0x09, 0x09, 0x30, 0xce, 0xb2, 0x02,
+	0x41, 0x34, 0x4d, 0xc2, 0xb6, 0x6c, 0xdf, 0x84, 0x6a, 0x8e, 0xdd, 0x99, 0x58, 0xaa, 0x12, 0x25,
+	0xc7, 0xfc, 0x18, 0x7b, 0xb0, 0x40, 0xc7, 0x5d, 0x0c, 0xaa, 0xac, 0x25, 0x99, 0x91, 0x41, 0x35,
+	0xc6, 0xa0, 0x8a, 0x7e, 0xc0, 0x81, 0x5b, 0x90, 0x22, 0x72, 0xbc, 0xe4, 0x35, 0xb2, 0x5f, 0x6e,
+	0x78, 0x9b, 0x71, 0x9a, 0x0b, 0x55, 0x80, 0xba, 0x60, 0xf0, 0x40, 0xc9, 0xe3, 0x24, 0x03, 0x89,
+	0x94, 0xc6, 0xd0, 0xb7, 0x39, 0x40, 0x1e, 0x7a, 0xa8, 0x5e, 0x74, 0x68, 0x3f, 0x01, 0x6f, 0x31,
+	0x01, 0x67, 0xa3, 0x02, 0x86, 0xaa, 0x45, 0x03, 0x09, 0x77, 0x92, 0x01, 0x84, 0x4a, 0x59, 0x02,
+	0x8c, 0xd1, 0x3b, 0xaf, 0xa2, 0x09, 0x86, 0xa8, 0xb5, 0x08, 0x2d, 0xcf, 0x3b, 0x32, 0xc5, 0x3b,
+	0x6a, 0x4b, 0xac, 0xd3, 0x5e, 0x3b, 0xc3, 0x64, 0x9a, 0xf4, 0xea, 0x12, 0x21, 0x6a, 0xfc, 0x9d,
+	0x0f, 0x4b, 0x1c, 0x7f, 0xcc, 0x79, 0x56, 0xd7, 0xdc, 0xce, 0x0f, 0x81, 0x13, 0xce, 0x2d, 0x43,
+	0x36, 0xc4, 0x6d, 0xcd, 0xf3, 0xb1, 0x23, 0xfb, 0xb9, 0x40, 0x89, 0xf1, 0x60, 0xc7, 0x63, 0x1c,
+	0x00, 0xf3, 0xc7, 0xfd, 0x47, 0xcc, 0xcf, 0xba, 0xd9, 0x54, 0x67, 0x8e, 0x0e, 0xc7, 0xa6, 0xda,
+	0xc5, 0xa6, 0x7a, 0xfe, 0xed, 0x33, 0x70, 0xe8, 0xb6, 0x13, 0x31, 0xd0, 0x8f, 0x38, 0xa0, 0xcd,
+	0x45, 0x13, 0x5d, 0x48, 0x7d, 0x5b, 0x0a, 0x7a, 0xa3, 0x85, 0x8b, 0x83, 0x11, 0xb9, 0xe1, 0x00,
+	0x5f, 0x7c, 0xed, 0xb7, 0x7f, 0xfa, 0x56, 0xae, 0x8c, 0x1e, 0xaf, 0xa4, 0xfd, 0x9a, 0xc1, 0x11,
+	0xf0, 0xc7, 0x1c, 0x1c, 0x76, 0xdb, 0x8b, 0x28, 0x35, 0xdb, 0x70, 0x77, 0xb3, 0x70, 0x69, 0x40,
+	0x2a, 0x26, 0xed, 0x25, 0x2a, 0x6d, 0x05, 0x9d, 0x4b, 0x2b, 0xad, 0x2b, 0xe3, 0xbb, 0x1c, 0x8c,
+	0x45, 0xbe, 0x3c, 0x40, 0x57, 0xd2, 0x16, 0x77, 0x12, 0xbe, 0xb5, 0x28, 0x5c, 0xcd, 0x46, 0xcc,
+	0x74, 0xa8, 0x51, 0x1d, 0xae, 0xa2, 0xf9, 0xca, 0x60, 0xdf, 0x8f, 0x98, 0x95, 0x57, 0xd8, 0xad,
+	0xfc, 0x3e, 0xfa, 0x84, 0x0b, 0xe5, 0xb2, 0xe1, 0xae, 0x06, 0x5a, 0x1c, 0xf4, 0x98, 0x4b, 0xe8,
+	0xb0, 0x14, 0x96, 0x86, 0x03, 0x61, 0x8a, 0xde, 0xa4, 0x8a, 0x2e, 0xa0, 0xeb, 0x29, 0x15, 0x0d,
+	0x6e, 0x7e, 0x5e, 0x73, 0xd4, 0x0d, 0x01, 0xe8, 0x9f, 0xe1, 0x36, 0x70, 0xb4, 0x69, 0x87, 0x9e,
+	0x19, 0x54, 0xd4, 0xc4, 0xb6, 0x6a, 0x61, 0x79, 0x58, 0x18, 0xa6, 0x73, 0x9d, 0xea, 0xbc, 0x88,
+	0x16, 0x06, 0xd6, 0x59, 0xa3, 0xed, 0x9f, 0xa0, 0x6e, 0x8a, 0xfe, 0xce, 0xc1, 0x54, 0x72, 0x77,
+	0x06, 0xa5, 0x5d, 0x9f, 0xbe, 0x7d, 0xa3, 0xc2, 0x33, 0x43, 0xa2, 0x64, 0x5c, 0xe6, 0x5e, 0x6d,
+	0x20, 0xf4, 0x47, 0x0e, 0x26, 0x12, 0xda, 0x32, 0x68, 0x61, 0x50, 0x39, 0xbb, 0x5a, 0x45, 0x85,
+	0xda, 0x30, 0x10, 0x4c, 0xcf, 0x45, 0xaa, 0xe7, 0x35, 0x74, 0x65, 0x60, 0x3d, 0x83, 0xc3, 0x15,
+	0xfd, 0x8a, 0x83, 0xd1, 0xf0, 0xf7, 0x3e, 0x68, 0x7e, 0xc0, 0xc2, 0x58, 0xe8, 0xa3, 0xa3, 0xc2,
+	0x95, 0x4c, 0xb4, 0x4c, 0x9d, 0x6b, 0x54, 0x9d, 0x27, 0xd1, 0xa5, 0x01, 0xc3, 0x90, 0xd0, 0xd8,
+	0x11, 0x14, 0x19, 0xfd, 0x85, 0x83, 0xa9, 0xe4, 0x7e, 0x4f, 0x6a, 0xef, 0xec, 0xdb, 0x7d, 0x4a,
+	0xed, 0x9d, 0xfd, 0x9b, 0x4e, 0x78, 0x81, 0xaa, 0x79, 0x05, 0x5d, 0x1e, 0xe0, 0x7c, 0x13, 0x44,
+	0x07, 0xcf, 0xf7, 0xcb, 0xf7, 0x39, 0x38, 0x11, 0xaf, 0x88, 0xa3, 0xa7, 0xb3, 0x95, 0xbb, 0x7d,
+	0xf5, 0xae, 0x67, 0xa6, 0x67, 0x8a, 0xdd, 0xa0, 0x8a, 0xcd, 0xa3, 0xa7, 0x2a, 0xd9, 0x3e, 0x28,
+	0x34, 0xd1, 0x5f, 0x39, 0x98, 0xee, 0xd1, 0xe8, 0x49, 0x1d, 0x56, 0xfb, 0xb7, 0xab, 0x52, 0x87,
+	0xd5, 0x7d, 0xfa, 0x4d, 0x03, 0x9f, 0x99, 0xf4, 0xf0, 0x70, 0x57, 0xd1, 0x6b, 0xbd, 0xa0, 0x9f,
+	0xe5, 0xe0, 0xd3, 0x69, 0xaa, 0xf0, 0x88, 0x4f, 0x1b, 0x2c, 0xd2, 0x37, 0x15, 0x0a, 0x2f, 0x3c,
+	0x50, 0x4c, 0x66, 0x15, 0x85, 0x5a, 0x45, 0x42, 0x62, 0xda, 0x88, 0x14, 0xea, 0x1a, 0x08, 0xaa,
+	0xa2, 0x6d, 0x0a, 0x4d, 0x43, 0x6f, 0x0b, 0x61, 0xa2, 0xca, 0x2b, 0x49, 0x5d, 0x8d, 0xfb, 0xe8,
+	0xdf, 0x1c, 0x4c, 0x25, 0xf7, 0x01, 0x52, 0x6f, 0xf7, 0xbe, 0x6d, 0x89, 0xd4, 0xdb, 0xbd, 0x7f,
+	0x33, 0x02, 0xdf, 0xa6, 0x26, 0x79, 0x0e, 0xd5, 0x53, 0x9a, 0xc4, 0x36, 0x89, 0x21, 0xd8, 0x1e,
+	0x9e, 0x90, 0x94, 0x6b, 0x7d, 0xc0, 0xc1, 0xc9, 0xae, 0x06, 0x02, 0x4a, 0xbb, 0x7f, 0x7b, 0xf5,
+	0x25, 0x0a, 0x37, 0xb2, 0x03, 0x64, 0xdc, 0x14, 0x2d, 0x62, 0x09, 0xb1, 0x66, 0x07, 0x4d, 0xad,
+	0x7a, 0x14, 0xe5, 0x53, 0xc7, 0x80, 0xfe, 0x9d, 0x8c, 0xd4, 0x31, 0x60, 0x9f, 0xde, 0xc0, 0xc0,
+	0xa9, 0x55, 0xef, 0x26, 0x05, 0xfa, 0x17, 0x07, 0xa7, 0x12, 0xcb, 0xe3, 0xa9, 0xd3, 0xe7, 0x7e,
+	0xa5, 0xfa, 0xd4, 0xe9, 0x73, 0xdf, 0x0a, 0x3d, 0x5e, 0xa3, 0xfa, 0x7e, 0x1e, 0x3d, 0x9b, 0x52,
+	0x5f, 0xe2, 0xa1, 0x09, 0x91, 0xb2, 0x7c, 0xe5, 0x15, 0x7f, 0x13, 0xff, 0x8e, 0x83, 0xf1, 0x68,
+	0x79, 0x0b, 0x5d, 0xcd, 0x54, 0x15, 0xf3, 0x14, 0xbd, 0x96, 0x91, 0x9a, 0x69, 0xf8, 0x2c, 0xd5,
+	0xb0, 0x86, 0x6e, 0x0c, 0x9c, 0x2c, 0xd3, 0xd6, 0x50, 0x48, 0xb3, 0x7f, 0x70, 0xc1, 0x67, 0xd9,
+	0xa1, 0xa2, 0x57, 0xea, 0xd4, 0xb1, 0x77, 0x21, 0x2e, 0x75, 0xea, 0xd8, 0xa7, 0xe6, 0x36, 0x70,
+	0x54, 0xf2, 0x73, 0xad, 0x4e, 0x00, 0xe6, 0x68, 0xeb, 0x97, 0xfc, 0xee, 0xd7, 0x36, 0xde, 0xf9,
+	0xa8, 0xc8, 0xbd, 0xf7, 0x51, 0x91, 0xfb, 0xc3, 0x47, 0x45, 0xee, 0x8d, 0x8f, 0x8b, 0x07, 0xde,
+	0xfb, 0xb8, 0x78, 0xe0, 0xf7, 0x1f, 0x17, 0x0f, 0x7c, 0x71, 0x75, 0xbf, 0xcf, 0xf3, 0xb6, 0x2e,
+	0x54, 0x2b, 0xf7, 0x22, 0x12, 0x9c, 0x0b, 0x44, 0x90, 0x54, 0x85, 0x68, 0x96, 0xfb, 0xef, 0x16,
+	0x6e, 0xa5, 0xe6, 0x30, 0xfd, 0x73, 0xe1, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x40, 0xd3, 0x60,
+	0xa5, 0x82, 0x32, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1962,6 +2437,17 @@ type QueryClient interface {
 	// NumNextInitializedTicks returns the provided number of next initialized
 	// ticks in the direction of swapping the token in denom.
 	NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error)
+	// EffectiveSpreadFactor returns the spread factor currently charged by the
+	// swaps of the given pool, and its volatility if the pool is in the dynamic
+	// spread factor mode.
+	EffectiveSpreadFactor(ctx context.Context, in *EffectiveSpreadFactorRequest, opts ...grpc.CallOption) (*EffectiveSpreadFactorResponse, error)
+	// LiquidityDepth returns the bid and ask ladders of the given pool, with the
+	// token amounts its liquidity trades at each price level, up to the given
+	// price impact.
+	LiquidityDepth(ctx context.Context, in *LiquidityDepthRequest, opts ...grpc.CallOption) (*LiquidityDepthResponse, error)
+	// PositionPerformance returns the lifetime performance of the position with
+	// the given id, along with its current state.
+	PositionPerformance(ctx context.Context, in *PositionPerformanceRequest, opts ...grpc.CallOption) (*PositionPerformanceResponse, error)
 }
 
 type queryClient struct {
@@ -2107,6 +2593,33 @@ func (c *queryClient) NumNextInitializedTicks(ctx context.Context, in *NumNextIn
 	return out, nil
 }
 
+func (c *queryClient) EffectiveSpreadFactor(ctx context.Context, in *EffectiveSpreadFactorRequest, opts ...grpc.CallOption) (*EffectiveSpreadFactorResponse, error) {
+	out := new(EffectiveSpreadFactorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/EffectiveSpreadFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityDepth(ctx context.Context, in *LiquidityDepthRequest, opts ...grpc.CallOption) (*LiquidityDepthResponse, error) {
+	out := new(LiquidityDepthResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityDepth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PositionPerformance(ctx context.Context, in *PositionPerformanceRequest, opts ...grpc.CallOption) (*PositionPerformanceResponse, error) {
+	out := new(PositionPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Pools returns all concentrated liquidity pools
@@ -2149,6 +2662,17 @@ type QueryServer interface {
 	// NumNextInitializedTicks returns the provided number of next initialized
 	// ticks in the direction of swapping the token in denom.
 	NumNextInitializedTicks(context.Context, *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error)
+	// EffectiveSpreadFactor returns the spread factor currently charged by the
+	// swaps of the given pool, and its volatility if the pool is in the dynamic
+	// spread factor mode.
+	EffectiveSpreadFactor(context.Context, *EffectiveSpreadFactorRequest) (*EffectiveSpreadFactorResponse, error)
+	// LiquidityDepth returns the bid and ask ladders of the given pool, with the
+	// token amounts its liquidity trades at each price level, up to the given
+	// price impact.
+	LiquidityDepth(context.Context, *LiquidityDepthRequest) (*LiquidityDepthResponse, error)
+	// PositionPerformance returns the lifetime performance of the position with
+	// the given id, along with its current state.
+	PositionPerformance(context.Context, *PositionPerformanceRequest) (*PositionPerformanceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2200,6 +2724,15 @@ func (*UnimplementedQueryServer) GetTotalLiquidity(ctx context.Context, req *Get
 func (*UnimplementedQueryServer) NumNextInitializedTicks(ctx context.Context, req *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NumNextInitializedTicks not implemented")
 }
+func (*UnimplementedQueryServer) EffectiveSpreadFactor(ctx context.Context, req *EffectiveSpreadFactorRequest) (*EffectiveSpreadFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveSpreadFactor not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityDepth(ctx context.Context, req *LiquidityDepthRequest) (*LiquidityDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityDepth not implemented")
+}
+func (*UnimplementedQueryServer) PositionPerformance(ctx context.Context, req *PositionPerformanceRequest) (*PositionPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionPerformance not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2475,17 +3008,72 @@ func _Query_NumNextInitializedTicks_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
+func _Query_EffectiveSpreadFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EffectiveSpreadFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveSpreadFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/EffectiveSpreadFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveSpreadFactor(ctx, req.(*EffectiveSpreadFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityDepth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityDepth(ctx, req.(*LiquidityDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionPerformance(ctx, req.(*PositionPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
 		},
 		{
 			MethodName: "UserPositions",
@@ -2539,6 +3127,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NumNextInitializedTicks",
 			Handler:    _Query_NumNextInitializedTicks_Handler,
 		},
+		{
+			MethodName: "EffectiveSpreadFactor",
+			Handler:    _Query_EffectiveSpreadFactor_Handler,
+		},
+		{
+			MethodName: "LiquidityDepth",
+			Handler:    _Query_LiquidityDepth_Handler,
+		},
+		{
+			MethodName: "PositionPerformance",
+			Handler:    _Query_PositionPerformance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/concentratedliquidity/v1beta1/query.proto",
@@ -3846,229 +4446,653 @@ func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EffectiveSpreadFactorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *UserPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EffectiveSpreadFactorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveSpreadFactorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UserPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EffectiveSpreadFactorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveSpreadFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveSpreadFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Volatility.Size()
+		i -= size
+		if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.IsDynamic {
+		i--
+		if m.IsDynamic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	{
+		size := m.SpreadFactor.Size()
+		i -= size
+		if _, err := m.SpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PositionByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+func (m *LiquidityDepthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PositionByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Position.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *LiquidityDepthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NumPoolPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LiquidityDepthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MaxPriceImpact.Size()
+		i -= size
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BucketWidth.Size()
+		i -= size
+		if _, err := m.BucketWidth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NumPoolPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionCount != 0 {
-		n += 1 + sovQuery(uint64(m.PositionCount))
+func (m *LiquidityDepthLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *LiquidityDepthLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LiquidityDepthLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.CumulativeAmount1.Size()
+		i -= size
+		if _, err := m.CumulativeAmount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CumulativeAmount0.Size()
+		i -= size
+		if _, err := m.CumulativeAmount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	return n
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidityDepthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityDepthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityDepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *TickLiquidityNet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PositionPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.LiquidityNet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityDepthWithRange) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PositionPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.LiquidityAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.LowerTick != 0 {
-		n += 1 + sovQuery(uint64(m.LowerTick))
+	{
+		size, err := m.WithdrawnAsset1.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.UpperTick != 0 {
-		n += 1 + sovQuery(uint64(m.UpperTick))
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.WithdrawnAsset0.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x3a
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeInRange, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeInRange):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x32
+	if len(m.ClaimedIncentives) > 0 {
+		for iNdEx := len(m.ClaimedIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ClaimedSpreadRewards) > 0 {
+		for iNdEx := len(m.ClaimedSpreadRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedSpreadRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.InitialAsset1.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.InitialAsset0.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityNetInDirectionRequest) Size() (n int) {
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UserPositionsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenIn)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.StartTick != 0 {
-		n += 1 + sovQuery(uint64(m.StartTick))
-	}
-	if m.UseCurTick {
-		n += 2
-	}
-	if m.BoundTick != 0 {
-		n += 1 + sovQuery(uint64(m.BoundTick))
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
 	}
-	if m.UseNoBound {
-		n += 2
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *LiquidityNetInDirectionResponse) Size() (n int) {
+func (m *UserPositionsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.LiquidityDepths) > 0 {
-		for _, e := range m.LiquidityDepths {
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.CurrentTick != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentTick))
-	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *PositionByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *NumPoolPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *NumPoolPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionCount != 0 {
+		n += 1 + sovQuery(uint64(m.PositionCount))
+	}
+	return n
+}
+
+func (m *PoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TickLiquidityNet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	return n
+}
+
+func (m *LiquidityDepthWithRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *LiquidityNetInDirectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTick != 0 {
+		n += 1 + sovQuery(uint64(m.StartTick))
+	}
+	if m.UseCurTick {
+		n += 2
+	}
+	if m.BoundTick != 0 {
+		n += 1 + sovQuery(uint64(m.BoundTick))
+	}
+	if m.UseNoBound {
+		n += 2
+	}
+	return n
+}
+
+func (m *LiquidityNetInDirectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityDepths) > 0 {
+		for _, e := range m.LiquidityDepths {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentTick != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTick))
+	}
 	l = m.CurrentLiquidity.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	l = m.CurrentSqrtPrice.Size()
@@ -4385,13 +5409,1141 @@ func (m *NumNextInitializedTicksResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EffectiveSpreadFactorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EffectiveSpreadFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpreadFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsDynamic {
+		n += 2
+	}
+	l = m.Volatility.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LiquidityDepthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.BucketWidth.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxPriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityDepthLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Amount0.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CumulativeAmount0.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CumulativeAmount1.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LiquidityDepthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *PositionPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InitialAsset0.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InitialAsset1.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClaimedSpreadRewards) > 0 {
+		for _, e := range m.ClaimedSpreadRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ClaimedIncentives) > 0 {
+		for _, e := range m.ClaimedIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeInRange)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WithdrawnAsset0.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WithdrawnAsset1.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, model.FullPositionBreakdown{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumPoolPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumPoolPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumPoolPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumPoolPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionCount", wireType)
+			}
+			m.PositionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4414,15 +6566,15 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4450,9 +6602,99 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
@@ -4471,11 +6713,11 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4485,28 +6727,102 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StartTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseCurTick", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseCurTick = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
+			}
+			m.BoundTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BoundTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseNoBound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseNoBound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4528,7 +6844,7 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4547,21 +6863,74 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UserPositionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
+			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4571,31 +6940,31 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, model.FullPositionBreakdown{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4605,25 +6974,23 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4648,7 +7015,7 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4671,17 +7038,17 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.PositionId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4691,7 +7058,7 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4717,7 +7084,7 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4740,15 +7107,15 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4775,10 +7142,30 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketIndex", wireType)
+			}
+			m.BucketIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4800,7 +7187,7 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4823,17 +7210,17 @@ func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumPoolPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumPoolPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			m.PoolId = 0
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4843,7 +7230,7 @@ func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4869,7 +7256,7 @@ func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4892,17 +7279,17 @@ func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumPoolPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumPoolPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableSpreadRewards", wireType)
 			}
-			m.PositionCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4912,11 +7299,26 @@ func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableSpreadRewards = append(m.ClaimableSpreadRewards, types2.Coin{})
+			if err := m.ClaimableSpreadRewards[len(m.ClaimableSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4938,7 +7340,7 @@ func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4961,17 +7363,17 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableIncentivesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			var msglen int
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4981,28 +7383,11 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5024,7 +7409,7 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5047,15 +7432,15 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableIncentivesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableIncentives", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5082,14 +7467,14 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimableIncentives = append(m.ClaimableIncentives, types2.Coin{})
+			if err := m.ClaimableIncentives[len(m.ClaimableIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5116,10 +7501,8 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types2.Coin{})
+			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5144,7 +7527,7 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5167,12 +7550,31 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5194,7 +7596,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5217,15 +7619,49 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthGlobal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpreadRewardGrowthGlobal = append(m.SpreadRewardGrowthGlobal, types2.DecCoin{})
+			if err := m.SpreadRewardGrowthGlobal[len(m.SpreadRewardGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeGrowthGlobal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5252,7 +7688,8 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UptimeGrowthGlobal = append(m.UptimeGrowthGlobal, model.UptimeTracker{})
+			if err := m.UptimeGrowthGlobal[len(m.UptimeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5277,7 +7714,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5300,17 +7737,17 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5320,26 +7757,11 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
@@ -5380,7 +7802,7 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
+func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5403,17 +7825,17 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthOppositeDirectionOfLastTraversal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5423,31 +7845,31 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpreadRewardGrowthOppositeDirectionOfLastTraversal = append(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal, types2.DecCoin{})
+			if err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
 			}
-			m.LowerTick = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5457,30 +7879,26 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LowerTick |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.UpperTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpperTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.UptimeTrackers = append(m.UptimeTrackers, model.UptimeTracker{})
+			if err := m.UptimeTrackers[len(m.UptimeTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5502,7 +7920,7 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
+func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5525,10 +7943,10 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5552,9 +7970,9 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5564,102 +7982,28 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
-			}
-			m.StartTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseCurTick", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseCurTick = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
-			}
-			m.BoundTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BoundTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseNoBound", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.UseNoBound = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5681,7 +8025,7 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
+func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5703,71 +8047,18 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
-			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
-			}
-			m.CurrentTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5777,31 +8068,31 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
+			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5811,23 +8102,25 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5852,7 +8145,7 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5875,17 +8168,17 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolId", wireType)
 			}
-			m.PoolId = 0
+			m.ConcentratedPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5895,7 +8188,7 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.ConcentratedPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5921,7 +8214,7 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5944,51 +8237,17 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CfmmPoolId", wireType)
 			}
-			m.BucketIndex = 0
+			m.CfmmPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5998,7 +8257,7 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BucketIndex |= int64(b&0x7F) << shift
+				m.CfmmPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6024,7 +8283,7 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6047,17 +8306,17 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserUnbondingPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserUnbondingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.PositionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6067,11 +8326,24 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6093,7 +8365,7 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6116,15 +8388,15 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserUnbondingPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserUnbondingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableSpreadRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionsWithPeriodLock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6151,8 +8423,8 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimableSpreadRewards = append(m.ClaimableSpreadRewards, types2.Coin{})
-			if err := m.ClaimableSpreadRewards[len(m.ClaimableSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PositionsWithPeriodLock = append(m.PositionsWithPeriodLock, model.PositionWithPeriodLock{})
+			if err := m.PositionsWithPeriodLock[len(m.PositionsWithPeriodLock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6177,7 +8449,7 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6200,31 +8472,12 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableIncentivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTotalLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
-			}
-			m.PositionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6246,7 +8499,7 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6269,49 +8522,15 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableIncentivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTotalLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableIncentives", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClaimableIncentives = append(m.ClaimableIncentives, types2.Coin{})
-			if err := m.ClaimableIncentives[len(m.ClaimableIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6338,8 +8557,8 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types2.Coin{})
-			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalLiquidity = append(m.TotalLiquidity, types2.Coin{})
+			if err := m.TotalLiquidity[len(m.TotalLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6364,7 +8583,7 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6374,30 +8593,81 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumNextInitializedTicksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumNextInitializedTicksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNextInitializedTicks", wireType)
 			}
-			m.PoolId = 0
+			m.NumNextInitializedTicks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6407,7 +8677,7 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.NumNextInitializedTicks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6433,7 +8703,7 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6456,15 +8726,15 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: NumNextInitializedTicksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NumNextInitializedTicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthGlobal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6491,16 +8761,35 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpreadRewardGrowthGlobal = append(m.SpreadRewardGrowthGlobal, types2.DecCoin{})
-			if err := m.SpreadRewardGrowthGlobal[len(m.SpreadRewardGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
+			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UptimeGrowthGlobal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6510,23 +8799,23 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UptimeGrowthGlobal = append(m.UptimeGrowthGlobal, model.UptimeTracker{})
-			if err := m.UptimeGrowthGlobal[len(m.UptimeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6551,7 +8840,7 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
+func (m *EffectiveSpreadFactorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6574,10 +8863,10 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EffectiveSpreadFactorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EffectiveSpreadFactorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6599,25 +8888,6 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
-			}
-			m.TickIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TickIndex |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6639,7 +8909,7 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
+func (m *EffectiveSpreadFactorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6662,17 +8932,17 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EffectiveSpreadFactorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EffectiveSpreadFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthOppositeDirectionOfLastTraversal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadFactor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6682,31 +8952,51 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpreadRewardGrowthOppositeDirectionOfLastTraversal = append(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal, types2.DecCoin{})
-			if err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDynamic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDynamic = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6716,23 +9006,23 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UptimeTrackers = append(m.UptimeTrackers, model.UptimeTracker{})
-			if err := m.UptimeTrackers[len(m.UptimeTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6757,7 +9047,7 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *LiquidityDepthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6780,10 +9070,10 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentiveRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityDepthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentiveRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityDepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6806,6 +9096,74 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketWidth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketWidth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6862,7 +9220,7 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *LiquidityDepthLevel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6885,17 +9243,51 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentiveRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityDepthLevel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentiveRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityDepthLevel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6905,31 +9297,31 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
-			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6939,83 +9331,31 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeAmount0", wireType)
 			}
-			m.ConcentratedPoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7025,66 +9365,31 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConcentratedPoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CumulativeAmount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CfmmPoolId", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeAmount1", wireType)
 			}
-			m.CfmmPoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7094,11 +9399,26 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CfmmPoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeAmount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7120,7 +9440,7 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *LiquidityDepthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7143,15 +9463,15 @@ func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbondingPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityDepthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbondingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7179,61 +9499,81 @@ func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Bids = append(m.Bids, LiquidityDepthLevel{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbondingPositionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbondingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Asks = append(m.Asks, LiquidityDepthLevel{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionsWithPeriodLock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7260,8 +9600,10 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PositionsWithPeriodLock = append(m.PositionsWithPeriodLock, model.PositionWithPeriodLock{})
-			if err := m.PositionsWithPeriodLock[len(m.PositionsWithPeriodLock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7286,7 +9628,7 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *PositionPerformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7309,12 +9651,31 @@ func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTotalLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionPerformanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7336,7 +9697,7 @@ func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *PositionPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7359,15 +9720,15 @@ func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTotalLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7394,66 +9755,48 @@ func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalLiquidity = append(m.TotalLiquidity, types2.Coin{})
-			if err := m.TotalLiquidity[len(m.TotalLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAsset0", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.InitialAsset0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NumNextInitializedTicksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumNextInitializedTicksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAsset1", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7463,16 +9806,30 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialAsset1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedSpreadRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7482,29 +9839,31 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			m.ClaimedSpreadRewards = append(m.ClaimedSpreadRewards, types2.Coin{})
+			if err := m.ClaimedSpreadRewards[len(m.ClaimedSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumNextInitializedTicks", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedIncentives", wireType)
 			}
-			m.NumNextInitializedTicks = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7514,64 +9873,29 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumNextInitializedTicks |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ClaimedIncentives = append(m.ClaimedIncentives, types2.Coin{})
+			if err := m.ClaimedIncentives[len(m.ClaimedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NumNextInitializedTicksResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumNextInitializedTicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInRange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7598,16 +9922,15 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
-			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeInRange, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnAsset0", wireType)
 			}
-			m.CurrentTick = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7617,16 +9940,30 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentTick |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawnAsset0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnAsset1", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7636,23 +9973,22 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WithdrawnAsset1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
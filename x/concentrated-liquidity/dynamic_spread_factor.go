@@ -0,0 +1,140 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+)
+
+// GetDynamicSpreadFactorState returns the dynamic spread factor state of the given pool.
+// Returns false if the pool is not in the dynamic spread factor mode.
+func (k Keeper) GetDynamicSpreadFactorState(ctx sdk.Context, poolId uint64) (types.DynamicSpreadFactorState, bool, error) {
+	state := types.DynamicSpreadFactorState{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactorState(poolId), &state)
+	if err != nil {
+		return types.DynamicSpreadFactorState{}, false, err
+	}
+	return state, found, nil
+}
+
+// setDynamicSpreadFactorState stores the dynamic spread factor state of the pool.
+func (k Keeper) setDynamicSpreadFactorState(ctx sdk.Context, state types.DynamicSpreadFactorState) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactorState(state.PoolId), &state)
+}
+
+// deleteDynamicSpreadFactorState deletes the dynamic spread factor state of the pool.
+func (k Keeper) deleteDynamicSpreadFactorState(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyDynamicSpreadFactorState(poolId))
+}
+
+// getAllDynamicSpreadFactorStates returns the dynamic spread factor states of all pools in the dynamic spread factor mode.
+func (k Keeper) getAllDynamicSpreadFactorStates(ctx sdk.Context) ([]types.DynamicSpreadFactorState, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.DynamicSpreadFactorStatePrefix, parseDynamicSpreadFactorState)
+}
+
+// updateDynamicSpreadFactors recomputes the spread factor of the pools in the dynamic spread factor params
+// from the price movement of the block, for the swaps of the next blocks. The pools that were removed from the
+// params leave the dynamic spread factor mode, and get back the spread factor they had before entering it.
+func (k Keeper) updateDynamicSpreadFactors(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	states, err := k.getAllDynamicSpreadFactorStates(ctx)
+	if err != nil {
+		panic(err)
+	}
+	for _, state := range states {
+		if _, ok := params.GetDynamicSpreadFactor(state.PoolId); ok {
+			continue
+		}
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.exitDynamicSpreadFactor(cacheCtx, state)
+		})
+		if err != nil {
+			ctx.Logger().Error(fmt.Errorf("unable to restore the spread factor of pool %d: %w", state.PoolId, err).Error())
+		}
+	}
+
+	for _, dynamicSpreadFactor := range params.DynamicSpreadFactors {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.updateDynamicSpreadFactor(cacheCtx, dynamicSpreadFactor)
+		})
+		if err != nil {
+			ctx.Logger().Error(fmt.Errorf("unable to update the dynamic spread factor of pool %d: %w", dynamicSpreadFactor.PoolId, err).Error())
+		}
+	}
+}
+
+// updateDynamicSpreadFactor updates the volatility of the pool with the price movement of its swaps since the
+// last block, and sets the spread factor of the pool to the one of the new volatility.
+// A pool entering the dynamic spread factor mode starts with a zero volatility, and has its spread factor saved
+// to be restored when leaving it.
+func (k Keeper) updateDynamicSpreadFactor(ctx sdk.Context, dynamicSpreadFactor types.DynamicSpreadFactor) error {
+	pool, err := k.getPoolById(ctx, dynamicSpreadFactor.PoolId)
+	if err != nil {
+		return err
+	}
+
+	state, found, err := k.GetDynamicSpreadFactorState(ctx, dynamicSpreadFactor.PoolId)
+	if err != nil {
+		return err
+	}
+	if !found {
+		state = types.DynamicSpreadFactorState{
+			PoolId:           dynamicSpreadFactor.PoolId,
+			PriceMovement:    osmomath.ZeroDec(),
+			Volatility:       osmomath.ZeroDec(),
+			BaseSpreadFactor: pool.GetSpreadFactor(ctx),
+		}
+	}
+
+	state.Volatility = dynamicSpreadFactor.NextVolatility(state.Volatility, state.PriceMovement)
+	state.PriceMovement = osmomath.ZeroDec()
+
+	pool.SetSpreadFactor(dynamicSpreadFactor.SpreadFactor(state.Volatility))
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+	k.setDynamicSpreadFactorState(ctx, state)
+	return nil
+}
+
+// recordDynamicSpreadFactorPriceMovement adds the price movement of a swap of the pool, from its sqrt price before
+// the swap to its sqrt price after it, to the price movement of the block, if the pool is in the dynamic spread
+// factor mode. The price moving in a single direction during a swap, this is the price distance the swap crossed.
+func (k Keeper) recordDynamicSpreadFactorPriceMovement(ctx sdk.Context, poolId uint64, sqrtPriceBefore, sqrtPriceAfter osmomath.BigDec) error {
+	state, found, err := k.GetDynamicSpreadFactorState(ctx, poolId)
+	if err != nil || !found {
+		return err
+	}
+	state.PriceMovement = state.PriceMovement.Add(types.PriceMovement(sqrtPriceBefore, sqrtPriceAfter))
+	k.setDynamicSpreadFactorState(ctx, state)
+	return nil
+}
+
+// exitDynamicSpreadFactor restores the spread factor the pool had before entering the dynamic spread factor mode,
+// and deletes its dynamic spread factor state.
+func (k Keeper) exitDynamicSpreadFactor(ctx sdk.Context, state types.DynamicSpreadFactorState) error {
+	pool, err := k.getPoolById(ctx, state.PoolId)
+	if err != nil {
+		return err
+	}
+	pool.SetSpreadFactor(state.BaseSpreadFactor)
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+
+	k.deleteDynamicSpreadFactorState(ctx, state.PoolId)
+	return nil
+}
+
+func parseDynamicSpreadFactorState(bz []byte) (types.DynamicSpreadFactorState, error) {
+	state := types.DynamicSpreadFactorState{}
+	if err := state.Unmarshal(bz); err != nil {
+		return types.DynamicSpreadFactorState{}, err
+	}
+	return state, nil
+}
@@ -0,0 +1,132 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/client"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateDynamicSpreadFactors() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	querier := client.Querier{Keeper: *clKeeper}
+	pool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+	s.Require().True(pool.GetSpreadFactor(s.Ctx).IsZero())
+
+	dynamicSpreadFactor := types.DynamicSpreadFactor{
+		PoolId:          pool.GetId(),
+		MinSpreadFactor: osmomath.MustNewDecFromStr("0.001"),
+		MaxSpreadFactor: osmomath.MustNewDecFromStr("0.01"),
+		MaxVolatility:   osmomath.NewDec(10_000),
+		VolatilityDecay: osmomath.MustNewDecFromStr("0.5"),
+	}
+	clKeeper.SetParam(s.Ctx, types.KeyDynamicSpreadFactors, []types.DynamicSpreadFactor{dynamicSpreadFactor})
+
+	requireEffectiveSpreadFactor := func(expectedSpreadFactor, expectedVolatility osmomath.Dec, expectedIsDynamic bool) {
+		pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		s.Require().Equal(expectedSpreadFactor, pool.GetSpreadFactor(s.Ctx))
+
+		response, err := querier.EffectiveSpreadFactor(s.Ctx, queryproto.EffectiveSpreadFactorRequest{PoolId: pool.GetId()})
+		s.Require().NoError(err)
+		s.Require().Equal(queryproto.EffectiveSpreadFactorResponse{SpreadFactor: expectedSpreadFactor, IsDynamic: expectedIsDynamic, Volatility: expectedVolatility}, *response)
+	}
+
+	// Before the end of the block, the pool is not in the dynamic spread factor mode yet.
+	requireEffectiveSpreadFactor(osmomath.ZeroDec(), osmomath.ZeroDec(), false)
+
+	// The pool enters the mode with a zero volatility.
+	clKeeper.EndBlock(s.Ctx)
+
+	requireEffectiveSpreadFactor(dynamicSpreadFactor.MinSpreadFactor, osmomath.ZeroDec(), true)
+	state, found, err := clKeeper.GetDynamicSpreadFactorState(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(types.DynamicSpreadFactorState{PoolId: pool.GetId(), PriceMovement: osmomath.ZeroDec(), Volatility: osmomath.ZeroDec(), BaseSpreadFactor: osmomath.ZeroDec()}, state)
+
+	// The swaps are charged the dynamic spread factor.
+	spreadRewardsBefore := s.App.BankKeeper.GetBalance(s.Ctx, pool.GetSpreadRewardsAddress(), USDC)
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	sqrtPriceBefore := pool.GetCurrentSqrtPrice()
+	tokenIn := sdk.NewCoin(USDC, apptesting.DefaultCoinAmount.QuoRaw(10))
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[1], []poolmanagertypes.SwapAmountInRoute{{PoolId: pool.GetId(), TokenOutDenom: ETH}}, tokenIn, osmomath.OneInt())
+	s.Require().NoError(err)
+	spreadRewardsAfter := s.App.BankKeeper.GetBalance(s.Ctx, pool.GetSpreadRewardsAddress(), USDC)
+	s.Require().True(spreadRewardsAfter.Amount.GT(spreadRewardsBefore.Amount))
+
+	// The swap records its price movement.
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	priceMovement := types.PriceMovement(sqrtPriceBefore, pool.GetCurrentSqrtPrice())
+	s.Require().True(priceMovement.IsPositive())
+	state, _, err = clKeeper.GetDynamicSpreadFactorState(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(priceMovement, state.PriceMovement)
+
+	// Swapping back adds its own price movement, although the pool ends the block close to its price before.
+	sqrtPriceBefore = pool.GetCurrentSqrtPrice()
+	ethIn := sdk.NewCoin(ETH, s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], ETH).Amount)
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[1], []poolmanagertypes.SwapAmountInRoute{{PoolId: pool.GetId(), TokenOutDenom: USDC}}, ethIn, osmomath.OneInt())
+	s.Require().NoError(err)
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	priceMovement = priceMovement.Add(types.PriceMovement(sqrtPriceBefore, pool.GetCurrentSqrtPrice()))
+	state, _, err = clKeeper.GetDynamicSpreadFactorState(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(priceMovement, state.PriceMovement)
+
+	// The volatility is updated with the price movement of the block.
+	expectedVolatility := dynamicSpreadFactor.NextVolatility(osmomath.ZeroDec(), priceMovement)
+
+	clKeeper.EndBlock(s.Ctx)
+
+	requireEffectiveSpreadFactor(dynamicSpreadFactor.SpreadFactor(expectedVolatility), expectedVolatility, true)
+	s.Require().True(dynamicSpreadFactor.SpreadFactor(expectedVolatility).GT(dynamicSpreadFactor.MinSpreadFactor))
+
+	// Without price movement, the volatility decays.
+	clKeeper.EndBlock(s.Ctx)
+
+	expectedVolatility = dynamicSpreadFactor.NextVolatility(expectedVolatility, osmomath.ZeroDec())
+	requireEffectiveSpreadFactor(dynamicSpreadFactor.SpreadFactor(expectedVolatility), expectedVolatility, true)
+
+	// Once removed from the params, the pool gets back its spread factor.
+	clKeeper.SetParam(s.Ctx, types.KeyDynamicSpreadFactors, []types.DynamicSpreadFactor{})
+	clKeeper.EndBlock(s.Ctx)
+
+	requireEffectiveSpreadFactor(osmomath.ZeroDec(), osmomath.ZeroDec(), false)
+}
+
+// validates that a pool of the dynamic spread factor params that does not exist does not prevent updating the others.
+func (s *KeeperTestSuite) TestUpdateDynamicSpreadFactorsPoolNotFound() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+
+	dynamicSpreadFactor := types.DynamicSpreadFactor{
+		MinSpreadFactor: osmomath.MustNewDecFromStr("0.001"),
+		MaxSpreadFactor: osmomath.MustNewDecFromStr("0.01"),
+		MaxVolatility:   osmomath.NewDec(100),
+		VolatilityDecay: osmomath.MustNewDecFromStr("0.5"),
+	}
+	missingPoolDynamicSpreadFactor, poolDynamicSpreadFactor := dynamicSpreadFactor, dynamicSpreadFactor
+	missingPoolDynamicSpreadFactor.PoolId = pool.GetId() + 1
+	poolDynamicSpreadFactor.PoolId = pool.GetId()
+	clKeeper.SetParam(s.Ctx, types.KeyDynamicSpreadFactors, []types.DynamicSpreadFactor{missingPoolDynamicSpreadFactor, poolDynamicSpreadFactor})
+
+	// System under test.
+	clKeeper.EndBlock(s.Ctx)
+
+	_, found, err := clKeeper.GetDynamicSpreadFactorState(s.Ctx, missingPoolDynamicSpreadFactor.PoolId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	_, found, err = clKeeper.GetDynamicSpreadFactorState(s.Ctx, poolDynamicSpreadFactor.PoolId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+}
@@ -201,6 +201,10 @@ func (k Keeper) AddToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 	return k.addToPosition(ctx, owner, positionId, amount0Added, amount1Added, amount0Min, amount1Min)
 }
 
+func (k Keeper) RebalancePosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, newLowerTick, newUpperTick int64, tokenSwappedIn sdk.Coin, tokenOutMinAmount, amount0Min, amount1Min osmomath.Int) (CreatePositionData, error) {
+	return k.rebalancePosition(ctx, owner, positionId, newLowerTick, newUpperTick, tokenSwappedIn, tokenOutMinAmount, amount0Min, amount1Min)
+}
+
 func (ss *SwapState) UpdateSpreadRewardGrowthGlobal(spreadRewardChargeTotal, spreadFactor osmomath.Dec) (osmomath.Dec, error) {
 	return ss.updateSpreadRewardGrowthGlobal(spreadRewardChargeTotal, spreadFactor)
 }
@@ -361,3 +365,19 @@ func ScaleDownSpreadRewardAmount(incentiveAmount osmomath.Int, scalingFactor osm
 func (k Keeper) RedepositForfeitedIncentives(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, scaledForfeitedIncentivesByUptime []sdk.Coins, totalForefeitedIncentives sdk.Coins) error {
 	return k.redepositForfeitedIncentives(ctx, poolId, owner, scaledForfeitedIncentivesByUptime, totalForefeitedIncentives)
 }
+
+func (k Keeper) GetFilledRangeOrders(ctx sdk.Context, limit uint64) []uint64 {
+	return k.getFilledRangeOrders(ctx, limit)
+}
+
+func (k Keeper) SetRangeOrder(ctx sdk.Context, rangeOrder types.RangeOrder) {
+	k.setRangeOrder(ctx, rangeOrder)
+}
+
+func (k Keeper) AutoCompoundPositions(ctx sdk.Context, epochIdentifier string) {
+	k.autoCompoundPositions(ctx, epochIdentifier)
+}
+
+func (k Keeper) DeletePositionPerformance(ctx sdk.Context, positionId uint64) {
+	k.deletePositionPerformance(ctx, positionId)
+}
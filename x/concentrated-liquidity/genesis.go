@@ -101,6 +101,38 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		}
 	}
 
+	// set range orders of positions
+	for _, rangeOrder := range genState.RangeOrders {
+		if _, err := k.GetPosition(ctx, rangeOrder.PositionId); err != nil {
+			panic(fmt.Sprintf("found range order of position (%d) but there is no position with such id that exists", rangeOrder.PositionId))
+		}
+		k.setRangeOrder(ctx, rangeOrder)
+	}
+
+	// set auto-compounding opt-ins of positions
+	for _, autoCompound := range genState.PositionAutoCompounds {
+		if _, err := k.GetPosition(ctx, autoCompound.PositionId); err != nil {
+			panic(fmt.Sprintf("found auto-compounding opt-in of position (%d) but there is no position with such id that exists", autoCompound.PositionId))
+		}
+		k.setPositionAutoCompound(ctx, autoCompound)
+	}
+
+	// set dynamic spread factor states of pools
+	for _, state := range genState.DynamicSpreadFactorStates {
+		if _, err := k.getPoolById(ctx, state.PoolId); err != nil {
+			panic(fmt.Sprintf("found dynamic spread factor state of pool (%d) but there is no pool with such id that exists", state.PoolId))
+		}
+		k.setDynamicSpreadFactorState(ctx, state)
+	}
+
+	// set position performances
+	for _, performance := range genState.PositionPerformances {
+		if _, err := k.GetPosition(ctx, performance.PositionId); err != nil {
+			panic(fmt.Sprintf("found performance of position (%d) but there is no position with such id that exists", performance.PositionId))
+		}
+		k.setPositionPerformance(ctx, performance)
+	}
+
 	// set total liquidity
 	k.setTotalLiquidity(ctx, totalLiquidity)
 
@@ -233,6 +265,26 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		})
 	}
 
+	rangeOrders, err := k.getAllRangeOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	positionAutoCompounds, err := k.getAllPositionAutoCompounds(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	dynamicSpreadFactorStates, err := k.getAllDynamicSpreadFactorStates(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	positionPerformances, err := k.getAllPositionPerformances(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	// Get the incentive pool ID migration threshold
 	incentivesAccumulatorPoolIDMigrationThreshold, err := k.GetIncentivePoolIDMigrationThreshold(ctx)
 	if err != nil {
@@ -253,6 +305,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		NextIncentiveRecordId: k.GetNextIncentiveRecordId(ctx),
 		IncentivesAccumulatorPoolIdMigrationThreshold: incentivesAccumulatorPoolIDMigrationThreshold,
 		SpreadFactorPoolIdMigrationThreshold:          spreadFactorPoolIdMigrationThreshold,
+		RangeOrders:                                   rangeOrders,
+		PositionAutoCompounds:                         positionAutoCompounds,
+		DynamicSpreadFactorStates:                     dynamicSpreadFactorStates,
+		PositionPerformances:                          positionPerformances,
 	}
 }
 
@@ -728,21 +728,35 @@ func moveRewardsToNewPositionAndDeleteOldAcc(accum *accum.AccumulatorObject, old
 		return types.ModifySamePositionAccumulatorError{PositionAccName: oldPositionName}
 	}
 
+	unclaimedRewards, err := deleteOldAccAndGetUnclaimedRewards(accum, oldPositionName, growthOutside)
+	if err != nil {
+		return err
+	}
+
+	return addUnclaimedRewardsToNewAcc(accum, newPositionName, unclaimedRewards, growthOutside)
+}
+
+// deleteOldAccAndGetUnclaimedRewards claims the rewards of the given position, and deletes its position tracker.
+// The given growth outside the position range is used for claim rewards accounting.
+// Returns the claimed rewards, to be moved to another position with addUnclaimedRewardsToNewAcc.
+func deleteOldAccAndGetUnclaimedRewards(accum *accum.AccumulatorObject, oldPositionName string, growthOutside sdk.DecCoins) (sdk.DecCoins, error) {
 	hasPosition := accum.HasPosition(oldPositionName)
 	if !hasPosition {
-		return fmt.Errorf("position %s does not exist", oldPositionName)
+		return sdk.DecCoins{}, fmt.Errorf("position %s does not exist", oldPositionName)
 	}
 
 	if err := updatePositionToInitValuePlusGrowthOutside(accum, oldPositionName, growthOutside); err != nil {
-		return err
+		return sdk.DecCoins{}, err
 	}
 
-	unclaimedRewards, err := accum.DeletePosition(oldPositionName)
-	if err != nil {
-		return err
-	}
+	return accum.DeletePosition(oldPositionName)
+}
 
-	err = accum.AddToUnclaimedRewards(newPositionName, unclaimedRewards)
+// addUnclaimedRewardsToNewAcc adds the given rewards as "unclaimed rewards" to the given position, and sets
+// its accumulator value to the growth inside its range, computed from the given growth outside.
+// The position must be associated with the given accumulator.
+func addUnclaimedRewardsToNewAcc(accum *accum.AccumulatorObject, newPositionName string, unclaimedRewards sdk.DecCoins, growthOutside sdk.DecCoins) error {
+	err := accum.AddToUnclaimedRewards(newPositionName, unclaimedRewards)
 	if err != nil {
 		return err
 	}
@@ -763,6 +777,12 @@ func moveRewardsToNewPositionAndDeleteOldAcc(accum *accum.AccumulatorObject, old
 //
 // Returns error if the position/uptime accumulators don't exist, or if there is an issue that arises while claiming.
 func (k Keeper) prepareClaimAllIncentivesForPosition(ctx sdk.Context, positionId uint64) (sdk.Coins, sdk.Coins, []sdk.Coins, error) {
+	return k.prepareClaimIncentivesForPosition(ctx, positionId, false)
+}
+
+// prepareClaimIncentivesForPosition is prepareClaimAllIncentivesForPosition, except that if onlyMetUptimes is true, the incentives
+// of the uptimes longer than the age of the position are not claimed, so they keep accruing to the position instead of being forfeited.
+func (k Keeper) prepareClaimIncentivesForPosition(ctx sdk.Context, positionId uint64, onlyMetUptimes bool) (sdk.Coins, sdk.Coins, []sdk.Coins, error) {
 	// Retrieve the position with the given ID.
 	position, err := k.GetPosition(ctx, positionId)
 	if err != nil {
@@ -811,6 +831,10 @@ func (k Keeper) prepareClaimAllIncentivesForPosition(ctx sdk.Context, positionId
 	// Loop through each uptime accumulator for the pool.
 	scaledForfeitedIncentivesByUptime := make([]sdk.Coins, len(types.SupportedUptimes))
 	for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+		if onlyMetUptimes && positionAge < supportedUptimes[uptimeIndex] {
+			continue
+		}
+
 		// Check if the accumulator contains the position.
 		// There should never be a case where you can have a position for 1 accumulator, and not the rest.
 		hasPosition := uptimeAccum.HasPosition(positionName)
@@ -937,6 +961,12 @@ func (k Keeper) GetClaimableIncentives(ctx sdk.Context, positionId uint64) (sdk.
 // - position with the given id does not exist
 // - other internal database or math errors.
 func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (sdk.Coins, sdk.Coins, []sdk.Coins, error) {
+	return k.collectIncentivesForUptimes(ctx, sender, positionId, false)
+}
+
+// collectIncentivesForUptimes is collectIncentives, except that if onlyMetUptimes is true, only the incentives of the uptimes
+// met by the age of the position are collected, and those of the longer uptimes keep accruing to the position.
+func (k Keeper) collectIncentivesForUptimes(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, onlyMetUptimes bool) (sdk.Coins, sdk.Coins, []sdk.Coins, error) {
 	// Retrieve the position with the given ID.
 	position, err := k.GetPosition(ctx, positionId)
 	if err != nil {
@@ -951,7 +981,7 @@ func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positi
 	}
 
 	// Claim all incentives for the position.
-	collectedIncentivesForPosition, totalForfeitedIncentivesForPosition, scaledAmountForfeitedByUptime, err := k.prepareClaimAllIncentivesForPosition(ctx, position.PositionId)
+	collectedIncentivesForPosition, totalForfeitedIncentivesForPosition, scaledAmountForfeitedByUptime, err := k.prepareClaimIncentivesForPosition(ctx, position.PositionId, onlyMetUptimes)
 	if err != nil {
 		return sdk.Coins{}, sdk.Coins{}, nil, err
 	}
@@ -972,6 +1002,13 @@ func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positi
 		if err := k.bankKeeper.SendCoins(ctx, pool.GetIncentivesAddress(), sender, collectedIncentivesForPosition); err != nil {
 			return sdk.Coins{}, sdk.Coins{}, nil, err
 		}
+
+		err = k.updatePositionPerformance(ctx, positionId, func(performance *types.PositionPerformance) {
+			performance.ClaimedIncentives = performance.ClaimedIncentives.Add(collectedIncentivesForPosition...)
+		})
+		if err != nil {
+			return sdk.Coins{}, sdk.Coins{}, nil, err
+		}
 	}
 
 	// Emit an event indicating that incentives were collected.
@@ -29,6 +29,7 @@ type Keeper struct {
 	lockupKeeper         types.LockupKeeper
 	communityPoolKeeper  types.CommunityPoolKeeper
 	contractKeeper       types.ContractKeeper
+	epochKeeper          types.EpochKeeper
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, gammKeeper types.GAMMKeeper, poolIncentivesKeeper types.PoolIncentivesKeeper, incentivesKeeper types.IncentivesKeeper, lockupKeeper types.LockupKeeper, communityPoolKeeper types.CommunityPoolKeeper, contractKeeper types.ContractKeeper, paramSpace paramtypes.Subspace) *Keeper {
@@ -67,6 +68,13 @@ func (k Keeper) SetParam(ctx sdk.Context, key []byte, value interface{}) {
 	k.paramSpace.Set(ctx, key, value)
 }
 
+// EndBlock withdraws the range orders filled by the swaps of the block to their owners,
+// and updates the spread factor of the pools in the dynamic spread factor mode.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	k.processFilledRangeOrders(ctx)
+	k.updateDynamicSpreadFactors(ctx)
+}
+
 // Set the poolmanager keeper.
 func (k *Keeper) SetPoolManagerKeeper(poolmanagerKeeper types.PoolManagerKeeper) {
 	k.poolmanagerKeeper = poolmanagerKeeper
@@ -92,6 +100,11 @@ func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
 	k.contractKeeper = contractKeeper
 }
 
+// Set the epoch keeper.
+func (k *Keeper) SetEpochKeeper(epochKeeper types.EpochKeeper) {
+	k.epochKeeper = epochKeeper
+}
+
 // GetNextPositionId returns the next position id.
 func (k Keeper) GetNextPositionId(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
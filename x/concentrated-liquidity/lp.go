@@ -12,6 +12,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/math"
 	types "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v31/x/lockup/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
 )
 
 const noUnderlyingLockId = uint64(0)
@@ -155,6 +156,10 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 		return CreatePositionData{}, err
 	}
 
+	if err := k.initPositionPerformance(ctx, pool, positionId, lowerTick, upperTick, updateData.Amount0, updateData.Amount1); err != nil {
+		return CreatePositionData{}, err
+	}
+
 	event := &liquidityChangeEvent{
 		eventType:      types.TypeEvtCreatePosition,
 		positionId:     positionId,
@@ -307,6 +312,7 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 		if err := k.deletePosition(ctx, positionId, owner, position.PoolId); err != nil {
 			return osmomath.Int{}, osmomath.Int{}, err
 		}
+		k.deletePositionPerformance(ctx, positionId)
 
 		// Note that here we currently use the iterator based definition to search
 		// for a remaining position in the pool. Since we have removed a position we need to
@@ -331,6 +337,15 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 			// invalid spot price for this pool.
 			k.listeners.AfterLastPoolPositionRemoved(ctx, owner, pool.GetId())
 		}
+	} else {
+		// The position remains, so record the amounts withdrawn from it next to the amounts deposited.
+		err := k.updatePositionPerformance(ctx, positionId, func(performance *types.PositionPerformance) {
+			performance.WithdrawnAsset0 = performance.WithdrawnAsset0.AddAmount(updateData.Amount0.Abs())
+			performance.WithdrawnAsset1 = performance.WithdrawnAsset1.AddAmount(updateData.Amount1.Abs())
+		})
+		if err != nil {
+			return osmomath.Int{}, osmomath.Int{}, err
+		}
 	}
 
 	// If lowertick/uppertick has no liquidity in it, delete it from state.
@@ -417,6 +432,35 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 		return 0, osmomath.Int{}, osmomath.Int{}, types.PositionSuperfluidStakedError{PositionId: position.PositionId}
 	}
 
+	// The auto-compounding opt-in is deleted along with the position, and carried over to the new position.
+	autoCompound, hasAutoCompound, err := k.GetPositionAutoCompound(ctx, positionId)
+	if err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	// So is the performance record, including the rewards claimed by withdrawing the position and its time in range.
+	performance, hasPerformance, err := k.GetPositionPerformance(ctx, positionId)
+	if err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, err
+	}
+	var timeInRange time.Duration
+	if hasPerformance {
+		timeInRange, err = k.getPositionTimeInRange(ctx, position, performance)
+		if err != nil {
+			return 0, osmomath.Int{}, osmomath.Int{}, err
+		}
+		claimableSpreadRewards, err := k.GetClaimableSpreadRewards(ctx, positionId)
+		if err != nil {
+			return 0, osmomath.Int{}, osmomath.Int{}, err
+		}
+		claimableIncentives, _, err := k.GetClaimableIncentives(ctx, positionId)
+		if err != nil {
+			return 0, osmomath.Int{}, osmomath.Int{}, err
+		}
+		performance.ClaimedSpreadRewards = performance.ClaimedSpreadRewards.Add(claimableSpreadRewards...)
+		performance.ClaimedIncentives = performance.ClaimedIncentives.Add(claimableIncentives...)
+	}
+
 	// Withdraw full position.
 	amount0Withdrawn, amount1Withdrawn, err := k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
 	if err != nil {
@@ -452,6 +496,21 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 		return 0, osmomath.Int{}, osmomath.Int{}, err
 	}
 
+	if hasAutoCompound {
+		autoCompound.PositionId = newPositionData.ID
+		k.setPositionAutoCompound(ctx, autoCompound)
+	}
+
+	if hasPerformance {
+		performance.PositionId = newPositionData.ID
+		performance.InitialAsset0 = performance.InitialAsset0.AddAmount(newPositionData.Amount0.Sub(amount0Withdrawn))
+		performance.InitialAsset1 = performance.InitialAsset1.AddAmount(newPositionData.Amount1.Sub(amount1Withdrawn))
+		err = k.setPositionPerformanceTimeInRange(ctx, performance, position.PoolId, position.LowerTick, position.UpperTick, timeInRange)
+		if err != nil {
+			return 0, osmomath.Int{}, osmomath.Int{}, err
+		}
+	}
+
 	// Emit an event indicating that a position was added to.
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -469,6 +528,346 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 	return newPositionData.ID, newPositionData.Amount0, newPositionData.Amount1, nil
 }
 
+// rebalancePosition moves the liquidity of the position with the given id to the new tick range, keeping its position id,
+// join time and unclaimed spread rewards and incentives, which are carried over to the new range instead of being claimed.
+// The position is fully withdrawn to the owner, who may swap tokenSwappedIn, out of the withdrawn tokens, into the other
+// pool token through the same pool. The resulting amounts are then provided in the new range, and any remainder
+// that cannot be provided in the range's proportion stays with the owner.
+// Returns the position data in the new range on success.
+// Returns error if
+// - the provided owner does not own the position
+// - the position is superfluid staked or has an unmatured underlying lock
+// - the new tick range is invalid or the same as the current one
+// - tokenSwappedIn is not a pool token or exceeds the amount of it withdrawn
+// - the swap returns less than tokenOutMinAmount
+// - the amount0 or amount1 provided in the new range is less than the given minimums
+//
+// Withdraw position hooks are triggered around withdrawing from the current range, and create position hooks around
+// providing liquidity in the new range.
+func (k Keeper) rebalancePosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, newLowerTick, newUpperTick int64, tokenSwappedIn sdk.Coin, tokenOutMinAmount, amount0Min, amount1Min osmomath.Int) (CreatePositionData, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// Check if the provided owner owns the position being rebalanced.
+	if owner.String() != position.Address {
+		return CreatePositionData{}, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	// The liquidity of a position with an active underlying lock cannot be withdrawn.
+	positionHasActiveUnderlyingLock, lockId, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	if positionHasActiveUnderlyingLock {
+		return CreatePositionData{}, types.LockNotMatureError{PositionId: positionId, LockId: lockId}
+	}
+
+	if amount0Min.IsNegative() {
+		return CreatePositionData{}, types.NotPositiveRequireAmountError{Amount: amount0Min.String()}
+	}
+	if amount1Min.IsNegative() {
+		return CreatePositionData{}, types.NotPositiveRequireAmountError{Amount: amount1Min.String()}
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	if err := validateTickRangeIsValid(pool.GetTickSpacing(), newLowerTick, newUpperTick); err != nil {
+		return CreatePositionData{}, err
+	}
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(newLowerTick, newUpperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	newLowerTick, newUpperTick, err = roundTickToCanonicalPriceTick(newLowerTick, newUpperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, pool.GetTickSpacing())
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	if newLowerTick == position.LowerTick && newUpperTick == position.UpperTick {
+		return CreatePositionData{}, types.RebalanceToSameRangeError{PositionId: positionId, LowerTick: newLowerTick, UpperTick: newUpperTick}
+	}
+
+	swap := !tokenSwappedIn.Amount.IsNil() && tokenSwappedIn.Amount.IsPositive()
+	if swap && tokenSwappedIn.Denom != pool.GetToken0() && tokenSwappedIn.Denom != pool.GetToken1() {
+		return CreatePositionData{}, fmt.Errorf("token swapped in (%s) is not one of the pool tokens", tokenSwappedIn.Denom)
+	}
+
+	// The time in range of the position is carried over to the new range.
+	performance, hasPerformance, err := k.GetPositionPerformance(ctx, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	var timeInRange time.Duration
+	if hasPerformance {
+		timeInRange, err = k.getPositionTimeInRange(ctx, position, performance)
+		if err != nil {
+			return CreatePositionData{}, err
+		}
+	}
+
+	// Trigger before hook for WithdrawPosition prior to mutating state.
+	// If no contract is set, this will be a no-op.
+	err = k.BeforeWithdrawPosition(ctx, position.PoolId, owner, positionId, position.Liquidity)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// Withdraw the full liquidity from the current range, without claiming the spread rewards and incentives.
+	withdrawData, err := k.UpdatePosition(ctx, position.PoolId, owner, position.LowerTick, position.UpperTick, position.Liquidity.Neg(), position.JoinTime, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// Take the unclaimed rewards out of the position accumulators of the current range, to carry them over to the new range.
+	unclaimedSpreadRewards, unclaimedIncentives, err := k.deletePositionAccsAndGetUnclaimedRewards(ctx, position.PoolId, positionId, position.LowerTick, position.UpperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	if withdrawData.LowerTickIsEmpty {
+		k.RemoveTickInfo(ctx, position.PoolId, position.LowerTick)
+	}
+	if withdrawData.UpperTickIsEmpty {
+		k.RemoveTickInfo(ctx, position.PoolId, position.UpperTick)
+	}
+
+	// Delete the position in the current range so that it is recreated under the same id in the new range.
+	// Its auto-compounding opt-in, deleted along with it, is kept.
+	autoCompound, hasAutoCompound, err := k.GetPositionAutoCompound(ctx, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	if err := k.deletePosition(ctx, positionId, owner, position.PoolId); err != nil {
+		return CreatePositionData{}, err
+	}
+	if hasAutoCompound {
+		k.setPositionAutoCompound(ctx, autoCompound)
+	}
+
+	amount0Withdrawn, amount1Withdrawn := withdrawData.Amount0.Neg(), withdrawData.Amount1.Neg()
+	err = k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), amount0Withdrawn, amount1Withdrawn, pool.GetAddress(), owner)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	tokensRemoved := sdk.Coins{}
+	if amount0Withdrawn.IsPositive() {
+		tokensRemoved = tokensRemoved.Add(sdk.NewCoin(pool.GetToken0(), amount0Withdrawn))
+	}
+	if amount1Withdrawn.IsPositive() {
+		tokensRemoved = tokensRemoved.Add(sdk.NewCoin(pool.GetToken1(), amount1Withdrawn))
+	}
+	k.RecordTotalLiquidityDecrease(ctx, tokensRemoved)
+
+	// Trigger after hook for WithdrawPosition.
+	// If no contract is set, this will be a no-op.
+	err = k.AfterWithdrawPosition(ctx, position.PoolId, owner, positionId, position.Liquidity)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// Swap the surplus token through the same pool. The swap is routed through the pool manager so that the taker fee applies.
+	amount0Desired, amount1Desired := amount0Withdrawn, amount1Withdrawn
+	if swap {
+		isToken0In := tokenSwappedIn.Denom == pool.GetToken0()
+		amountInWithdrawn, tokenOutDenom := amount1Withdrawn, pool.GetToken0()
+		if isToken0In {
+			amountInWithdrawn, tokenOutDenom = amount0Withdrawn, pool.GetToken1()
+		}
+		if tokenSwappedIn.Amount.GT(amountInWithdrawn) {
+			return CreatePositionData{}, types.RebalanceSwapExceedsWithdrawnError{PositionId: positionId, TokenSwappedIn: tokenSwappedIn, AmountWithdrawn: amountInWithdrawn}
+		}
+
+		route := []poolmanagertypes.SwapAmountInRoute{{PoolId: position.PoolId, TokenOutDenom: tokenOutDenom}}
+		tokenOutAmount, err := k.poolmanagerKeeper.RouteExactAmountIn(ctx, owner, route, tokenSwappedIn, tokenOutMinAmount)
+		if err != nil {
+			return CreatePositionData{}, err
+		}
+
+		if isToken0In {
+			amount0Desired, amount1Desired = amount0Desired.Sub(tokenSwappedIn.Amount), amount1Desired.Add(tokenOutAmount)
+		} else {
+			amount0Desired, amount1Desired = amount0Desired.Add(tokenOutAmount), amount1Desired.Sub(tokenSwappedIn.Amount)
+		}
+
+		// Refetch the pool, as the swap moves its current tick.
+		pool, err = k.getPoolById(ctx, position.PoolId)
+		if err != nil {
+			return CreatePositionData{}, err
+		}
+	}
+
+	tokensProvided := sdk.Coins{}
+	if amount0Desired.IsPositive() {
+		tokensProvided = tokensProvided.Add(sdk.NewCoin(pool.GetToken0(), amount0Desired))
+	}
+	if amount1Desired.IsPositive() {
+		tokensProvided = tokensProvided.Add(sdk.NewCoin(pool.GetToken1(), amount1Desired))
+	}
+
+	// Trigger before hook for CreatePosition prior to providing liquidity in the new range.
+	// If no contract is set, this will be a no-op.
+	err = k.BeforeCreatePosition(ctx, position.PoolId, owner, tokensProvided, amount0Min, amount1Min, newLowerTick, newUpperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	liquidityDelta := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0Desired, amount1Desired)
+	if liquidityDelta.IsZero() {
+		return CreatePositionData{}, types.ErrZeroLiquidity
+	}
+
+	// Provide the liquidity in the new range under the same position id and join time.
+	createData, err := k.UpdatePosition(ctx, position.PoolId, owner, newLowerTick, newUpperTick, liquidityDelta, position.JoinTime, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	if createData.Amount0.LT(amount0Min) {
+		return CreatePositionData{}, types.InsufficientLiquidityCreatedError{Actual: createData.Amount0, Minimum: amount0Min, IsTokenZero: true}
+	}
+	if createData.Amount1.LT(amount1Min) {
+		return CreatePositionData{}, types.InsufficientLiquidityCreatedError{Actual: createData.Amount1, Minimum: amount1Min}
+	}
+
+	err = k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), createData.Amount0, createData.Amount1, owner, pool.GetAddress())
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// Carry the unclaimed rewards over to the position accumulators of the new range.
+	err = k.addUnclaimedRewardsToPositionAccs(ctx, position.PoolId, positionId, newLowerTick, newUpperTick, unclaimedSpreadRewards, unclaimedIncentives)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	if hasPerformance {
+		if err := k.setPositionPerformanceTimeInRange(ctx, performance, position.PoolId, newLowerTick, newUpperTick, timeInRange); err != nil {
+			return CreatePositionData{}, err
+		}
+	}
+
+	tokensAdded := sdk.Coins{}
+	if createData.Amount0.IsPositive() {
+		tokensAdded = tokensAdded.Add(sdk.NewCoin(pool.GetToken0(), createData.Amount0))
+	}
+	if createData.Amount1.IsPositive() {
+		tokensAdded = tokensAdded.Add(sdk.NewCoin(pool.GetToken1(), createData.Amount1))
+	}
+	k.RecordTotalLiquidityIncrease(ctx, tokensAdded)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtRebalancePosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(position.LowerTick, 10)),
+			sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(position.UpperTick, 10)),
+			sdk.NewAttribute(types.AttributeNewLowerTick, strconv.FormatInt(newLowerTick, 10)),
+			sdk.NewAttribute(types.AttributeNewUpperTick, strconv.FormatInt(newUpperTick, 10)),
+			sdk.NewAttribute(types.AttributeLiquidity, liquidityDelta.String()),
+			sdk.NewAttribute(types.AttributeAmount0, createData.Amount0.String()),
+			sdk.NewAttribute(types.AttributeAmount1, createData.Amount1.String()),
+		),
+	})
+
+	// Trigger after hook for CreatePosition.
+	// If no contract is set, this will be a no-op.
+	err = k.AfterCreatePosition(ctx, position.PoolId, owner, tokensProvided, amount0Min, amount1Min, newLowerTick, newUpperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	return CreatePositionData{
+		ID:        positionId,
+		Amount0:   createData.Amount0,
+		Amount1:   createData.Amount1,
+		Liquidity: liquidityDelta,
+		LowerTick: newLowerTick,
+		UpperTick: newUpperTick,
+	}, nil
+}
+
+// deletePositionAccsAndGetUnclaimedRewards claims the spread rewards and incentives of the position in the given range
+// from the pool accumulators, and deletes its position trackers.
+// Returns the claimed spread rewards and incentives by uptime, to be carried over with addUnclaimedRewardsToPositionAccs.
+func (k Keeper) deletePositionAccsAndGetUnclaimedRewards(ctx sdk.Context, poolId, positionId uint64, lowerTick, upperTick int64) (sdk.DecCoins, []sdk.DecCoins, error) {
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, poolId)
+	if err != nil {
+		return sdk.DecCoins{}, nil, err
+	}
+	spreadRewardGrowthOutside, err := k.getSpreadRewardGrowthOutside(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return sdk.DecCoins{}, nil, err
+	}
+	unclaimedSpreadRewards, err := deleteOldAccAndGetUnclaimedRewards(spreadRewardAccumulator, types.KeySpreadRewardPositionAccumulator(positionId), spreadRewardGrowthOutside)
+	if err != nil {
+		return sdk.DecCoins{}, nil, err
+	}
+
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return sdk.DecCoins{}, nil, err
+	}
+	uptimeGrowthOutside, err := k.GetUptimeGrowthOutsideRange(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return sdk.DecCoins{}, nil, err
+	}
+
+	positionName := string(types.KeyPositionId(positionId))
+	unclaimedIncentives := make([]sdk.DecCoins, len(uptimeAccumulators))
+	for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+		unclaimedIncentives[uptimeIndex], err = deleteOldAccAndGetUnclaimedRewards(uptimeAccum, positionName, uptimeGrowthOutside[uptimeIndex])
+		if err != nil {
+			return sdk.DecCoins{}, nil, err
+		}
+	}
+
+	return unclaimedSpreadRewards, unclaimedIncentives, nil
+}
+
+// addUnclaimedRewardsToPositionAccs adds the given spread rewards and incentives by uptime as unclaimed rewards
+// of the position in the given range, in the pool accumulators.
+func (k Keeper) addUnclaimedRewardsToPositionAccs(ctx sdk.Context, poolId, positionId uint64, lowerTick, upperTick int64, unclaimedSpreadRewards sdk.DecCoins, unclaimedIncentives []sdk.DecCoins) error {
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	spreadRewardGrowthOutside, err := k.getSpreadRewardGrowthOutside(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return err
+	}
+	err = addUnclaimedRewardsToNewAcc(spreadRewardAccumulator, types.KeySpreadRewardPositionAccumulator(positionId), unclaimedSpreadRewards, spreadRewardGrowthOutside)
+	if err != nil {
+		return err
+	}
+
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	uptimeGrowthOutside, err := k.GetUptimeGrowthOutsideRange(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return err
+	}
+
+	positionName := string(types.KeyPositionId(positionId))
+	for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+		err = addUnclaimedRewardsToNewAcc(uptimeAccum, positionName, unclaimedIncentives[uptimeIndex], uptimeGrowthOutside[uptimeIndex])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // UpdatePosition updates the position in the given pool id and in the given tick range and liquidityAmount.
 // Negative liquidityDelta implies withdrawing liquidity.
 // Positive liquidityDelta implies adding liquidity.
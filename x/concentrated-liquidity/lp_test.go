@@ -2212,3 +2212,178 @@ func (s *KeeperTestSuite) TestValidatePositionUpdateById() {
 		})
 	}
 }
+
+// validates that rebalancing a position moves its liquidity to the new range under the same position id and join time,
+// carrying over its unclaimed spread rewards and incentives.
+func (s *KeeperTestSuite) TestRebalancePosition() {
+	const (
+		lowerTick = int64(-1000)
+		upperTick = int64(1000)
+	)
+	positionCoinAmount := osmomath.NewInt(1_000_000_000_000_000)
+
+	tests := map[string]struct {
+		newLowerTick, newUpperTick int64
+		tokenSwappedIn             sdk.Coin
+		amount1Min                 osmomath.Int
+		sender                     sdk.AccAddress
+
+		expectedSingleSidedToken0 bool
+		expectedError             error
+		expectedErrorContains     string
+	}{
+		"wider range, no swap": {
+			newLowerTick: -2000,
+			newUpperTick: 2000,
+		},
+		"narrower range, no swap": {
+			newLowerTick: -500,
+			newUpperTick: 500,
+		},
+		"range above the current tick, swapping part of token1": {
+			newLowerTick:   1500,
+			newUpperTick:   3000,
+			tokenSwappedIn: sdk.NewCoin(USDC, positionCoinAmount.QuoRaw(20)),
+
+			expectedSingleSidedToken0: true,
+		},
+		"error: same range": {
+			newLowerTick: lowerTick,
+			newUpperTick: upperTick,
+
+			expectedError: types.RebalanceToSameRangeError{PositionId: 2, LowerTick: lowerTick, UpperTick: upperTick},
+		},
+		"error: not the position owner": {
+			newLowerTick: -2000,
+			newUpperTick: 2000,
+			sender:       s.TestAccs[2],
+
+			expectedError: types.NotPositionOwnerError{PositionId: 2, Address: s.TestAccs[2].String()},
+		},
+		"error: token swapped in exceeds the amount withdrawn": {
+			newLowerTick:   100,
+			newUpperTick:   1000,
+			tokenSwappedIn: sdk.NewCoin(USDC, positionCoinAmount.MulRaw(2)),
+
+			expectedErrorContains: "exceeds the amount",
+		},
+		"error: token swapped in is not a pool token": {
+			newLowerTick:   100,
+			newUpperTick:   1000,
+			tokenSwappedIn: sdk.NewCoin("foo", osmomath.OneInt()),
+
+			expectedErrorContains: "is not one of the pool tokens",
+		},
+		"error: amount1 provided in the new range is below the minimum": {
+			newLowerTick: -2000,
+			newUpperTick: 2000,
+			amount1Min:   positionCoinAmount,
+
+			expectedErrorContains: "insufficient amount of token 1 created",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+			owner := s.TestAccs[1]
+
+			// Incentivize the pool and create the position to rebalance.
+			incentiveCoin := sdk.NewCoin(ETH, osmomath.NewInt(1_000_000))
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(incentiveCoin))
+			_, err := clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[0], incentiveCoin, osmomath.NewDec(100), s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+			s.Require().NoError(err)
+
+			positionCoins := sdk.NewCoins(sdk.NewCoin(ETH, positionCoinAmount), sdk.NewCoin(USDC, positionCoinAmount))
+			s.FundAcc(owner, positionCoins)
+			positionData, err := clKeeper.CreatePosition(s.Ctx, pool.GetId(), owner, positionCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+			s.Require().NoError(err)
+			positionId := positionData.ID
+			joinTime := s.Ctx.BlockTime()
+
+			// Accrue spread rewards and incentives to the position.
+			s.AddToSpreadRewardAccumulator(pool.GetId(), sdk.NewDecCoin(ETH, osmomath.NewInt(10)))
+			s.AddBlockTime(time.Minute)
+			expectedSpreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().False(expectedSpreadRewards.IsZero())
+			s.FundAcc(pool.GetSpreadRewardsAddress(), expectedSpreadRewards)
+			expectedIncentives, _, err := clKeeper.GetClaimableIncentives(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().False(expectedIncentives.IsZero())
+
+			sender := owner
+			if tc.sender != nil {
+				sender = tc.sender
+			}
+			tokenSwappedIn := tc.tokenSwappedIn
+			if tokenSwappedIn.Amount.IsNil() {
+				tokenSwappedIn = sdk.NewCoin(USDC, osmomath.ZeroInt())
+			}
+			amount1Min := osmomath.ZeroInt()
+			if !tc.amount1Min.IsNil() {
+				amount1Min = tc.amount1Min
+			}
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test.
+			rebalanceData, err := clKeeper.RebalancePosition(s.Ctx, sender, positionId, tc.newLowerTick, tc.newUpperTick, tokenSwappedIn, osmomath.ZeroInt(), osmomath.ZeroInt(), amount1Min)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			if tc.expectedErrorContains != "" {
+				s.Require().ErrorContains(err, tc.expectedErrorContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtRebalancePosition, 1)
+
+			// The position keeps its id and join time, in the new range.
+			s.Require().Equal(positionId, rebalanceData.ID)
+			position, err := clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.newLowerTick, position.LowerTick)
+			s.Require().Equal(tc.newUpperTick, position.UpperTick)
+			s.Require().Equal(joinTime.UTC(), position.JoinTime.UTC())
+			s.Require().Equal(rebalanceData.Liquidity, position.Liquidity)
+			userPositions, err := clKeeper.GetUserPositions(s.Ctx, owner, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Len(userPositions, 1)
+
+			// The ticks of the previous range, only used by the position, are removed.
+			for _, tick := range []int64{lowerTick, upperTick} {
+				tickInfo, err := clKeeper.GetTickInfo(s.Ctx, pool.GetId(), tick)
+				s.Require().NoError(err)
+				s.Require().True(tickInfo.LiquidityGross.IsZero())
+			}
+
+			if tc.expectedSingleSidedToken0 {
+				s.Require().True(rebalanceData.Amount0.IsPositive())
+				s.Require().True(rebalanceData.Amount1.IsZero())
+			} else {
+				s.Require().True(rebalanceData.Amount0.IsPositive())
+				s.Require().True(rebalanceData.Amount1.IsPositive())
+			}
+
+			// The owner keeps the tokens that could not be provided in the new range.
+			ownerBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			s.Require().True(ownerBalanceAfter.IsAllGTE(ownerBalanceBefore))
+
+			// The unclaimed spread rewards and incentives are carried over.
+			spreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedSpreadRewards, spreadRewards)
+			incentives, _, err := clKeeper.GetClaimableIncentives(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedIncentives, incentives)
+
+			// The rebalanced position is fully withdrawable.
+			_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionId, position.Liquidity)
+			s.Require().NoError(err)
+		})
+	}
+}
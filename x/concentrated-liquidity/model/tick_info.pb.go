@@ -15,15 +15,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -55,6 +59,12 @@ type TickInfo struct {
 	// because we need the ability to serialize and deserialize the
 	// container easily for events when crossing a tick.
 	UptimeTrackers UptimeTrackers `protobuf:"bytes,4,opt,name=uptime_trackers,json=uptimeTrackers,proto3" json:"uptime_trackers" yaml:"uptime_trackers"`
+	// Time elapsed in the opposite direction that the tick was last crossed,
+	// since the Unix epoch. It is tracked like the spread rewards, so that the
+	// time a position spends in range can be derived from the values of its
+	// ticks. The uptime trackers cannot serve this purpose, as their growth is
+	// the time elapsed divided by the active liquidity.
+	TimeOppositeDirectionOfLastTraversal time.Duration `protobuf:"bytes,5,opt,name=time_opposite_direction_of_last_traversal,json=timeOppositeDirectionOfLastTraversal,proto3,stdduration" json:"time_opposite_direction_of_last_traversal" yaml:"time_opposite_direction_of_last_traversal"`
 }
 
 func (m *TickInfo) Reset()         { *m = TickInfo{} }
@@ -104,6 +114,13 @@ func (m *TickInfo) GetUptimeTrackers() UptimeTrackers {
 	return UptimeTrackers{}
 }
 
+func (m *TickInfo) GetTimeOppositeDirectionOfLastTraversal() time.Duration {
+	if m != nil {
+		return m.TimeOppositeDirectionOfLastTraversal
+	}
+	return 0
+}
+
 type UptimeTrackers struct {
 	List []UptimeTracker `protobuf:"bytes,1,rep,name=list,proto3" json:"list" yaml:"list"`
 }
@@ -203,41 +220,45 @@ func init() {
 }
 
 var fileDescriptor_193d635744e474d2 = []byte{
-	// 540 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xcf, 0x8a, 0xd3, 0x50,
-	0x14, 0xc6, 0x1b, 0xa7, 0x88, 0xa6, 0x4e, 0x07, 0x32, 0xa3, 0x94, 0x51, 0xd2, 0x12, 0x10, 0x0a,
-	0xd2, 0x1b, 0xda, 0x3a, 0x0b, 0x15, 0x37, 0xb1, 0x30, 0x08, 0x83, 0x03, 0xa1, 0x6e, 0x44, 0x89,
-	0xb7, 0x37, 0xb7, 0xed, 0xa5, 0x49, 0x6e, 0xcc, 0x39, 0xe9, 0xd8, 0x8d, 0x3b, 0xf7, 0xee, 0x5c,
-	0xba, 0xf7, 0x49, 0xba, 0x9c, 0xa5, 0xb8, 0xa8, 0xd2, 0xbe, 0x81, 0x4f, 0x20, 0xf9, 0xd7, 0xda,
-	0xe2, 0x62, 0x10, 0x5c, 0x25, 0xf7, 0xe6, 0x7c, 0xdf, 0xf7, 0xe3, 0x9c, 0x1c, 0xf5, 0x44, 0x82,
-	0x2f, 0x41, 0x80, 0xc9, 0x64, 0xc0, 0x78, 0x80, 0x11, 0x45, 0xee, 0x7a, 0xe2, 0x5d, 0x2c, 0x5c,
-	0x81, 0x33, 0x73, 0xda, 0x1e, 0x70, 0xa4, 0x6d, 0x13, 0x05, 0x9b, 0x38, 0x22, 0x18, 0x4a, 0x12,
-	0x46, 0x12, 0xa5, 0x76, 0x3f, 0x97, 0x91, 0xbf, 0xca, 0x48, 0x2e, 0x3b, 0x3e, 0x1a, 0xc9, 0x91,
-	0x4c, 0x15, 0x66, 0xf2, 0x96, 0x89, 0x8f, 0x75, 0x96, 0xaa, 0xcd, 0x01, 0x05, 0xbe, 0x4e, 0x60,
-	0x52, 0x04, 0xd9, 0x77, 0xe3, 0x4b, 0x59, 0xbd, 0xd1, 0x17, 0x6c, 0xf2, 0x3c, 0x18, 0x4a, 0x6d,
-	0xa8, 0x1e, 0xac, 0x7d, 0x9d, 0x51, 0x24, 0x01, 0x6a, 0x4a, 0x43, 0x69, 0xde, 0xb4, 0x9e, 0xce,
-	0x17, 0xf5, 0xd2, 0xf7, 0x45, 0xfd, 0x6e, 0xe6, 0x06, 0xee, 0x84, 0x08, 0x69, 0xfa, 0x14, 0xc7,
-	0xe4, 0x8c, 0x8f, 0x28, 0x9b, 0xf5, 0x38, 0xfb, 0xb5, 0xa8, 0xdf, 0x99, 0x51, 0xdf, 0x7b, 0x6c,
-	0xec, 0x78, 0x18, 0x76, 0x75, 0x7d, 0x73, 0x9a, 0x5c, 0x68, 0x6f, 0xd5, 0xfd, 0x4d, 0x4d, 0xc0,
-	0xb1, 0x76, 0x2d, 0x4d, 0x79, 0x72, 0xb5, 0x94, 0xa3, 0xdd, 0x94, 0x80, 0xa3, 0x61, 0xdf, 0x5a,
-	0x9f, 0x5f, 0x70, 0xd4, 0xe6, 0x8a, 0xfa, 0x08, 0xc2, 0x88, 0x53, 0xd7, 0x89, 0xf8, 0x05, 0x8d,
-	0xdc, 0x04, 0xe5, 0x02, 0xc7, 0x8e, 0x0c, 0x43, 0x09, 0x02, 0xb9, 0xe3, 0x8a, 0x88, 0x33, 0x14,
-	0x32, 0x70, 0xe4, 0xd0, 0xf1, 0x28, 0xa0, 0x83, 0x11, 0x9d, 0xf2, 0x08, 0xa8, 0x57, 0xdb, 0x6b,
-	0xec, 0x35, 0x2b, 0x9d, 0x7b, 0x24, 0xe3, 0x20, 0x49, 0xef, 0x8a, 0x36, 0x93, 0x1e, 0x67, 0xcf,
-	0xa4, 0x08, 0xac, 0x6e, 0x02, 0xfb, 0xf5, 0x47, 0xfd, 0xc1, 0x48, 0xe0, 0x38, 0x1e, 0x10, 0x26,
-	0x7d, 0x33, 0xef, 0x75, 0xf6, 0x68, 0x81, 0x3b, 0x31, 0x71, 0x16, 0x72, 0x28, 0x34, 0x60, 0x77,
-	0x32, 0x26, 0x3b, 0x45, 0x3a, 0x4d, 0x89, 0xce, 0x73, 0xa0, 0x5e, 0xc1, 0x73, 0x3e, 0x3c, 0xa3,
-	0x80, 0xfd, 0x02, 0x46, 0xfb, 0xa0, 0x1e, 0xc4, 0x21, 0x0a, 0x9f, 0x27, 0x80, 0x6c, 0xc2, 0x23,
-	0xa8, 0x95, 0x1b, 0x4a, 0xb3, 0xd2, 0x39, 0x21, 0x57, 0xfa, 0x31, 0xc8, 0xcb, 0x54, 0xdd, 0xcf,
-	0xc5, 0x96, 0x9e, 0x80, 0x6f, 0x86, 0xb5, 0xe3, 0x6d, 0xd8, 0xd5, 0x78, 0xab, 0xde, 0x90, 0x6a,
-	0x75, 0xdb, 0x41, 0x7b, 0xa3, 0x96, 0x3d, 0x01, 0x58, 0x53, 0xd2, 0x36, 0x3d, 0xfc, 0x17, 0x0c,
-	0xeb, 0x30, 0xa7, 0xa8, 0x14, 0xc3, 0x04, 0x34, 0xec, 0xd4, 0xd6, 0xf8, 0xac, 0xa8, 0xfb, 0x5b,
-	0xc5, 0xda, 0x47, 0x45, 0xbd, 0x9d, 0x73, 0x16, 0x63, 0x8c, 0x11, 0x84, 0xcb, 0x73, 0x84, 0xff,
-	0x30, 0xa9, 0xc3, 0x2c, 0x2f, 0x9f, 0x51, 0x96, 0x66, 0xbd, 0x9e, 0x2f, 0x75, 0xe5, 0x72, 0xa9,
-	0x2b, 0x3f, 0x97, 0xba, 0xf2, 0x69, 0xa5, 0x97, 0x2e, 0x57, 0x7a, 0xe9, 0xdb, 0x4a, 0x2f, 0xbd,
-	0xb2, 0xfe, 0xf0, 0xce, 0xdb, 0xd1, 0xf2, 0xe8, 0x00, 0x8a, 0x83, 0x39, 0xed, 0xb6, 0xcd, 0xf7,
-	0x5b, 0x8b, 0xdf, 0xda, 0x6c, 0xbe, 0x2f, 0x5d, 0xee, 0x0d, 0xae, 0xa7, 0x1b, 0xd9, 0xfd, 0x1d,
-	0x00, 0x00, 0xff, 0xff, 0xbb, 0x08, 0xc2, 0xa7, 0x27, 0x04, 0x00, 0x00,
+	// 602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x6b, 0xd4, 0x4e,
+	0x18, 0xde, 0xf9, 0xb5, 0xfd, 0xa1, 0xa9, 0x6d, 0x21, 0xad, 0x12, 0xab, 0x64, 0x4b, 0x50, 0xa8,
+	0x48, 0x67, 0x6c, 0x6b, 0x0f, 0xfe, 0xbb, 0xc4, 0x85, 0x22, 0x14, 0x0b, 0xa1, 0x5e, 0x44, 0x89,
+	0x93, 0xc9, 0x24, 0x1d, 0x36, 0xc9, 0xc4, 0xcc, 0xa4, 0x75, 0x2f, 0xde, 0xbc, 0x7b, 0xd3, 0xcf,
+	0xe0, 0xc9, 0x8f, 0xd1, 0x63, 0xf1, 0x24, 0x1e, 0x5a, 0xd9, 0xfd, 0x06, 0x7e, 0x02, 0xc9, 0x64,
+	0xb2, 0xeb, 0x2e, 0x82, 0x8b, 0xe0, 0x69, 0xf7, 0x9d, 0x79, 0x9f, 0xf7, 0x79, 0xf2, 0xbc, 0x0f,
+	0x63, 0xec, 0x70, 0x91, 0x72, 0xc1, 0x04, 0x22, 0x3c, 0x23, 0x34, 0x93, 0x05, 0x96, 0x34, 0x4c,
+	0xd8, 0xeb, 0x92, 0x85, 0x4c, 0xf6, 0xd0, 0xd1, 0x66, 0x40, 0x25, 0xde, 0x44, 0x92, 0x91, 0xae,
+	0xcf, 0xb2, 0x88, 0xc3, 0xbc, 0xe0, 0x92, 0x9b, 0x37, 0x35, 0x0c, 0xfe, 0x16, 0x06, 0x35, 0x6c,
+	0x75, 0x25, 0xe6, 0x31, 0x57, 0x08, 0x54, 0xfd, 0xab, 0xc1, 0xab, 0x36, 0x51, 0x68, 0x14, 0x60,
+	0x41, 0x87, 0x0c, 0x84, 0xb3, 0xac, 0xb9, 0x8f, 0x39, 0x8f, 0x13, 0x8a, 0x54, 0x15, 0x94, 0x11,
+	0x0a, 0xcb, 0x02, 0x4b, 0xc6, 0xf5, 0xbd, 0xf3, 0x65, 0xce, 0xb8, 0x70, 0xc0, 0x48, 0xf7, 0x49,
+	0x16, 0x71, 0x33, 0x32, 0x96, 0x86, 0xbc, 0x7e, 0x5c, 0x70, 0x21, 0x2c, 0xb0, 0x06, 0xd6, 0x2f,
+	0xba, 0x8f, 0x4e, 0xce, 0xda, 0xad, 0x6f, 0x67, 0xed, 0x6b, 0x35, 0x9b, 0x08, 0xbb, 0x90, 0x71,
+	0x94, 0x62, 0x79, 0x08, 0xf7, 0x68, 0x8c, 0x49, 0xaf, 0x43, 0xc9, 0x8f, 0xb3, 0xf6, 0x95, 0x1e,
+	0x4e, 0x93, 0xfb, 0xce, 0xc4, 0x0c, 0xc7, 0x5b, 0x1c, 0x9e, 0xec, 0x56, 0x07, 0xe6, 0x2b, 0x63,
+	0x61, 0xd4, 0x93, 0x51, 0x69, 0xfd, 0xa7, 0x58, 0x1e, 0x4c, 0xc7, 0xb2, 0x32, 0xc9, 0x92, 0x51,
+	0xe9, 0x78, 0x97, 0x86, 0xf5, 0x53, 0x2a, 0xcd, 0x13, 0x60, 0xdc, 0x13, 0x79, 0x41, 0x71, 0xe8,
+	0x17, 0xf4, 0x18, 0x17, 0x61, 0x25, 0xe5, 0x58, 0x1e, 0xfa, 0x3c, 0xcf, 0xb9, 0x60, 0x92, 0xfa,
+	0x21, 0x2b, 0x28, 0xa9, 0x8c, 0xf0, 0x79, 0xe4, 0x27, 0x58, 0x48, 0x5f, 0x16, 0xf8, 0x88, 0x16,
+	0x02, 0x27, 0xd6, 0xcc, 0xda, 0xcc, 0xfa, 0xfc, 0xd6, 0x75, 0x58, 0xeb, 0x80, 0x95, 0xb7, 0xcd,
+	0x1a, 0x60, 0x87, 0x92, 0xc7, 0x9c, 0x65, 0xee, 0x76, 0x25, 0xf6, 0xd3, 0x79, 0xfb, 0x76, 0xcc,
+	0xe4, 0x61, 0x19, 0x40, 0xc2, 0x53, 0xa4, 0x77, 0x51, 0xff, 0x6c, 0x88, 0xb0, 0x8b, 0x64, 0x2f,
+	0xa7, 0xa2, 0xc1, 0x08, 0x6f, 0xab, 0xd6, 0xe4, 0x29, 0x49, 0xbb, 0x4a, 0xd1, 0xbe, 0x16, 0xd4,
+	0x69, 0xf4, 0xec, 0x47, 0x7b, 0x58, 0xc8, 0x83, 0x46, 0x8c, 0xf9, 0xd6, 0x58, 0x2a, 0x73, 0xc9,
+	0x52, 0x5a, 0x09, 0x24, 0x5d, 0x5a, 0x08, 0x6b, 0x76, 0x0d, 0xac, 0xcf, 0x6f, 0xed, 0xc0, 0xa9,
+	0x82, 0x03, 0x9f, 0x29, 0xf4, 0x81, 0x06, 0xbb, 0x76, 0x25, 0x7c, 0xb4, 0xac, 0x89, 0xd9, 0x8e,
+	0xb7, 0x58, 0x8e, 0xf5, 0x9b, 0x9f, 0x81, 0x71, 0x4b, 0xb5, 0x4c, 0x65, 0xdd, 0x9c, 0x92, 0x76,
+	0x15, 0xd6, 0xb1, 0x83, 0x4d, 0xec, 0x60, 0x47, 0xc7, 0xce, 0x7d, 0xa8, 0xe9, 0xef, 0xd4, 0xf4,
+	0x53, 0x4f, 0x76, 0x3e, 0x9e, 0xb7, 0x81, 0x77, 0xa3, 0xea, 0xff, 0x93, 0x65, 0x0e, 0x37, 0x16,
+	0xc7, 0x3f, 0xda, 0x7c, 0x69, 0xcc, 0x26, 0x4c, 0x48, 0x0b, 0xa8, 0xcd, 0xde, 0xfd, 0x1b, 0xe7,
+	0xdc, 0x65, 0xad, 0x7c, 0xbe, 0xc9, 0x9f, 0x90, 0x8e, 0xa7, 0xc6, 0x3a, 0x1f, 0x80, 0xb1, 0x30,
+	0xd6, 0x6c, 0xbe, 0x03, 0xc6, 0x65, 0x6d, 0x6d, 0x93, 0xbc, 0x52, 0x0a, 0x16, 0x52, 0x0b, 0xfc,
+	0xab, 0x70, 0x2d, 0xd7, 0x7c, 0x3a, 0x56, 0x35, 0x9b, 0xfb, 0xe2, 0xa4, 0x6f, 0x83, 0xd3, 0xbe,
+	0x0d, 0xbe, 0xf7, 0x6d, 0xf0, 0x7e, 0x60, 0xb7, 0x4e, 0x07, 0x76, 0xeb, 0xeb, 0xc0, 0x6e, 0x3d,
+	0x77, 0x7f, 0x99, 0xad, 0xed, 0xd8, 0x48, 0x70, 0x20, 0x9a, 0x02, 0x1d, 0x6d, 0x6f, 0xa2, 0x37,
+	0x63, 0x6f, 0xd9, 0xc6, 0xe8, 0x31, 0x4b, 0x79, 0x48, 0x93, 0xe0, 0x7f, 0xb5, 0xdf, 0xed, 0x9f,
+	0x03, 0x00, 0x78, 0x06, 0xec, 0x63, 0xfa, 0x04, 0x00, 0x00,
 }
 
 func (m *TickInfo) Marshal() (dAtA []byte, err error) {
@@ -260,6 +281,14 @@ func (m *TickInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeOppositeDirectionOfLastTraversal, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeOppositeDirectionOfLastTraversal):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTickInfo(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.UptimeTrackers.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -410,6 +439,8 @@ func (m *TickInfo) Size() (n int) {
 	}
 	l = m.UptimeTrackers.Size()
 	n += 1 + l + sovTickInfo(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeOppositeDirectionOfLastTraversal)
+	n += 1 + l + sovTickInfo(uint64(l))
 	return n
 }
 
@@ -613,6 +644,39 @@ func (m *TickInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeOppositeDirectionOfLastTraversal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeOppositeDirectionOfLastTraversal, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTickInfo(dAtA[iNdEx:])
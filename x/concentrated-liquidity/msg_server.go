@@ -53,7 +53,12 @@ func (server msgServer) CreatePosition(goCtx context.Context, msg *types.MsgCrea
 		return nil, err
 	}
 
-	positionData, err := server.keeper.CreatePosition(ctx, msg.PoolId, sender, msg.TokensProvided, msg.TokenMinAmount0, msg.TokenMinAmount1, msg.LowerTick, msg.UpperTick)
+	createPosition := server.keeper.CreatePosition
+	if msg.RangeOrder {
+		createPosition = server.keeper.CreateRangeOrder
+	}
+
+	positionData, err := createPosition(ctx, msg.PoolId, sender, msg.TokensProvided, msg.TokenMinAmount0, msg.TokenMinAmount1, msg.LowerTick, msg.UpperTick)
 	if err != nil {
 		return nil, err
 	}
@@ -198,3 +203,49 @@ func (server msgServer) TransferPositions(goCtx context.Context, msg *types.MsgT
 
 	return &types.MsgTransferPositionsResponse{}, nil
 }
+
+// RebalancePosition moves the liquidity of a position to a new tick range, optionally swapping the surplus token
+// through the same pool, while keeping its position id, join time and unclaimed rewards.
+func (server msgServer) RebalancePosition(goCtx context.Context, msg *types.MsgRebalancePosition) (*types.MsgRebalancePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// A nil minimum amount out is only valid without a swap, in which case it is unused.
+	if msg.TokenOutMinAmount.IsNil() {
+		msg.TokenOutMinAmount = osmomath.ZeroInt()
+	}
+	if msg.TokenMinAmount0.IsNil() {
+		msg.TokenMinAmount0 = osmomath.ZeroInt()
+	}
+	if msg.TokenMinAmount1.IsNil() {
+		msg.TokenMinAmount1 = osmomath.ZeroInt()
+	}
+
+	positionData, err := server.keeper.rebalancePosition(ctx, sender, msg.PositionId, msg.NewLowerTick, msg.NewUpperTick, msg.TokenSwappedIn, msg.TokenOutMinAmount, msg.TokenMinAmount0, msg.TokenMinAmount1)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: rebalance position event is emitted in keeper.rebalancePosition(...)
+
+	return &types.MsgRebalancePositionResponse{PositionId: positionData.ID, Amount0: positionData.Amount0, Amount1: positionData.Amount1, Liquidity: positionData.Liquidity, LowerTick: positionData.LowerTick, UpperTick: positionData.UpperTick}, nil
+}
+
+func (server msgServer) SetPositionAutoCompound(goCtx context.Context, msg *types.MsgSetPositionAutoCompound) (*types.MsgSetPositionAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.SetPositionAutoCompound(ctx, sender, msg.PositionId, msg.EpochIdentifier, msg.MaxSlippage); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPositionAutoCompoundResponse{}, nil
+}
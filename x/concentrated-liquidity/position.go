@@ -391,7 +391,13 @@ func (k Keeper) deletePosition(ctx sdk.Context,
 		store.Delete(lockIdPositionKey)
 	}
 
-	return nil
+	// Remove the range order of the position (if it exists)
+	if err := k.deleteRangeOrder(ctx, positionId); err != nil {
+		return err
+	}
+
+	// Remove the auto-compounding opt-in of the position (if it exists)
+	return k.deletePositionAutoCompound(ctx, positionId)
 }
 
 // CreateFullRangePosition creates a full range (min to max tick) concentrated liquidity position for the given pool ID, owner, and coins.
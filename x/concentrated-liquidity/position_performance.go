@@ -0,0 +1,147 @@
+package concentrated_liquidity
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+)
+
+// GetPositionPerformance returns the lifetime performance record of the given position.
+// Returns false if the position was created before the performance of the positions was recorded.
+func (k Keeper) GetPositionPerformance(ctx sdk.Context, positionId uint64) (types.PositionPerformance, bool, error) {
+	performance := types.PositionPerformance{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPositionPerformance(positionId), &performance)
+	if err != nil {
+		return types.PositionPerformance{}, false, err
+	}
+	return performance, found, nil
+}
+
+// setPositionPerformance stores the performance record of the position.
+func (k Keeper) setPositionPerformance(ctx sdk.Context, performance types.PositionPerformance) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPositionPerformance(performance.PositionId), &performance)
+}
+
+// deletePositionPerformance deletes the performance record of the position.
+func (k Keeper) deletePositionPerformance(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPositionPerformance(positionId))
+}
+
+// getAllPositionPerformances returns the performance records of all positions.
+func (k Keeper) getAllPositionPerformances(ctx sdk.Context) ([]types.PositionPerformance, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.PositionPerformancePrefix, parsePositionPerformance)
+}
+
+// GetPositionTimeInRange returns the time the current tick of the pool spent in the range of the given position since its creation.
+// Returns error if the position does not exist or has no performance record.
+func (k Keeper) GetPositionTimeInRange(ctx sdk.Context, positionId uint64) (time.Duration, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return 0, err
+	}
+	performance, found, err := k.GetPositionPerformance(ctx, positionId)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, types.PositionPerformanceNotFoundError{PositionId: positionId}
+	}
+	return k.getPositionTimeInRange(ctx, position, performance)
+}
+
+// initPositionPerformance records the amounts deposited in a new position, along with the time in range of its tick range.
+func (k Keeper) initPositionPerformance(ctx sdk.Context, pool types.ConcentratedPoolExtension, positionId uint64, lowerTick, upperTick int64, amount0, amount1 osmomath.Int) error {
+	performance := types.PositionPerformance{
+		PositionId:      positionId,
+		InitialAsset0:   sdk.NewCoin(pool.GetToken0(), amount0),
+		InitialAsset1:   sdk.NewCoin(pool.GetToken1(), amount1),
+		WithdrawnAsset0: sdk.NewCoin(pool.GetToken0(), osmomath.ZeroInt()),
+		WithdrawnAsset1: sdk.NewCoin(pool.GetToken1(), osmomath.ZeroInt()),
+	}
+	return k.setPositionPerformanceTimeInRange(ctx, performance, pool.GetId(), lowerTick, upperTick, 0)
+}
+
+// updatePositionPerformance applies the given update to the performance record of the position.
+// It is a no-op for the positions without a performance record.
+func (k Keeper) updatePositionPerformance(ctx sdk.Context, positionId uint64, update func(*types.PositionPerformance)) error {
+	performance, found, err := k.GetPositionPerformance(ctx, positionId)
+	if err != nil || !found {
+		return err
+	}
+	update(&performance)
+	k.setPositionPerformance(ctx, performance)
+	return nil
+}
+
+// setPositionPerformanceTimeInRange stores the performance record of a position in the given tick range, such that the
+// position has already spent timeInRange in range. This carries the time in range of a position over to a new tick range.
+func (k Keeper) setPositionPerformanceTimeInRange(ctx sdk.Context, performance types.PositionPerformance, poolId uint64, lowerTick, upperTick int64, timeInRange time.Duration) error {
+	rangeTimeInRange, err := k.getTimeInRange(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return err
+	}
+	performance.InitTimeInRange = rangeTimeInRange - timeInRange
+	k.setPositionPerformance(ctx, performance)
+	return nil
+}
+
+// getPositionTimeInRange returns the time the current tick of the pool spent in the range of the position since its creation.
+func (k Keeper) getPositionTimeInRange(ctx sdk.Context, position model.Position, performance types.PositionPerformance) (time.Duration, error) {
+	rangeTimeInRange, err := k.getTimeInRange(ctx, position.PoolId, position.LowerTick, position.UpperTick)
+	if err != nil {
+		return 0, err
+	}
+	return rangeTimeInRange - performance.InitTimeInRange, nil
+}
+
+// getTimeInRange returns the time the current tick of the pool spent in the given tick range, up to the current block time.
+// The value is offset by the time elapsed before the ticks were initialized, so only the differences of its values are meaningful.
+// It is computed from the time opposite direction of last traversal of the ticks, the same way as the spread reward growth inside.
+func (k Keeper) getTimeInRange(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64) (time.Duration, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+	currentTick := pool.GetCurrentTick()
+
+	lowerTickInfo, err := k.GetTickInfo(ctx, poolId, lowerTick)
+	if err != nil {
+		return 0, err
+	}
+	upperTickInfo, err := k.GetTickInfo(ctx, poolId, upperTick)
+	if err != nil {
+		return 0, err
+	}
+
+	timeGlobal := timeSinceUnixEpoch(ctx)
+	timeAboveUpperTick := calculateTimeOutside(upperTick, upperTickInfo.TimeOppositeDirectionOfLastTraversal, currentTick, timeGlobal, true)
+	timeBelowLowerTick := calculateTimeOutside(lowerTick, lowerTickInfo.TimeOppositeDirectionOfLastTraversal, currentTick, timeGlobal, false)
+	return timeGlobal - timeAboveUpperTick - timeBelowLowerTick, nil
+}
+
+// calculateTimeOutside returns the time elapsed above the upper tick or below the lower tick, as calculateSpreadRewardGrowth
+// does for the spread rewards.
+func calculateTimeOutside(targetTick int64, tickTimeOppositeDirectionOfLastTraversal time.Duration, currentTick int64, timeGlobal time.Duration, isUpperTick bool) time.Duration {
+	if (isUpperTick && currentTick >= targetTick) || (!isUpperTick && currentTick < targetTick) {
+		return timeGlobal - tickTimeOppositeDirectionOfLastTraversal
+	}
+	return tickTimeOppositeDirectionOfLastTraversal
+}
+
+// timeSinceUnixEpoch returns the time elapsed from the Unix epoch to the current block time.
+func timeSinceUnixEpoch(ctx sdk.Context) time.Duration {
+	return time.Duration(ctx.BlockTime().UnixNano())
+}
+
+func parsePositionPerformance(bz []byte) (types.PositionPerformance, error) {
+	performance := types.PositionPerformance{}
+	if err := performance.Unmarshal(bz); err != nil {
+		return types.PositionPerformance{}, err
+	}
+	return performance, nil
+}
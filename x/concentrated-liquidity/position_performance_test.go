@@ -0,0 +1,154 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/client"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+// validates that the performance of a position records its deposits, claimed rewards and time in range,
+// and that it follows the position when it is added to and withdrawn.
+func (s *KeeperTestSuite) TestPositionPerformance() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	querier := client.Querier{Keeper: *clKeeper}
+	pool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+	owner := s.TestAccs[1]
+
+	swap := func(tokenIn sdk.Coin, tokenOutDenom string) int64 {
+		s.FundAcc(s.TestAccs[2], sdk.NewCoins(tokenIn))
+		_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[2], []poolmanagertypes.SwapAmountInRoute{{PoolId: pool.GetId(), TokenOutDenom: tokenOutDenom}}, tokenIn, osmomath.OneInt())
+		s.Require().NoError(err)
+		pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		return pool.GetCurrentTick()
+	}
+	requireTimeInRange := func(positionId uint64, expectedTimeInRange time.Duration) {
+		timeInRange, err := clKeeper.GetPositionTimeInRange(s.Ctx, positionId)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedTimeInRange, timeInRange)
+	}
+
+	positionCoins := sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(1_000_000_000_000)), sdk.NewCoin(USDC, osmomath.NewInt(1_000_000_000_000)))
+	s.FundAcc(owner, positionCoins)
+	positionData, err := clKeeper.CreatePosition(s.Ctx, pool.GetId(), owner, positionCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), -1000, 1000)
+	s.Require().NoError(err)
+
+	performance, found, err := clKeeper.GetPositionPerformance(s.Ctx, positionData.ID)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewCoin(ETH, positionData.Amount0), performance.InitialAsset0)
+	s.Require().Equal(sdk.NewCoin(USDC, positionData.Amount1), performance.InitialAsset1)
+	s.Require().Equal(sdk.NewCoin(ETH, osmomath.ZeroInt()), performance.WithdrawnAsset0)
+	s.Require().Equal(sdk.NewCoin(USDC, osmomath.ZeroInt()), performance.WithdrawnAsset1)
+	s.Require().True(performance.ClaimedSpreadRewards.IsZero())
+	s.Require().True(performance.ClaimedIncentives.IsZero())
+	requireTimeInRange(positionData.ID, 0)
+
+	// The position is in range.
+	s.AddBlockTime(10 * time.Second)
+	requireTimeInRange(positionData.ID, 10*time.Second)
+
+	// The position is out of range after the price moves below its lower tick.
+	currentTick := swap(sdk.NewCoin(ETH, osmomath.NewInt(2_000_000_000_000_000)), USDC)
+	s.Require().Less(currentTick, int64(-1000))
+	s.AddBlockTime(20 * time.Second)
+	requireTimeInRange(positionData.ID, 10*time.Second)
+
+	// The position is back in range after the price moves back.
+	currentTick = swap(sdk.NewCoin(USDC, osmomath.NewInt(2_000_000_000_000_000)), ETH)
+	s.Require().GreaterOrEqual(currentTick, int64(-1000))
+	s.Require().Less(currentTick, int64(1000))
+	s.AddBlockTime(30 * time.Second)
+	requireTimeInRange(positionData.ID, 40*time.Second)
+
+	// The claimed spread rewards are recorded.
+	s.FundAcc(pool.GetSpreadRewardsAddress(), sdk.NewCoins(sdk.NewCoin(ETH, apptesting.DefaultCoinAmount)))
+	s.AddToSpreadRewardAccumulator(pool.GetId(), sdk.NewDecCoin(ETH, osmomath.NewInt(10)))
+	claimedSpreadRewards, err := clKeeper.CollectSpreadRewards(s.Ctx, owner, positionData.ID)
+	s.Require().NoError(err)
+	s.Require().False(claimedSpreadRewards.IsZero())
+
+	response, err := querier.PositionPerformance(s.Ctx, queryproto.PositionPerformanceRequest{PositionId: positionData.ID})
+	s.Require().NoError(err)
+	positionResponse, err := querier.PositionById(s.Ctx, queryproto.PositionByIdRequest{PositionId: positionData.ID})
+	s.Require().NoError(err)
+	s.Require().Equal(queryproto.PositionPerformanceResponse{
+		Position:             positionResponse.Position,
+		InitialAsset0:        sdk.NewCoin(ETH, positionData.Amount0),
+		InitialAsset1:        sdk.NewCoin(USDC, positionData.Amount1),
+		ClaimedSpreadRewards: claimedSpreadRewards,
+		TimeInRange:          40 * time.Second,
+		WithdrawnAsset0:      sdk.NewCoin(ETH, osmomath.ZeroInt()),
+		WithdrawnAsset1:      sdk.NewCoin(USDC, osmomath.ZeroInt()),
+	}, *response)
+
+	// Adding to the position carries its performance over to the new position, counting the rewards
+	// claimed by adding to it.
+	s.AddToSpreadRewardAccumulator(pool.GetId(), sdk.NewDecCoin(ETH, osmomath.NewInt(10)))
+	claimableSpreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionData.ID)
+	s.Require().NoError(err)
+	s.FundAcc(owner, positionCoins)
+	balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	newPositionId, _, _, err := clKeeper.AddToPosition(s.Ctx, owner, positionData.ID, positionCoins.AmountOf(ETH), positionCoins.AmountOf(USDC), osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+	// The amounts added to the position are the amounts the owner paid, net of the claimed rewards.
+	amountsAdded := balancesBefore.Add(claimableSpreadRewards...).Sub(s.App.BankKeeper.GetAllBalances(s.Ctx, owner)...)
+
+	_, found, err = clKeeper.GetPositionPerformance(s.Ctx, positionData.ID)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	performance, found, err = clKeeper.GetPositionPerformance(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(newPositionId, performance.PositionId)
+	s.Require().Equal(sdk.NewCoin(ETH, positionData.Amount0.Add(amountsAdded.AmountOf(ETH))), performance.InitialAsset0)
+	s.Require().Equal(sdk.NewCoin(USDC, positionData.Amount1.Add(amountsAdded.AmountOf(USDC))), performance.InitialAsset1)
+	s.Require().Equal(claimedSpreadRewards.Add(claimableSpreadRewards...), performance.ClaimedSpreadRewards)
+	requireTimeInRange(newPositionId, 40*time.Second)
+
+	s.AddBlockTime(5 * time.Second)
+	requireTimeInRange(newPositionId, 45*time.Second)
+
+	// Withdrawing part of the position records the withdrawn amounts.
+	position, err := clKeeper.GetPosition(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	amount0Withdrawn, amount1Withdrawn, err := clKeeper.WithdrawPosition(s.Ctx, owner, newPositionId, position.Liquidity.QuoInt64(4))
+	s.Require().NoError(err)
+	s.Require().True(amount0Withdrawn.IsPositive())
+	s.Require().True(amount1Withdrawn.IsPositive())
+	performance, found, err = clKeeper.GetPositionPerformance(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewCoin(ETH, amount0Withdrawn), performance.WithdrawnAsset0)
+	s.Require().Equal(sdk.NewCoin(USDC, amount1Withdrawn), performance.WithdrawnAsset1)
+
+	// Withdrawing the rest of the position deletes its performance.
+	position, err = clKeeper.GetPosition(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, newPositionId, position.Liquidity)
+	s.Require().NoError(err)
+	_, found, err = clKeeper.GetPositionPerformance(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	// The positions without a performance record are not found.
+	_, err = querier.PositionPerformance(s.Ctx, queryproto.PositionPerformanceRequest{PositionId: 1})
+	s.Require().NoError(err)
+	clKeeper.DeletePositionPerformance(s.Ctx, 1)
+	_, err = querier.PositionPerformance(s.Ctx, queryproto.PositionPerformanceRequest{PositionId: 1})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+	s.Require().ErrorContains(err, types.PositionPerformanceNotFoundError{PositionId: 1}.Error())
+	_, err = clKeeper.GetPositionTimeInRange(s.Ctx, 1)
+	s.Require().ErrorIs(err, types.PositionPerformanceNotFoundError{PositionId: 1})
+}
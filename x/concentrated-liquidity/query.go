@@ -2,11 +2,13 @@ package concentrated_liquidity
 
 import (
 	"fmt"
+	gomath "math"
 
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	db "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
@@ -17,7 +19,15 @@ import (
 	types "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
 )
 
-const invalidTickIndex = int64(-1)
+const (
+	invalidTickIndex = int64(-1)
+
+	// maxLiquidityDepthLevelsPerPage is the max number of levels per ladder returned by a page of the liquidity depth query.
+	maxLiquidityDepthLevelsPerPage = uint64(1000)
+	// maxLiquidityDepthOffset is the max offset of a page of the liquidity depth query. The ladders are walked from the spot
+	// price up to the end of the page, so the levels past it are not served.
+	maxLiquidityDepthOffset = uint64(10_000)
+)
 
 // This file contains query-related helper functions for the Concentrated Liquidity module
 
@@ -364,3 +374,200 @@ func (k Keeper) GetNumNextInitializedTicks(ctx sdk.Context, poolId, numberOfNext
 
 	return liquidityDepths, nil
 }
+
+// GetLiquidityDepth returns the bid and ask ladders of the given pool, in price levels bounded by the multiples of the
+// bucket width, up to the given max price impact from the spot price. Each level has the amounts traded by the pool
+// liquidity between the previous level and itself, and between the spot price and itself. The amounts exclude the
+// spread factor and the taker fee.
+// The pagination applies to the levels of both ladders, from the spot price outwards.
+func (k Keeper) GetLiquidityDepth(ctx sdk.Context, poolId uint64, bucketWidth, maxPriceImpact osmomath.Dec, pagination *query.PageRequest) (*queryproto.LiquidityDepthResponse, error) {
+	if bucketWidth.IsNil() || !bucketWidth.IsPositive() {
+		return nil, types.InvalidBucketWidthError{BucketWidth: bucketWidth}
+	}
+	if maxPriceImpact.IsNil() || !maxPriceImpact.IsPositive() || maxPriceImpact.GTE(osmomath.OneDec()) {
+		return nil, types.InvalidMaxPriceImpactError{MaxPriceImpact: maxPriceImpact}
+	}
+
+	offset, limit, countTotal := uint64(0), uint64(0), false
+	if pagination != nil {
+		offset, limit, countTotal = pagination.Offset, pagination.Limit, pagination.CountTotal
+		if len(pagination.Key) > 0 {
+			if len(pagination.Key) != 8 {
+				return nil, fmt.Errorf("pagination key must be 8 bytes long, got %d", len(pagination.Key))
+			}
+			offset = sdk.BigEndianToUint64(pagination.Key)
+		}
+	}
+	if offset > maxLiquidityDepthOffset {
+		return nil, fmt.Errorf("pagination offset (%d) cannot exceed %d levels", offset, maxLiquidityDepthOffset)
+	}
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	limit = min(limit, maxLiquidityDepthLevelsPerPage)
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	sqrtPrice := pool.GetCurrentSqrtPrice()
+	if sqrtPrice.IsZero() {
+		return nil, types.NoSpotPriceWhenNoLiquidityError{PoolId: poolId}
+	}
+	spotPrice := sqrtPrice.Mul(sqrtPrice)
+
+	bucketWidthBigDec := osmomath.BigDecFromDec(bucketWidth)
+	maxPriceImpactBigDec := osmomath.BigDecFromDec(maxPriceImpact)
+	askLimitPrice := spotPrice.Mul(osmomath.OneBigDec().Add(maxPriceImpactBigDec))
+	if askLimitPrice.GT(types.MaxSpotPriceBigDec) {
+		askLimitPrice = types.MaxSpotPriceBigDec
+	}
+	bidLimitPrice := spotPrice.Mul(osmomath.OneBigDec().Sub(maxPriceImpactBigDec))
+
+	askPrices, numAsks := getLiquidityDepthLevelPrices(spotPrice, bucketWidthBigDec, askLimitPrice, true, offset+limit)
+	bidPrices, numBids := getLiquidityDepthLevelPrices(spotPrice, bucketWidthBigDec, bidLimitPrice, false, offset+limit)
+
+	asks, err := k.getLiquidityDepthLevels(ctx, pool, askPrices, true)
+	if err != nil {
+		return nil, err
+	}
+	bids, err := k.getLiquidityDepthLevels(ctx, pool, bidPrices, false)
+	if err != nil {
+		return nil, err
+	}
+
+	numLevels := max(numAsks, numBids)
+	pageResponse := &query.PageResponse{}
+	if offset+limit < numLevels {
+		pageResponse.NextKey = sdk.Uint64ToBigEndian(offset + limit)
+	}
+	if countTotal {
+		pageResponse.Total = numLevels
+	}
+
+	return &queryproto.LiquidityDepthResponse{
+		SpotPrice:  spotPrice.Dec(),
+		Bids:       bids[min(offset, uint64(len(bids))):],
+		Asks:       asks[min(offset, uint64(len(asks))):],
+		Pagination: pageResponse,
+	}, nil
+}
+
+// getLiquidityDepthLevelPrices returns the prices bounding the first numLevels levels of a ladder, from the spot price
+// towards the limit price, along with the total number of levels of the ladder. The prices are the multiples of the
+// bucket width strictly between the spot price and the limit price, followed by the limit price.
+func getLiquidityDepthLevelPrices(spotPrice, bucketWidth, limitPrice osmomath.BigDec, isAsk bool, numLevels uint64) ([]osmomath.BigDec, uint64) {
+	var firstPrice, distance osmomath.BigDec
+	if isAsk {
+		firstPrice = spotPrice.Quo(bucketWidth).TruncateDec().Add(osmomath.OneBigDec()).Mul(bucketWidth)
+		distance = limitPrice.Sub(firstPrice)
+	} else {
+		firstPrice = spotPrice.Quo(bucketWidth).Ceil().Sub(osmomath.OneBigDec()).Mul(bucketWidth)
+		distance = firstPrice.Sub(limitPrice)
+	}
+
+	totalLevels := uint64(1)
+	if distance.IsPositive() {
+		numBuckets := distance.Quo(bucketWidth).Ceil().TruncateInt()
+		if numBuckets.IsUint64() && numBuckets.Uint64() < gomath.MaxUint64 {
+			totalLevels += numBuckets.Uint64()
+		} else {
+			totalLevels = gomath.MaxUint64
+		}
+	}
+
+	prices := make([]osmomath.BigDec, 0, min(numLevels, totalLevels))
+	for i := uint64(0); i < numLevels && i < totalLevels; i++ {
+		if i == totalLevels-1 {
+			prices = append(prices, limitPrice)
+			break
+		}
+		offset := bucketWidth.MulInt64(int64(i))
+		if isAsk {
+			prices = append(prices, firstPrice.Add(offset))
+		} else {
+			prices = append(prices, firstPrice.Sub(offset))
+		}
+	}
+	return prices, totalLevels
+}
+
+// getLiquidityDepthLevels walks the initialized ticks of the pool from its current sqrt price through the given level
+// prices, and returns the levels with the amounts traded by the pool liquidity up to each of them.
+// The asks trade token0 out for token1 in, and the bids token0 in for token1 out. The amounts in are rounded up
+// and the amounts out are truncated.
+func (k Keeper) getLiquidityDepthLevels(ctx sdk.Context, pool types.ConcentratedPoolExtension, levelPrices []osmomath.BigDec, isAsk bool) ([]queryproto.LiquidityDepthLevel, error) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyTickPrefixByPoolId(pool.GetId()))
+
+	// The active range is inclusive of the current tick, so the bids start by crossing it
+	// and the asks start past it.
+	startTickKey := types.TickIndexToBytes(pool.GetCurrentTick() + 1)
+	var iterator db.Iterator
+	if isAsk {
+		iterator = prefixStore.Iterator(startTickKey, nil)
+	} else {
+		iterator = prefixStore.ReverseIterator(nil, startTickKey)
+	}
+	defer iterator.Close()
+
+	sqrtPrice := pool.GetCurrentSqrtPrice()
+	liquidity := pool.GetLiquidity()
+	cumulativeAmount0, cumulativeAmount1 := osmomath.ZeroBigDec(), osmomath.ZeroBigDec()
+	moveSqrtPrice := func(nextSqrtPrice osmomath.BigDec) {
+		cumulativeAmount0 = cumulativeAmount0.Add(math.CalcAmount0Delta(liquidity, sqrtPrice, nextSqrtPrice, !isAsk))
+		cumulativeAmount1 = cumulativeAmount1.Add(math.CalcAmount1Delta(liquidity, sqrtPrice, nextSqrtPrice, isAsk))
+		sqrtPrice = nextSqrtPrice
+	}
+
+	levels := make([]queryproto.LiquidityDepthLevel, 0, len(levelPrices))
+	previousAmount0, previousAmount1 := osmomath.ZeroInt(), osmomath.ZeroInt()
+	for _, levelPrice := range levelPrices {
+		levelSqrtPrice, err := osmomath.MonotonicSqrtBigDec(levelPrice)
+		if err != nil {
+			return nil, err
+		}
+
+		for ; iterator.Valid(); iterator.Next() {
+			tickIndex, err := types.TickIndexFromBytes(iterator.Key())
+			if err != nil {
+				return nil, err
+			}
+			tickSqrtPrice, err := math.TickToSqrtPrice(tickIndex)
+			if err != nil {
+				return nil, err
+			}
+			if (isAsk && tickSqrtPrice.GTE(levelSqrtPrice)) || (!isAsk && tickSqrtPrice.LTE(levelSqrtPrice)) {
+				break
+			}
+
+			tickInfo, err := ParseTickFromBz(iterator.Value())
+			if err != nil {
+				return nil, err
+			}
+			moveSqrtPrice(tickSqrtPrice)
+			// Crossing a tick upwards adds its liquidity net to the active liquidity, and downwards removes it.
+			if isAsk {
+				liquidity = liquidity.Add(tickInfo.LiquidityNet)
+			} else {
+				liquidity = liquidity.Sub(tickInfo.LiquidityNet)
+			}
+		}
+		moveSqrtPrice(levelSqrtPrice)
+
+		var amount0, amount1 osmomath.Int
+		if isAsk {
+			amount0, amount1 = cumulativeAmount0.Dec().TruncateInt(), cumulativeAmount1.DecRoundUp().Ceil().TruncateInt()
+		} else {
+			amount0, amount1 = cumulativeAmount0.DecRoundUp().Ceil().TruncateInt(), cumulativeAmount1.Dec().TruncateInt()
+		}
+		levels = append(levels, queryproto.LiquidityDepthLevel{
+			Price:             levelPrice.Dec(),
+			Amount0:           amount0.Sub(previousAmount0),
+			Amount1:           amount1.Sub(previousAmount1),
+			CumulativeAmount0: amount0,
+			CumulativeAmount1: amount1,
+		})
+		previousAmount0, previousAmount1 = amount0, amount1
+	}
+	return levels, nil
+}
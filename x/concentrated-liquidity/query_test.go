@@ -1,11 +1,15 @@
 package concentrated_liquidity_test
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types/genesis"
 )
 
@@ -1028,3 +1032,171 @@ func (s *KeeperTestSuite) TestGetNumNextInitializedTicks() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGetLiquidityDepth() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	defaultLiquidity, _ := s.SetupPosition(pool.GetId(), s.TestAccs[0], DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+	// The narrow position from 4900 to 5100 adds liquidity around the spot price of 5000.
+	narrowLiquidity, _ := s.SetupPosition(pool.GetId(), s.TestAccs[1], DefaultCoins, 30_900_000, 31_100_000, false)
+
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	sqrtSpotPrice := pool.GetCurrentSqrtPrice()
+	spotPrice := sqrtSpotPrice.Mul(sqrtSpotPrice)
+
+	// The expected ladders with a bucket width of 150 and a max price impact of 5%. Both positions trade
+	// from the spot price to the ticks of 5100 and 4900, and only the default position past them.
+	askLimitPrice := spotPrice.Mul(osmomath.MustNewBigDecFromStr("1.05"))
+	bidLimitPrice := spotPrice.Mul(osmomath.MustNewBigDecFromStr("0.95"))
+	askPrices := []osmomath.BigDec{osmomath.NewBigDec(5100), osmomath.NewBigDec(5250), askLimitPrice}
+	bidPrices := []osmomath.BigDec{osmomath.NewBigDec(4950), osmomath.NewBigDec(4800), bidLimitPrice}
+	expectedLevels := func(prices []osmomath.BigDec, isAsk bool) []queryproto.LiquidityDepthLevel {
+		narrowTick := int64(31_100_000)
+		if !isAsk {
+			narrowTick = 30_900_000
+		}
+		narrowTickSqrtPrice, err := math.TickToSqrtPrice(narrowTick)
+		s.Require().NoError(err)
+
+		levels := []queryproto.LiquidityDepthLevel{}
+		cumulativeAmount0, cumulativeAmount1 := osmomath.ZeroBigDec(), osmomath.ZeroBigDec()
+		previousAmount0, previousAmount1 := osmomath.ZeroInt(), osmomath.ZeroInt()
+		currentSqrtPrice, liquidity := sqrtSpotPrice, defaultLiquidity.Add(narrowLiquidity)
+		moveSqrtPrice := func(nextSqrtPrice osmomath.BigDec) {
+			cumulativeAmount0 = cumulativeAmount0.Add(math.CalcAmount0Delta(liquidity, currentSqrtPrice, nextSqrtPrice, !isAsk))
+			cumulativeAmount1 = cumulativeAmount1.Add(math.CalcAmount1Delta(liquidity, currentSqrtPrice, nextSqrtPrice, isAsk))
+			currentSqrtPrice = nextSqrtPrice
+		}
+		for _, price := range prices {
+			levelSqrtPrice := osmomath.MustMonotonicSqrtBigDec(price)
+			crossesNarrowTick := (isAsk && narrowTickSqrtPrice.LT(levelSqrtPrice)) || (!isAsk && narrowTickSqrtPrice.GT(levelSqrtPrice))
+			if !liquidity.Equal(defaultLiquidity) && crossesNarrowTick {
+				moveSqrtPrice(narrowTickSqrtPrice)
+				liquidity = defaultLiquidity
+			}
+			moveSqrtPrice(levelSqrtPrice)
+
+			amount0, amount1 := cumulativeAmount0.Dec().TruncateInt(), cumulativeAmount1.DecRoundUp().Ceil().TruncateInt()
+			if !isAsk {
+				amount0, amount1 = cumulativeAmount0.DecRoundUp().Ceil().TruncateInt(), cumulativeAmount1.Dec().TruncateInt()
+			}
+			levels = append(levels, queryproto.LiquidityDepthLevel{
+				Price:             price.Dec(),
+				Amount0:           amount0.Sub(previousAmount0),
+				Amount1:           amount1.Sub(previousAmount1),
+				CumulativeAmount0: amount0,
+				CumulativeAmount1: amount1,
+			})
+			previousAmount0, previousAmount1 = amount0, amount1
+		}
+		return levels
+	}
+	expectedAsks := expectedLevels(askPrices, true)
+	expectedBids := expectedLevels(bidPrices, false)
+
+	tests := map[string]struct {
+		poolId         uint64
+		bucketWidth    osmomath.Dec
+		maxPriceImpact osmomath.Dec
+		pagination     *query.PageRequest
+
+		expectedAsks       []queryproto.LiquidityDepthLevel
+		expectedBids       []queryproto.LiquidityDepthLevel
+		expectedPagination *query.PageResponse
+		expectedErr        error
+	}{
+		"all levels": {
+			poolId:         pool.GetId(),
+			bucketWidth:    osmomath.NewDec(150),
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+			pagination:     &query.PageRequest{CountTotal: true},
+
+			expectedAsks:       expectedAsks,
+			expectedBids:       expectedBids,
+			expectedPagination: &query.PageResponse{Total: 3},
+		},
+		"first page": {
+			poolId:         pool.GetId(),
+			bucketWidth:    osmomath.NewDec(150),
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+			pagination:     &query.PageRequest{Limit: 2},
+
+			expectedAsks:       expectedAsks[:2],
+			expectedBids:       expectedBids[:2],
+			expectedPagination: &query.PageResponse{NextKey: sdk.Uint64ToBigEndian(2)},
+		},
+		"next page": {
+			poolId:         pool.GetId(),
+			bucketWidth:    osmomath.NewDec(150),
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+			pagination:     &query.PageRequest{Key: sdk.Uint64ToBigEndian(2), Limit: 2},
+
+			expectedAsks:       expectedAsks[2:],
+			expectedBids:       expectedBids[2:],
+			expectedPagination: &query.PageResponse{},
+		},
+		"bucket width wider than the max price impact": {
+			poolId:         pool.GetId(),
+			bucketWidth:    osmomath.NewDec(2000),
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+
+			expectedAsks:       expectedLevels([]osmomath.BigDec{askLimitPrice}, true),
+			expectedBids:       expectedLevels([]osmomath.BigDec{bidLimitPrice}, false),
+			expectedPagination: &query.PageResponse{},
+		},
+		"error: zero bucket width": {
+			poolId:         pool.GetId(),
+			bucketWidth:    osmomath.ZeroDec(),
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+
+			expectedErr: types.InvalidBucketWidthError{BucketWidth: osmomath.ZeroDec()},
+		},
+		"error: max price impact of one": {
+			poolId:         pool.GetId(),
+			bucketWidth:    osmomath.NewDec(150),
+			maxPriceImpact: osmomath.OneDec(),
+
+			expectedErr: types.InvalidMaxPriceImpactError{MaxPriceImpact: osmomath.OneDec()},
+		},
+		"error: pool not found": {
+			poolId:         pool.GetId() + 1,
+			bucketWidth:    osmomath.NewDec(150),
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+
+			expectedErr: types.PoolNotFoundError{PoolId: pool.GetId() + 1},
+		},
+		"error: pagination key shorter than 8 bytes": {
+			poolId:         pool.GetId(),
+			bucketWidth:    osmomath.NewDec(150),
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+			pagination:     &query.PageRequest{Key: []byte{0x02}},
+
+			expectedErr: fmt.Errorf("pagination key must be 8 bytes long, got 1"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			response, err := s.App.ConcentratedLiquidityKeeper.GetLiquidityDepth(s.Ctx, tc.poolId, tc.bucketWidth, tc.maxPriceImpact, tc.pagination)
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(spotPrice.Dec(), response.SpotPrice)
+			s.Require().Equal(tc.expectedAsks, response.Asks)
+			s.Require().Equal(tc.expectedBids, response.Bids)
+			s.Require().Equal(tc.expectedPagination, response.Pagination)
+		})
+	}
+
+	// Swapping in the amount of token1 of an ask level trades its amount of token0 out.
+	ask := expectedAsks[len(expectedAsks)-1]
+	tokenIn := sdk.NewCoin(USDC, ask.CumulativeAmount1)
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(tokenIn))
+	tokenOutAmount, err := s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool, tokenIn, ETH, osmomath.OneInt(), osmomath.ZeroDec())
+	s.Require().NoError(err)
+	s.Require().Equal(0, osmomath.ErrTolerance{AdditiveTolerance: osmomath.OneDec()}.Compare(ask.CumulativeAmount0, tokenOutAmount))
+}
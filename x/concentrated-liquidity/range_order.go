@@ -0,0 +1,260 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+)
+
+// CreateRangeOrder creates a single-sided position through CreatePosition, and makes it a range order,
+// withdrawn to the owner once a swap of the pool fully crosses its range.
+// The range must be entirely above the current tick, for a position of token0 only, or below it,
+// for a position of token1 only.
+func (k Keeper) CreateRangeOrder(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, tokensProvided sdk.Coins, amount0Min, amount1Min osmomath.Int, lowerTick, upperTick int64) (CreatePositionData, error) {
+	positionData, err := k.CreatePosition(ctx, poolId, owner, tokensProvided, amount0Min, amount1Min, lowerTick, upperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	rangeOrder := types.RangeOrder{PositionId: positionData.ID, PoolId: poolId}
+	currentTick := pool.GetCurrentTick()
+	switch {
+	case positionData.LowerTick > currentTick && positionData.Amount1.IsZero():
+		// Token0 is swapped into token1 as the price increases through the range.
+		rangeOrder.FillTick, rangeOrder.ZeroForOne = positionData.UpperTick, false
+	case positionData.UpperTick <= currentTick && positionData.Amount0.IsZero():
+		// Token1 is swapped into token0 as the price decreases through the range.
+		rangeOrder.FillTick, rangeOrder.ZeroForOne = positionData.LowerTick, true
+	default:
+		return CreatePositionData{}, types.RangeOrderNotSingleSidedError{PositionId: positionData.ID, LowerTick: positionData.LowerTick, UpperTick: positionData.UpperTick, CurrentTick: currentTick}
+	}
+
+	k.setRangeOrder(ctx, rangeOrder)
+
+	return positionData, nil
+}
+
+// GetRangeOrder returns the range order of the given position.
+// Returns false if the position is not a range order.
+func (k Keeper) GetRangeOrder(ctx sdk.Context, positionId uint64) (types.RangeOrder, bool, error) {
+	rangeOrder := types.RangeOrder{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyRangeOrder(positionId), &rangeOrder)
+	if err != nil {
+		return types.RangeOrder{}, false, err
+	}
+	return rangeOrder, found, nil
+}
+
+// setRangeOrder stores the range order, indexed by its fill tick until it is filled,
+// and queued for withdrawal once it is.
+func (k Keeper) setRangeOrder(ctx sdk.Context, rangeOrder types.RangeOrder) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyRangeOrder(rangeOrder.PositionId), &rangeOrder)
+	if rangeOrder.Filled {
+		store.Set(types.KeyFilledRangeOrder(rangeOrder.PositionId), []byte{})
+	} else {
+		store.Set(types.KeyRangeOrderByTick(rangeOrder.PoolId, rangeOrder.ZeroForOne, rangeOrder.FillTick, rangeOrder.PositionId), []byte{})
+	}
+}
+
+// deleteRangeOrder deletes the range order of the given position, if any, along with its fill tick index
+// and its place in the withdrawal queue.
+func (k Keeper) deleteRangeOrder(ctx sdk.Context, positionId uint64) error {
+	rangeOrder, found, err := k.GetRangeOrder(ctx, positionId)
+	if err != nil || !found {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRangeOrder(positionId))
+	store.Delete(types.KeyRangeOrderByTick(rangeOrder.PoolId, rangeOrder.ZeroForOne, rangeOrder.FillTick, positionId))
+	store.Delete(types.KeyFilledRangeOrder(positionId))
+	return nil
+}
+
+// fillRangeOrdersAtTick marks as filled the range orders of the pool whose fill tick is crossed by a swap
+// in the given direction, queues them for withdrawal and returns their position ids.
+// The positions are not withdrawn while crossing the tick, as that would change the liquidity being swapped through.
+// Instead, the swap withdraws them once it is computed.
+func (k Keeper) fillRangeOrdersAtTick(ctx sdk.Context, poolId uint64, zeroForOne bool, tickIndex int64) ([]uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyRangeOrdersByTick(poolId, zeroForOne, tickIndex)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+
+	positionIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		positionIds = append(positionIds, sdk.BigEndianToUint64(iterator.Key()[len(prefix):]))
+	}
+	iterator.Close()
+
+	for _, positionId := range positionIds {
+		rangeOrder, found, err := k.GetRangeOrder(ctx, positionId)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, fmt.Errorf("range order of position (%d) indexed at tick (%d) not found", positionId, tickIndex)
+		}
+
+		store.Delete(types.KeyRangeOrderByTick(poolId, zeroForOne, tickIndex, positionId))
+		rangeOrder.Filled = true
+		k.setRangeOrder(ctx, rangeOrder)
+	}
+	return positionIds, nil
+}
+
+// withdrawFilledRangeOrders withdraws the given filled range orders to their owners, within the budget of
+// MaxRangeOrderFillsPerBlock withdrawals per block shared by the swaps and the end block.
+// The range orders beyond the budget, and those whose withdrawal fails, stay queued and are withdrawn
+// at the end of the next blocks.
+func (k Keeper) withdrawFilledRangeOrders(ctx sdk.Context, positionIds []uint64) {
+	maxRangeOrderFillsPerBlock := k.getMaxRangeOrderFillsPerBlock(ctx)
+	withdrawals := k.getRangeOrderWithdrawalsInBlock(ctx)
+
+	for _, positionId := range positionIds {
+		if withdrawals >= maxRangeOrderFillsPerBlock {
+			break
+		}
+		withdrawals++
+
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.withdrawFilledRangeOrder(cacheCtx, positionId)
+		})
+		if err != nil {
+			ctx.Logger().Error(fmt.Errorf("unable to withdraw filled range order of position %d: %w", positionId, err).Error())
+		}
+	}
+
+	k.setRangeOrderWithdrawalsInBlock(ctx, withdrawals)
+}
+
+// processFilledRangeOrders withdraws the queued filled range orders with the budget of withdrawals left by the swaps
+// of the block, in the order of their position ids, and resets the budget for the next block.
+// It resumes from where the previous block stopped, so that range orders failing again stay queued
+// without blocking the others.
+func (k Keeper) processFilledRangeOrders(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	maxRangeOrderFillsPerBlock := k.getMaxRangeOrderFillsPerBlock(ctx)
+	withdrawals := k.getRangeOrderWithdrawalsInBlock(ctx)
+
+	if withdrawals < maxRangeOrderFillsPerBlock {
+		limit := maxRangeOrderFillsPerBlock - withdrawals
+
+		start := store.Get(types.KeyFilledRangeOrderCursor)
+		if start == nil {
+			start = types.FilledRangeOrderPrefix
+		}
+
+		positionIds := []uint64{}
+		var nextKey []byte
+
+		iterator := store.Iterator(start, storetypes.PrefixEndBytes(types.FilledRangeOrderPrefix))
+		for ; iterator.Valid(); iterator.Next() {
+			if uint64(len(positionIds)) == limit {
+				nextKey = iterator.Key()
+				break
+			}
+			positionIds = append(positionIds, sdk.BigEndianToUint64(iterator.Key()[len(types.FilledRangeOrderPrefix):]))
+		}
+		iterator.Close()
+
+		k.withdrawFilledRangeOrders(ctx, positionIds)
+
+		if nextKey == nil {
+			store.Delete(types.KeyFilledRangeOrderCursor)
+		} else {
+			store.Set(types.KeyFilledRangeOrderCursor, nextKey)
+		}
+	}
+
+	store.Delete(types.KeyRangeOrderWithdrawalsInBlock)
+}
+
+// getMaxRangeOrderFillsPerBlock returns the maximum number of filled range orders withdrawn per block.
+func (k Keeper) getMaxRangeOrderFillsPerBlock(ctx sdk.Context) uint64 {
+	var maxRangeOrderFillsPerBlock uint64
+	k.paramSpace.Get(ctx, types.KeyMaxRangeOrderFillsPerBlock, &maxRangeOrderFillsPerBlock)
+	return maxRangeOrderFillsPerBlock
+}
+
+// getRangeOrderWithdrawalsInBlock returns the number of filled range order withdrawals attempted so far in the block.
+func (k Keeper) getRangeOrderWithdrawalsInBlock(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyRangeOrderWithdrawalsInBlock)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setRangeOrderWithdrawalsInBlock sets the number of filled range order withdrawals attempted so far in the block.
+func (k Keeper) setRangeOrderWithdrawalsInBlock(ctx sdk.Context, withdrawals uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyRangeOrderWithdrawalsInBlock, sdk.Uint64ToBigEndian(withdrawals))
+}
+
+// getFilledRangeOrders returns the position ids of up to limit filled range orders pending withdrawal.
+func (k Keeper) getFilledRangeOrders(ctx sdk.Context, limit uint64) []uint64 {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FilledRangeOrderPrefix)
+	defer iterator.Close()
+
+	positionIds := []uint64{}
+	for ; iterator.Valid() && uint64(len(positionIds)) < limit; iterator.Next() {
+		positionIds = append(positionIds, sdk.BigEndianToUint64(iterator.Key()[len(types.FilledRangeOrderPrefix):]))
+	}
+	return positionIds
+}
+
+// withdrawFilledRangeOrder withdraws the full liquidity of the filled range order position to its owner,
+// collecting its spread rewards and incentives, and emits the fill range order event.
+// The range order is deleted along with the position.
+func (k Keeper) withdrawFilledRangeOrder(ctx sdk.Context, positionId uint64) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return err
+	}
+
+	amount0, amount1, err := k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtFillRangeOrder,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(position.LowerTick, 10)),
+		sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(position.UpperTick, 10)),
+		sdk.NewAttribute(types.AttributeAmount0, amount0.String()),
+		sdk.NewAttribute(types.AttributeAmount1, amount1.String()),
+	))
+	return nil
+}
+
+// getAllRangeOrders returns all the range orders, filled or not.
+func (k Keeper) getAllRangeOrders(ctx sdk.Context) ([]types.RangeOrder, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.RangeOrderPrefix, parseRangeOrder)
+}
+
+func parseRangeOrder(bz []byte) (types.RangeOrder, error) {
+	rangeOrder := types.RangeOrder{}
+	if err := rangeOrder.Unmarshal(bz); err != nil {
+		return types.RangeOrder{}, err
+	}
+	return rangeOrder, nil
+}
@@ -0,0 +1,229 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+)
+
+var rangeOrderCoinAmount = apptesting.DefaultCoinAmount.QuoRaw(1000)
+
+// createRangeOrder funds the owner with the token and creates a range order of it in the given range.
+func (s *KeeperTestSuite) createRangeOrder(poolId uint64, owner sdk.AccAddress, token sdk.Coin, lowerTick, upperTick int64) uint64 {
+	s.FundAcc(owner, sdk.NewCoins(token))
+	positionData, err := s.App.ConcentratedLiquidityKeeper.CreateRangeOrder(s.Ctx, poolId, owner, sdk.NewCoins(token), osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+	s.Require().NoError(err)
+	return positionData.ID
+}
+
+// swapExactAmountIn funds the first test account with the token in and swaps it in the pool.
+func (s *KeeperTestSuite) swapExactAmountIn(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) {
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	_, err = s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[0], pool, tokenIn, tokenOutDenom, osmomath.OneInt(), osmomath.ZeroDec())
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestCreateRangeOrder() {
+	tests := map[string]struct {
+		tokens               sdk.Coins
+		lowerTick, upperTick int64
+
+		expectedRangeOrder types.RangeOrder
+		expectedError      error
+	}{
+		"token0 above the current tick: filled crossing the upper tick as the price increases": {
+			tokens:    sdk.NewCoins(sdk.NewCoin(ETH, rangeOrderCoinAmount)),
+			lowerTick: 100,
+			upperTick: 200,
+
+			expectedRangeOrder: types.RangeOrder{FillTick: 200, ZeroForOne: false},
+		},
+		"token1 below the current tick: filled crossing the lower tick as the price decreases": {
+			tokens:    sdk.NewCoins(sdk.NewCoin(USDC, rangeOrderCoinAmount)),
+			lowerTick: -200,
+			upperTick: -100,
+
+			expectedRangeOrder: types.RangeOrder{FillTick: -200, ZeroForOne: true},
+		},
+		"error: range containing the current tick": {
+			tokens:    sdk.NewCoins(sdk.NewCoin(ETH, rangeOrderCoinAmount), sdk.NewCoin(USDC, rangeOrderCoinAmount)),
+			lowerTick: -100,
+			upperTick: 100,
+
+			expectedError: types.RangeOrderNotSingleSidedError{PositionId: 2, LowerTick: -100, UpperTick: 100, CurrentTick: 0},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, tc.tokens)
+
+			// System under test.
+			positionData, err := s.App.ConcentratedLiquidityKeeper.CreateRangeOrder(s.Ctx, pool.GetId(), owner, tc.tokens, osmomath.ZeroInt(), osmomath.ZeroInt(), tc.lowerTick, tc.upperTick)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			rangeOrder, found, err := s.App.ConcentratedLiquidityKeeper.GetRangeOrder(s.Ctx, positionData.ID)
+			s.Require().NoError(err)
+			s.Require().True(found)
+			tc.expectedRangeOrder.PositionId = positionData.ID
+			tc.expectedRangeOrder.PoolId = pool.GetId()
+			s.Require().Equal(tc.expectedRangeOrder, rangeOrder)
+		})
+	}
+}
+
+// validates that the range orders whose range is fully crossed by a swap are withdrawn to their owners
+// by that swap, and that the others stay.
+func (s *KeeperTestSuite) TestFillRangeOrders() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+	owner := s.TestAccs[1]
+
+	crossedOrderId := s.createRangeOrder(pool.GetId(), owner, sdk.NewCoin(ETH, rangeOrderCoinAmount), 100, 200)
+	notCrossedOrderId := s.createRangeOrder(pool.GetId(), owner, sdk.NewCoin(ETH, rangeOrderCoinAmount), 100_000, 100_100)
+	oppositeOrderId := s.createRangeOrder(pool.GetId(), owner, sdk.NewCoin(USDC, rangeOrderCoinAmount), -200, -100)
+
+	balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+	// System under test.
+	// The price increases through the range of the first range order only.
+	s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(USDC, apptesting.DefaultCoinAmount.QuoRaw(100)), ETH)
+
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtFillRangeOrder, 1)
+
+	// The filled range order is withdrawn by the swap, and the owner receives token1 only.
+	_, err := clKeeper.GetPosition(s.Ctx, crossedOrderId)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: crossedOrderId})
+	_, found, err := clKeeper.GetRangeOrder(s.Ctx, crossedOrderId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	s.Require().Empty(clKeeper.GetFilledRangeOrders(s.Ctx, 10))
+	balanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	s.Require().Equal(balanceBefore.AmountOf(ETH), balanceAfter.AmountOf(ETH))
+	s.Require().True(balanceAfter.AmountOf(USDC).GT(balanceBefore.AmountOf(USDC)))
+
+	// The other range orders are not filled.
+	for _, positionId := range []uint64{notCrossedOrderId, oppositeOrderId} {
+		_, err = clKeeper.GetPosition(s.Ctx, positionId)
+		s.Require().NoError(err)
+		rangeOrder, found, err := clKeeper.GetRangeOrder(s.Ctx, positionId)
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Require().False(rangeOrder.Filled)
+	}
+
+	// The price decreases through the range of the opposite range order.
+	s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(ETH, apptesting.DefaultCoinAmount.QuoRaw(50)), USDC)
+
+	_, err = clKeeper.GetPosition(s.Ctx, oppositeOrderId)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: oppositeOrderId})
+	_, err = clKeeper.GetPosition(s.Ctx, notCrossedOrderId)
+	s.Require().NoError(err)
+}
+
+// validates that the swaps of a block withdraw no more than MaxRangeOrderFillsPerBlock filled range orders,
+// and that the end block withdraws the queued ones with the budget left by the swaps only.
+func (s *KeeperTestSuite) TestFillRangeOrdersBlockBudget() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	clKeeper.SetParam(s.Ctx, types.KeyMaxRangeOrderFillsPerBlock, uint64(2))
+	pool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+	owner := s.TestAccs[1]
+
+	positionIds := []uint64{}
+	for i := 0; i < 3; i++ {
+		positionIds = append(positionIds, s.createRangeOrder(pool.GetId(), owner, sdk.NewCoin(ETH, rangeOrderCoinAmount), 100, 200))
+	}
+
+	// System under test.
+	// The swap fills the three range orders, but withdraws the first two only.
+	s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(USDC, apptesting.DefaultCoinAmount.QuoRaw(100)), ETH)
+
+	for _, positionId := range positionIds[:2] {
+		_, err := clKeeper.GetPosition(s.Ctx, positionId)
+		s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionId})
+	}
+	s.Require().Equal(positionIds[2:], clKeeper.GetFilledRangeOrders(s.Ctx, 10))
+
+	// The swaps used up the budget of the block, so the end block leaves the third range order queued.
+	clKeeper.EndBlock(s.Ctx)
+
+	s.Require().Equal(positionIds[2:], clKeeper.GetFilledRangeOrders(s.Ctx, 10))
+
+	// The budget of the next block starts afresh.
+	clKeeper.EndBlock(s.Ctx)
+
+	s.Require().Empty(clKeeper.GetFilledRangeOrders(s.Ctx, 10))
+	_, err := clKeeper.GetPosition(s.Ctx, positionIds[2])
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionIds[2]})
+}
+
+// validates that the end block withdraws no more than MaxRangeOrderFillsPerBlock queued range orders,
+// keeps the range orders whose withdrawal fails queued, and resumes from where the previous end block stopped.
+func (s *KeeperTestSuite) TestProcessFilledRangeOrders() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	clKeeper.SetParam(s.Ctx, types.KeyMaxRangeOrderFillsPerBlock, uint64(1))
+	pool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+	owner := s.TestAccs[1]
+
+	// A queued range order whose position does not exist fails to be withdrawn. Its position id is lower than
+	// any other, so that it is retried first.
+	failingOrder := types.RangeOrder{PositionId: 0, PoolId: pool.GetId(), FillTick: 200, Filled: true}
+	clKeeper.SetRangeOrder(s.Ctx, failingOrder)
+	positionId := s.createRangeOrder(pool.GetId(), owner, sdk.NewCoin(ETH, rangeOrderCoinAmount), 100, 200)
+	rangeOrder, _, err := clKeeper.GetRangeOrder(s.Ctx, positionId)
+	s.Require().NoError(err)
+	rangeOrder.Filled = true
+	clKeeper.SetRangeOrder(s.Ctx, rangeOrder)
+
+	// System under test.
+	// The failing range order is retried first, and stays queued.
+	clKeeper.EndBlock(s.Ctx)
+
+	s.Require().Equal([]uint64{failingOrder.PositionId, positionId}, clKeeper.GetFilledRangeOrders(s.Ctx, 10))
+
+	// The next end block resumes with the other range order, which is withdrawn.
+	clKeeper.EndBlock(s.Ctx)
+
+	s.Require().Equal([]uint64{failingOrder.PositionId}, clKeeper.GetFilledRangeOrders(s.Ctx, 10))
+	_, err = clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionId})
+}
+
+// validates that withdrawing a range order position deletes its range order.
+func (s *KeeperTestSuite) TestWithdrawRangeOrderPosition() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+	owner := s.TestAccs[1]
+
+	positionId := s.createRangeOrder(pool.GetId(), owner, sdk.NewCoin(ETH, rangeOrderCoinAmount), 100, 200)
+	position, err := clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+
+	// System under test.
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionId, position.Liquidity)
+	s.Require().NoError(err)
+
+	_, found, err := clKeeper.GetRangeOrder(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	// Crossing the fill tick afterwards does not fill anything.
+	s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(USDC, apptesting.DefaultCoinAmount.QuoRaw(100)), ETH)
+	s.Require().Empty(clKeeper.GetFilledRangeOrders(s.Ctx, 10))
+}
@@ -189,6 +189,13 @@ func (k Keeper) collectSpreadRewards(ctx sdk.Context, sender sdk.AccAddress, pos
 		return sdk.Coins{}, err
 	}
 
+	err = k.updatePositionPerformance(ctx, positionId, func(performance *types.PositionPerformance) {
+		performance.ClaimedSpreadRewards = performance.ClaimedSpreadRewards.Add(spreadRewardsClaimed...)
+	})
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
 	// Emit an event for the spread rewards collected.
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -64,6 +64,11 @@ type SwapState struct {
 	// The scaling factor only applies when we are updating the pool's tick accumulators.
 	globalSpreadRewardGrowth osmomath.Dec
 
+	// Position ids of the range orders filled by crossing ticks.
+	// Initialized to empty.
+	// Updated each time a tick is crossed.
+	filledRangeOrders []uint64
+
 	swapStrategy swapstrategy.SwapStrategy
 }
 
@@ -92,6 +97,7 @@ func newSwapState(specifiedAmount osmomath.Int, p types.ConcentratedPoolExtensio
 		liquidity:                                p.GetLiquidity(),
 		globalSpreadRewardGrowthPerUnitLiquidity: osmomath.ZeroDec(),
 		globalSpreadRewardGrowth:                 osmomath.ZeroDec(),
+		filledRangeOrders:                        []uint64{},
 		swapStrategy:                             strategy,
 	}
 }
@@ -103,9 +109,10 @@ type SwapDetails struct {
 }
 
 type PoolUpdates struct {
-	NewCurrentTick int64
-	NewLiquidity   osmomath.Dec
-	NewSqrtPrice   osmomath.BigDec
+	NewCurrentTick    int64
+	NewLiquidity      osmomath.Dec
+	NewSqrtPrice      osmomath.BigDec
+	FilledRangeOrders []uint64
 }
 
 var (
@@ -291,6 +298,9 @@ func (k Keeper) swapOutAmtGivenIn(
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
 
+	// Withdraw the range orders filled by the swap while their positions are fully converted.
+	k.withdrawFilledRangeOrders(ctx, poolUpdates.FilledRangeOrders)
+
 	return tokenIn, tokenOut, poolUpdates, nil
 }
 
@@ -323,6 +333,9 @@ func (k *Keeper) swapInAmtGivenOut(
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
 
+	// Withdraw the range orders filled by the swap while their positions are fully converted.
+	k.withdrawFilledRangeOrders(ctx, poolUpdates.FilledRangeOrders)
+
 	return tokenIn, tokenOut, poolUpdates, nil
 }
 
@@ -551,7 +564,7 @@ func (k Keeper) computeOutAmtGivenIn(
 		AmountIn:      amountIn,
 		AmountOut:     amountOut,
 		SpreadRewards: swapState.globalSpreadRewardGrowth,
-	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice}, nil
+	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice, swapState.filledRangeOrders}, nil
 }
 
 // computeInAmtGivenOut calculates tokens to be swapped in given the desired token out and spread factor deducted. It also returns
@@ -695,7 +708,7 @@ func (k Keeper) computeInAmtGivenOut(
 		AmountIn:      amountIn,
 		AmountOut:     amountOut,
 		SpreadRewards: swapState.globalSpreadRewardGrowth,
-	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice}, nil
+	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice, swapState.filledRangeOrders}, nil
 }
 
 func emitSwapDebugLogs(ctx sdk.Context, swapState SwapState, reachedPrice osmomath.BigDec, amountIn, amountOut, spreadCharge osmomath.Dec) {
@@ -740,6 +753,13 @@ func (k Keeper) swapCrossTickLogic(ctx sdk.Context,
 		if err != nil {
 			return swapState, err
 		}
+
+		// Fill the range orders whose range is fully crossed by crossing this tick.
+		filledRangeOrders, err := k.fillRangeOrdersAtTick(ctx, p.GetId(), strategy.ZeroForOne(), nextInitializedTick)
+		if err != nil {
+			return swapState, err
+		}
+		swapState.filledRangeOrders = append(swapState.filledRangeOrders, filledRangeOrders...)
 	}
 	liquidityNet := nextInitializedTickInfo.LiquidityNet
 
@@ -814,6 +834,10 @@ func (k Keeper) updatePoolForSwap(
 		return types.InsufficientPoolBalanceError{Err: err}
 	}
 
+	if err := k.recordDynamicSpreadFactorPriceMovement(ctx, poolId, pool.GetCurrentSqrtPrice(), poolUpdates.NewSqrtPrice); err != nil {
+		return err
+	}
+
 	err = pool.ApplySwap(poolUpdates.NewLiquidity, poolUpdates.NewCurrentTick, poolUpdates.NewSqrtPrice)
 	if err != nil {
 		return fmt.Errorf("error applying swap: %w", err)
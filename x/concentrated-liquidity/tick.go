@@ -2,6 +2,7 @@ package concentrated_liquidity
 
 import (
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -59,7 +60,8 @@ func (k Keeper) initOrUpdateTick(ctx sdk.Context, poolId uint64, tickIndex int64
 }
 
 // crossTick crosses the given tick. The tick is specified by its index and tick info.
-// It updates the given tick's uptime and spread reward accumulators and writes it back to state.
+// It updates the given tick's uptime and spread reward accumulators, as well as its time opposite direction of last traversal,
+// and writes it back to state.
 // Prior to updating the tick info and writing it to state, it updates the pool uptime accumulators until the current block time.
 // WARNING: this method may mutate the pool, make sure to refetch the pool after calling this method.
 // CONTRACT: the caller validates that the pool with the given id exists.
@@ -80,6 +82,9 @@ func (k Keeper) crossTick(ctx sdk.Context, poolId uint64, tickIndex int64, tickI
 		updatedUptimeTrackers[uptimeId].UptimeGrowthOutside = uptimeAccums[uptimeId].GetValue().Sub(updatedUptimeTrackers[uptimeId].UptimeGrowthOutside)
 	}
 
+	// Flip the time opposite direction of last traversal the same way.
+	tickInfo.TimeOppositeDirectionOfLastTraversal = timeSinceUnixEpoch(ctx) - tickInfo.TimeOppositeDirectionOfLastTraversal
+
 	k.SetTickInfo(ctx, poolId, tickIndex, tickInfo)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -142,6 +147,12 @@ func (k Keeper) makeInitialTickInfo(ctx sdk.Context, poolId uint64, tickIndex in
 
 	uptimeTrackers := model.UptimeTrackers{List: initialUptimeTrackers}
 
+	// As for the spread rewards, the time to date is considered to have elapsed below the tick.
+	initialTimeOppositeDirectionOfLastTraversal := time.Duration(0)
+	if pool.GetCurrentTick() >= tickIndex {
+		initialTimeOppositeDirectionOfLastTraversal = timeSinceUnixEpoch(ctx)
+	}
+
 	// Emit init tick event
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -154,7 +165,7 @@ func (k Keeper) makeInitialTickInfo(ctx sdk.Context, poolId uint64, tickIndex in
 		),
 	})
 
-	return model.TickInfo{LiquidityGross: osmomath.ZeroDec(), LiquidityNet: osmomath.ZeroDec(), SpreadRewardGrowthOppositeDirectionOfLastTraversal: initialSpreadRewardGrowthOppositeDirectionOfLastTraversal, UptimeTrackers: uptimeTrackers}, nil
+	return model.TickInfo{LiquidityGross: osmomath.ZeroDec(), LiquidityNet: osmomath.ZeroDec(), SpreadRewardGrowthOppositeDirectionOfLastTraversal: initialSpreadRewardGrowthOppositeDirectionOfLastTraversal, UptimeTrackers: uptimeTrackers, TimeOppositeDirectionOfLastTraversal: initialTimeOppositeDirectionOfLastTraversal}, nil
 }
 
 func (k Keeper) SetTickInfo(ctx sdk.Context, poolId uint64, tickIndex int64, tickInfo *model.TickInfo) {
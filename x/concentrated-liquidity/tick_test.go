@@ -2,6 +2,7 @@ package concentrated_liquidity_test
 
 import (
 	"errors"
+	"time"
 
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
@@ -455,6 +456,11 @@ func (s *KeeperTestSuite) TestGetTickInfo() {
 			// Upscale accum value
 			test.expectedTickInfo.SpreadRewardGrowthOppositeDirectionOfLastTraversal = test.expectedTickInfo.SpreadRewardGrowthOppositeDirectionOfLastTraversal.MulDecTruncate(cl.PerUnitLiqScalingFactor)
 
+			// The time outside of the ticks at or below the current tick is initialized to the block time.
+			if test.expectedErr == nil && test.tickToGet <= DefaultCurrTick {
+				test.expectedTickInfo.TimeOppositeDirectionOfLastTraversal = time.Duration(s.Ctx.BlockTime().UnixNano())
+			}
+
 			if test.preInitUptimeAccumValues != nil {
 				err := addToUptimeAccums(s.Ctx, clPool.GetId(), clKeeper, test.preInitUptimeAccumValues)
 				s.Require().NoError(err)
@@ -28,6 +28,9 @@ const (
 	BaseGasFeeForNewIncentive           = 10_000
 	BaseGasFeeForInitializingTick       = 10_000
 	BaseGasFeeForTransferPosition       = 10_000
+	// BasisPointsPerUnit is the number of basis points in a unit, the price movement of the dynamic spread factor
+	// mode being measured in basis points.
+	BasisPointsPerUnit = 10_000
 )
 
 var (
@@ -69,4 +72,13 @@ var (
 	// 2M gas is enough to execute tens of expensive CL operations and is only set this high
 	// to accommodate position withdrawals, which are unusually expensive.
 	DefaultContractHookGasLimit = uint64(2_000_000)
+	// AutoCompoundGasLimit bounds the gas of compounding the rewards of a single position at the end of an epoch,
+	// including the estimation of the routes converting its rewards. A position exceeding it is left as is.
+	AutoCompoundGasLimit = uint64(5_000_000)
+	// DefaultMaxRangeOrderFillsPerBlock bounds the number of filled range orders withdrawn in each block,
+	// by the swaps filling them and by the end block.
+	DefaultMaxRangeOrderFillsPerBlock = uint64(100)
+	// DefaultMaxAutoCompoundsPerEpoch bounds the number of positions compounded at the end of each epoch
+	// of a given identifier. The following positions are compounded at the end of the next epochs.
+	DefaultMaxAutoCompoundsPerEpoch = uint64(500)
 )
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// Validate checks that the spread factor bounds are in [0, 1) with the min not greater than the max,
+// that the max volatility is positive and that the volatility decay is in [0, 1).
+func (d DynamicSpreadFactor) Validate() error {
+	if d.MinSpreadFactor.IsNil() || d.MaxSpreadFactor.IsNil() || d.MaxVolatility.IsNil() || d.VolatilityDecay.IsNil() {
+		return fmt.Errorf("dynamic spread factor fields cannot be nil")
+	}
+
+	for _, spreadFactor := range []osmomath.Dec{d.MinSpreadFactor, d.MaxSpreadFactor} {
+		if spreadFactor.IsNegative() || spreadFactor.GTE(osmomath.OneDec()) {
+			return InvalidSpreadFactorError{ActualSpreadFactor: spreadFactor}
+		}
+	}
+	if d.MinSpreadFactor.GT(d.MaxSpreadFactor) {
+		return fmt.Errorf("min spread factor (%s) cannot be greater than max spread factor (%s)", d.MinSpreadFactor, d.MaxSpreadFactor)
+	}
+
+	if !d.MaxVolatility.IsPositive() {
+		return fmt.Errorf("max volatility (%s) must be positive", d.MaxVolatility)
+	}
+
+	if d.VolatilityDecay.IsNegative() || d.VolatilityDecay.GTE(osmomath.OneDec()) {
+		return fmt.Errorf("volatility decay (%s) must be in the range [0, 1)", d.VolatilityDecay)
+	}
+
+	return nil
+}
+
+// NextVolatility returns the volatility of the pool after a block moving its price by the given price movement.
+// It is the average of the previous volatility and of the price movement, weighted by the volatility decay.
+func (d DynamicSpreadFactor) NextVolatility(volatility, priceMovement osmomath.Dec) osmomath.Dec {
+	priceMovementWeight := osmomath.OneDec().Sub(d.VolatilityDecay)
+	return volatility.Mul(d.VolatilityDecay).Add(priceMovement.Mul(priceMovementWeight))
+}
+
+// PriceMovement returns the price movement of a swap from the given sqrt price to the given one, in basis points
+// of the price it started from. Unlike the number of ticks crossed, whose price width depends on the price decade,
+// it is proportional to the price change at any price.
+func PriceMovement(sqrtPriceBefore, sqrtPriceAfter osmomath.BigDec) osmomath.Dec {
+	if sqrtPriceBefore.IsZero() {
+		return osmomath.ZeroDec()
+	}
+	sqrtPriceRatio := sqrtPriceAfter.Quo(sqrtPriceBefore)
+	return sqrtPriceRatio.Mul(sqrtPriceRatio).Sub(osmomath.OneBigDec()).Abs().MulInt64(BasisPointsPerUnit).Dec()
+}
+
+// SpreadFactor returns the spread factor of the pool at the given volatility, linearly interpolated between
+// the min spread factor at zero volatility and the max spread factor at the max volatility.
+func (d DynamicSpreadFactor) SpreadFactor(volatility osmomath.Dec) osmomath.Dec {
+	if volatility.GTE(d.MaxVolatility) {
+		return d.MaxSpreadFactor
+	}
+	return d.MinSpreadFactor.Add(d.MaxSpreadFactor.Sub(d.MinSpreadFactor).Mul(volatility).Quo(d.MaxVolatility))
+}
@@ -956,3 +956,93 @@ type InvalidForfeitedIncentivesLengthError struct {
 func (e InvalidForfeitedIncentivesLengthError) Error() string {
 	return fmt.Sprintf("attempted to redeposit incorrectly constructed forfeited incentives slice. forfeited incentives must have an entry for each supported uptime. forfeit entries: %d, expected: %d", e.ForfeitedIncentivesLength, e.ExpectedLength)
 }
+
+type RangeOrderNotSingleSidedError struct {
+	PositionId  uint64
+	LowerTick   int64
+	UpperTick   int64
+	CurrentTick int64
+}
+
+func (e RangeOrderNotSingleSidedError) Error() string {
+	return fmt.Sprintf("range order position (%d) range [%d, %d) must be entirely above or below the current tick (%d)", e.PositionId, e.LowerTick, e.UpperTick, e.CurrentTick)
+}
+
+type RebalanceToSameRangeError struct {
+	PositionId uint64
+	LowerTick  int64
+	UpperTick  int64
+}
+
+func (e RebalanceToSameRangeError) Error() string {
+	return fmt.Sprintf("cannot rebalance position (%d) to its current range [%d, %d)", e.PositionId, e.LowerTick, e.UpperTick)
+}
+
+type RebalanceSwapExceedsWithdrawnError struct {
+	PositionId      uint64
+	TokenSwappedIn  sdk.Coin
+	AmountWithdrawn osmomath.Int
+}
+
+func (e RebalanceSwapExceedsWithdrawnError) Error() string {
+	return fmt.Sprintf("token swapped in (%s) exceeds the amount (%s) withdrawn from position (%d)", e.TokenSwappedIn, e.AmountWithdrawn, e.PositionId)
+}
+
+type InvalidMaxSlippageError struct {
+	MaxSlippage osmomath.Dec
+}
+
+func (e InvalidMaxSlippageError) Error() string {
+	return fmt.Sprintf("max slippage (%s) must be in the range [0, 1)", e.MaxSlippage)
+}
+
+type EpochIdentifierNotFoundError struct {
+	EpochIdentifier string
+}
+
+func (e EpochIdentifierNotFoundError) Error() string {
+	return fmt.Sprintf("epoch identifier (%s) not found", e.EpochIdentifier)
+}
+
+type AutoCompoundOutOfGasError struct {
+	PositionId uint64
+	GasLimit   uint64
+}
+
+func (e AutoCompoundOutOfGasError) Error() string {
+	return fmt.Sprintf("auto-compounding the rewards of position (%d) exceeds %d gas", e.PositionId, e.GasLimit)
+}
+
+type NoAutoCompoundRouteError struct {
+	PoolId      uint64
+	TokenIn     sdk.Coin
+	MaxSlippage osmomath.Dec
+}
+
+func (e NoAutoCompoundRouteError) Error() string {
+	return fmt.Sprintf("no route found converting the rewards (%s) into a token of pool (%d) within the max slippage (%s)", e.TokenIn, e.PoolId, e.MaxSlippage)
+}
+
+type InvalidBucketWidthError struct {
+	BucketWidth osmomath.Dec
+}
+
+func (e InvalidBucketWidthError) Error() string {
+	return fmt.Sprintf("bucket width (%s) must be positive", e.BucketWidth)
+}
+
+type InvalidMaxPriceImpactError struct {
+	MaxPriceImpact osmomath.Dec
+}
+
+func (e InvalidMaxPriceImpactError) Error() string {
+	return fmt.Sprintf("max price impact (%s) must be in the range (0, 1)", e.MaxPriceImpact)
+}
+
+type PositionPerformanceNotFoundError struct {
+	PositionId uint64
+}
+
+func (e PositionPerformanceNotFoundError) Error() string {
+	return fmt.Sprintf("performance of position (%d) not found, it was created before its tracking", e.PositionId)
+}
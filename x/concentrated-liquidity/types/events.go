@@ -15,6 +15,9 @@ const (
 	TypeEvtTransferPositions         = "transfer_positions"
 	TypeEvtInitTick                  = "init_tick"
 	TypeEvtRemoveTick                = "remove_tick"
+	TypeEvtFillRangeOrder            = "fill_range_order"
+	TypeEvtRebalancePosition         = "rebalance_position"
+	TypeEvtAutoCompoundPosition      = "auto_compound_position"
 
 	AttributeValueCategory                                         = ModuleName
 	AttributeKeyPositionId                                         = "position_id"
@@ -30,6 +33,8 @@ const (
 	AttributeJoinTime                                              = "join_time"
 	AttributeLowerTick                                             = "lower_tick"
 	AttributeUpperTick                                             = "upper_tick"
+	AttributeNewLowerTick                                          = "new_lower_tick"
+	AttributeNewUpperTick                                          = "new_upper_tick"
 	TypeEvtPoolJoined                                              = "pool_joined"
 	TypeEvtPoolExited                                              = "pool_exited"
 	TypeEvtTokenSwapped                                            = "token_swapped"
@@ -247,12 +247,16 @@ type GenesisState struct {
 	// params are all the parameters of the module
 	Params types1.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// pool data containing serialized pool struct and ticks.
-	PoolData                                      []PoolData     `protobuf:"bytes,2,rep,name=pool_data,json=poolData,proto3" json:"pool_data"`
-	PositionData                                  []PositionData `protobuf:"bytes,3,rep,name=position_data,json=positionData,proto3" json:"position_data"`
-	NextPositionId                                uint64         `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
-	NextIncentiveRecordId                         uint64         `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
-	IncentivesAccumulatorPoolIdMigrationThreshold uint64         `protobuf:"varint,6,opt,name=incentives_accumulator_pool_id_migration_threshold,json=incentivesAccumulatorPoolIdMigrationThreshold,proto3" json:"incentives_accumulator_pool_id_migration_threshold,omitempty" yaml:"incentives_accumulator_pool_id_migration_threshold"`
-	SpreadFactorPoolIdMigrationThreshold          uint64         `protobuf:"varint,7,opt,name=spread_factor_pool_id_migration_threshold,json=spreadFactorPoolIdMigrationThreshold,proto3" json:"spread_factor_pool_id_migration_threshold,omitempty" yaml:"spread_factor_pool_id_migration_threshold"`
+	PoolData                                      []PoolData                        `protobuf:"bytes,2,rep,name=pool_data,json=poolData,proto3" json:"pool_data"`
+	PositionData                                  []PositionData                    `protobuf:"bytes,3,rep,name=position_data,json=positionData,proto3" json:"position_data"`
+	NextPositionId                                uint64                            `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
+	NextIncentiveRecordId                         uint64                            `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
+	IncentivesAccumulatorPoolIdMigrationThreshold uint64                            `protobuf:"varint,6,opt,name=incentives_accumulator_pool_id_migration_threshold,json=incentivesAccumulatorPoolIdMigrationThreshold,proto3" json:"incentives_accumulator_pool_id_migration_threshold,omitempty" yaml:"incentives_accumulator_pool_id_migration_threshold"`
+	SpreadFactorPoolIdMigrationThreshold          uint64                            `protobuf:"varint,7,opt,name=spread_factor_pool_id_migration_threshold,json=spreadFactorPoolIdMigrationThreshold,proto3" json:"spread_factor_pool_id_migration_threshold,omitempty" yaml:"spread_factor_pool_id_migration_threshold"`
+	RangeOrders                                   []types1.RangeOrder               `protobuf:"bytes,8,rep,name=range_orders,json=rangeOrders,proto3" json:"range_orders" yaml:"range_orders"`
+	PositionAutoCompounds                         []types1.PositionAutoCompound     `protobuf:"bytes,9,rep,name=position_auto_compounds,json=positionAutoCompounds,proto3" json:"position_auto_compounds" yaml:"position_auto_compounds"`
+	DynamicSpreadFactorStates                     []types1.DynamicSpreadFactorState `protobuf:"bytes,10,rep,name=dynamic_spread_factor_states,json=dynamicSpreadFactorStates,proto3" json:"dynamic_spread_factor_states" yaml:"dynamic_spread_factor_states"`
+	PositionPerformances                          []types1.PositionPerformance      `protobuf:"bytes,11,rep,name=position_performances,json=positionPerformances,proto3" json:"position_performances" yaml:"position_performances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -337,6 +341,34 @@ func (m *GenesisState) GetSpreadFactorPoolIdMigrationThreshold() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetRangeOrders() []types1.RangeOrder {
+	if m != nil {
+		return m.RangeOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPositionAutoCompounds() []types1.PositionAutoCompound {
+	if m != nil {
+		return m.PositionAutoCompounds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDynamicSpreadFactorStates() []types1.DynamicSpreadFactorState {
+	if m != nil {
+		return m.DynamicSpreadFactorStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPositionPerformances() []types1.PositionPerformance {
+	if m != nil {
+		return m.PositionPerformances
+	}
+	return nil
+}
+
 type AccumObject struct {
 	// Accumulator's name (pulled from AccumulatorContent)
 	Name         string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
@@ -403,67 +435,80 @@ func init() {
 }
 
 var fileDescriptor_4cdf50d18c43a7c5 = []byte{
-	// 947 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0x36, 0xb6, 0x9b, 0x8c, 0xdd, 0x92, 0x8e, 0x52, 0xb2, 0x0d, 0xaa, 0xd7, 0x4c, 0x89,
-	0x94, 0x82, 0xe2, 0x25, 0x76, 0x40, 0x02, 0xc1, 0x21, 0x2e, 0x14, 0x19, 0x04, 0x44, 0x43, 0xb8,
-	0xf0, 0x65, 0xc6, 0xbb, 0x63, 0x67, 0xe8, 0x7a, 0xc7, 0xec, 0x8c, 0x43, 0x7c, 0xe5, 0x8e, 0x84,
-	0x38, 0xf1, 0x13, 0xf8, 0x01, 0x48, 0x9c, 0xb9, 0x55, 0x88, 0x43, 0x8f, 0x9c, 0x56, 0x28, 0x39,
-	0x70, 0xf7, 0x2f, 0x40, 0x3b, 0x1f, 0xfe, 0x08, 0x4e, 0xe2, 0x70, 0x9b, 0xf1, 0xfb, 0x3e, 0xcf,
-	0xfb, 0xcc, 0xfb, 0xe5, 0x05, 0x75, 0x2e, 0x7a, 0x5c, 0x30, 0xe1, 0x07, 0x3c, 0x0e, 0x68, 0x2c,
-	0x13, 0x22, 0x69, 0x18, 0xb1, 0x6f, 0x07, 0x2c, 0x64, 0x72, 0xe8, 0x1f, 0xef, 0xb6, 0xa9, 0x24,
-	0xbb, 0x7e, 0x97, 0xc6, 0x54, 0x30, 0x51, 0xed, 0x27, 0x5c, 0x72, 0xb8, 0x65, 0x40, 0xd5, 0xb9,
-	0xa0, 0xaa, 0x01, 0x6d, 0xae, 0x77, 0x79, 0x97, 0x2b, 0x84, 0x9f, 0x9d, 0x34, 0x78, 0xf3, 0x5e,
-	0xa0, 0xd0, 0x2d, 0x6d, 0xd0, 0x17, 0x6b, 0xea, 0x72, 0xde, 0x8d, 0xa8, 0xaf, 0x6e, 0xed, 0x41,
-	0xc7, 0x27, 0xf1, 0xd0, 0x98, 0x5e, 0xb4, 0x3a, 0x49, 0x10, 0x0c, 0x7a, 0x63, 0x5d, 0xea, 0x66,
-	0x5c, 0x5e, 0xbe, 0xfc, 0x29, 0x7d, 0x92, 0x90, 0x9e, 0x8d, 0xb4, 0xb7, 0xd8, 0xb3, 0xfb, 0x5c,
-	0x30, 0xc9, 0x78, 0x6c, 0x50, 0xaf, 0x2d, 0x86, 0x92, 0x2c, 0x78, 0xd2, 0x62, 0x71, 0xc7, 0xbe,
-	0xf8, 0xad, 0xc5, 0x60, 0x4c, 0x19, 0xd9, 0x31, 0x6d, 0x25, 0x34, 0xe0, 0x49, 0xa8, 0xd1, 0xe8,
-	0x4f, 0x07, 0xac, 0x3c, 0x1e, 0x44, 0xd1, 0x21, 0x0b, 0x9e, 0xc0, 0x57, 0xc0, 0xcd, 0x3e, 0xe7,
-	0x51, 0x8b, 0x85, 0xae, 0x53, 0x71, 0xb6, 0x73, 0x0d, 0x38, 0x4a, 0xbd, 0xdb, 0x43, 0xd2, 0x8b,
-	0xde, 0x44, 0xc6, 0x80, 0x70, 0x21, 0x3b, 0x35, 0x43, 0xb8, 0x07, 0x80, 0x91, 0x12, 0xd2, 0x13,
-	0xf7, 0x46, 0xc5, 0xd9, 0x5e, 0x6e, 0xdc, 0x1d, 0xa5, 0xde, 0x1d, 0xed, 0x3f, 0xb1, 0x21, 0xbc,
-	0x9a, 0x5d, 0x9a, 0xd9, 0x19, 0x7e, 0x09, 0x72, 0x99, 0x76, 0x77, 0xb9, 0xe2, 0x6c, 0x17, 0x6b,
-	0x7e, 0x75, 0xa1, 0x5a, 0x57, 0x0f, 0x15, 0xbe, 0xc3, 0x1b, 0xee, 0xd3, 0xd4, 0x5b, 0x1a, 0xa5,
-	0xde, 0xda, 0x4c, 0x90, 0x0e, 0x47, 0x58, 0xd1, 0xa2, 0xdf, 0x72, 0x60, 0xe5, 0x80, 0xf3, 0xe8,
-	0x1d, 0x22, 0x09, 0xac, 0x83, 0x5c, 0xa6, 0x55, 0xbd, 0xa5, 0x58, 0x5b, 0xaf, 0xea, 0xfa, 0x57,
-	0x6d, 0xfd, 0xab, 0xfb, 0xf1, 0xb0, 0xb1, 0xfa, 0xc7, 0xaf, 0x3b, 0xf9, 0x0c, 0xd1, 0xc4, 0xca,
-	0x19, 0x7e, 0x0e, 0xf2, 0x19, 0xab, 0x70, 0x6f, 0x54, 0x96, 0xaf, 0xa1, 0xd0, 0xe6, 0xb0, 0xb1,
-	0x6e, 0x14, 0x96, 0x26, 0x0a, 0x05, 0xc2, 0x9a, 0x13, 0xfe, 0xec, 0x80, 0x7b, 0xa2, 0x9f, 0x50,
-	0x12, 0xb6, 0x12, 0xfa, 0x1d, 0x49, 0xc2, 0x96, 0x6a, 0xb1, 0x41, 0x44, 0x24, 0x4f, 0x4c, 0x4e,
-	0x6a, 0x0b, 0x46, 0xdc, 0xcf, 0x90, 0x1f, 0xb7, 0xbf, 0xa1, 0x81, 0x6c, 0x6c, 0x9b, 0xa0, 0x15,
-	0x1d, 0xf4, 0xc2, 0x10, 0x08, 0x6f, 0x68, 0x1b, 0x56, 0xa6, 0xfd, 0x89, 0x05, 0xfe, 0xe4, 0x80,
-	0x8d, 0x71, 0x8f, 0x88, 0x69, 0x90, 0x70, 0x73, 0x2a, 0x15, 0xff, 0x47, 0xd8, 0x96, 0x11, 0x76,
-	0x5f, 0x0b, 0x9b, 0x1f, 0x00, 0xe1, 0xe7, 0x27, 0x86, 0x29, 0x4d, 0x02, 0x32, 0x70, 0xe7, 0x7c,
-	0xdf, 0x0a, 0x37, 0xaf, 0xd4, 0xbc, 0xbe, 0xa0, 0x9a, 0xa6, 0xc5, 0x63, 0x05, 0x6f, 0xe4, 0x32,
-	0x45, 0x78, 0x8d, 0xcd, 0xfe, 0x2c, 0xd0, 0xef, 0x37, 0x40, 0xe9, 0xc0, 0x0c, 0xa4, 0xea, 0x9e,
-	0x0f, 0xc0, 0x8a, 0x1d, 0x50, 0xd3, 0x41, 0x8b, 0xf6, 0x82, 0xa5, 0xc1, 0x63, 0x82, 0x6c, 0xb2,
-	0x22, 0x9e, 0xf5, 0x6a, 0xa8, 0x26, 0x65, 0x66, 0xb2, 0x8c, 0x01, 0xe1, 0x42, 0x76, 0x6a, 0x86,
-	0xf0, 0x6b, 0xb0, 0x39, 0xa7, 0x82, 0xe6, 0xfd, 0xa6, 0x4b, 0xee, 0x8f, 0xb5, 0xe8, 0x25, 0x65,
-	0x63, 0xcf, 0xbc, 0xf2, 0xbf, 0xc5, 0xd6, 0x66, 0xf8, 0x29, 0x58, 0x1f, 0xf4, 0x25, 0xeb, 0xd1,
-	0x19, 0x6a, 0x5b, 0xe8, 0x85, 0xb8, 0xa1, 0x26, 0x98, 0x62, 0x15, 0xe8, 0x9f, 0x3c, 0x28, 0xbd,
-	0xa7, 0x77, 0xf9, 0x27, 0x92, 0x48, 0x0a, 0x1f, 0x81, 0x82, 0x5e, 0x8c, 0x26, 0x83, 0x5b, 0x57,
-	0x64, 0xf0, 0x40, 0x39, 0x9b, 0x08, 0x06, 0x0a, 0x31, 0x58, 0x55, 0xcb, 0x27, 0x24, 0x92, 0x5c,
-	0x73, 0x2a, 0xed, 0x2a, 0x30, 0x8c, 0x2b, 0x7d, 0xbb, 0x1a, 0xbe, 0x02, 0xb7, 0x6c, 0x6d, 0x34,
-	0xef, 0xb2, 0xe2, 0xad, 0x5f, 0xb3, 0xc2, 0x53, 0xdc, 0xa5, 0xfe, 0x74, 0xf3, 0xbc, 0x0b, 0xd6,
-	0x62, 0x7a, 0x22, 0x5b, 0xe3, 0x20, 0x2c, 0x74, 0x73, 0xaa, 0xf0, 0x2f, 0x8c, 0x52, 0x6f, 0x43,
-	0x17, 0xfe, 0xbc, 0x07, 0xc2, 0xb7, 0xb3, 0x9f, 0x2c, 0x79, 0x33, 0x84, 0x5f, 0x00, 0x57, 0x39,
-	0x9d, 0x1f, 0x82, 0x8c, 0x2e, 0xaf, 0xe8, 0x1e, 0x8c, 0x52, 0xcf, 0x9b, 0xa2, 0x9b, 0xe3, 0x89,
-	0xf0, 0xdd, 0xcc, 0x74, 0x6e, 0x10, 0x9a, 0x21, 0xfc, 0xc5, 0x01, 0xb5, 0xf9, 0x13, 0xd9, 0x32,
-	0xdb, 0xbe, 0xd5, 0x63, 0xdd, 0x84, 0x28, 0x79, 0xf2, 0x28, 0xa1, 0xe2, 0x88, 0x47, 0xa1, 0x5b,
-	0x50, 0x81, 0xdf, 0x1e, 0xa5, 0xde, 0x1b, 0x97, 0x4d, 0xf5, 0x65, 0x1c, 0x08, 0xef, 0xcc, 0x9d,
-	0x78, 0xb5, 0x88, 0xc3, 0x0f, 0x2d, 0xe0, 0xd0, 0xfa, 0xc3, 0x1f, 0x1c, 0xf0, 0xd0, 0xcc, 0x44,
-	0x87, 0x04, 0x57, 0x29, 0xbc, 0xa9, 0x14, 0xee, 0x8d, 0x52, 0xef, 0xd5, 0x99, 0x85, 0x78, 0x35,
-	0x14, 0xe1, 0x97, 0xb4, 0xef, 0x63, 0xe5, 0x7a, 0x91, 0x1e, 0xf4, 0xbd, 0x03, 0x8a, 0x53, 0x7b,
-	0x0e, 0x3e, 0x00, 0xb9, 0x98, 0xf4, 0xa8, 0x6a, 0xf3, 0xd5, 0xc6, 0x73, 0xa3, 0xd4, 0x2b, 0x9a,
-	0xa2, 0x90, 0x1e, 0x45, 0x58, 0x19, 0xe1, 0x47, 0xe0, 0x96, 0x1e, 0xb7, 0x80, 0xc7, 0x92, 0xc6,
-	0x52, 0xad, 0x82, 0x62, 0xed, 0xe1, 0x05, 0xe3, 0x36, 0x95, 0x97, 0x47, 0x1a, 0x80, 0x4b, 0xca,
-	0xc3, 0xdc, 0x1a, 0xe1, 0xd3, 0xd3, 0xb2, 0xf3, 0xec, 0xb4, 0xec, 0xfc, 0x7d, 0x5a, 0x76, 0x7e,
-	0x3c, 0x2b, 0x2f, 0x3d, 0x3b, 0x2b, 0x2f, 0xfd, 0x75, 0x56, 0x5e, 0xfa, 0xec, 0xfd, 0x2e, 0x93,
-	0x47, 0x83, 0x76, 0x35, 0xe0, 0x3d, 0xdf, 0x90, 0xef, 0x44, 0xa4, 0x2d, 0xec, 0xc5, 0x3f, 0xae,
-	0xef, 0xfa, 0x27, 0x33, 0x5f, 0x0c, 0x3b, 0x93, 0x4f, 0x06, 0x39, 0xec, 0x53, 0x61, 0x3f, 0xca,
-	0xda, 0x05, 0xf5, 0x7f, 0x59, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0xc5, 0xaf, 0xc8, 0x75, 0xcc,
-	0x09, 0x00, 0x00,
+	// 1156 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xcf, 0x26, 0x4e, 0x9a, 0x8c, 0xdd, 0xfe, 0xd3, 0xf9, 0x27, 0x64, 0x93, 0xb6, 0xb6, 0x99,
+	0x34, 0x28, 0xa5, 0x8a, 0x4d, 0x5e, 0x00, 0xb5, 0x80, 0x20, 0x9b, 0x52, 0x64, 0x10, 0x34, 0x9a,
+	0x86, 0x0b, 0x6f, 0xcb, 0x78, 0x77, 0xec, 0x2c, 0xdd, 0xdd, 0xd9, 0xee, 0x8c, 0x43, 0x7c, 0xe5,
+	0x8e, 0x84, 0x90, 0x90, 0x90, 0xf8, 0x02, 0x5c, 0xb8, 0x21, 0x71, 0x85, 0x5b, 0x85, 0x38, 0xf4,
+	0xc8, 0xc9, 0x42, 0xc9, 0x37, 0xf0, 0x27, 0x40, 0x3b, 0x3b, 0x6b, 0xaf, 0x5d, 0xc7, 0x5d, 0x73,
+	0xdb, 0xf1, 0xf3, 0xfc, 0x7e, 0xcf, 0x6f, 0xe6, 0x79, 0x99, 0x31, 0xd8, 0x65, 0xdc, 0x63, 0xdc,
+	0xe1, 0x55, 0x8b, 0xf9, 0x16, 0xf5, 0x45, 0x48, 0x04, 0xb5, 0x5d, 0xe7, 0x71, 0xcb, 0xb1, 0x1d,
+	0xd1, 0xae, 0x9e, 0x6c, 0xd7, 0xa9, 0x20, 0xdb, 0xd5, 0x26, 0xf5, 0x29, 0x77, 0x78, 0x25, 0x08,
+	0x99, 0x60, 0x70, 0x43, 0x81, 0x2a, 0x23, 0x41, 0x15, 0x05, 0x5a, 0x5b, 0x6a, 0xb2, 0x26, 0x93,
+	0x88, 0x6a, 0xf4, 0x15, 0x83, 0xd7, 0x56, 0x2d, 0x89, 0x36, 0x63, 0x43, 0xbc, 0x48, 0x4c, 0x4d,
+	0xc6, 0x9a, 0x2e, 0xad, 0xca, 0x55, 0xbd, 0xd5, 0xa8, 0x12, 0xbf, 0xad, 0x4c, 0x2f, 0x26, 0x3a,
+	0x89, 0x65, 0xb5, 0xbc, 0x9e, 0x2e, 0xb9, 0x52, 0x2e, 0x2f, 0x8f, 0xdf, 0x4a, 0x40, 0x42, 0xe2,
+	0x25, 0x91, 0xf6, 0xb2, 0x6d, 0x3b, 0x60, 0xdc, 0x11, 0x0e, 0xf3, 0x15, 0xea, 0xd5, 0x6c, 0x28,
+	0xe1, 0x58, 0x8f, 0x4c, 0xc7, 0x6f, 0x24, 0x3b, 0x7e, 0x33, 0x1b, 0xcc, 0x91, 0x46, 0xe7, 0x84,
+	0x9a, 0x21, 0xb5, 0x58, 0x68, 0x2b, 0xf4, 0xeb, 0xd9, 0xd0, 0x21, 0xf1, 0x9b, 0xd4, 0x64, 0xa1,
+	0x4d, 0x43, 0x05, 0xbc, 0x93, 0x0d, 0x48, 0x5a, 0x82, 0x99, 0x16, 0xf3, 0x02, 0xd6, 0xf2, 0x93,
+	0x98, 0xfb, 0xd9, 0xa0, 0x76, 0xdb, 0x27, 0x9e, 0x63, 0x99, 0x3c, 0x08, 0x29, 0xb1, 0xcd, 0x06,
+	0xb1, 0x04, 0x4b, 0xa2, 0xbf, 0x33, 0xd9, 0x09, 0x9b, 0x01, 0x0d, 0x1b, 0x2c, 0xf4, 0x88, 0x6f,
+	0xd1, 0x98, 0x01, 0xfd, 0xa5, 0x81, 0xf9, 0xfb, 0x2d, 0xd7, 0x3d, 0x72, 0xac, 0x47, 0xf0, 0x36,
+	0xb8, 0x14, 0x30, 0xe6, 0x9a, 0x8e, 0xad, 0x6b, 0x65, 0x6d, 0x33, 0x67, 0xc0, 0x6e, 0xa7, 0x74,
+	0xa5, 0x4d, 0x3c, 0xf7, 0x2e, 0x52, 0x06, 0x84, 0xe7, 0xa2, 0xaf, 0x9a, 0x0d, 0xf7, 0x00, 0x50,
+	0x39, 0xb0, 0xe9, 0xa9, 0x3e, 0x5d, 0xd6, 0x36, 0x67, 0x8c, 0xe5, 0x6e, 0xa7, 0x74, 0x35, 0xf6,
+	0xef, 0xdb, 0x10, 0x5e, 0x88, 0x16, 0xb5, 0xe8, 0x1b, 0x7e, 0x0e, 0x72, 0x51, 0xd2, 0xf4, 0x99,
+	0xb2, 0xb6, 0x99, 0xdf, 0xa9, 0x56, 0x32, 0x15, 0x79, 0xe5, 0x48, 0xe2, 0x1b, 0xcc, 0xd0, 0x9f,
+	0x74, 0x4a, 0x53, 0xdd, 0x4e, 0x69, 0x71, 0x20, 0x48, 0x83, 0x21, 0x2c, 0x69, 0xd1, 0x6f, 0x39,
+	0x30, 0x7f, 0xc8, 0x98, 0x7b, 0x8f, 0x08, 0x02, 0x77, 0x41, 0x2e, 0xd2, 0x2a, 0xf7, 0x92, 0xdf,
+	0x59, 0xaa, 0xc4, 0x85, 0x5f, 0x49, 0x0a, 0xbf, 0xb2, 0xef, 0xb7, 0x8d, 0x85, 0x3f, 0x7f, 0xdd,
+	0x9a, 0x8d, 0x10, 0x35, 0x2c, 0x9d, 0xe1, 0xa7, 0x60, 0x36, 0x62, 0xe5, 0xfa, 0x74, 0x79, 0x66,
+	0x02, 0x85, 0xc9, 0x19, 0x1a, 0x4b, 0x4a, 0x61, 0xa1, 0xaf, 0x90, 0x23, 0x1c, 0x73, 0xc2, 0x1f,
+	0x35, 0xb0, 0xaa, 0xf2, 0x18, 0xd2, 0xaf, 0x49, 0x68, 0x9b, 0xb2, 0xb7, 0x5a, 0x2e, 0x11, 0x2c,
+	0x54, 0x67, 0xb2, 0x93, 0x31, 0xe2, 0x7e, 0x84, 0x7c, 0x50, 0xff, 0x8a, 0x5a, 0xc2, 0xd8, 0x54,
+	0x41, 0xcb, 0x71, 0xd0, 0x0b, 0x43, 0x20, 0xbc, 0x12, 0xdb, 0xb0, 0x34, 0xed, 0xf7, 0x2d, 0xf0,
+	0x7b, 0x0d, 0xac, 0xf4, 0x9a, 0x83, 0xa7, 0x41, 0x5c, 0xcf, 0x95, 0x67, 0xfe, 0xa3, 0xb0, 0x0d,
+	0x25, 0xec, 0x46, 0x2c, 0x6c, 0x74, 0x00, 0x84, 0x5f, 0xe8, 0x1b, 0x52, 0x9a, 0x38, 0x74, 0xc0,
+	0xd5, 0xe1, 0x86, 0xe5, 0xfa, 0xac, 0x54, 0xf3, 0x5a, 0x46, 0x35, 0xb5, 0x04, 0x8f, 0x25, 0xdc,
+	0xc8, 0x45, 0x8a, 0xf0, 0xa2, 0x33, 0xf8, 0x33, 0x47, 0x7f, 0x4c, 0x83, 0xc2, 0xa1, 0xea, 0x13,
+	0x59, 0x3d, 0x1f, 0x80, 0xf9, 0xa4, 0x6f, 0x54, 0x05, 0x65, 0xad, 0x85, 0x84, 0x06, 0xf7, 0x08,
+	0xa2, 0xce, 0x72, 0x59, 0x54, 0xab, 0xb6, 0x3e, 0x3d, 0xdc, 0x59, 0xca, 0x80, 0xf0, 0x5c, 0xf4,
+	0x55, 0xb3, 0xe1, 0x97, 0x60, 0x6d, 0x44, 0x06, 0xd5, 0xfe, 0x55, 0x95, 0xdc, 0xe8, 0x69, 0x91,
+	0xc6, 0x5e, 0xec, 0x81, 0x5d, 0x3e, 0x9b, 0xec, 0xd8, 0x0c, 0x3f, 0x06, 0x4b, 0xad, 0x40, 0x38,
+	0x1e, 0x1d, 0xa0, 0x4e, 0x12, 0x9d, 0x89, 0x1b, 0xc6, 0x04, 0x29, 0x56, 0x8e, 0x7e, 0x07, 0xa0,
+	0xf0, 0x5e, 0x7c, 0x89, 0x3d, 0x14, 0x44, 0x50, 0x78, 0x00, 0xe6, 0xe2, 0x1b, 0x41, 0x9d, 0xe0,
+	0xc6, 0x73, 0x4e, 0xf0, 0x50, 0x3a, 0xab, 0x08, 0x0a, 0x0a, 0x31, 0x58, 0x90, 0xc3, 0xc7, 0x26,
+	0x82, 0x4c, 0xd8, 0x95, 0xc9, 0x28, 0x50, 0x8c, 0xf3, 0x41, 0x32, 0x1a, 0xbe, 0x00, 0x97, 0x7b,
+	0x43, 0x51, 0xf2, 0xce, 0x48, 0xde, 0xdd, 0x09, 0x33, 0x9c, 0xe2, 0x2e, 0x04, 0xe9, 0xe2, 0x79,
+	0x17, 0x2c, 0xfa, 0xf4, 0x54, 0x98, 0xbd, 0x20, 0x8e, 0xad, 0xe7, 0x64, 0xe2, 0xaf, 0x75, 0x3b,
+	0xa5, 0x95, 0x38, 0xf1, 0xc3, 0x1e, 0x08, 0x5f, 0x89, 0x7e, 0x4a, 0xc8, 0x6b, 0x36, 0xfc, 0x0c,
+	0xe8, 0xd2, 0x69, 0xb8, 0x09, 0x22, 0xba, 0x59, 0x49, 0xb7, 0xde, 0xed, 0x94, 0x4a, 0x29, 0xba,
+	0x11, 0x9e, 0x08, 0x2f, 0x47, 0xa6, 0xa1, 0x46, 0xa8, 0xd9, 0xf0, 0x67, 0x0d, 0xec, 0x8c, 0xee,
+	0x48, 0x53, 0x4d, 0x7b, 0xd3, 0x73, 0x9a, 0x21, 0x91, 0xf2, 0xc4, 0x71, 0x48, 0xf9, 0x31, 0x73,
+	0x6d, 0x7d, 0x4e, 0x06, 0x7e, 0xab, 0xdb, 0x29, 0xdd, 0x19, 0xd7, 0xd5, 0xe3, 0x38, 0x10, 0xde,
+	0x1a, 0xd9, 0xf1, 0x72, 0x10, 0xdb, 0x1f, 0x26, 0x80, 0xa3, 0xc4, 0x1f, 0x7e, 0xab, 0x81, 0x5b,
+	0x03, 0x17, 0xe0, 0x58, 0x85, 0x97, 0xa4, 0xc2, 0xbd, 0x6e, 0xa7, 0xf4, 0xca, 0xc0, 0x40, 0x7c,
+	0x3e, 0x14, 0xe1, 0x9b, 0xb1, 0xef, 0x7d, 0x62, 0x8d, 0xd3, 0xf3, 0x18, 0x14, 0x52, 0x6f, 0x01,
+	0xae, 0xcf, 0xcb, 0xf2, 0xd9, 0xce, 0x58, 0x3e, 0x38, 0x82, 0x3e, 0x88, 0x90, 0xc6, 0x35, 0x35,
+	0x20, 0xff, 0x1f, 0x0b, 0x4d, 0x93, 0x22, 0x9c, 0x0f, 0x7b, 0x8e, 0x1c, 0xfe, 0xa4, 0x81, 0x95,
+	0x5e, 0xb1, 0x0c, 0xbc, 0x27, 0xb8, 0xbe, 0x20, 0xc3, 0xbf, 0x31, 0x61, 0xf5, 0xee, 0xb7, 0x04,
+	0x3b, 0x50, 0x1c, 0xc6, 0x4b, 0x4a, 0x48, 0x31, 0xb9, 0xee, 0x47, 0x46, 0x42, 0x78, 0x39, 0x18,
+	0x81, 0xe6, 0xf0, 0x17, 0x0d, 0x5c, 0x1f, 0xf9, 0x52, 0x31, 0xb9, 0x20, 0x82, 0x72, 0x1d, 0x48,
+	0x89, 0x6f, 0x67, 0x94, 0x78, 0x2f, 0xa6, 0x7a, 0x98, 0xca, 0x85, 0x9c, 0x28, 0xc6, 0x6d, 0x25,
+	0x73, 0x3d, 0x96, 0x39, 0x2e, 0x24, 0xc2, 0xab, 0xf6, 0x05, 0x34, 0x1c, 0xfe, 0xa0, 0x81, 0xe5,
+	0x51, 0xcf, 0x22, 0xae, 0xe7, 0xa5, 0xd0, 0xbb, 0x13, 0x9e, 0xe5, 0x61, 0x9f, 0xc2, 0xb8, 0xa9,
+	0x34, 0x5e, 0x1f, 0x3a, 0xca, 0x74, 0x18, 0x84, 0x97, 0x82, 0x67, 0xa1, 0x1c, 0x7d, 0xa3, 0x81,
+	0x7c, 0xea, 0x02, 0x85, 0xeb, 0x20, 0xe7, 0x13, 0x8f, 0xca, 0xf9, 0xb9, 0x60, 0xfc, 0xaf, 0xdb,
+	0x29, 0xe5, 0x55, 0xb7, 0x13, 0x8f, 0x22, 0x2c, 0x8d, 0xf0, 0x23, 0x70, 0x39, 0x9e, 0xe3, 0x16,
+	0xf3, 0x05, 0xf5, 0x85, 0xbc, 0x63, 0xf2, 0x3b, 0xb7, 0x2e, 0x98, 0xe3, 0xa9, 0x86, 0x3b, 0x88,
+	0x01, 0xb8, 0x20, 0x3d, 0xd4, 0xca, 0xb0, 0x9f, 0x9c, 0x15, 0xb5, 0xa7, 0x67, 0x45, 0xed, 0x9f,
+	0xb3, 0xa2, 0xf6, 0xdd, 0x79, 0x71, 0xea, 0xe9, 0x79, 0x71, 0xea, 0xef, 0xf3, 0xe2, 0xd4, 0x27,
+	0xef, 0x37, 0x1d, 0x71, 0xdc, 0xaa, 0x57, 0x2c, 0xe6, 0x55, 0x15, 0xf9, 0x96, 0x4b, 0xea, 0x3c,
+	0x59, 0x54, 0x4f, 0x76, 0xb7, 0xab, 0xa7, 0x03, 0xcf, 0xd1, 0xad, 0xfe, 0x7b, 0x54, 0xb4, 0x03,
+	0xca, 0x93, 0xbf, 0x39, 0xf5, 0x39, 0xf9, 0x10, 0xdb, 0xfd, 0x77, 0x00, 0x57, 0x24, 0x69, 0x78,
+	0x1e, 0x0d, 0x00, 0x00,
 }
 
 func (m *FullTick) Marshal() (dAtA []byte, err error) {
@@ -680,6 +725,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PositionPerformances) > 0 {
+		for iNdEx := len(m.PositionPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DynamicSpreadFactorStates) > 0 {
+		for iNdEx := len(m.DynamicSpreadFactorStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DynamicSpreadFactorStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PositionAutoCompounds) > 0 {
+		for iNdEx := len(m.PositionAutoCompounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionAutoCompounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RangeOrders) > 0 {
+		for iNdEx := len(m.RangeOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RangeOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.SpreadFactorPoolIdMigrationThreshold != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SpreadFactorPoolIdMigrationThreshold))
 		i--
@@ -900,6 +1001,30 @@ func (m *GenesisState) Size() (n int) {
 	if m.SpreadFactorPoolIdMigrationThreshold != 0 {
 		n += 1 + sovGenesis(uint64(m.SpreadFactorPoolIdMigrationThreshold))
 	}
+	if len(m.RangeOrders) > 0 {
+		for _, e := range m.RangeOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PositionAutoCompounds) > 0 {
+		for _, e := range m.PositionAutoCompounds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DynamicSpreadFactorStates) > 0 {
+		for _, e := range m.DynamicSpreadFactorStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PositionPerformances) > 0 {
+		for _, e := range m.PositionPerformances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1646,6 +1771,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangeOrders = append(m.RangeOrders, types1.RangeOrder{})
+			if err := m.RangeOrders[len(m.RangeOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionAutoCompounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionAutoCompounds = append(m.PositionAutoCompounds, types1.PositionAutoCompound{})
+			if err := m.PositionAutoCompounds[len(m.PositionAutoCompounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactorStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DynamicSpreadFactorStates = append(m.DynamicSpreadFactorStates, types1.DynamicSpreadFactorState{})
+			if err := m.DynamicSpreadFactorStates[len(m.DynamicSpreadFactorStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionPerformances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionPerformances = append(m.PositionPerformances, types1.PositionPerformance{})
+			if err := m.PositionPerformances[len(m.PositionPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -53,6 +54,23 @@ var (
 	KeyIncentiveAccumulatorMigrationThreshold    = []byte{0x15}
 	KeySpreadRewardAccumulatorMigrationThreshold = []byte{0x16}
 
+	RangeOrderPrefix       = []byte{0x17}
+	RangeOrderByTickPrefix = []byte{0x18}
+	FilledRangeOrderPrefix = []byte{0x19}
+
+	PositionAutoCompoundPrefix        = []byte{0x1A}
+	PositionAutoCompoundByEpochPrefix = []byte{0x1B}
+
+	DynamicSpreadFactorStatePrefix = []byte{0x1C}
+
+	PositionPerformancePrefix = []byte{0x1D}
+
+	KeyFilledRangeOrderCursor = []byte{0x1E}
+
+	AutoCompoundCursorPrefix = []byte{0x1F}
+
+	KeyRangeOrderWithdrawalsInBlock = []byte{0x20}
+
 	// TickPrefix + pool id
 	KeyTickPrefixByPoolIdLengthBytes = len(TickPrefix) + Uint64ByteSize
 	// TickPrefix + pool id + sign byte(negative / positive prefix) + tick index: 18bytes in total
@@ -308,6 +326,80 @@ func GetDenomPrefix(denom string) []byte {
 	return append(KeyTotalLiquidity, []byte(denom)...)
 }
 
+// Range Order Prefix Keys
+
+// KeyRangeOrder returns the key consisted of (RangeOrderPrefix | position id) and is used to store range orders.
+func KeyRangeOrder(positionId uint64) []byte {
+	return append(RangeOrderPrefix, sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// KeyRangeOrdersByTick returns the prefix key consisted of (RangeOrderByTickPrefix | pool id | direction | fill tick).
+// It can be used to iterate over the range orders of the pool filled by a swap in the given direction crossing the tick.
+func KeyRangeOrdersByTick(poolId uint64, zeroForOne bool, fillTick int64) []byte {
+	key := make([]byte, 0, len(RangeOrderByTickPrefix)+Uint64ByteSize+1+len(TickIndexToBytes(fillTick))+Uint64ByteSize)
+	key = append(key, RangeOrderByTickPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	if zeroForOne {
+		key = append(key, 0x01)
+	} else {
+		key = append(key, 0x00)
+	}
+	key = append(key, TickIndexToBytes(fillTick)...)
+	return key
+}
+
+// KeyRangeOrderByTick returns the key consisted of (RangeOrderByTickPrefix | pool id | direction | fill tick | position id),
+// indexing the range order by the tick crossing that fills it.
+func KeyRangeOrderByTick(poolId uint64, zeroForOne bool, fillTick int64, positionId uint64) []byte {
+	return append(KeyRangeOrdersByTick(poolId, zeroForOne, fillTick), sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// KeyFilledRangeOrder returns the key consisted of (FilledRangeOrderPrefix | position id), queueing the filled range order
+// for its withdrawal at the end of the block.
+func KeyFilledRangeOrder(positionId uint64) []byte {
+	return append(FilledRangeOrderPrefix, sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// Position Auto-Compound Prefix Keys
+
+// KeyPositionAutoCompound returns the key consisted of (PositionAutoCompoundPrefix | position id) and is used to store
+// the auto-compounding opt-in of positions.
+func KeyPositionAutoCompound(positionId uint64) []byte {
+	return append(PositionAutoCompoundPrefix, sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// KeyPositionAutoCompoundsByEpoch returns the prefix key consisted of (PositionAutoCompoundByEpochPrefix | length prefixed epoch identifier).
+// It can be used to iterate over the positions compounded at the end of each epoch of the identifier.
+func KeyPositionAutoCompoundsByEpoch(epochIdentifier string) []byte {
+	return append(append([]byte{}, PositionAutoCompoundByEpochPrefix...), address.MustLengthPrefix([]byte(epochIdentifier))...)
+}
+
+// KeyPositionAutoCompoundByEpoch returns the key consisted of (PositionAutoCompoundByEpochPrefix | length prefixed epoch identifier | position id),
+// indexing the auto-compounding opt-in of the position by its epoch identifier.
+func KeyPositionAutoCompoundByEpoch(epochIdentifier string, positionId uint64) []byte {
+	return append(KeyPositionAutoCompoundsByEpoch(epochIdentifier), sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// KeyAutoCompoundCursor returns the key consisted of (AutoCompoundCursorPrefix | length prefixed epoch identifier),
+// storing the key of the next position compounded at the end of an epoch of the identifier.
+func KeyAutoCompoundCursor(epochIdentifier string) []byte {
+	return append(append([]byte{}, AutoCompoundCursorPrefix...), address.MustLengthPrefix([]byte(epochIdentifier))...)
+}
+
+// Dynamic Spread Factor Prefix Keys
+
+// KeyDynamicSpreadFactorState returns the key consisted of (DynamicSpreadFactorStatePrefix | pool id) and is used to store
+// the state of the pools in the dynamic spread factor mode.
+func KeyDynamicSpreadFactorState(poolId uint64) []byte {
+	return append(DynamicSpreadFactorStatePrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyPositionPerformance returns the key consisted of (PositionPerformancePrefix | position id) and is used to store
+// the lifetime performance records of the positions.
+func KeyPositionPerformance(positionId uint64) []byte {
+	return append(PositionPerformancePrefix, sdk.Uint64ToBigEndian(positionId)...)
+}
+
 // CL Hook Keys
 
 // GetPoolPrefixStore returns a unique key for each combination of poolID and prefix
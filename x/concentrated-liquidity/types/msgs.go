@@ -19,6 +19,8 @@ const (
 	TypeMsgCollectIncentives       = "collect-incentives"
 	TypeMsgFungifyChargedPositions = "fungify-charged-positions"
 	TypeMsgTransferPositions       = "transfer-positions"
+	TypeMsgRebalancePosition       = "rebalance-position"
+	TypeMsgSetPositionAutoCompound = "set-position-auto-compound"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -239,3 +241,88 @@ func (msg MsgTransferPositions) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgRebalancePosition{}
+
+func (msg MsgRebalancePosition) Route() string { return RouterKey }
+func (msg MsgRebalancePosition) Type() string  { return TypeMsgRebalancePosition }
+func (msg MsgRebalancePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId <= 0 {
+		return fmt.Errorf("Invalid position id (%s)", strconv.FormatUint(msg.PositionId, 10))
+	}
+
+	if msg.NewLowerTick >= msg.NewUpperTick {
+		return InvalidLowerUpperTickError{LowerTick: msg.NewLowerTick, UpperTick: msg.NewUpperTick}
+	}
+
+	if !msg.TokenSwappedIn.Amount.IsNil() && !msg.TokenSwappedIn.IsZero() && !msg.TokenSwappedIn.IsValid() {
+		return fmt.Errorf("Invalid token swapped in (%s)", msg.TokenSwappedIn.String())
+	}
+
+	// The swap of the token swapped in must be protected by a positive minimum amount out.
+	swap := !msg.TokenSwappedIn.Amount.IsNil() && msg.TokenSwappedIn.Amount.IsPositive()
+	if swap && (msg.TokenOutMinAmount.IsNil() || !msg.TokenOutMinAmount.IsPositive()) {
+		return NotPositiveRequireAmountError{Amount: msg.TokenOutMinAmount.String()}
+	}
+
+	if !msg.TokenOutMinAmount.IsNil() && msg.TokenOutMinAmount.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenOutMinAmount.String()}
+	}
+
+	if !msg.TokenMinAmount0.IsNil() && msg.TokenMinAmount0.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount0.String()}
+	}
+
+	if !msg.TokenMinAmount1.IsNil() && msg.TokenMinAmount1.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount1.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgRebalancePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPositionAutoCompound{}
+
+func (msg MsgSetPositionAutoCompound) Route() string { return RouterKey }
+func (msg MsgSetPositionAutoCompound) Type() string  { return TypeMsgSetPositionAutoCompound }
+func (msg MsgSetPositionAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId <= 0 {
+		return fmt.Errorf("Invalid position id (%s)", strconv.FormatUint(msg.PositionId, 10))
+	}
+
+	// The max slippage is ignored when opting out of auto-compounding.
+	if msg.EpochIdentifier == "" {
+		return nil
+	}
+
+	if msg.MaxSlippage.IsNil() || msg.MaxSlippage.IsNegative() || msg.MaxSlippage.GTE(osmomath.OneDec()) {
+		return InvalidMaxSlippageError{MaxSlippage: msg.MaxSlippage}
+	}
+
+	return nil
+}
+
+func (msg MsgSetPositionAutoCompound) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -367,6 +367,28 @@ func TestConcentratedLiquiditySerialization(t *testing.T) {
 				PositionIds: []uint64{1, 2},
 			},
 		},
+		{
+			name: "MsgRebalancePosition",
+			clMsg: &types.MsgRebalancePosition{
+				PositionId:        1,
+				Sender:            addr1,
+				NewLowerTick:      int64(10000),
+				NewUpperTick:      int64(20000),
+				TokenSwappedIn:    sdk.NewCoin("foo", osmomath.NewInt(100)),
+				TokenOutMinAmount: osmomath.OneInt(),
+				TokenMinAmount0:   osmomath.OneInt(),
+				TokenMinAmount1:   osmomath.OneInt(),
+			},
+		},
+		{
+			name: "MsgSetPositionAutoCompound",
+			clMsg: &types.MsgSetPositionAutoCompound{
+				PositionId:      1,
+				Sender:          addr1,
+				EpochIdentifier: "day",
+				MaxSlippage:     osmomath.NewDecWithPrec(1, 2),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -439,3 +461,198 @@ func TestMsgTransferPositions(t *testing.T) {
 		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgTransferPositions)
 	}
 }
+
+func TestMsgRebalancePosition(t *testing.T) {
+	baseMsg := types.MsgRebalancePosition{
+		PositionId:        1,
+		Sender:            addr1,
+		NewLowerTick:      -100,
+		NewUpperTick:      100,
+		TokenSwappedIn:    sdk.NewCoin("foo", osmomath.NewInt(100)),
+		TokenOutMinAmount: osmomath.OneInt(),
+		TokenMinAmount0:   osmomath.OneInt(),
+		TokenMinAmount1:   osmomath.OneInt(),
+	}
+
+	tests := []struct {
+		name       string
+		msgFn      func() types.MsgRebalancePosition
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msgFn:      func() types.MsgRebalancePosition { return baseMsg },
+			expectPass: true,
+		},
+		{
+			name: "proper msg: no swap",
+			msgFn: func() types.MsgRebalancePosition {
+				copy := baseMsg
+				copy.TokenSwappedIn = sdk.Coin{}
+				copy.TokenOutMinAmount = osmomath.Int{}
+				return copy
+			},
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msgFn:      func() types.MsgRebalancePosition { copy := baseMsg; copy.Sender = invalidAddr.String(); return copy },
+			expectPass: false,
+		},
+		{
+			name:       "position id zero",
+			msgFn:      func() types.MsgRebalancePosition { copy := baseMsg; copy.PositionId = 0; return copy },
+			expectPass: false,
+		},
+		{
+			name:       "new lower tick equal to new upper tick",
+			msgFn:      func() types.MsgRebalancePosition { copy := baseMsg; copy.NewLowerTick = copy.NewUpperTick; return copy },
+			expectPass: false,
+		},
+		{
+			name: "invalid token swapped in denom",
+			msgFn: func() types.MsgRebalancePosition {
+				copy := baseMsg
+				copy.TokenSwappedIn = sdk.Coin{Denom: "1foo", Amount: osmomath.OneInt()}
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name: "token out min amount is negative",
+			msgFn: func() types.MsgRebalancePosition {
+				copy := baseMsg
+				copy.TokenOutMinAmount = osmomath.OneInt().Neg()
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name: "token out min amount is zero with a swap",
+			msgFn: func() types.MsgRebalancePosition {
+				copy := baseMsg
+				copy.TokenOutMinAmount = osmomath.ZeroInt()
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name: "token out min amount is nil with a swap",
+			msgFn: func() types.MsgRebalancePosition {
+				copy := baseMsg
+				copy.TokenOutMinAmount = osmomath.Int{}
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name: "token min amount0 is negative",
+			msgFn: func() types.MsgRebalancePosition {
+				copy := baseMsg
+				copy.TokenMinAmount0 = osmomath.OneInt().Neg()
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name: "token min amount1 is negative",
+			msgFn: func() types.MsgRebalancePosition {
+				copy := baseMsg
+				copy.TokenMinAmount1 = osmomath.OneInt().Neg()
+				return copy
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msgFn()
+		runValidateBasicTest(t, test.name, &msg, test.expectPass, types.TypeMsgRebalancePosition)
+	}
+}
+
+func TestMsgSetPositionAutoCompound(t *testing.T) {
+	baseMsg := types.MsgSetPositionAutoCompound{
+		PositionId:      1,
+		Sender:          addr1,
+		EpochIdentifier: "day",
+		MaxSlippage:     osmomath.NewDecWithPrec(1, 2),
+	}
+
+	tests := []struct {
+		name       string
+		msgFn      func() types.MsgSetPositionAutoCompound
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msgFn:      func() types.MsgSetPositionAutoCompound { return baseMsg },
+			expectPass: true,
+		},
+		{
+			name: "proper msg: zero max slippage",
+			msgFn: func() types.MsgSetPositionAutoCompound {
+				copy := baseMsg
+				copy.MaxSlippage = osmomath.ZeroDec()
+				return copy
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper msg: opt out ignores the max slippage",
+			msgFn: func() types.MsgSetPositionAutoCompound {
+				copy := baseMsg
+				copy.EpochIdentifier = ""
+				copy.MaxSlippage = osmomath.Dec{}
+				return copy
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msgFn: func() types.MsgSetPositionAutoCompound {
+				copy := baseMsg
+				copy.Sender = invalidAddr.String()
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name:       "position id zero",
+			msgFn:      func() types.MsgSetPositionAutoCompound { copy := baseMsg; copy.PositionId = 0; return copy },
+			expectPass: false,
+		},
+		{
+			name: "max slippage is nil",
+			msgFn: func() types.MsgSetPositionAutoCompound {
+				copy := baseMsg
+				copy.MaxSlippage = osmomath.Dec{}
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name: "max slippage is negative",
+			msgFn: func() types.MsgSetPositionAutoCompound {
+				copy := baseMsg
+				copy.MaxSlippage = osmomath.NewDecWithPrec(-1, 2)
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name: "max slippage is one",
+			msgFn: func() types.MsgSetPositionAutoCompound {
+				copy := baseMsg
+				copy.MaxSlippage = osmomath.OneDec()
+				return copy
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msgFn()
+		runValidateBasicTest(t, test.name, &msg, test.expectPass, types.TypeMsgSetPositionAutoCompound)
+	}
+}
@@ -20,6 +20,9 @@ var (
 	KeyIsPermisionlessPoolCreationEnabled = []byte("IsPermisionlessPoolCreationEnabled")
 	KeyUnrestrictedPoolCreatorWhitelist   = []byte("UnrestrictedPoolCreatorWhitelist")
 	KeyHookGasLimit                       = []byte("HookGasLimit")
+	KeyMaxRangeOrderFillsPerBlock         = []byte("MaxRangeOrderFillsPerBlock")
+	KeyMaxAutoCompoundsPerEpoch           = []byte("MaxAutoCompoundsPerEpoch")
+	KeyDynamicSpreadFactors               = []byte("DynamicSpreadFactors")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -29,7 +32,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomath.Dec, discountRate osmomath.Dec, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, unrestrictedPoolCreatorWhitelist []string, hookGasLimit uint64) Params {
+func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomath.Dec, discountRate osmomath.Dec, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, unrestrictedPoolCreatorWhitelist []string, hookGasLimit uint64, maxRangeOrderFillsPerBlock uint64, maxAutoCompoundsPerEpoch uint64, dynamicSpreadFactors []DynamicSpreadFactor) Params {
 	return Params{
 		AuthorizedTickSpacing:               authorizedTickSpacing,
 		AuthorizedSpreadFactors:             authorizedSpreadFactors,
@@ -38,6 +41,9 @@ func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomat
 		IsPermissionlessPoolCreationEnabled: isPermissionlessPoolCreationEnabled,
 		UnrestrictedPoolCreatorWhitelist:    unrestrictedPoolCreatorWhitelist,
 		HookGasLimit:                        hookGasLimit,
+		MaxRangeOrderFillsPerBlock:          maxRangeOrderFillsPerBlock,
+		MaxAutoCompoundsPerEpoch:            maxAutoCompoundsPerEpoch,
+		DynamicSpreadFactors:                dynamicSpreadFactors,
 	}
 }
 
@@ -51,6 +57,9 @@ func DefaultParams() Params {
 		IsPermissionlessPoolCreationEnabled: false,
 		UnrestrictedPoolCreatorWhitelist:    DefaultUnrestrictedPoolCreatorWhitelist,
 		HookGasLimit:                        DefaultContractHookGasLimit,
+		MaxRangeOrderFillsPerBlock:          DefaultMaxRangeOrderFillsPerBlock,
+		MaxAutoCompoundsPerEpoch:            DefaultMaxAutoCompoundsPerEpoch,
+		DynamicSpreadFactors:                []DynamicSpreadFactor{},
 	}
 }
 
@@ -77,6 +86,15 @@ func (p Params) Validate() error {
 	if err := validateHookGasLimit(p.HookGasLimit); err != nil {
 		return err
 	}
+	if err := validateMaxRangeOrderFillsPerBlock(p.MaxRangeOrderFillsPerBlock); err != nil {
+		return err
+	}
+	if err := validateMaxAutoCompoundsPerEpoch(p.MaxAutoCompoundsPerEpoch); err != nil {
+		return err
+	}
+	if err := validateDynamicSpreadFactors(p.DynamicSpreadFactors); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -90,6 +108,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyAuthorizedUptimes, &p.AuthorizedUptimes, validateAuthorizedUptimes),
 		paramtypes.NewParamSetPair(KeyUnrestrictedPoolCreatorWhitelist, &p.UnrestrictedPoolCreatorWhitelist, osmoutils.ValidateAddressList),
 		paramtypes.NewParamSetPair(KeyHookGasLimit, &p.HookGasLimit, validateHookGasLimit),
+		paramtypes.NewParamSetPair(KeyMaxRangeOrderFillsPerBlock, &p.MaxRangeOrderFillsPerBlock, validateMaxRangeOrderFillsPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxAutoCompoundsPerEpoch, &p.MaxAutoCompoundsPerEpoch, validateMaxAutoCompoundsPerEpoch),
+		paramtypes.NewParamSetPair(KeyDynamicSpreadFactors, &p.DynamicSpreadFactors, validateDynamicSpreadFactors),
 	}
 }
 
@@ -223,3 +244,63 @@ func validateHookGasLimit(i interface{}) error {
 
 	return nil
 }
+
+// validateMaxRangeOrderFillsPerBlock validates that the maximum number of range order fills per block is of type uint64.
+// Zero pauses the withdrawal of filled range orders.
+func validateMaxRangeOrderFillsPerBlock(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for max range order fills per block: %T", i)
+	}
+
+	return nil
+}
+
+// validateMaxAutoCompoundsPerEpoch validates that the maximum number of auto-compounded positions per epoch is of type uint64.
+// Zero pauses auto-compounding.
+func validateMaxAutoCompoundsPerEpoch(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for max auto compounds per epoch: %T", i)
+	}
+
+	return nil
+}
+
+// validateDynamicSpreadFactors validates the dynamic spread factor modes of pools.
+// Returns an error if a pool id is zero or duplicated, if the spread factor bounds are not
+// in [0, 1) with the min not greater than the max, if the max volatility is not positive or
+// if the volatility decay is not in [0, 1).
+func validateDynamicSpreadFactors(i interface{}) error {
+	dynamicSpreadFactors, ok := i.([]DynamicSpreadFactor)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for dynamic spread factors: %T", i)
+	}
+
+	seenPoolIds := make(map[uint64]struct{}, len(dynamicSpreadFactors))
+	for _, dynamicSpreadFactor := range dynamicSpreadFactors {
+		if dynamicSpreadFactor.PoolId == 0 {
+			return fmt.Errorf("dynamic spread factor pool id cannot be 0")
+		}
+		if _, ok := seenPoolIds[dynamicSpreadFactor.PoolId]; ok {
+			return fmt.Errorf("duplicate dynamic spread factor for pool %d", dynamicSpreadFactor.PoolId)
+		}
+		seenPoolIds[dynamicSpreadFactor.PoolId] = struct{}{}
+
+		if err := dynamicSpreadFactor.Validate(); err != nil {
+			return fmt.Errorf("invalid dynamic spread factor for pool %d: %w", dynamicSpreadFactor.PoolId, err)
+		}
+	}
+
+	return nil
+}
+
+// GetDynamicSpreadFactor returns the dynamic spread factor mode of the pool, and whether the pool is in it.
+func (p Params) GetDynamicSpreadFactor(poolId uint64) (DynamicSpreadFactor, bool) {
+	for _, dynamicSpreadFactor := range p.DynamicSpreadFactors {
+		if dynamicSpreadFactor.PoolId == poolId {
+			return dynamicSpreadFactor, true
+		}
+	}
+	return DynamicSpreadFactor{}, false
+}
@@ -64,6 +64,20 @@ type Params struct {
 	// double creation of pools, etc.
 	UnrestrictedPoolCreatorWhitelist []string `protobuf:"bytes,7,rep,name=unrestricted_pool_creator_whitelist,json=unrestrictedPoolCreatorWhitelist,proto3" json:"unrestricted_pool_creator_whitelist,omitempty" yaml:"unrestricted_pool_creator_whitelist"`
 	HookGasLimit                     uint64   `protobuf:"varint,8,opt,name=hook_gas_limit,json=hookGasLimit,proto3" json:"hook_gas_limit,omitempty" yaml:"hook_gas_limit"`
+	// max_range_order_fills_per_block is the maximum number of filled range
+	// orders withdrawn in each block, by the swaps filling them and then at the
+	// end of the block. The rest are withdrawn in the next blocks. Zero pauses
+	// the withdrawals.
+	MaxRangeOrderFillsPerBlock uint64 `protobuf:"varint,9,opt,name=max_range_order_fills_per_block,json=maxRangeOrderFillsPerBlock,proto3" json:"max_range_order_fills_per_block,omitempty" yaml:"max_range_order_fills_per_block"`
+	// max_auto_compounds_per_epoch is the maximum number of positions whose
+	// rewards are compounded at the end of each epoch of an identifier. The
+	// next epoch of the identifier resumes with the following positions. Zero
+	// pauses auto-compounding.
+	MaxAutoCompoundsPerEpoch uint64 `protobuf:"varint,10,opt,name=max_auto_compounds_per_epoch,json=maxAutoCompoundsPerEpoch,proto3" json:"max_auto_compounds_per_epoch,omitempty" yaml:"max_auto_compounds_per_epoch"`
+	// dynamic_spread_factors are the pools whose spread factor is recomputed at
+	// the end of each block from the recent movement of their current tick,
+	// instead of being fixed at creation.
+	DynamicSpreadFactors []DynamicSpreadFactor `protobuf:"bytes,11,rep,name=dynamic_spread_factors,json=dynamicSpreadFactors,proto3" json:"dynamic_spread_factors" yaml:"dynamic_spread_factors"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -142,8 +156,89 @@ func (m *Params) GetHookGasLimit() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxRangeOrderFillsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRangeOrderFillsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoCompoundsPerEpoch() uint64 {
+	if m != nil {
+		return m.MaxAutoCompoundsPerEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetDynamicSpreadFactors() []DynamicSpreadFactor {
+	if m != nil {
+		return m.DynamicSpreadFactors
+	}
+	return nil
+}
+
+// DynamicSpreadFactor is the dynamic spread factor mode of a pool. The spread
+// factor of the pool goes from min_spread_factor, when its price does not
+// move, to max_spread_factor, when its volatility reaches
+// max_volatility.
+type DynamicSpreadFactor struct {
+	PoolId          uint64                      `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	MinSpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_spread_factor,json=minSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_spread_factor" yaml:"min_spread_factor"`
+	MaxSpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_spread_factor,json=maxSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_spread_factor" yaml:"max_spread_factor"`
+	// max_volatility is the volatility, in basis points of price movement per
+	// block, at and above which the spread factor of the pool is
+	// max_spread_factor.
+	MaxVolatility cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_volatility,json=maxVolatility,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_volatility" yaml:"max_volatility"`
+	// volatility_decay is the weight of the previous volatility of the pool in
+	// its new volatility at the end of each block, the rest being the weight of
+	// the price movement of the block. It ranges from [0,1), higher values
+	// smoothing the volatility over more blocks.
+	VolatilityDecay cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=volatility_decay,json=volatilityDecay,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volatility_decay" yaml:"volatility_decay"`
+}
+
+func (m *DynamicSpreadFactor) Reset()         { *m = DynamicSpreadFactor{} }
+func (m *DynamicSpreadFactor) String() string { return proto.CompactTextString(m) }
+func (*DynamicSpreadFactor) ProtoMessage()    {}
+func (*DynamicSpreadFactor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42a3f6981164624c, []int{1}
+}
+func (m *DynamicSpreadFactor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSpreadFactor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactor.Merge(m, src)
+}
+func (m *DynamicSpreadFactor) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactor) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSpreadFactor proto.InternalMessageInfo
+
+func (m *DynamicSpreadFactor) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.concentratedliquidity.Params")
+	proto.RegisterType((*DynamicSpreadFactor)(nil), "osmosis.concentratedliquidity.DynamicSpreadFactor")
 }
 
 func init() {
@@ -151,48 +246,66 @@ func init() {
 }
 
 var fileDescriptor_42a3f6981164624c = []byte{
-	// 651 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x37, 0x6e, 0xed, 0x8f, 0x28, 0x82, 0xc1, 0x62, 0xb6, 0x6a, 0x12, 0x52, 0xd0, 0xa5,
-	0xb4, 0x89, 0xb6, 0xe0, 0xa1, 0x1e, 0x84, 0xb8, 0xda, 0x4b, 0x85, 0x9a, 0x2a, 0x42, 0x11, 0x86,
-	0xd9, 0xc9, 0x34, 0x3b, 0x6c, 0x92, 0x97, 0xce, 0x4c, 0xac, 0x2b, 0x78, 0x12, 0xc1, 0xa3, 0x07,
-	0x0f, 0xfe, 0x41, 0x1e, 0x7a, 0xec, 0x51, 0x3c, 0x44, 0x69, 0x6f, 0x1e, 0xf3, 0x17, 0xc8, 0x26,
-	0xbb, 0x36, 0x4b, 0x15, 0xf7, 0x96, 0x99, 0xef, 0xe7, 0xfd, 0x98, 0x97, 0x2f, 0x4f, 0x5d, 0x01,
-	0x11, 0x83, 0x60, 0xc2, 0x25, 0x90, 0x10, 0x9a, 0x48, 0x8e, 0x25, 0x0d, 0x22, 0x76, 0x90, 0xb1,
-	0x80, 0xc9, 0x81, 0x9b, 0x62, 0x8e, 0x63, 0xe1, 0xa4, 0x1c, 0x24, 0x68, 0xb7, 0x46, 0xac, 0xf3,
-	0x57, 0x76, 0xe9, 0x5a, 0x08, 0x21, 0x94, 0xa4, 0x3b, 0xfc, 0xaa, 0x82, 0x96, 0x8c, 0x10, 0x20,
-	0x8c, 0xa8, 0x5b, 0x9e, 0xba, 0xd9, 0xbe, 0x1b, 0x64, 0x1c, 0x4b, 0x06, 0x49, 0xa5, 0xdb, 0x5f,
-	0xe7, 0xd4, 0xd9, 0x9d, 0xb2, 0x8a, 0xb6, 0xa7, 0x5e, 0xc7, 0x99, 0xec, 0x01, 0x67, 0x6f, 0x69,
-	0x80, 0x24, 0x23, 0x7d, 0x24, 0x52, 0x4c, 0x58, 0x12, 0xea, 0x8a, 0xd5, 0x6c, 0xcf, 0x78, 0x76,
-	0x91, 0x9b, 0xc6, 0x00, 0xc7, 0xd1, 0xa6, 0xfd, 0x0f, 0xd0, 0xf6, 0x17, 0xcf, 0x94, 0xe7, 0x8c,
-	0xf4, 0x77, 0xab, 0x7b, 0xed, 0xbd, 0xa2, 0xb6, 0x6a, 0x31, 0x22, 0xe5, 0x14, 0x07, 0x68, 0x1f,
-	0x13, 0x09, 0x5c, 0xe8, 0x17, 0xac, 0x66, 0x7b, 0xc1, 0xdb, 0x3a, 0xca, 0xcd, 0xc6, 0xf7, 0xdc,
-	0xbc, 0x41, 0xca, 0x87, 0x8a, 0xa0, 0xef, 0x30, 0x70, 0x63, 0x2c, 0x7b, 0xce, 0x36, 0x0d, 0x31,
-	0x19, 0x74, 0x28, 0x29, 0x72, 0xd3, 0x3a, 0xd7, 0xc1, 0x64, 0x36, 0xdb, 0xaf, 0x3d, 0x63, 0xb7,
-	0x94, 0x9e, 0x54, 0x8a, 0xf6, 0x59, 0x51, 0xcd, 0x2e, 0x8e, 0x70, 0x42, 0x28, 0x47, 0xa2, 0x87,
-	0x39, 0x15, 0x88, 0xd3, 0x43, 0xcc, 0x03, 0x14, 0x30, 0x41, 0x20, 0x4b, 0xa4, 0xde, 0xb4, 0x94,
-	0xf6, 0x82, 0xf7, 0x74, 0xba, 0x5e, 0x6e, 0x57, 0xbd, 0xfc, 0x27, 0xa7, 0xed, 0xdf, 0x1c, 0x13,
-	0xbb, 0x25, 0xe0, 0x97, 0x7a, 0x67, 0x24, 0x6b, 0xc9, 0xc4, 0xe0, 0x0f, 0x32, 0x90, 0x14, 0x05,
-	0x34, 0x81, 0x58, 0xe8, 0x33, 0xe5, 0x64, 0xee, 0x17, 0xb9, 0x79, 0xf7, 0xdc, 0xb3, 0xeb, 0xa0,
-	0xbd, 0x1a, 0xd0, 0x94, 0x53, 0x32, 0xb4, 0xc4, 0xa6, 0x2d, 0x79, 0x46, 0x6d, 0x5d, 0xa9, 0xff,
-	0x8c, 0x67, 0x43, 0xb8, 0x53, 0xb2, 0xda, 0x07, 0x45, 0xd5, 0x6a, 0x79, 0xb2, 0x54, 0xb2, 0x98,
-	0x0a, 0xfd, 0xa2, 0xd5, 0x6c, 0x5f, 0x5a, 0x6f, 0x39, 0x95, 0x63, 0x9c, 0xb1, 0x63, 0x9c, 0xce,
-	0xc8, 0x31, 0xde, 0x83, 0xe1, 0x50, 0x7e, 0xe5, 0xa6, 0x36, 0xf6, 0xd0, 0x2a, 0xc4, 0x4c, 0xd2,
-	0x38, 0x95, 0x83, 0x22, 0x37, 0x5b, 0xe7, 0x1a, 0x1c, 0x25, 0xb6, 0xbf, 0xfc, 0x30, 0x15, 0xff,
-	0xea, 0x99, 0xf0, 0xa2, 0xba, 0xd7, 0x3e, 0x2a, 0xea, 0x1d, 0x26, 0x50, 0x4a, 0x79, 0xcc, 0x84,
-	0x60, 0x90, 0x44, 0x54, 0x08, 0x94, 0x02, 0x44, 0x88, 0x70, 0x5a, 0x56, 0x40, 0x34, 0xc1, 0xdd,
-	0x88, 0x06, 0xfa, 0xac, 0xa5, 0xb4, 0xe7, 0xbd, 0xf5, 0x22, 0x37, 0x9d, 0xaa, 0xce, 0x94, 0x81,
-	0xb6, 0xbf, 0xcc, 0xc4, 0xce, 0x04, 0xb8, 0x03, 0x10, 0x3d, 0x1a, 0x61, 0x8f, 0x2b, 0x4a, 0x7b,
-	0xa7, 0x2e, 0x67, 0x09, 0xa7, 0x42, 0x72, 0x46, 0x24, 0x0d, 0x6a, 0xb9, 0x80, 0xa3, 0xc3, 0x1e,
-	0x93, 0x34, 0x62, 0x42, 0xea, 0x73, 0xe5, 0xef, 0x70, 0x8a, 0xdc, 0x5c, 0xa9, 0xba, 0x98, 0x22,
-	0xc8, 0xf6, 0xad, 0x3a, 0xf5, 0xa7, 0x3a, 0xf0, 0x97, 0x63, 0x44, 0x7b, 0xa8, 0x5e, 0xe9, 0x01,
-	0xf4, 0x51, 0x88, 0x05, 0x8a, 0x58, 0xcc, 0xa4, 0x3e, 0x6f, 0x29, 0xed, 0x19, 0xaf, 0x55, 0xe4,
-	0xe6, 0x62, 0x55, 0x69, 0x52, 0xb7, 0xfd, 0xcb, 0xc3, 0x8b, 0x2d, 0x2c, 0xb6, 0x87, 0x47, 0xef,
-	0xd5, 0xd1, 0x89, 0xa1, 0x1c, 0x9f, 0x18, 0xca, 0xcf, 0x13, 0x43, 0xf9, 0x74, 0x6a, 0x34, 0x8e,
-	0x4f, 0x8d, 0xc6, 0xb7, 0x53, 0xa3, 0xb1, 0xe7, 0x85, 0x4c, 0xf6, 0xb2, 0xae, 0x43, 0x20, 0x76,
-	0x47, 0x0b, 0x64, 0x2d, 0xc2, 0x5d, 0x31, 0x3e, 0xb8, 0xaf, 0x37, 0xee, 0xb9, 0x6f, 0x26, 0xf6,
-	0xcf, 0xda, 0xd9, 0x02, 0x92, 0x83, 0x94, 0x8a, 0xee, 0x6c, 0xe9, 0x85, 0x8d, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xd0, 0x1a, 0x23, 0x40, 0xae, 0x04, 0x00, 0x00,
+	// 935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x8f, 0x1b, 0x35,
+	0x1c, 0xdd, 0x69, 0xb6, 0xdb, 0xd6, 0x85, 0x2d, 0x1d, 0xba, 0x74, 0x76, 0x69, 0x33, 0x91, 0x57,
+	0xd0, 0x68, 0x69, 0x27, 0xb0, 0x95, 0x38, 0x14, 0x44, 0xc5, 0x34, 0x6d, 0x85, 0x54, 0xc4, 0x32,
+	0xcb, 0x1f, 0xa9, 0x42, 0xb2, 0x1c, 0xdb, 0x3b, 0xb1, 0x32, 0x1e, 0x4f, 0x6d, 0x4f, 0x9b, 0x20,
+	0x71, 0x42, 0x48, 0xdc, 0xe0, 0xc0, 0x81, 0x8f, 0xc1, 0xc7, 0xe8, 0xb1, 0x47, 0xc4, 0x61, 0x40,
+	0xbb, 0x37, 0xc4, 0x69, 0x3e, 0x01, 0x1a, 0x4f, 0xd2, 0x24, 0x9b, 0x2d, 0x8d, 0xb8, 0x8d, 0xfd,
+	0xde, 0xef, 0xbd, 0x9f, 0x3d, 0x4f, 0x3f, 0x83, 0x1d, 0xa9, 0x85, 0xd4, 0x5c, 0x77, 0x88, 0x4c,
+	0x09, 0x4b, 0x8d, 0xc2, 0x86, 0xd1, 0x84, 0x3f, 0xca, 0x39, 0xe5, 0x66, 0xd4, 0xc9, 0xb0, 0xc2,
+	0x42, 0x07, 0x99, 0x92, 0x46, 0xba, 0x57, 0xc7, 0xdc, 0xe0, 0x44, 0xee, 0xd6, 0xa5, 0x58, 0xc6,
+	0xd2, 0x32, 0x3b, 0xd5, 0x57, 0x5d, 0xb4, 0xd5, 0x8c, 0xa5, 0x8c, 0x13, 0xd6, 0xb1, 0xab, 0x5e,
+	0x7e, 0xd0, 0xa1, 0xb9, 0xc2, 0x86, 0xcb, 0xb4, 0xc6, 0xe1, 0x6f, 0x00, 0xac, 0xed, 0x59, 0x17,
+	0xf7, 0x21, 0xb8, 0x8c, 0x73, 0xd3, 0x97, 0x8a, 0x7f, 0xcb, 0x28, 0x32, 0x9c, 0x0c, 0x90, 0xce,
+	0x30, 0xe1, 0x69, 0xec, 0x39, 0xad, 0x46, 0x7b, 0x35, 0x84, 0x65, 0xe1, 0x37, 0x47, 0x58, 0x24,
+	0xb7, 0xe0, 0x0b, 0x88, 0x30, 0xda, 0x98, 0x22, 0x5f, 0x70, 0x32, 0xd8, 0xaf, 0xf7, 0xdd, 0xef,
+	0x1d, 0xb0, 0x39, 0x53, 0xa3, 0x33, 0xc5, 0x30, 0x45, 0x07, 0x98, 0x18, 0xa9, 0xb4, 0x77, 0xaa,
+	0xd5, 0x68, 0x9f, 0x0b, 0xef, 0x3f, 0x2d, 0xfc, 0x95, 0x3f, 0x0a, 0xff, 0x4d, 0x62, 0x0f, 0xaa,
+	0xe9, 0x20, 0xe0, 0xb2, 0x23, 0xb0, 0xe9, 0x07, 0x0f, 0x58, 0x8c, 0xc9, 0xa8, 0xcb, 0x48, 0x59,
+	0xf8, 0xad, 0x85, 0x0e, 0xe6, 0xd5, 0x60, 0x34, 0x73, 0x8c, 0x7d, 0x0b, 0xdd, 0xab, 0x11, 0xf7,
+	0x17, 0x07, 0xf8, 0x3d, 0x9c, 0xe0, 0x94, 0x30, 0x85, 0x74, 0x1f, 0x2b, 0xa6, 0x91, 0x62, 0x4f,
+	0xb0, 0xa2, 0x88, 0x72, 0x4d, 0x64, 0x9e, 0x1a, 0xaf, 0xd1, 0x72, 0xda, 0xe7, 0xc2, 0x4f, 0x97,
+	0xeb, 0xe5, 0xed, 0xba, 0x97, 0x97, 0x68, 0xc2, 0xe8, 0xca, 0x84, 0xb1, 0x6f, 0x09, 0x91, 0xc5,
+	0xbb, 0x63, 0xd8, 0x4d, 0xe7, 0x2e, 0xfe, 0x51, 0x2e, 0x0d, 0x43, 0x94, 0xa5, 0x52, 0x68, 0x6f,
+	0xd5, 0xde, 0xcc, 0xfb, 0x65, 0xe1, 0xbf, 0xbb, 0x70, 0xec, 0x59, 0x22, 0xbc, 0x4e, 0x59, 0xa6,
+	0x18, 0xa9, 0x22, 0x71, 0x0b, 0x1a, 0x95, 0x33, 0xe8, 0x39, 0xb3, 0x3f, 0xe3, 0xf3, 0x8a, 0xdc,
+	0xb5, 0x5c, 0xf7, 0x07, 0x07, 0xb8, 0x33, 0x3a, 0x79, 0x66, 0xb8, 0x60, 0xda, 0x3b, 0xdd, 0x6a,
+	0xb4, 0xcf, 0xef, 0x6e, 0x06, 0x75, 0x62, 0x82, 0x49, 0x62, 0x82, 0xee, 0x38, 0x31, 0xe1, 0x07,
+	0xd5, 0xa5, 0xfc, 0x5d, 0xf8, 0xee, 0x24, 0x43, 0xd7, 0xa5, 0xe0, 0x86, 0x89, 0xcc, 0x8c, 0xca,
+	0xc2, 0xdf, 0x5c, 0x68, 0x70, 0x2c, 0x0c, 0x7f, 0xfd, 0xd3, 0x77, 0xa2, 0x8b, 0x53, 0xe0, 0xcb,
+	0x7a, 0xdf, 0xfd, 0xd1, 0x01, 0xd7, 0xb8, 0x46, 0x19, 0x53, 0x82, 0x6b, 0xcd, 0x65, 0x9a, 0x30,
+	0xad, 0x51, 0x26, 0x65, 0x82, 0x88, 0x62, 0xd6, 0x01, 0xb1, 0x14, 0xf7, 0x12, 0x46, 0xbd, 0xb5,
+	0x96, 0xd3, 0x3e, 0x1b, 0xee, 0x96, 0x85, 0x1f, 0xd4, 0x3e, 0x4b, 0x16, 0xc2, 0x68, 0x9b, 0xeb,
+	0xbd, 0x39, 0xe2, 0x9e, 0x94, 0xc9, 0x9d, 0x31, 0xed, 0x6e, 0xcd, 0x72, 0xbf, 0x03, 0xdb, 0x79,
+	0xaa, 0x98, 0x36, 0x8a, 0x13, 0xc3, 0xe8, 0x8c, 0x96, 0x54, 0xe8, 0x49, 0x9f, 0x1b, 0x96, 0x70,
+	0x6d, 0xbc, 0x33, 0xf6, 0x77, 0x04, 0x65, 0xe1, 0xef, 0xd4, 0x5d, 0x2c, 0x51, 0x04, 0xa3, 0xd6,
+	0x2c, 0xeb, 0xb9, 0xbb, 0x54, 0x5f, 0x4f, 0x28, 0xee, 0x6d, 0xb0, 0xde, 0x97, 0x72, 0x80, 0x62,
+	0xac, 0x51, 0xc2, 0x05, 0x37, 0xde, 0xd9, 0x96, 0xd3, 0x5e, 0x0d, 0x37, 0xcb, 0xc2, 0xdf, 0xa8,
+	0x9d, 0xe6, 0x71, 0x18, 0xbd, 0x52, 0x6d, 0xdc, 0xc7, 0xfa, 0x41, 0xb5, 0x74, 0x25, 0xf0, 0x05,
+	0x1e, 0x22, 0x85, 0xd3, 0x98, 0x21, 0xa9, 0x28, 0x53, 0xe8, 0x80, 0x27, 0x89, 0xbd, 0x23, 0xd4,
+	0x4b, 0x24, 0x19, 0x78, 0xe7, 0xac, 0xe2, 0xce, 0x34, 0xb5, 0x2f, 0x29, 0x80, 0xd1, 0x96, 0xc0,
+	0xc3, 0xa8, 0x22, 0x7c, 0x56, 0xe1, 0xf7, 0x2a, 0x78, 0x8f, 0xa9, 0xb0, 0x02, 0xdd, 0x18, 0x5c,
+	0xa9, 0xea, 0x71, 0x6e, 0x24, 0x22, 0x52, 0x64, 0x32, 0x4f, 0x69, 0x5d, 0xcc, 0x32, 0x49, 0xfa,
+	0x1e, 0xb0, 0x6e, 0xd7, 0xca, 0xc2, 0xdf, 0x9e, 0xba, 0xbd, 0x88, 0x0d, 0x23, 0x4f, 0xe0, 0xe1,
+	0xc7, 0xb9, 0x91, 0x77, 0x26, 0xe0, 0x1e, 0x53, 0x77, 0x2b, 0xc8, 0xfd, 0xc9, 0x01, 0x6f, 0xd0,
+	0x51, 0x8a, 0x05, 0x27, 0xc7, 0xc7, 0xc6, 0x79, 0x1b, 0xd8, 0xdd, 0xe0, 0x3f, 0xe7, 0x62, 0xd0,
+	0xad, 0x8b, 0x67, 0x27, 0x41, 0xf8, 0x56, 0x95, 0xe4, 0xb2, 0xf0, 0xaf, 0xd6, 0xbd, 0x9d, 0xac,
+	0x0f, 0xa3, 0x4b, 0x74, 0xb1, 0x56, 0xc3, 0x7f, 0x1a, 0xe0, 0xf5, 0x13, 0x44, 0xdd, 0x77, 0xc0,
+	0x19, 0x9b, 0x00, 0x4e, 0x3d, 0xc7, 0x9e, 0xde, 0x2d, 0x0b, 0x7f, 0xbd, 0x76, 0x18, 0x03, 0x30,
+	0x5a, 0xab, 0xbe, 0x3e, 0xa1, 0xee, 0x00, 0x5c, 0x14, 0x3c, 0x9d, 0x77, 0xf4, 0x4e, 0xd9, 0xd9,
+	0x73, 0x7b, 0xb9, 0xd9, 0xe3, 0x8d, 0xef, 0xf5, 0xb8, 0x0a, 0x8c, 0x2e, 0x08, 0x9e, 0xce, 0x75,
+	0x56, 0x99, 0xe1, 0xe1, 0x31, 0xb3, 0xc6, 0xff, 0x31, 0xc3, 0xc3, 0x45, 0x33, 0x3c, 0x9c, 0x33,
+	0x23, 0x60, 0xbd, 0xa2, 0x3d, 0x96, 0x09, 0x36, 0x3c, 0xe1, 0x66, 0xe4, 0xad, 0x5a, 0xa7, 0x0f,
+	0x97, 0x73, 0xda, 0x98, 0x3a, 0x4d, 0x25, 0x60, 0xf4, 0xaa, 0xc0, 0xc3, 0xaf, 0x9e, 0xaf, 0x5d,
+	0x0e, 0x5e, 0x9b, 0xa2, 0x88, 0x32, 0x82, 0x47, 0xde, 0x69, 0x6b, 0xf3, 0xd1, 0x72, 0x36, 0x97,
+	0x6b, 0x9b, 0xe3, 0x22, 0x30, 0xba, 0x30, 0xdd, 0xea, 0x56, 0x3b, 0xe1, 0x37, 0x4f, 0x0f, 0x9b,
+	0xce, 0xb3, 0xc3, 0xa6, 0xf3, 0xd7, 0x61, 0xd3, 0xf9, 0xf9, 0xa8, 0xb9, 0xf2, 0xec, 0xa8, 0xb9,
+	0xf2, 0xfb, 0x51, 0x73, 0xe5, 0x61, 0x18, 0x73, 0xd3, 0xcf, 0x7b, 0x01, 0x91, 0xa2, 0x33, 0xce,
+	0xe0, 0x8d, 0x04, 0xf7, 0xf4, 0x64, 0xd1, 0x79, 0x7c, 0xf3, 0xbd, 0xce, 0x70, 0xee, 0x69, 0xbf,
+	0x31, 0x7d, 0xdb, 0xcd, 0x28, 0x63, 0xba, 0xb7, 0x66, 0xc7, 0xec, 0xcd, 0x7f, 0x07, 0x00, 0x9b,
+	0xe2, 0x95, 0xf2, 0x09, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -215,6 +328,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DynamicSpreadFactors) > 0 {
+		for iNdEx := len(m.DynamicSpreadFactors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DynamicSpreadFactors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.MaxAutoCompoundsPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAutoCompoundsPerEpoch))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxRangeOrderFillsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRangeOrderFillsPerBlock))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.HookGasLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.HookGasLimit))
 		i--
@@ -305,6 +442,74 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DynamicSpreadFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VolatilityDecay.Size()
+		i -= size
+		if _, err := m.VolatilityDecay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxVolatility.Size()
+		i -= size
+		if _, err := m.MaxVolatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxSpreadFactor.Size()
+		i -= size
+		if _, err := m.MaxSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinSpreadFactor.Size()
+		i -= size
+		if _, err := m.MinSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -361,6 +566,38 @@ func (m *Params) Size() (n int) {
 	if m.HookGasLimit != 0 {
 		n += 1 + sovParams(uint64(m.HookGasLimit))
 	}
+	if m.MaxRangeOrderFillsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxRangeOrderFillsPerBlock))
+	}
+	if m.MaxAutoCompoundsPerEpoch != 0 {
+		n += 1 + sovParams(uint64(m.MaxAutoCompoundsPerEpoch))
+	}
+	if len(m.DynamicSpreadFactors) > 0 {
+		for _, e := range m.DynamicSpreadFactors {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DynamicSpreadFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovParams(uint64(m.PoolId))
+	}
+	l = m.MinSpreadFactor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxSpreadFactor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxVolatility.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.VolatilityDecay.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -682,6 +919,283 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRangeOrderFillsPerBlock", wireType)
+			}
+			m.MaxRangeOrderFillsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRangeOrderFillsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundsPerEpoch", wireType)
+			}
+			m.MaxAutoCompoundsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DynamicSpreadFactors = append(m.DynamicSpreadFactors, DynamicSpreadFactor{})
+			if err := m.DynamicSpreadFactors[len(m.DynamicSpreadFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicSpreadFactor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSpreadFactor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSpreadFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVolatility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxVolatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityDecay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolatilityDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
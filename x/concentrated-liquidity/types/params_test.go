@@ -107,3 +107,116 @@ func TestValidateBalancerSharesDiscount(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDynamicSpreadFactors(t *testing.T) {
+	validDynamicSpreadFactor := func(poolId uint64) types.DynamicSpreadFactor {
+		return types.DynamicSpreadFactor{
+			PoolId:          poolId,
+			MinSpreadFactor: osmomath.MustNewDecFromStr("0.0005"),
+			MaxSpreadFactor: osmomath.MustNewDecFromStr("0.01"),
+			MaxVolatility:   osmomath.NewDec(100),
+			VolatilityDecay: osmomath.MustNewDecFromStr("0.9"),
+		}
+	}
+	withChange := func(change func(*types.DynamicSpreadFactor)) []types.DynamicSpreadFactor {
+		dynamicSpreadFactor := validDynamicSpreadFactor(1)
+		change(&dynamicSpreadFactor)
+		return []types.DynamicSpreadFactor{dynamicSpreadFactor}
+	}
+
+	tests := map[string]struct {
+		i           interface{}
+		expectError bool
+	}{
+		"happy path": {
+			i: []types.DynamicSpreadFactor{validDynamicSpreadFactor(1), validDynamicSpreadFactor(2)},
+		},
+		"empty": {
+			i: []types.DynamicSpreadFactor{},
+		},
+		"equal min and max spread factors, zero decay": {
+			i: withChange(func(d *types.DynamicSpreadFactor) {
+				d.MinSpreadFactor = d.MaxSpreadFactor
+				d.VolatilityDecay = osmomath.ZeroDec()
+			}),
+		},
+		"error: wrong type": {
+			i:           validDynamicSpreadFactor(1),
+			expectError: true,
+		},
+		"error: zero pool id": {
+			i:           []types.DynamicSpreadFactor{validDynamicSpreadFactor(0)},
+			expectError: true,
+		},
+		"error: duplicate pool id": {
+			i:           []types.DynamicSpreadFactor{validDynamicSpreadFactor(1), validDynamicSpreadFactor(1)},
+			expectError: true,
+		},
+		"error: nil field": {
+			i:           withChange(func(d *types.DynamicSpreadFactor) { d.MaxVolatility = osmomath.Dec{} }),
+			expectError: true,
+		},
+		"error: negative min spread factor": {
+			i:           withChange(func(d *types.DynamicSpreadFactor) { d.MinSpreadFactor = osmomath.NewDec(-1) }),
+			expectError: true,
+		},
+		"error: max spread factor of one": {
+			i:           withChange(func(d *types.DynamicSpreadFactor) { d.MaxSpreadFactor = osmomath.OneDec() }),
+			expectError: true,
+		},
+		"error: min spread factor greater than max": {
+			i:           withChange(func(d *types.DynamicSpreadFactor) { d.MinSpreadFactor = osmomath.MustNewDecFromStr("0.02") }),
+			expectError: true,
+		},
+		"error: zero max volatility": {
+			i:           withChange(func(d *types.DynamicSpreadFactor) { d.MaxVolatility = osmomath.ZeroDec() }),
+			expectError: true,
+		},
+		"error: volatility decay of one": {
+			i:           withChange(func(d *types.DynamicSpreadFactor) { d.VolatilityDecay = osmomath.OneDec() }),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateDynamicSpreadFactors(tc.i)
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDynamicSpreadFactor(t *testing.T) {
+	dynamicSpreadFactor := types.DynamicSpreadFactor{
+		PoolId:          1,
+		MinSpreadFactor: osmomath.MustNewDecFromStr("0.001"),
+		MaxSpreadFactor: osmomath.MustNewDecFromStr("0.011"),
+		MaxVolatility:   osmomath.NewDec(100),
+		VolatilityDecay: osmomath.MustNewDecFromStr("0.75"),
+	}
+
+	// 0.75 * 40 + 0.25 * 120
+	require.Equal(t, osmomath.NewDec(60), dynamicSpreadFactor.NextVolatility(osmomath.NewDec(40), osmomath.NewDec(120)))
+	require.Equal(t, osmomath.NewDec(30), dynamicSpreadFactor.NextVolatility(osmomath.NewDec(40), osmomath.ZeroDec()))
+
+	require.Equal(t, dynamicSpreadFactor.MinSpreadFactor, dynamicSpreadFactor.SpreadFactor(osmomath.ZeroDec()))
+	require.Equal(t, osmomath.MustNewDecFromStr("0.007"), dynamicSpreadFactor.SpreadFactor(osmomath.NewDec(60)))
+	require.Equal(t, dynamicSpreadFactor.MaxSpreadFactor, dynamicSpreadFactor.SpreadFactor(osmomath.NewDec(100)))
+	require.Equal(t, dynamicSpreadFactor.MaxSpreadFactor, dynamicSpreadFactor.SpreadFactor(osmomath.NewDec(250)))
+}
+
+func TestPriceMovement(t *testing.T) {
+	// A sqrt price up 1% is a price up 2.01%, whatever the price decade.
+	require.Equal(t, osmomath.NewDec(201), types.PriceMovement(osmomath.NewBigDec(2), osmomath.MustNewBigDecFromStr("2.02")))
+	require.Equal(t, osmomath.NewDec(201), types.PriceMovement(osmomath.NewBigDec(1000), osmomath.NewBigDec(1010)))
+	// A sqrt price down 1% is a price down 1.99%.
+	require.Equal(t, osmomath.NewDec(199), types.PriceMovement(osmomath.NewBigDec(10), osmomath.MustNewBigDecFromStr("9.9")))
+	require.Equal(t, osmomath.ZeroDec(), types.PriceMovement(osmomath.NewBigDec(10), osmomath.NewBigDec(10)))
+	require.Equal(t, osmomath.ZeroDec(), types.PriceMovement(osmomath.ZeroBigDec(), osmomath.NewBigDec(10)))
+}
@@ -46,6 +46,10 @@ type MsgCreatePosition struct {
 	TokensProvided  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=tokens_provided,json=tokensProvided,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_provided"`
 	TokenMinAmount0 cosmossdk_io_math.Int                    `protobuf:"bytes,6,opt,name=token_min_amount0,json=tokenMinAmount0,proto3,customtype=cosmossdk.io/math.Int" json:"token_min_amount0" yaml:"token_min_amount0"`
 	TokenMinAmount1 cosmossdk_io_math.Int                    `protobuf:"bytes,7,opt,name=token_min_amount1,json=tokenMinAmount1,proto3,customtype=cosmossdk.io/math.Int" json:"token_min_amount1" yaml:"token_min_amount1"`
+	// range_order makes the position a range order, withdrawn to the sender
+	// once a swap of the pool fully crosses its range. The position must be
+	// single-sided, entirely above or below the current tick.
+	RangeOrder bool `protobuf:"varint,8,opt,name=range_order,json=rangeOrder,proto3" json:"range_order,omitempty" yaml:"range_order"`
 }
 
 func (m *MsgCreatePosition) Reset()         { *m = MsgCreatePosition{} }
@@ -116,6 +120,13 @@ func (m *MsgCreatePosition) GetTokensProvided() github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+func (m *MsgCreatePosition) GetRangeOrder() bool {
+	if m != nil {
+		return m.RangeOrder
+	}
+	return false
+}
+
 type MsgCreatePositionResponse struct {
 	PositionId       uint64                      `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
 	Amount0          cosmossdk_io_math.Int       `protobuf:"bytes,2,opt,name=amount0,proto3,customtype=cosmossdk.io/math.Int" json:"amount0" yaml:"amount0"`
@@ -784,6 +795,266 @@ func (m *MsgTransferPositionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTransferPositionsResponse proto.InternalMessageInfo
 
+// ===================== MsgRebalancePosition
+type MsgRebalancePosition struct {
+	PositionId   uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Sender       string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	NewLowerTick int64  `protobuf:"varint,3,opt,name=new_lower_tick,json=newLowerTick,proto3" json:"new_lower_tick,omitempty" yaml:"new_lower_tick"`
+	NewUpperTick int64  `protobuf:"varint,4,opt,name=new_upper_tick,json=newUpperTick,proto3" json:"new_upper_tick,omitempty" yaml:"new_upper_tick"`
+	// token_swapped_in is the amount of the surplus token, out of the tokens
+	// withdrawn from the position, swapped through the same pool into the other
+	// token before providing liquidity in the new range. A zero amount skips
+	// the swap.
+	TokenSwappedIn types.Coin `protobuf:"bytes,5,opt,name=token_swapped_in,json=tokenSwappedIn,proto3" json:"token_swapped_in" yaml:"token_swapped_in"`
+	// token_out_min_amount is the minimum amount of the other token out of the
+	// swap. It must be positive when token_swapped_in is set.
+	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// token_min_amount0 represents the minimum amount of token0 provided to the
+	// position in the new range.
+	TokenMinAmount0 cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=token_min_amount0,json=tokenMinAmount0,proto3,customtype=cosmossdk.io/math.Int" json:"token_min_amount0" yaml:"token_min_amount0"`
+	// token_min_amount1 represents the minimum amount of token1 provided to the
+	// position in the new range.
+	TokenMinAmount1 cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=token_min_amount1,json=tokenMinAmount1,proto3,customtype=cosmossdk.io/math.Int" json:"token_min_amount1" yaml:"token_min_amount1"`
+}
+
+func (m *MsgRebalancePosition) Reset()         { *m = MsgRebalancePosition{} }
+func (m *MsgRebalancePosition) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalancePosition) ProtoMessage()    {}
+func (*MsgRebalancePosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b181243e31403684, []int{14}
+}
+func (m *MsgRebalancePosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebalancePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebalancePosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRebalancePosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalancePosition.Merge(m, src)
+}
+func (m *MsgRebalancePosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebalancePosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalancePosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebalancePosition proto.InternalMessageInfo
+
+func (m *MsgRebalancePosition) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *MsgRebalancePosition) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRebalancePosition) GetNewLowerTick() int64 {
+	if m != nil {
+		return m.NewLowerTick
+	}
+	return 0
+}
+
+func (m *MsgRebalancePosition) GetNewUpperTick() int64 {
+	if m != nil {
+		return m.NewUpperTick
+	}
+	return 0
+}
+
+func (m *MsgRebalancePosition) GetTokenSwappedIn() types.Coin {
+	if m != nil {
+		return m.TokenSwappedIn
+	}
+	return types.Coin{}
+}
+
+type MsgRebalancePositionResponse struct {
+	PositionId uint64                      `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Amount0    cosmossdk_io_math.Int       `protobuf:"bytes,2,opt,name=amount0,proto3,customtype=cosmossdk.io/math.Int" json:"amount0" yaml:"amount0"`
+	Amount1    cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=amount1,proto3,customtype=cosmossdk.io/math.Int" json:"amount1" yaml:"amount1"`
+	Liquidity  cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=liquidity,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity" yaml:"liquidity"`
+	// the new lower and upper tick, moved to the canonical ticks representing
+	// the same prices.
+	LowerTick int64 `protobuf:"varint,5,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64 `protobuf:"varint,6,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+}
+
+func (m *MsgRebalancePositionResponse) Reset()         { *m = MsgRebalancePositionResponse{} }
+func (m *MsgRebalancePositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalancePositionResponse) ProtoMessage()    {}
+func (*MsgRebalancePositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b181243e31403684, []int{15}
+}
+func (m *MsgRebalancePositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebalancePositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebalancePositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRebalancePositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalancePositionResponse.Merge(m, src)
+}
+func (m *MsgRebalancePositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebalancePositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalancePositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebalancePositionResponse proto.InternalMessageInfo
+
+func (m *MsgRebalancePositionResponse) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *MsgRebalancePositionResponse) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *MsgRebalancePositionResponse) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+// ===================== MsgSetPositionAutoCompound
+type MsgSetPositionAutoCompound struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Sender     string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// epoch_identifier is the identifier of the epoch at the end of which the
+	// rewards of the position are compounded. An empty identifier opts the
+	// position out of auto-compounding.
+	EpochIdentifier string `protobuf:"bytes,3,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
+	// max_slippage is the maximum slippage, relative to the spot price, of the
+	// swaps converting the rewards that are not pool tokens into a pool token,
+	// and of the swap through the pool balancing the rewards in the proportion
+	// of the position. Spread and taker fees count toward the slippage.
+	MaxSlippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_slippage,json=maxSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_slippage" yaml:"max_slippage"`
+}
+
+func (m *MsgSetPositionAutoCompound) Reset()         { *m = MsgSetPositionAutoCompound{} }
+func (m *MsgSetPositionAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPositionAutoCompound) ProtoMessage()    {}
+func (*MsgSetPositionAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b181243e31403684, []int{16}
+}
+func (m *MsgSetPositionAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPositionAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPositionAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPositionAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPositionAutoCompound.Merge(m, src)
+}
+func (m *MsgSetPositionAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPositionAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPositionAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPositionAutoCompound proto.InternalMessageInfo
+
+func (m *MsgSetPositionAutoCompound) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *MsgSetPositionAutoCompound) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetPositionAutoCompound) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+type MsgSetPositionAutoCompoundResponse struct {
+}
+
+func (m *MsgSetPositionAutoCompoundResponse) Reset()         { *m = MsgSetPositionAutoCompoundResponse{} }
+func (m *MsgSetPositionAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPositionAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetPositionAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b181243e31403684, []int{17}
+}
+func (m *MsgSetPositionAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPositionAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPositionAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPositionAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPositionAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetPositionAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPositionAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPositionAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPositionAutoCompoundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreatePosition)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreatePosition")
 	proto.RegisterType((*MsgCreatePositionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreatePositionResponse")
@@ -799,6 +1070,10 @@ func init() {
 	proto.RegisterType((*MsgFungifyChargedPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgFungifyChargedPositionsResponse")
 	proto.RegisterType((*MsgTransferPositions)(nil), "osmosis.concentratedliquidity.v1beta1.MsgTransferPositions")
 	proto.RegisterType((*MsgTransferPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgTransferPositionsResponse")
+	proto.RegisterType((*MsgRebalancePosition)(nil), "osmosis.concentratedliquidity.v1beta1.MsgRebalancePosition")
+	proto.RegisterType((*MsgRebalancePositionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgRebalancePositionResponse")
+	proto.RegisterType((*MsgSetPositionAutoCompound)(nil), "osmosis.concentratedliquidity.v1beta1.MsgSetPositionAutoCompound")
+	proto.RegisterType((*MsgSetPositionAutoCompoundResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgSetPositionAutoCompoundResponse")
 }
 
 func init() {
@@ -806,85 +1081,106 @@ func init() {
 }
 
 var fileDescriptor_b181243e31403684 = []byte{
-	// 1234 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0xe3, 0x44,
-	0x18, 0xad, 0x9b, 0x6c, 0x4a, 0x67, 0xe9, 0x8f, 0xb8, 0xdd, 0x36, 0x4d, 0x97, 0xb8, 0x1a, 0x81,
-	0x94, 0x2d, 0xc4, 0x6e, 0x76, 0x57, 0x02, 0x82, 0xb4, 0xcb, 0xa6, 0x68, 0xa5, 0xac, 0x88, 0x76,
-	0xe5, 0xad, 0x84, 0x84, 0x90, 0x22, 0xd7, 0x9e, 0xba, 0xa3, 0x26, 0x9e, 0xe0, 0x71, 0x9b, 0xed,
-	0x95, 0x03, 0x02, 0xc4, 0x01, 0xad, 0xc4, 0x11, 0xce, 0x08, 0x38, 0xac, 0xc4, 0x89, 0x3b, 0x12,
-	0x7b, 0xe0, 0xb0, 0x47, 0xc4, 0xc1, 0x8b, 0xda, 0xc3, 0x8a, 0x6b, 0xfe, 0x02, 0x64, 0x8f, 0x33,
-	0x76, 0xed, 0x74, 0x5b, 0xa7, 0x28, 0x07, 0x2e, 0x89, 0x3d, 0x33, 0xdf, 0x9b, 0x37, 0xef, 0x7d,
-	0xdf, 0x8c, 0x6d, 0x20, 0x13, 0xda, 0x21, 0x14, 0x53, 0x45, 0x27, 0x96, 0x8e, 0x2c, 0xc7, 0xd6,
-	0x1c, 0x64, 0xb4, 0xf1, 0xa7, 0xfb, 0xd8, 0xc0, 0xce, 0xa1, 0x72, 0x50, 0xdd, 0x46, 0x8e, 0x56,
-	0x55, 0x9c, 0x47, 0x72, 0xd7, 0x26, 0x0e, 0x11, 0xdf, 0x08, 0xc6, 0xcb, 0x43, 0xc7, 0xcb, 0xc1,
-	0xf8, 0xe2, 0xb2, 0xee, 0x8f, 0x53, 0x3a, 0xd4, 0x54, 0x0e, 0xaa, 0xde, 0x1f, 0x8b, 0x2f, 0x2e,
-	0x9a, 0xc4, 0x24, 0xfe, 0xa5, 0xe2, 0x5d, 0x05, 0xad, 0x79, 0xad, 0x83, 0x2d, 0xa2, 0xf8, 0xbf,
-	0x41, 0x53, 0x29, 0x40, 0xd8, 0xd6, 0x28, 0xe2, 0x34, 0x74, 0x82, 0x2d, 0xd6, 0x0f, 0x7f, 0xcf,
-	0x82, 0x7c, 0x93, 0x9a, 0x9b, 0x36, 0xd2, 0x1c, 0xf4, 0x80, 0x50, 0xec, 0x60, 0x62, 0x89, 0x6f,
-	0x82, 0xa9, 0x2e, 0x21, 0xed, 0x16, 0x36, 0x0a, 0xc2, 0x9a, 0x50, 0xce, 0xd6, 0xc5, 0xbe, 0x2b,
-	0xcd, 0x1e, 0x6a, 0x9d, 0x76, 0x0d, 0x06, 0x1d, 0x50, 0xcd, 0x79, 0x57, 0x0d, 0x43, 0xbc, 0x06,
-	0x72, 0x14, 0x59, 0x06, 0xb2, 0x0b, 0x93, 0x6b, 0x42, 0x79, 0xba, 0x9e, 0xef, 0xbb, 0xd2, 0x0c,
-	0x1b, 0xcb, 0xda, 0xa1, 0x1a, 0x0c, 0x10, 0x6f, 0x02, 0xd0, 0x26, 0x3d, 0x64, 0xb7, 0x1c, 0xac,
-	0xef, 0x15, 0x32, 0x6b, 0x42, 0x39, 0x53, 0xbf, 0xd2, 0x77, 0xa5, 0x3c, 0x1b, 0x1e, 0xf6, 0x41,
-	0x75, 0xda, 0xbf, 0xd9, 0xc2, 0xfa, 0x9e, 0x17, 0xb5, 0xdf, 0xed, 0x0e, 0xa2, 0xb2, 0xf1, 0xa8,
-	0xb0, 0x0f, 0xaa, 0xd3, 0xfe, 0x8d, 0x1f, 0xe5, 0x80, 0x39, 0x87, 0xec, 0x21, 0x8b, 0xb6, 0xba,
-	0x36, 0x39, 0xc0, 0x06, 0x32, 0x0a, 0x97, 0xd6, 0x32, 0xe5, 0xcb, 0xd7, 0x57, 0x64, 0xa6, 0x89,
-	0xec, 0x69, 0x32, 0x90, 0x5a, 0xde, 0x24, 0xd8, 0xaa, 0x6f, 0x3c, 0x75, 0xa5, 0x89, 0x1f, 0x9f,
-	0x4b, 0x65, 0x13, 0x3b, 0xbb, 0xfb, 0xdb, 0xb2, 0x4e, 0x3a, 0x4a, 0x20, 0x20, 0xfb, 0xab, 0x50,
-	0x63, 0x4f, 0x71, 0x0e, 0xbb, 0x88, 0xfa, 0x01, 0x54, 0x9d, 0x65, 0x73, 0x3c, 0x08, 0xa6, 0x10,
-	0x11, 0xc8, 0xfb, 0x2d, 0xad, 0x0e, 0xb6, 0x5a, 0x5a, 0x87, 0xec, 0x5b, 0xce, 0x46, 0x21, 0xe7,
-	0xeb, 0xf2, 0xae, 0x07, 0xfe, 0x97, 0x2b, 0x5d, 0x61, 0x50, 0xd4, 0xd8, 0x93, 0x31, 0x51, 0x3a,
-	0x9a, 0xb3, 0x2b, 0x37, 0x2c, 0xa7, 0xef, 0x4a, 0x05, 0xb6, 0x9e, 0x44, 0x3c, 0x54, 0xd9, 0x4a,
-	0x9a, 0xd8, 0xba, 0xc3, 0x5a, 0x86, 0x4d, 0x53, 0x2d, 0x4c, 0x5d, 0x68, 0x9a, 0x6a, 0x62, 0x9a,
-	0x6a, 0x6d, 0xfd, 0xb3, 0x17, 0x4f, 0xd6, 0x03, 0xf3, 0xbe, 0x7a, 0xf1, 0x64, 0xbd, 0xc8, 0xd3,
-	0xbc, 0x5d, 0xd1, 0xfd, 0x94, 0xa9, 0x74, 0x83, 0x9c, 0x81, 0xbf, 0x65, 0xc0, 0x4a, 0x22, 0x93,
-	0x54, 0x44, 0xbb, 0xc4, 0xa2, 0x48, 0x7c, 0x1b, 0x5c, 0x1e, 0x8c, 0x0c, 0xb3, 0x6a, 0xa9, 0xef,
-	0x4a, 0xe2, 0x20, 0xab, 0x78, 0x27, 0x54, 0xc1, 0xe0, 0xae, 0x61, 0x88, 0x0d, 0x30, 0x35, 0x90,
-	0x91, 0xa5, 0x97, 0x72, 0xd6, 0xfa, 0x82, 0x3c, 0xe5, 0xe2, 0x0d, 0xe2, 0x43, 0xa8, 0xaa, 0x9f,
-	0x7a, 0x69, 0xa1, 0xaa, 0x1c, 0xaa, 0x2a, 0xb6, 0x41, 0x9e, 0x57, 0x6b, 0x8b, 0x29, 0xe1, 0xa5,
-	0x97, 0x07, 0x7a, 0x3b, 0x00, 0x5d, 0x4d, 0x82, 0x7e, 0x88, 0x4c, 0x4d, 0x3f, 0xfc, 0x00, 0xe9,
-	0xa1, 0x0b, 0x09, 0x14, 0xa8, 0xce, 0xf3, 0x36, 0xa6, 0xa5, 0x11, 0x2b, 0x9b, 0xdc, 0x48, 0x65,
-	0x33, 0x75, 0xbe, 0xb2, 0x81, 0x5f, 0x64, 0xc1, 0x7c, 0x93, 0x9a, 0x77, 0x0c, 0x63, 0x8b, 0xf0,
-	0xfd, 0x60, 0x64, 0xf7, 0x52, 0xec, 0x0d, 0xf7, 0x42, 0xa3, 0x99, 0x3b, 0x1b, 0x67, 0xb9, 0x33,
-	0x17, 0x75, 0xa7, 0x15, 0x75, 0xfa, 0x5e, 0xe8, 0x74, 0x76, 0x14, 0xac, 0xa8, 0xd5, 0x43, 0x2b,
-	0xfa, 0xd2, 0x78, 0x2a, 0x3a, 0x37, 0xd6, 0x8a, 0xd6, 0x0c, 0xa3, 0xe2, 0x90, 0xb0, 0xa2, 0xff,
-	0x11, 0x40, 0x21, 0x9e, 0x0a, 0xff, 0xd3, 0x82, 0x86, 0x8f, 0x27, 0xc1, 0x42, 0x93, 0x9a, 0x1f,
-	0x61, 0x67, 0xd7, 0xb0, 0xb5, 0xde, 0x58, 0x33, 0x1f, 0x83, 0xb0, 0xe4, 0x03, 0xeb, 0x82, 0xf5,
-	0xdc, 0x3a, 0xdf, 0x5e, 0xb2, 0x1c, 0xdf, 0x4b, 0x18, 0x08, 0x54, 0xe7, 0x78, 0x13, 0xf3, 0xbf,
-	0xf6, 0x56, 0xcc, 0xfe, 0xab, 0x11, 0xfb, 0x7b, 0xc1, 0xda, 0xc3, 0x04, 0xf8, 0x45, 0x00, 0xab,
-	0x43, 0x44, 0xe1, 0x39, 0x10, 0xb1, 0x52, 0xf8, 0xef, 0xac, 0x9c, 0xbc, 0xa0, 0x95, 0x3f, 0x09,
-	0x60, 0xd9, 0x3b, 0x88, 0x48, 0xbb, 0x8d, 0x74, 0xe7, 0x61, 0xd7, 0x46, 0x9a, 0xa1, 0xa2, 0x9e,
-	0x66, 0x1b, 0x54, 0xac, 0x81, 0x57, 0x23, 0x8e, 0xd1, 0x82, 0xb0, 0x96, 0x29, 0x67, 0xeb, 0xcb,
-	0x7d, 0x57, 0x5a, 0x48, 0xf8, 0x49, 0xa1, 0x7a, 0x39, 0x34, 0x94, 0xa6, 0x70, 0xb4, 0x76, 0x2d,
-	0x26, 0xf3, 0x4a, 0xf4, 0xdc, 0x24, 0xed, 0x0a, 0xed, 0x56, 0x6c, 0xc6, 0x08, 0xfe, 0x21, 0x00,
-	0xe9, 0x14, 0xb6, 0x5c, 0xe7, 0x1f, 0x04, 0x50, 0xd0, 0xd9, 0x00, 0x64, 0xb4, 0xa8, 0x3f, 0xa6,
-	0x15, 0x00, 0xf8, 0x4b, 0x78, 0xe9, 0x43, 0xcd, 0x43, 0x4f, 0xc9, 0xbe, 0x2b, 0x49, 0x8c, 0xeb,
-	0x69, 0x40, 0x30, 0xd5, 0x73, 0xcf, 0x12, 0x87, 0x39, 0x41, 0x19, 0xfe, 0x2c, 0x80, 0xc5, 0x70,
-	0x39, 0x0d, 0xff, 0xe1, 0x16, 0x1f, 0xa0, 0xb1, 0x29, 0x5f, 0x89, 0x29, 0xff, 0xda, 0x49, 0xe5,
-	0x3d, 0x52, 0x15, 0xcc, 0x59, 0x41, 0x77, 0x12, 0x5c, 0x1d, 0x46, 0x97, 0x4b, 0xff, 0x9d, 0x00,
-	0x16, 0x43, 0xc5, 0xc2, 0xc8, 0xb3, 0x65, 0xbf, 0x1f, 0xc8, 0xbe, 0x1a, 0x97, 0x3d, 0x32, 0x7d,
-	0x2a, 0xc9, 0x17, 0x38, 0x44, 0x44, 0x56, 0x8f, 0xdf, 0x0e, 0xb1, 0x77, 0x10, 0x8e, 0xf1, 0x9b,
-	0x4c, 0xc9, 0x6f, 0x18, 0x48, 0x4a, 0x7e, 0x1c, 0x22, 0xe4, 0x07, 0x7f, 0x15, 0x40, 0xb1, 0x49,
-	0xcd, 0xbb, 0xfb, 0x96, 0x89, 0x77, 0x0e, 0x37, 0x77, 0x35, 0xdb, 0x44, 0xc6, 0x60, 0x23, 0x19,
-	0x5b, 0x56, 0xdc, 0x8c, 0x65, 0xc5, 0xeb, 0x91, 0xac, 0xd8, 0x61, 0xd4, 0x2a, 0x3a, 0xe3, 0xc6,
-	0x77, 0x3f, 0x0a, 0x77, 0x01, 0x3c, 0x9d, 0x3a, 0xcf, 0x90, 0x3a, 0x98, 0xb3, 0x50, 0xaf, 0x95,
-	0x3c, 0x25, 0x8a, 0x7d, 0x57, 0x5a, 0x62, 0x7c, 0x62, 0x03, 0xa0, 0x3a, 0x63, 0x21, 0xbe, 0x9d,
-	0x36, 0x0c, 0xf8, 0x9c, 0x55, 0xcd, 0x96, 0xad, 0x59, 0x74, 0x07, 0xd9, 0xe3, 0xd6, 0x47, 0xac,
-	0x82, 0x69, 0x8f, 0x22, 0xe9, 0x59, 0xc8, 0x0e, 0x8e, 0x9e, 0xc5, 0xbe, 0x2b, 0xcd, 0x87, 0xec,
-	0xfd, 0x2e, 0xa8, 0xbe, 0x62, 0xa1, 0xde, 0x7d, 0xef, 0xf2, 0xa5, 0x85, 0xe6, 0x04, 0xeb, 0x88,
-	0x68, 0x59, 0xf2, 0xeb, 0x2c, 0xb1, 0xc0, 0x81, 0x8a, 0xd7, 0x3f, 0x9f, 0x02, 0x99, 0x26, 0x35,
-	0xc5, 0xaf, 0x05, 0x30, 0x1b, 0x7b, 0x19, 0x7d, 0x47, 0x3e, 0xd7, 0xcb, 0xb2, 0x9c, 0x78, 0xf9,
-	0x28, 0xbe, 0x3f, 0x6a, 0x24, 0x37, 0xf7, 0xb1, 0x00, 0xe6, 0x13, 0xcf, 0x04, 0xb5, 0xf3, 0xc3,
-	0xc6, 0x63, 0x8b, 0xf5, 0xd1, 0x63, 0x39, 0xa9, 0x2f, 0x05, 0x30, 0x13, 0x7b, 0x3e, 0x3f, 0x3f,
-	0xea, 0x89, 0xc0, 0xe2, 0xed, 0x11, 0x03, 0x39, 0x97, 0xef, 0x05, 0xb0, 0x38, 0xf4, 0xa4, 0xbd,
-	0x95, 0x42, 0xfb, 0x21, 0xf1, 0xc5, 0xbb, 0x17, 0x8b, 0xe7, 0x04, 0xbf, 0x15, 0x40, 0x3e, 0x79,
-	0x1a, 0xbd, 0x97, 0x1a, 0x3d, 0x0c, 0x2e, 0x6e, 0x5e, 0x20, 0xf8, 0x04, 0xaf, 0x64, 0xbd, 0xa7,
-	0xe0, 0x95, 0x08, 0x4e, 0xc3, 0xeb, 0xd4, 0x42, 0xac, 0x7f, 0xf2, 0xf4, 0xa8, 0x24, 0x3c, 0x3b,
-	0x2a, 0x09, 0x7f, 0x1f, 0x95, 0x84, 0x6f, 0x8e, 0x4b, 0x13, 0xcf, 0x8e, 0x4b, 0x13, 0x7f, 0x1e,
-	0x97, 0x26, 0x3e, 0xae, 0x47, 0x4e, 0x82, 0x60, 0xa2, 0x4a, 0x5b, 0xdb, 0xa6, 0x83, 0x1b, 0xe5,
-	0xe0, 0x46, 0x55, 0x79, 0x74, 0xe2, 0x0b, 0x58, 0x25, 0xfc, 0x04, 0xe6, 0x9f, 0x14, 0xdb, 0x39,
-	0xff, 0xab, 0xd3, 0x8d, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xac, 0xa7, 0x5c, 0xee, 0x30, 0x13,
-	0x00, 0x00,
+	// 1584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x16, 0x36, 0x2d, 0x3f, 0xc7, 0x89, 0x1f, 0x8c, 0x13, 0xcb, 0xb2, 0xaf, 0x68, 0x0c, 0x12, 0xc0,
+	0xc9, 0xbd, 0x92, 0xa2, 0x24, 0x17, 0x69, 0xd5, 0x22, 0xa9, 0xe5, 0x22, 0x80, 0x82, 0x18, 0x0e,
+	0xe8, 0x04, 0x05, 0x8a, 0x16, 0x02, 0x4d, 0x8e, 0x69, 0xc2, 0x12, 0x87, 0xe5, 0x50, 0x96, 0xbd,
+	0x2b, 0xba, 0x6a, 0x8b, 0x2e, 0x8a, 0x00, 0x5d, 0xb6, 0xdb, 0x16, 0x6d, 0x17, 0x01, 0xba, 0xea,
+	0xbe, 0x8b, 0x2c, 0x8a, 0x22, 0xcb, 0xa2, 0x0b, 0xa6, 0x48, 0x16, 0x41, 0xb7, 0xfa, 0x05, 0x05,
+	0x39, 0xc3, 0x21, 0x45, 0xca, 0xb1, 0x1e, 0x81, 0x16, 0xd9, 0xd8, 0xe2, 0x70, 0xce, 0x37, 0xdf,
+	0x7c, 0xe7, 0x9c, 0x99, 0x73, 0x24, 0x90, 0xc7, 0xa4, 0x8e, 0x89, 0x41, 0x0a, 0x2a, 0x36, 0x55,
+	0x64, 0x3a, 0xb6, 0xe2, 0x20, 0xad, 0x66, 0x7c, 0xd2, 0x30, 0x34, 0xc3, 0x39, 0x2e, 0x1c, 0x16,
+	0x77, 0x91, 0xa3, 0x14, 0x0b, 0xce, 0x51, 0xde, 0xb2, 0xb1, 0x83, 0xc5, 0x4b, 0x6c, 0x7e, 0xbe,
+	0xe3, 0xfc, 0x3c, 0x9b, 0x9f, 0x59, 0x52, 0xfd, 0x79, 0x85, 0x3a, 0xd1, 0x0b, 0x87, 0x45, 0xef,
+	0x1f, 0xb5, 0xcf, 0x2c, 0xea, 0x58, 0xc7, 0xfe, 0xc7, 0x82, 0xf7, 0x89, 0x8d, 0x2e, 0x28, 0x75,
+	0xc3, 0xc4, 0x05, 0xff, 0x2f, 0x1b, 0xca, 0x32, 0x84, 0x5d, 0x85, 0x20, 0x4e, 0x43, 0xc5, 0x86,
+	0x49, 0xdf, 0xc3, 0x4f, 0xc7, 0xc1, 0xc2, 0x16, 0xd1, 0x37, 0x6d, 0xa4, 0x38, 0xe8, 0x3e, 0x26,
+	0x86, 0x63, 0x60, 0x53, 0xfc, 0x2f, 0x98, 0xb4, 0x30, 0xae, 0x55, 0x0d, 0x2d, 0x2d, 0xac, 0x09,
+	0xeb, 0x63, 0x65, 0xb1, 0xe5, 0x4a, 0xb3, 0xc7, 0x4a, 0xbd, 0x56, 0x82, 0xec, 0x05, 0x94, 0x27,
+	0xbc, 0x4f, 0x15, 0x4d, 0xbc, 0x0c, 0x26, 0x08, 0x32, 0x35, 0x64, 0xa7, 0x47, 0xd7, 0x84, 0xf5,
+	0xe9, 0xf2, 0x42, 0xcb, 0x95, 0xce, 0xd2, 0xb9, 0x74, 0x1c, 0xca, 0x6c, 0x82, 0x78, 0x03, 0x80,
+	0x1a, 0x6e, 0x22, 0xbb, 0xea, 0x18, 0xea, 0x41, 0x3a, 0xb5, 0x26, 0xac, 0xa7, 0xca, 0xe7, 0x5b,
+	0xae, 0xb4, 0x40, 0xa7, 0x87, 0xef, 0xa0, 0x3c, 0xed, 0x3f, 0x3c, 0x30, 0xd4, 0x03, 0xcf, 0xaa,
+	0x61, 0x59, 0x81, 0xd5, 0x58, 0xdc, 0x2a, 0x7c, 0x07, 0xe5, 0x69, 0xff, 0xc1, 0xb7, 0x72, 0xc0,
+	0x9c, 0x83, 0x0f, 0x90, 0x49, 0xaa, 0x96, 0x8d, 0x0f, 0x0d, 0x0d, 0x69, 0xe9, 0xf1, 0xb5, 0xd4,
+	0xfa, 0xcc, 0xb5, 0xe5, 0x3c, 0xd5, 0x24, 0xef, 0x69, 0x12, 0x48, 0x9d, 0xdf, 0xc4, 0x86, 0x59,
+	0xbe, 0xfa, 0xc4, 0x95, 0x46, 0x7e, 0x7c, 0x26, 0xad, 0xeb, 0x86, 0xb3, 0xdf, 0xd8, 0xcd, 0xab,
+	0xb8, 0x5e, 0x60, 0x02, 0xd2, 0x7f, 0x39, 0xa2, 0x1d, 0x14, 0x9c, 0x63, 0x0b, 0x11, 0xdf, 0x80,
+	0xc8, 0xb3, 0x74, 0x8d, 0xfb, 0x6c, 0x09, 0x11, 0x81, 0x05, 0x7f, 0xa4, 0x5a, 0x37, 0xcc, 0xaa,
+	0x52, 0xc7, 0x0d, 0xd3, 0xb9, 0x9a, 0x9e, 0xf0, 0x75, 0x79, 0xdb, 0x03, 0xff, 0xcb, 0x95, 0xce,
+	0x53, 0x28, 0xa2, 0x1d, 0xe4, 0x0d, 0x5c, 0xa8, 0x2b, 0xce, 0x7e, 0xbe, 0x62, 0x3a, 0x2d, 0x57,
+	0x4a, 0xd3, 0xfd, 0x24, 0xec, 0xa1, 0x4c, 0x77, 0xb2, 0x65, 0x98, 0x1b, 0x74, 0xa4, 0xd3, 0x32,
+	0xc5, 0xf4, 0xe4, 0x40, 0xcb, 0x14, 0x13, 0xcb, 0x14, 0xc5, 0x9b, 0x60, 0xc6, 0x56, 0x4c, 0x1d,
+	0x55, 0xb1, 0xed, 0xf9, 0x77, 0x6a, 0x4d, 0x58, 0x9f, 0x2a, 0x5f, 0x68, 0xb9, 0x92, 0x48, 0x31,
+	0x22, 0x2f, 0xa1, 0x0c, 0xfc, 0xa7, 0x6d, 0xef, 0xa1, 0x74, 0xe5, 0xb3, 0x97, 0x8f, 0xaf, 0x30,
+	0xaf, 0x7f, 0xf9, 0xf2, 0xf1, 0x95, 0x0c, 0xcf, 0x8f, 0x5a, 0x4e, 0xf5, 0x63, 0x2d, 0x67, 0xb1,
+	0x60, 0x83, 0xbf, 0xa5, 0xc0, 0x72, 0x22, 0x04, 0x65, 0x44, 0x2c, 0x6c, 0x12, 0xe4, 0x51, 0x08,
+	0x66, 0x86, 0xe1, 0x18, 0xa1, 0x10, 0x79, 0x09, 0x65, 0x10, 0x3c, 0x55, 0x34, 0xb1, 0x02, 0x26,
+	0x03, 0xfd, 0x69, 0x5c, 0x16, 0x4e, 0x13, 0x86, 0x05, 0x38, 0x57, 0x3d, 0xb0, 0x0f, 0xa1, 0x8a,
+	0xe9, 0x54, 0x1f, 0x50, 0x45, 0x0e, 0x55, 0x14, 0x6b, 0x60, 0x81, 0xa7, 0x79, 0x95, 0x2a, 0xe1,
+	0xc5, 0xa5, 0x07, 0x7a, 0x9b, 0x81, 0xae, 0x24, 0x41, 0xef, 0x21, 0x5d, 0x51, 0x8f, 0xdf, 0x47,
+	0x6a, 0xe8, 0xbe, 0x04, 0x0a, 0x94, 0xe7, 0xf9, 0x18, 0xd5, 0x52, 0x8b, 0xe5, 0xdb, 0x44, 0x5f,
+	0xf9, 0x36, 0xd9, 0x5d, 0xbe, 0xc1, 0xcf, 0xc7, 0xc0, 0xfc, 0x16, 0xd1, 0x37, 0x34, 0xed, 0x01,
+	0xe6, 0x07, 0x49, 0xdf, 0xde, 0xeb, 0xe1, 0x50, 0xb9, 0x1b, 0x3a, 0x9a, 0x7a, 0xe7, 0xea, 0x69,
+	0xde, 0x99, 0x8b, 0x7a, 0xa7, 0x1a, 0xf5, 0xf4, 0xdd, 0xd0, 0xd3, 0x63, 0xfd, 0x60, 0x45, 0x5d,
+	0xdd, 0xf1, 0x28, 0x18, 0x1f, 0xce, 0x51, 0x30, 0xf1, 0xba, 0x8f, 0x82, 0x57, 0x66, 0xb4, 0xa2,
+	0x69, 0x39, 0x07, 0x87, 0x19, 0xfd, 0x8f, 0x00, 0xd2, 0xf1, 0x50, 0x78, 0x43, 0x13, 0x1a, 0x3e,
+	0x1a, 0x05, 0xe7, 0xb6, 0x88, 0xfe, 0x81, 0xe1, 0xec, 0x6b, 0xb6, 0xd2, 0x1c, 0x6a, 0xe4, 0x1b,
+	0x20, 0x4c, 0x79, 0xe6, 0x3a, 0xb6, 0x9f, 0x5b, 0xdd, 0x9d, 0x25, 0x4b, 0xf1, 0xb3, 0x84, 0x82,
+	0x40, 0x79, 0x8e, 0x0f, 0x51, 0xff, 0x97, 0xfe, 0x17, 0x73, 0xff, 0x6a, 0xc4, 0xfd, 0x4d, 0xb6,
+	0xf7, 0x30, 0x00, 0x7e, 0x11, 0xc0, 0x4a, 0x07, 0x51, 0x78, 0x0c, 0x44, 0x5c, 0x29, 0xbc, 0x3e,
+	0x57, 0x8e, 0x0e, 0xe8, 0xca, 0x9f, 0x04, 0xb0, 0xe4, 0x5d, 0x44, 0xb8, 0x56, 0x43, 0xaa, 0xb3,
+	0x63, 0xd9, 0x48, 0xd1, 0x64, 0xd4, 0x54, 0x6c, 0x8d, 0x88, 0x25, 0x70, 0x26, 0xe2, 0x31, 0x92,
+	0x16, 0xd6, 0x52, 0xeb, 0x63, 0xe5, 0xa5, 0x96, 0x2b, 0x9d, 0x4b, 0xf8, 0x93, 0x40, 0x79, 0x26,
+	0x74, 0x28, 0xe9, 0xc1, 0xa3, 0xa5, 0xcb, 0x31, 0x99, 0x97, 0xa3, 0xf7, 0x26, 0xae, 0xe5, 0x88,
+	0x95, 0xb3, 0x29, 0x23, 0xf8, 0xbb, 0x00, 0xa4, 0x13, 0xd8, 0x72, 0x9d, 0x7f, 0x10, 0x40, 0x5a,
+	0xa5, 0x13, 0x90, 0x56, 0x25, 0xfe, 0x9c, 0x2a, 0x03, 0x48, 0x0b, 0xa7, 0x55, 0x43, 0x3b, 0x9e,
+	0x92, 0x2d, 0x57, 0x92, 0x28, 0xd7, 0x93, 0x80, 0x60, 0x4f, 0x05, 0xd3, 0x05, 0x0e, 0xd3, 0x46,
+	0x19, 0xfe, 0x2c, 0x80, 0xc5, 0x70, 0x3b, 0x15, 0xbf, 0x2a, 0x36, 0x0e, 0xd1, 0xd0, 0x94, 0xcf,
+	0xc5, 0x94, 0xff, 0x4f, 0xbb, 0xf2, 0x1e, 0xa9, 0x9c, 0xc1, 0x59, 0x41, 0x77, 0x14, 0xac, 0x76,
+	0xa2, 0xcb, 0xa5, 0xff, 0x56, 0x00, 0x8b, 0xa1, 0x62, 0xa1, 0xe5, 0xe9, 0xb2, 0x6f, 0x33, 0xd9,
+	0x57, 0xe2, 0xb2, 0x47, 0x96, 0xef, 0x49, 0xf2, 0x73, 0x1c, 0x22, 0x22, 0xab, 0xc7, 0x6f, 0x0f,
+	0xdb, 0x7b, 0xc8, 0x88, 0xf1, 0x1b, 0xed, 0x91, 0x5f, 0x27, 0x90, 0x1e, 0xf9, 0x71, 0x88, 0x90,
+	0x1f, 0xfc, 0x55, 0x00, 0x99, 0x2d, 0xa2, 0xdf, 0x69, 0x98, 0xba, 0xb1, 0x77, 0xbc, 0xb9, 0xaf,
+	0xd8, 0x3a, 0xd2, 0x82, 0x83, 0x64, 0x68, 0x51, 0x71, 0x23, 0x16, 0x15, 0x17, 0x23, 0x51, 0xb1,
+	0x47, 0xa9, 0xe5, 0x54, 0xca, 0x8d, 0x9f, 0x7e, 0x04, 0xee, 0x03, 0x78, 0x32, 0x75, 0x1e, 0x21,
+	0x65, 0x30, 0x67, 0xa2, 0x66, 0x35, 0x79, 0x4b, 0x64, 0x5a, 0xae, 0x74, 0x81, 0xf2, 0x89, 0x4d,
+	0x80, 0xf2, 0x59, 0x13, 0xf1, 0xe3, 0xb4, 0xa2, 0xc1, 0x67, 0x34, 0x6b, 0x1e, 0xd8, 0x8a, 0x49,
+	0xf6, 0x90, 0x3d, 0x6c, 0x7d, 0xc4, 0x22, 0x98, 0xf6, 0x28, 0xe2, 0xa6, 0x89, 0x6c, 0x76, 0xf5,
+	0x2c, 0xb6, 0x5c, 0x69, 0x3e, 0x64, 0xef, 0xbf, 0x82, 0xf2, 0x94, 0x89, 0x9a, 0xdb, 0x4d, 0xf3,
+	0x94, 0x44, 0x73, 0xd8, 0x3e, 0x22, 0x5a, 0x66, 0xc1, 0x6a, 0xa7, 0x0d, 0x06, 0x2a, 0xc2, 0x3f,
+	0xc6, 0x7d, 0x05, 0x64, 0xb4, 0xab, 0xd4, 0x14, 0x53, 0x45, 0x43, 0xbd, 0x80, 0x6f, 0x83, 0x59,
+	0x6f, 0x8f, 0x89, 0x9e, 0x76, 0xb9, 0xe5, 0x4a, 0xe7, 0x43, 0x0d, 0xa2, 0x75, 0xf6, 0x19, 0x13,
+	0x35, 0xef, 0xf1, 0x52, 0x9b, 0x01, 0x24, 0xda, 0xdb, 0x18, 0x40, 0xb4, 0xe4, 0xf6, 0x00, 0x1e,
+	0xf2, 0x2e, 0x57, 0x03, 0xf3, 0xb4, 0x7a, 0x23, 0x4d, 0xc5, 0xb2, 0xfc, 0x24, 0xf4, 0x6b, 0xcc,
+	0x57, 0x66, 0xb0, 0xc4, 0x32, 0x78, 0x29, 0x5a, 0xfe, 0x85, 0x00, 0x90, 0x75, 0xb5, 0x3b, 0x74,
+	0xa4, 0x62, 0x8a, 0x75, 0xb0, 0x48, 0x27, 0xe1, 0x86, 0x13, 0xa9, 0x13, 0x59, 0x99, 0xf9, 0xee,
+	0x69, 0x37, 0xee, 0x4a, 0x74, 0x9d, 0x76, 0x08, 0x28, 0xd3, 0xea, 0x75, 0xbb, 0xe1, 0xf0, 0x62,
+	0xb3, 0x73, 0xe5, 0x3c, 0x39, 0x9c, 0xca, 0x79, 0xea, 0xb5, 0x57, 0xce, 0xaf, 0x0a, 0x78, 0x3b,
+	0x08, 0xdb, 0xb0, 0x76, 0x7a, 0x9c, 0x02, 0xab, 0x9d, 0x02, 0xfa, 0x4d, 0xed, 0x88, 0x1f, 0x82,
+	0x69, 0x5e, 0x6c, 0xb2, 0xa6, 0xeb, 0x66, 0x77, 0xd5, 0xeb, 0x7c, 0xac, 0x7a, 0xf5, 0x9a, 0xd8,
+	0xe0, 0x73, 0xac, 0xf5, 0x1d, 0xef, 0xab, 0xf5, 0x9d, 0xe8, 0xb2, 0xf5, 0x75, 0x47, 0xfd, 0xbb,
+	0x6a, 0x07, 0x39, 0x81, 0xb3, 0x36, 0x1a, 0x0e, 0xde, 0xc4, 0x75, 0x0b, 0x37, 0x4c, 0x6d, 0x28,
+	0x27, 0xd1, 0x1d, 0x30, 0x8f, 0x2c, 0xac, 0xee, 0x57, 0x0d, 0x0d, 0x99, 0x8e, 0xb1, 0x67, 0xf0,
+	0xf3, 0x78, 0x25, 0x4c, 0xf4, 0xf8, 0x0c, 0x28, 0xcf, 0xf9, 0x43, 0x15, 0x3e, 0x22, 0x7e, 0x0c,
+	0xce, 0xd4, 0x95, 0xa3, 0x2a, 0xa9, 0x19, 0x96, 0xa5, 0xe8, 0x88, 0x39, 0xa4, 0xd4, 0x9d, 0x43,
+	0xd8, 0xd5, 0x12, 0x05, 0x80, 0xf2, 0x4c, 0x5d, 0x39, 0xda, 0x61, 0x4f, 0xa5, 0xff, 0xc7, 0x72,
+	0xe1, 0x52, 0x24, 0x17, 0x08, 0x72, 0x78, 0x16, 0xe4, 0x94, 0x86, 0x83, 0x73, 0x2a, 0x53, 0x10,
+	0x5e, 0x04, 0xf0, 0x64, 0x7d, 0x83, 0xc4, 0xb8, 0xf6, 0x64, 0x1a, 0xa4, 0xb6, 0x88, 0x2e, 0x7e,
+	0x25, 0x80, 0xd9, 0xd8, 0x17, 0x9a, 0x6f, 0xe5, 0xbb, 0xfa, 0xc2, 0x35, 0x9f, 0xf8, 0x1e, 0x2a,
+	0xf3, 0x5e, 0xbf, 0x96, 0x3c, 0x5f, 0x1f, 0x09, 0x60, 0x3e, 0xd1, 0x1e, 0x96, 0xba, 0x87, 0x8d,
+	0xdb, 0x66, 0xca, 0xfd, 0xdb, 0x72, 0x52, 0x5f, 0x08, 0xe0, 0x6c, 0xec, 0xab, 0x9a, 0xee, 0x51,
+	0xdb, 0x0c, 0x33, 0xb7, 0xfb, 0x34, 0xe4, 0x5c, 0xbe, 0x13, 0xc0, 0x62, 0xc7, 0xa6, 0xeb, 0x56,
+	0x0f, 0xda, 0x77, 0xb0, 0xcf, 0xdc, 0x19, 0xcc, 0x9e, 0x13, 0xfc, 0x46, 0x00, 0x0b, 0xc9, 0xc6,
+	0xe4, 0x9d, 0x9e, 0xd1, 0x43, 0xe3, 0xcc, 0xe6, 0x00, 0xc6, 0x6d, 0xbc, 0x92, 0xa5, 0x5f, 0x0f,
+	0xbc, 0x12, 0xc6, 0x99, 0xcd, 0x01, 0x8c, 0xdb, 0x78, 0x25, 0x0b, 0xb2, 0x1e, 0x78, 0x25, 0x8c,
+	0x33, 0x9b, 0x03, 0x18, 0x73, 0x5e, 0xdf, 0x0b, 0x60, 0xe9, 0xa4, 0x43, 0x7a, 0xa3, 0xfb, 0x05,
+	0x4e, 0x80, 0xc8, 0x54, 0x06, 0x86, 0x08, 0x98, 0x96, 0x3f, 0x7a, 0xf2, 0x3c, 0x2b, 0x3c, 0x7d,
+	0x9e, 0x15, 0xfe, 0x7e, 0x9e, 0x15, 0xbe, 0x7e, 0x91, 0x1d, 0x79, 0xfa, 0x22, 0x3b, 0xf2, 0xe7,
+	0x8b, 0xec, 0xc8, 0x87, 0xe5, 0x48, 0x5b, 0xc5, 0x96, 0xcb, 0xd5, 0x94, 0x5d, 0x12, 0x3c, 0x14,
+	0x0e, 0xaf, 0x17, 0x0b, 0x47, 0x6d, 0xbf, 0x43, 0xe5, 0xc2, 0x1f, 0xa2, 0xfc, 0xb6, 0x6b, 0x77,
+	0xc2, 0xff, 0xed, 0xe7, 0xfa, 0xbf, 0x03, 0x00, 0x42, 0x29, 0xae, 0x14, 0xb6, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -912,6 +1208,12 @@ type MsgClient interface {
 	// TransferPositions transfers ownership of a set of one or more positions
 	// from a sender to a recipient.
 	TransferPositions(ctx context.Context, in *MsgTransferPositions, opts ...grpc.CallOption) (*MsgTransferPositionsResponse, error)
+	// RebalancePosition moves the liquidity of a position to a new tick range,
+	// keeping its position id, join time and unclaimed rewards.
+	RebalancePosition(ctx context.Context, in *MsgRebalancePosition, opts ...grpc.CallOption) (*MsgRebalancePositionResponse, error)
+	// SetPositionAutoCompound opts a position in or out of the auto-compounding
+	// of its rewards at the end of each epoch of the given identifier.
+	SetPositionAutoCompound(ctx context.Context, in *MsgSetPositionAutoCompound, opts ...grpc.CallOption) (*MsgSetPositionAutoCompoundResponse, error)
 }
 
 type msgClient struct {
@@ -976,6 +1278,24 @@ func (c *msgClient) TransferPositions(ctx context.Context, in *MsgTransferPositi
 	return out, nil
 }
 
+func (c *msgClient) RebalancePosition(ctx context.Context, in *MsgRebalancePosition, opts ...grpc.CallOption) (*MsgRebalancePositionResponse, error) {
+	out := new(MsgRebalancePositionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/RebalancePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPositionAutoCompound(ctx context.Context, in *MsgSetPositionAutoCompound, opts ...grpc.CallOption) (*MsgSetPositionAutoCompoundResponse, error) {
+	out := new(MsgSetPositionAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/SetPositionAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreatePosition(context.Context, *MsgCreatePosition) (*MsgCreatePositionResponse, error)
@@ -991,6 +1311,12 @@ type MsgServer interface {
 	// TransferPositions transfers ownership of a set of one or more positions
 	// from a sender to a recipient.
 	TransferPositions(context.Context, *MsgTransferPositions) (*MsgTransferPositionsResponse, error)
+	// RebalancePosition moves the liquidity of a position to a new tick range,
+	// keeping its position id, join time and unclaimed rewards.
+	RebalancePosition(context.Context, *MsgRebalancePosition) (*MsgRebalancePositionResponse, error)
+	// SetPositionAutoCompound opts a position in or out of the auto-compounding
+	// of its rewards at the end of each epoch of the given identifier.
+	SetPositionAutoCompound(context.Context, *MsgSetPositionAutoCompound) (*MsgSetPositionAutoCompoundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1015,6 +1341,12 @@ func (*UnimplementedMsgServer) CollectIncentives(ctx context.Context, req *MsgCo
 func (*UnimplementedMsgServer) TransferPositions(ctx context.Context, req *MsgTransferPositions) (*MsgTransferPositionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferPositions not implemented")
 }
+func (*UnimplementedMsgServer) RebalancePosition(ctx context.Context, req *MsgRebalancePosition) (*MsgRebalancePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalancePosition not implemented")
+}
+func (*UnimplementedMsgServer) SetPositionAutoCompound(ctx context.Context, req *MsgSetPositionAutoCompound) (*MsgSetPositionAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPositionAutoCompound not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1128,6 +1460,43 @@ func _Msg_TransferPositions_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RebalancePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRebalancePosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RebalancePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/RebalancePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RebalancePosition(ctx, req.(*MsgRebalancePosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPositionAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPositionAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPositionAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/SetPositionAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPositionAutoCompound(ctx, req.(*MsgSetPositionAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.concentratedliquidity.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1156,6 +1525,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TransferPositions",
 			Handler:    _Msg_TransferPositions_Handler,
 		},
+		{
+			MethodName: "RebalancePosition",
+			Handler:    _Msg_RebalancePosition_Handler,
+		},
+		{
+			MethodName: "SetPositionAutoCompound",
+			Handler:    _Msg_SetPositionAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/concentratedliquidity/v1beta1/tx.proto",
@@ -1181,6 +1558,16 @@ func (m *MsgCreatePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RangeOrder {
+		i--
+		if m.RangeOrder {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.TokenMinAmount1.Size()
 		i -= size
@@ -1857,46 +2244,277 @@ func (m *MsgTransferPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRebalancePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreatePosition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRebalancePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalancePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.LowerTick != 0 {
-		n += 1 + sovTx(uint64(m.LowerTick))
+	{
+		size := m.TokenMinAmount1.Size()
+		i -= size
+		if _, err := m.TokenMinAmount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.UpperTick != 0 {
-		n += 1 + sovTx(uint64(m.UpperTick))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TokenMinAmount0.Size()
+		i -= size
+		if _, err := m.TokenMinAmount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.TokensProvided) > 0 {
-		for _, e := range m.TokensProvided {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.TokenMinAmount0.Size()
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.TokenSwappedIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.NewUpperTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewUpperTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewLowerTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewLowerTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRebalancePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebalancePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalancePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPositionAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPositionAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPositionAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPositionAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPositionAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPositionAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreatePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovTx(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovTx(uint64(m.UpperTick))
+	}
+	if len(m.TokensProvided) > 0 {
+		for _, e := range m.TokensProvided {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenMinAmount0.Size()
 	n += 1 + l + sovTx(uint64(l))
 	l = m.TokenMinAmount1.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.RangeOrder {
+		n += 2
+	}
 	return n
 }
 
@@ -2136,27 +2754,112 @@ func (m *MsgTransferPositionsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgRebalancePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewLowerTick != 0 {
+		n += 1 + sovTx(uint64(m.NewLowerTick))
+	}
+	if m.NewUpperTick != 0 {
+		n += 1 + sovTx(uint64(m.NewUpperTick))
+	}
+	l = m.TokenSwappedIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenMinAmount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenMinAmount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgRebalancePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.Amount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LowerTick != 0 {
+		n += 1 + sovTx(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovTx(uint64(m.UpperTick))
+	}
+	return n
 }
-func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
+
+func (m *MsgSetPositionAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetPositionAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
@@ -2362,6 +3065,26 @@ func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeOrder", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RangeOrder = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4204,6 +4927,706 @@ func (m *MsgTransferPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRebalancePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRebalancePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRebalancePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewLowerTick", wireType)
+			}
+			m.NewLowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewLowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewUpperTick", wireType)
+			}
+			m.NewUpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewUpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSwappedIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenSwappedIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinAmount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenMinAmount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinAmount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenMinAmount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRebalancePositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRebalancePositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRebalancePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPositionAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPositionAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPositionAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPositionAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPositionAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPositionAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,103 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v31/x/txfees/types"
+)
+
+// GetTakerFeeAffiliates returns the frontends whitelisted to receive a share of the taker fee of the swaps they are the affiliate of.
+func (k Keeper) GetTakerFeeAffiliates(ctx sdk.Context) []types.TakerFeeAffiliate {
+	takerFeeAffiliates := []types.TakerFeeAffiliate{}
+	k.paramSpace.Get(ctx, types.KeyTakerFeeAffiliates, &takerFeeAffiliates)
+	return takerFeeAffiliates
+}
+
+// GetAffiliateRebates returns the taker fee rebates received so far by the given affiliate.
+func (k Keeper) GetAffiliateRebates(ctx sdk.Context, affiliate sdk.AccAddress) sdk.Coins {
+	return k.getAffiliateRebates(ctx, affiliate).Rebates
+}
+
+// rebateTakerFeesToAffiliate sends the rebate share of the taker fees charged on a swap route from the taker fee
+// collector to the affiliate of the swap, and returns the remaining taker fees.
+// No-op if the affiliate is nil or not whitelisted.
+func (k Keeper) rebateTakerFeesToAffiliate(ctx sdk.Context, sender, affiliate sdk.AccAddress, takerFeesCharged sdk.Coins) (sdk.Coins, error) {
+	if affiliate == nil || takerFeesCharged.IsZero() {
+		return takerFeesCharged, nil
+	}
+
+	var takerFeeAffiliate types.TakerFeeAffiliate
+	found := false
+	for _, whitelistedAffiliate := range k.GetTakerFeeAffiliates(ctx) {
+		if whitelistedAffiliate.Address == affiliate.String() {
+			takerFeeAffiliate, found = whitelistedAffiliate, true
+			break
+		}
+	}
+	if !found {
+		return takerFeesCharged, nil
+	}
+
+	rebate := sdk.Coins{}
+	for _, takerFee := range takerFeesCharged {
+		rebateAmount := takerFeeAffiliate.RebateShare.MulInt(takerFee.Amount).TruncateInt()
+		rebate = rebate.Add(sdk.NewCoin(takerFee.Denom, rebateAmount))
+	}
+	if rebate.IsZero() {
+		return takerFeesCharged, nil
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, txfeestypes.TakerFeeCollectorName, affiliate, rebate)
+	if err != nil {
+		return nil, err
+	}
+
+	affiliateRebates := k.getAffiliateRebates(ctx, affiliate)
+	affiliateRebates.Rebates = affiliateRebates.Rebates.Add(rebate...)
+	k.setAffiliateRebates(ctx, affiliateRebates)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtAffiliateRebate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyAffiliate, affiliate.String()),
+			sdk.NewAttribute(types.AttributeKeyRebate, rebate.String()),
+		),
+	})
+
+	return takerFeesCharged.Sub(rebate...), nil
+}
+
+// getAffiliateRebates returns the taker fee rebates received so far by the given affiliate.
+func (k Keeper) getAffiliateRebates(ctx sdk.Context, affiliate sdk.AccAddress) types.AffiliateRebates {
+	affiliateRebates := types.AffiliateRebates{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FormatAffiliateRebatesKey(affiliate), &affiliateRebates)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return types.AffiliateRebates{Address: affiliate.String(), Rebates: sdk.Coins{}}
+	}
+	return affiliateRebates
+}
+
+func (k Keeper) setAffiliateRebates(ctx sdk.Context, affiliateRebates types.AffiliateRebates) {
+	affiliate := sdk.MustAccAddressFromBech32(affiliateRebates.Address)
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatAffiliateRebatesKey(affiliate), &affiliateRebates)
+}
+
+// getAllAffiliateRebates returns the taker fee rebates received by all the affiliates.
+func (k Keeper) getAllAffiliateRebates(ctx sdk.Context) ([]types.AffiliateRebates, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.AffiliateRebatesPrefix, parseAffiliateRebates)
+}
+
+func parseAffiliateRebates(bz []byte) (types.AffiliateRebates, error) {
+	affiliateRebates := types.AffiliateRebates{}
+	if err := affiliateRebates.Unmarshal(bz); err != nil {
+		return types.AffiliateRebates{}, err
+	}
+	return affiliateRebates, nil
+}
@@ -0,0 +1,124 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v31/x/txfees/types"
+)
+
+// validates that whitelisted affiliates set on swap msgs receive their rebate share of the taker fee charged,
+// and that the rebates are tracked and queryable.
+func (s *KeeperTestSuite) TestAffiliateRebates() {
+	var (
+		takerFee    = osmomath.MustNewDecFromStr("0.01")
+		rebateShare = osmomath.MustNewDecFromStr("0.25")
+		amount      = osmomath.NewInt(1_000_000)
+	)
+
+	tests := map[string]struct {
+		setAffiliate         bool
+		whitelistedAffiliate bool
+		exactIn              bool
+
+		expectRebate bool
+	}{
+		"exact in: whitelisted affiliate receives its rebate share": {
+			setAffiliate:         true,
+			whitelistedAffiliate: true,
+			exactIn:              true,
+			expectRebate:         true,
+		},
+		"exact out: whitelisted affiliate receives its rebate share": {
+			setAffiliate:         true,
+			whitelistedAffiliate: true,
+			exactIn:              false,
+			expectRebate:         true,
+		},
+		"affiliate not whitelisted: no rebate": {
+			setAffiliate: true,
+			exactIn:      true,
+		},
+		"no affiliate: no rebate": {
+			whitelistedAffiliate: true,
+			exactIn:              true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			sender := s.TestAccs[0]
+			affiliate := s.TestAccs[1]
+			poolManager := s.App.PoolManagerKeeper
+			msgServer := poolmanager.NewMsgServerImpl(poolManager)
+
+			s.PrepareBalancerPool()
+			poolManager.SetDenomPairTakerFee(s.Ctx, "baz", "bar", takerFee)
+			if tc.whitelistedAffiliate {
+				poolManager.SetParam(s.Ctx, types.KeyTakerFeeAffiliates, []types.TakerFeeAffiliate{
+					{Address: affiliate.String(), RebateShare: rebateShare},
+				})
+			}
+			affiliateStr := ""
+			if tc.setAffiliate {
+				affiliateStr = affiliate.String()
+			}
+
+			takerFeeCollector := s.App.AccountKeeper.GetModuleAddress(txfeestypes.TakerFeeCollectorName)
+			collectorBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, takerFeeCollector, "baz")
+			affiliateBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, affiliate, "baz")
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test.
+			if tc.exactIn {
+				_, err := msgServer.SwapExactAmountIn(s.Ctx, &types.MsgSwapExactAmountIn{
+					Sender:            sender.String(),
+					Routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}},
+					TokenIn:           sdk.NewCoin("baz", amount),
+					TokenOutMinAmount: osmomath.OneInt(),
+					Affiliate:         affiliateStr,
+				})
+				s.Require().NoError(err)
+			} else {
+				_, err := msgServer.SwapExactAmountOut(s.Ctx, &types.MsgSwapExactAmountOut{
+					Sender:           sender.String(),
+					Routes:           []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "baz"}},
+					TokenInMaxAmount: amount.MulRaw(2),
+					TokenOut:         sdk.NewCoin("bar", amount),
+					Affiliate:        affiliateStr,
+				})
+				s.Require().NoError(err)
+			}
+
+			takerFeeCharged := s.App.BankKeeper.GetBalance(s.Ctx, takerFeeCollector, "baz").Sub(collectorBalanceBefore)
+			rebate := s.App.BankKeeper.GetBalance(s.Ctx, affiliate, "baz").Sub(affiliateBalanceBefore)
+
+			queryClient := queryproto.NewQueryClient(s.QueryHelper)
+			res, err := queryClient.AffiliateRebates(s.Ctx, &queryproto.AffiliateRebatesRequest{Address: affiliate.String()})
+			s.Require().NoError(err)
+
+			if !tc.expectRebate {
+				s.Require().True(rebate.IsZero())
+				s.Require().True(res.Rebates.IsZero())
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtAffiliateRebate, 0)
+				return
+			}
+
+			// The taker fee charged is split between the collector and the affiliate.
+			totalTakerFee := takerFeeCharged.Add(rebate)
+			s.Require().Equal(rebateShare.MulInt(totalTakerFee.Amount).TruncateInt(), rebate.Amount)
+			s.Require().True(rebate.IsPositive())
+			s.Require().Equal(sdk.NewCoins(rebate), poolManager.GetAffiliateRebates(s.Ctx, affiliate))
+			s.Require().Equal(sdk.NewCoins(rebate), res.Rebates)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtAffiliateRebate, 1)
+
+			// Rebates are exported in genesis.
+			genesis := poolManager.ExportGenesis(s.Ctx)
+			s.Require().Equal([]types.AffiliateRebates{{Address: affiliate.String(), Rebates: sdk.NewCoins(rebate)}}, genesis.AffiliateRebates)
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package poolmanager
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+var (
+	// MaxBestSplitRoutes is the maximum number of routes the token in of a best split quote is split across.
+	MaxBestSplitRoutes = uint32(5)
+	// MaxBestSplitCandidateRoutes is the maximum number of routes quoted to find the best split, which bounds
+	// the gas consumed by a best split quote.
+	MaxBestSplitCandidateRoutes = 20
+	// BestSplitIncrements is the number of increments the token in is split into when allocating it across routes.
+	BestSplitIncrements = int64(10)
+)
+
+// routeQuote is a candidate route of a best split quote, along with its token out amount for the whole token in.
+type routeQuote struct {
+	route          types.SwapAmountInRoutes
+	tokenOutAmount osmomath.Int
+}
+
+// EstimateBestSplitSwapExactAmountIn finds routes from the token in to the token out denom, and returns the split of the token in
+// across up to maxRoutes of them that maximizes the token out amount, along with that amount.
+// If maxRoutes is 0 or above MaxBestSplitRoutes, it is set to MaxBestSplitRoutes.
+//
+// The candidate routes are the direct route and two hop routes through the protorev base denoms, using the highest liquidity pools
+// of each pair that protorev tracks, as protorev does to build arbitrage routes.
+// Each candidate route is quoted for the whole token in, and the best ones that do not share any pool are kept,
+// so that the quotes of the routes of the split are independent.
+// The token in is then split into BestSplitIncrements increments, each allocated to the route whose token out increases the most with it.
+//
+// Returns error if the token in and token out denoms are the same, or if no route between them can be quoted.
+func (k Keeper) EstimateBestSplitSwapExactAmountIn(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxRoutes uint32,
+) ([]types.SwapAmountInSplitRoute, osmomath.Int, error) {
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, osmomath.Int{}, types.ErrSameTokenInAndOutDenom
+	}
+	if !tokenIn.IsValid() || !tokenIn.IsPositive() {
+		return nil, osmomath.Int{}, fmt.Errorf("token in must be positive, was (%s)", tokenIn)
+	}
+	if maxRoutes == 0 || maxRoutes > MaxBestSplitRoutes {
+		maxRoutes = MaxBestSplitRoutes
+	}
+
+	candidateRoutes, err := k.findBestSplitCandidateRoutes(ctx, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return nil, osmomath.Int{}, err
+	}
+
+	routeQuotes := make([]routeQuote, 0, len(candidateRoutes))
+	for _, route := range candidateRoutes {
+		tokenOutAmount, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, route, tokenIn)
+		if err != nil || !tokenOutAmount.IsPositive() {
+			continue
+		}
+		routeQuotes = append(routeQuotes, routeQuote{route: route, tokenOutAmount: tokenOutAmount})
+	}
+	sort.SliceStable(routeQuotes, func(i, j int) bool {
+		return routeQuotes[i].tokenOutAmount.GT(routeQuotes[j].tokenOutAmount)
+	})
+
+	routes := selectPoolDisjointRoutes(routeQuotes, maxRoutes)
+	if len(routes) == 0 {
+		return nil, osmomath.Int{}, types.NoRouteFoundError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom}
+	}
+
+	tokenInAmounts := make([]osmomath.Int, len(routes))
+	tokenOutAmounts := make([]osmomath.Int, len(routes))
+	for i := range routes {
+		tokenInAmounts[i] = osmomath.ZeroInt()
+		tokenOutAmounts[i] = osmomath.ZeroInt()
+	}
+
+	allocated := osmomath.ZeroInt()
+	for i := int64(1); i <= BestSplitIncrements; i++ {
+		// Increments are computed from the cumulative amounts so that they add up to the token in exactly.
+		increment := tokenIn.Amount.MulRaw(i).QuoRaw(BestSplitIncrements).Sub(allocated)
+		if increment.IsZero() {
+			continue
+		}
+
+		bestRouteIndex := -1
+		bestTokenOutAmount, bestGain := osmomath.ZeroInt(), osmomath.ZeroInt()
+		for j, route := range routes {
+			tokenOutAmount, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, route, sdk.NewCoin(tokenIn.Denom, tokenInAmounts[j].Add(increment)))
+			if err != nil {
+				continue
+			}
+			gain := tokenOutAmount.Sub(tokenOutAmounts[j])
+			if bestRouteIndex == -1 || gain.GT(bestGain) {
+				bestRouteIndex, bestTokenOutAmount, bestGain = j, tokenOutAmount, gain
+			}
+		}
+		if bestRouteIndex == -1 {
+			return nil, osmomath.Int{}, types.NoRouteFoundError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom}
+		}
+
+		tokenInAmounts[bestRouteIndex] = tokenInAmounts[bestRouteIndex].Add(increment)
+		tokenOutAmounts[bestRouteIndex] = bestTokenOutAmount
+		allocated = allocated.Add(increment)
+	}
+
+	splitRoutes := []types.SwapAmountInSplitRoute{}
+	totalTokenOutAmount := osmomath.ZeroInt()
+	for i, route := range routes {
+		if tokenInAmounts[i].IsZero() {
+			continue
+		}
+		splitRoutes = append(splitRoutes, types.SwapAmountInSplitRoute{
+			Pools:         route,
+			TokenInAmount: tokenInAmounts[i],
+		})
+		totalTokenOutAmount = totalTokenOutAmount.Add(tokenOutAmounts[i])
+	}
+
+	return splitRoutes, totalTokenOutAmount, nil
+}
+
+// findBestSplitCandidateRoutes returns up to MaxBestSplitCandidateRoutes routes from the token in to the token out denom.
+// The single hop route through the highest liquidity pool of the denom pair comes first, followed by two hop routes through
+// the protorev base denoms, each hop going through the highest liquidity pool of its denom pair.
+// Only the pools indexed by protorev are looked up, so that the candidate set is bounded by the number of base denoms
+// rather than by the number of pools.
+func (k Keeper) findBestSplitCandidateRoutes(ctx sdk.Context, tokenInDenom, tokenOutDenom string) ([]types.SwapAmountInRoutes, error) {
+	routes := []types.SwapAmountInRoutes{}
+	if poolId, err := k.getHighestLiquidityPoolForDenomPair(ctx, tokenInDenom, tokenOutDenom); err == nil {
+		routes = append(routes, types.SwapAmountInRoutes{{PoolId: poolId, TokenOutDenom: tokenOutDenom}})
+	}
+
+	baseDenoms, err := k.protorevKeeper.GetAllBaseDenomNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, intermediateDenom := range baseDenoms {
+		if len(routes) >= MaxBestSplitCandidateRoutes {
+			break
+		}
+		if intermediateDenom == tokenInDenom || intermediateDenom == tokenOutDenom {
+			continue
+		}
+
+		firstPoolId, err := k.getHighestLiquidityPoolForDenomPair(ctx, tokenInDenom, intermediateDenom)
+		if err != nil {
+			continue
+		}
+		secondPoolId, err := k.getHighestLiquidityPoolForDenomPair(ctx, intermediateDenom, tokenOutDenom)
+		if err != nil || firstPoolId == secondPoolId {
+			continue
+		}
+
+		routes = append(routes, types.SwapAmountInRoutes{
+			{PoolId: firstPoolId, TokenOutDenom: intermediateDenom},
+			{PoolId: secondPoolId, TokenOutDenom: tokenOutDenom},
+		})
+	}
+
+	return routes, nil
+}
+
+// getHighestLiquidityPoolForDenomPair returns the highest liquidity pool of the denom pair tracked by protorev.
+// Protorev only tracks the pairs with one of its base denoms, which it stores first.
+func (k Keeper) getHighestLiquidityPoolForDenomPair(ctx sdk.Context, denomA, denomB string) (uint64, error) {
+	poolId, err := k.protorevKeeper.GetPoolForDenomPair(ctx, denomA, denomB)
+	if err == nil {
+		return poolId, nil
+	}
+	return k.protorevKeeper.GetPoolForDenomPair(ctx, denomB, denomA)
+}
+
+// selectPoolDisjointRoutes returns up to maxRoutes routes of the route quotes, in order, skipping the routes
+// sharing a pool with a previously selected route.
+func selectPoolDisjointRoutes(routeQuotes []routeQuote, maxRoutes uint32) []types.SwapAmountInRoutes {
+	routes := []types.SwapAmountInRoutes{}
+	usedPoolIds := map[uint64]bool{}
+	for _, routeQuote := range routeQuotes {
+		if uint32(len(routes)) >= maxRoutes {
+			break
+		}
+
+		poolIds := routeQuote.route.PoolIds()
+		sharesPool := false
+		for _, poolId := range poolIds {
+			if usedPoolIds[poolId] {
+				sharesPool = true
+				break
+			}
+		}
+		if sharesPool {
+			continue
+		}
+
+		for _, poolId := range poolIds {
+			usedPoolIds[poolId] = true
+		}
+		routes = append(routes, routeQuote.route)
+	}
+	return routes
+}
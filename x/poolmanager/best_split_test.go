@@ -0,0 +1,134 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestEstimateBestSplitSwapExactAmountIn() {
+	var (
+		tokenIn   = sdk.NewCoin(FOO, osmomath.NewInt(10_000_000))
+		poolCoins = sdk.NewCoins(
+			sdk.NewCoin(FOO, osmomath.NewInt(100_000_000)),
+			sdk.NewCoin(BAR, osmomath.NewInt(100_000_000)),
+		)
+		fooOsmoPoolCoins = sdk.NewCoins(
+			sdk.NewCoin(FOO, osmomath.NewInt(100_000_000)),
+			sdk.NewCoin(UOSMO, osmomath.NewInt(100_000_000)),
+		)
+		osmoBarPoolCoins = sdk.NewCoins(
+			sdk.NewCoin(UOSMO, osmomath.NewInt(100_000_000)),
+			sdk.NewCoin(BAR, osmomath.NewInt(100_000_000)),
+		)
+		fooBazPoolCoins = sdk.NewCoins(
+			sdk.NewCoin(FOO, osmomath.NewInt(100_000_000)),
+			sdk.NewCoin(BAZ, osmomath.NewInt(100_000_000)),
+		)
+		bazBarPoolCoins = sdk.NewCoins(
+			sdk.NewCoin(BAZ, osmomath.NewInt(100_000_000)),
+			sdk.NewCoin(BAR, osmomath.NewInt(100_000_000)),
+		)
+	)
+
+	tests := map[string]struct {
+		poolCoins []sdk.Coins
+		// denom pairs set in protorev, indexed by the pool id minus one.
+		protorevPairs  map[int][2]string
+		tokenIn        sdk.Coin
+		tokenOutDenom  string
+		maxRoutes      uint32
+		expectedRoutes [][]uint64
+		expectedErr    error
+	}{
+		"single pool": {
+			poolCoins:      []sdk.Coins{poolCoins},
+			protorevPairs:  map[int][2]string{0: {FOO, BAR}},
+			tokenIn:        tokenIn,
+			tokenOutDenom:  BAR,
+			expectedRoutes: [][]uint64{{1}},
+		},
+		"only the pool of the pair tracked by protorev is a candidate": {
+			poolCoins:      []sdk.Coins{poolCoins, poolCoins},
+			protorevPairs:  map[int][2]string{1: {FOO, BAR}},
+			tokenIn:        tokenIn,
+			tokenOutDenom:  BAR,
+			expectedRoutes: [][]uint64{{2}},
+		},
+		"split capped by max routes": {
+			poolCoins:      []sdk.Coins{poolCoins, fooOsmoPoolCoins, osmoBarPoolCoins},
+			protorevPairs:  map[int][2]string{0: {FOO, BAR}, 1: {UOSMO, FOO}, 2: {UOSMO, BAR}},
+			tokenIn:        tokenIn,
+			tokenOutDenom:  BAR,
+			maxRoutes:      1,
+			expectedRoutes: [][]uint64{{1}},
+		},
+		"split across a direct pool and a two hop route through protorev pools": {
+			poolCoins:      []sdk.Coins{poolCoins, fooOsmoPoolCoins, osmoBarPoolCoins},
+			protorevPairs:  map[int][2]string{0: {FOO, BAR}, 1: {UOSMO, FOO}, 2: {UOSMO, BAR}},
+			tokenIn:        tokenIn,
+			tokenOutDenom:  BAR,
+			expectedRoutes: [][]uint64{{1}, {2, 3}},
+		},
+		"pair not tracked by protorev": {
+			poolCoins:     []sdk.Coins{poolCoins},
+			tokenIn:       tokenIn,
+			tokenOutDenom: BAR,
+			expectedErr:   types.NoRouteFoundError{TokenInDenom: FOO, TokenOutDenom: BAR},
+		},
+		"two hop route not through a base denom": {
+			poolCoins:     []sdk.Coins{fooBazPoolCoins, bazBarPoolCoins},
+			protorevPairs: map[int][2]string{0: {BAZ, FOO}, 1: {BAZ, BAR}},
+			tokenIn:       tokenIn,
+			tokenOutDenom: BAR,
+			expectedErr:   types.NoRouteFoundError{TokenInDenom: FOO, TokenOutDenom: BAR},
+		},
+		"same token in and token out denoms": {
+			poolCoins:     []sdk.Coins{poolCoins},
+			tokenIn:       tokenIn,
+			tokenOutDenom: FOO,
+			expectedErr:   types.ErrSameTokenInAndOutDenom,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.createBalancerPoolsFromCoins(tc.poolCoins)
+			for i, pair := range tc.protorevPairs {
+				s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, pair[0], pair[1], uint64(i+1))
+			}
+
+			routes, tokenOutAmount, err := s.App.PoolManagerKeeper.EstimateBestSplitSwapExactAmountIn(s.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxRoutes)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			routePoolIds := make([][]uint64, len(routes))
+			totalTokenInAmount := osmomath.ZeroInt()
+			for i, route := range routes {
+				routePoolIds[i] = types.SwapAmountInRoutes(route.Pools).PoolIds()
+				totalTokenInAmount = totalTokenInAmount.Add(route.TokenInAmount)
+			}
+			s.Require().Equal(tc.expectedRoutes, routePoolIds)
+			s.Require().Equal(tc.tokenIn.Amount, totalTokenInAmount)
+
+			// The split must give at least the token out of the best single route.
+			bestSingleRouteTokenOut, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, routes[0].Pools, tc.tokenIn)
+			s.Require().NoError(err)
+			s.Require().True(tokenOutAmount.GTE(bestSingleRouteTokenOut))
+			if len(routes) > 1 {
+				s.Require().True(tokenOutAmount.GT(bestSingleRouteTokenOut))
+			}
+
+			// The estimate must match the output of the split route swap.
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(tc.tokenIn))
+			swapTokenOutAmount, err := s.App.PoolManagerKeeper.SplitRouteExactAmountIn(s.Ctx, s.TestAccs[0], routes, tc.tokenIn.Denom, osmomath.OneInt())
+			s.Require().NoError(err)
+			s.Require().Equal(tokenOutAmount, swapTokenOutAmount)
+		})
+	}
+}
@@ -27,6 +27,20 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// Will be parsed to string.
 	FlagRoutesFile = "routes-file"
+	// Will be parsed to types.TriggerDirection.
+	FlagTriggerDirection = "trigger-direction"
+	// Will be parsed to types.PriceSource.
+	FlagPriceSource = "price-source"
+	// Will be parsed to time.Duration.
+	FlagTwapDuration = "twap-duration"
+	// Will be parsed to string.
+	FlagSender = "sender"
+	// Will be parsed to uint32.
+	FlagMaxRoutes = "max-routes"
+	// Will be parsed to string.
+	FlagAffiliate = "affiliate"
+	// Will be parsed to osmomath.Dec.
+	FlagExternalPrice = "external-price"
 )
 
 type createBalancerPoolInputs struct {
@@ -106,3 +120,40 @@ func FlagSetCreateRoutes() *flag.FlagSet {
 	fs.String(FlagRoutesFile, "", "Routes json file path (if this path is given, other routes flags should not be used)")
 	return fs
 }
+
+func FlagSetConditionalSwapTrigger() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagTriggerDirection, "at-or-below", "Side of the trigger price the route price has to cross to execute the swap (at-or-below or at-or-above)")
+	fs.String(FlagPriceSource, "spot", "Source of the route price compared against the trigger price (spot or arithmetic-twap)")
+	fs.Duration(FlagTwapDuration, 0, "Duration of the twap of the route price, only for the arithmetic-twap price source")
+	return fs
+}
+
+func FlagSetConditionalSwapSender() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagSender, "", "Only return the conditional swaps placed by this address")
+	return fs
+}
+
+func FlagSetBestSplitMaxRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagMaxRoutes, "0", "Maximum number of routes to split the token in across (0 for the module maximum)")
+	return fs
+}
+
+func FlagSetAffiliate() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagAffiliate, "", "Frontend address receiving a rebate of the taker fee, if whitelisted by governance")
+	return fs
+}
+
+func FlagSetExternalPrice() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagExternalPrice, "0", "Reference price of the token out in units of the token in, reducing the max price impact by the deviation of the spot price from it (0 to ignore)")
+	return fs
+}
@@ -25,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSinglePoolSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSinglePoolSwapExactAmountOut)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateBestSplitSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdSpotPrice)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTotalPoolLiquidity)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAllPools)
@@ -40,6 +41,12 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRegisteredAlloyedPoolFromDenom)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRegisteredAlloyedPoolFromPoolId)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetAllRegisteredAlloyedPools)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetConditionalSwap)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetConditionalSwaps)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTraderVolume)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTraderTakerFeeTier)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAffiliateRebates)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdSimulateSwapExactAmountIn)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -141,6 +148,33 @@ func EstimateSwapExactAmountInParseArgs(args []string, fs *flag.FlagSet) (proto.
 	}, nil
 }
 
+// GetCmdSimulateSwapExactAmountIn returns the per hop breakdown of a swap route simulated from a sender.
+func GetCmdSimulateSwapExactAmountIn() (*osmocli.QueryDescriptor, *queryproto.SimulateSwapExactAmountInRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "simulate-swap-exact-amount-in",
+		Short: "Query the per hop breakdown of a swap route simulated from a sender",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} simulate-swap-exact-amount-in osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj 1000stake --swap-route-pool-ids=2 --swap-route-pool-ids=3`,
+		ParseQuery:          SimulateSwapExactAmountInParseArgs,
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
+		QueryFnName:         "SimulateSwapExactAmountIn",
+		CustomFlagOverrides: customRouterFlagOverride,
+	}, &queryproto.SimulateSwapExactAmountInRequest{}
+}
+
+func SimulateSwapExactAmountInParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
+	routes, err := swapAmountInRoutes(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.SimulateSwapExactAmountInRequest{
+		Sender:  args[0],
+		TokenIn: args[1],
+		Routes:  routes,
+	}, nil
+}
+
 func EstimateSwapExactAmountOutParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
 	poolID, err := strconv.Atoi(args[0])
 	if err != nil {
@@ -279,3 +313,67 @@ func GetAllRegisteredAlloyedPools() (*osmocli.QueryDescriptor, *queryproto.AllRe
 		Long:  "{{.Short}}",
 	}, &queryproto.AllRegisteredAlloyedPoolsRequest{}
 }
+
+// GetCmdEstimateBestSplitSwapExactAmountIn returns the best split of the token in across routes to the token out denom.
+func GetCmdEstimateBestSplitSwapExactAmountIn() (*osmocli.QueryDescriptor, *queryproto.EstimateBestSplitSwapExactAmountInRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-best-split-swap-exact-amount-in",
+		Short: "Query the best split of a token in across routes to a token out denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-best-split-swap-exact-amount-in 1000stake uosmo --max-routes 3`,
+		QueryFnName:         "EstimateBestSplitSwapExactAmountIn",
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetBestSplitMaxRoutes()}},
+		CustomFlagOverrides: map[string]string{"MaxRoutes": FlagMaxRoutes},
+	}, &queryproto.EstimateBestSplitSwapExactAmountInRequest{}
+}
+
+func GetConditionalSwap() (*osmocli.QueryDescriptor, *queryproto.ConditionalSwapRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "conditional-swap",
+		Short: "Query a pending conditional swap by id",
+		Long: `{{.Short}}
+		{{.CommandPrefix}} conditional-swap 1`,
+	}, &queryproto.ConditionalSwapRequest{}
+}
+
+func GetConditionalSwaps() (*osmocli.QueryDescriptor, *queryproto.ConditionalSwapsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "conditional-swaps",
+		Short: "Query pending conditional swaps, optionally placed by a sender",
+		Long: `{{.Short}}
+		{{.CommandPrefix}} conditional-swaps --sender osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`,
+		HasPagination:       true,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetConditionalSwapSender()}},
+		CustomFlagOverrides: map[string]string{"Sender": FlagSender},
+	}, &queryproto.ConditionalSwapsRequest{}
+}
+
+// GetCmdTraderVolume returns the swap volume of a trader over the last 30 days.
+func GetCmdTraderVolume() (*osmocli.QueryDescriptor, *queryproto.TraderVolumeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "trader-volume",
+		Short: "Query the swap volume of a trader over the last 30 days, in OSMO",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} trader-volume osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`,
+	}, &queryproto.TraderVolumeRequest{}
+}
+
+// GetCmdTraderTakerFeeTier returns the taker fee tier of a trader.
+func GetCmdTraderTakerFeeTier() (*osmocli.QueryDescriptor, *queryproto.TraderTakerFeeTierRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "trader-taker-fee-tier",
+		Short: "Query the taker fee tier of a trader given its swap volume over the last 30 days",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} trader-taker-fee-tier osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`,
+	}, &queryproto.TraderTakerFeeTierRequest{}
+}
+
+// GetCmdAffiliateRebates returns the taker fee rebates received by an affiliate frontend.
+func GetCmdAffiliateRebates() (*osmocli.QueryDescriptor, *queryproto.AffiliateRebatesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "affiliate-rebates",
+		Short: "Query the taker fee rebates received by an affiliate frontend",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} affiliate-rebates osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`,
+	}, &queryproto.AffiliateRebatesRequest{}
+}
@@ -37,6 +37,9 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountOutCmd)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountIn)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountOut)
+	osmocli.AddTxCmd(txCmd, NewPlaceConditionalSwapCmd)
+	osmocli.AddTxCmd(txCmd, NewCancelConditionalSwapCmd)
+	osmocli.AddTxCmd(txCmd, NewSwapExactAmountInWithMaxPriceImpactCmd)
 	txCmd.AddCommand(NewSetDenomPairTakerFeeCmd())
 
 	txCmd.AddCommand(
@@ -54,7 +57,11 @@ func NewSwapExactAmountInCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountIn)
 		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
 			"Routes": osmocli.FlagOnlyParser(swapAmountInRoutes),
 		},
-		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
+		CustomFlagOverrides: map[string]string{"Affiliate": FlagAffiliate},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetAffiliate()},
+		},
 	}, &types.MsgSwapExactAmountIn{}
 }
 
@@ -66,7 +73,10 @@ func NewSwapExactAmountOutCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountOu
 		Example:          "osmosisd tx poolmanager swap-exact-amount-out 100uion 1000000 --swap-route-pool-ids 1 --swap-route-denoms uosmo --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
 		NumArgs:          2,
 		ParseAndBuildMsg: NewBuildSwapExactAmountOutMsg,
-		Flags:            osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetAffiliate()},
+		},
 	}, &types.MsgSwapExactAmountOut{}
 }
 
@@ -110,8 +120,10 @@ func NewSplitRouteSwapExactAmountIn() (*osmocli.TxCliDesc, *types.MsgSplitRouteS
 		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
 			"Routes": osmocli.FlagOnlyParser(NewMsgNewSplitRouteSwapExactAmountIn),
 		},
+		CustomFlagOverrides: map[string]string{"Affiliate": FlagAffiliate},
 		Flags: osmocli.FlagDesc{
 			RequiredFlags: []*flag.FlagSet{FlagSetCreateRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetAffiliate()},
 		},
 	}, &types.MsgSplitRouteSwapExactAmountIn{}
 }
@@ -156,12 +168,55 @@ func NewSplitRouteSwapExactAmountOut() (*osmocli.TxCliDesc, *types.MsgSplitRoute
 		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
 			"Routes": osmocli.FlagOnlyParser(NewMsgNewSplitRouteSwapExactAmountOut),
 		},
+		CustomFlagOverrides: map[string]string{"Affiliate": FlagAffiliate},
 		Flags: osmocli.FlagDesc{
 			RequiredFlags: []*flag.FlagSet{FlagSetCreateRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetAffiliate()},
 		},
 	}, &types.MsgSplitRouteSwapExactAmountOut{}
 }
 
+func NewPlaceConditionalSwapCmd() (*osmocli.TxCliDesc, *types.MsgPlaceConditionalSwap) {
+	return &osmocli.TxCliDesc{
+		Use:   "place-conditional-swap",
+		Short: "escrow tokens to swap along a route once its price crosses a trigger price, until an expiry",
+		Long: `Escrow tokens to swap along a route once the route price crosses the trigger price, until the expiry.
+The route price is the price of the token out in units of the token in. Expiry is an RFC3339 timestamp.`,
+		Example:          "osmosisd tx poolmanager place-conditional-swap 2000000uosmo 1 0.5 2025-01-01T00:00:00Z --swap-route-pool-ids 5 --swap-route-denoms uion --trigger-direction at-or-below --price-source arithmetic-twap --twap-duration 10m --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+		NumArgs:          4,
+		ParseAndBuildMsg: NewBuildPlaceConditionalSwapMsg,
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetConditionalSwapTrigger()},
+		},
+	}, &types.MsgPlaceConditionalSwap{}
+}
+
+func NewCancelConditionalSwapCmd() (*osmocli.TxCliDesc, *types.MsgCancelConditionalSwap) {
+	return &osmocli.TxCliDesc{
+		Use:     "cancel-conditional-swap",
+		Short:   "cancel a pending conditional swap and refund its escrowed tokens",
+		Example: "osmosisd tx poolmanager cancel-conditional-swap 1 --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+	}, &types.MsgCancelConditionalSwap{}
+}
+
+func NewSwapExactAmountInWithMaxPriceImpactCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountInWithMaxPriceImpact) {
+	return &osmocli.TxCliDesc{
+		Use:   "swap-exact-amount-in-with-max-price-impact",
+		Short: "swap as much of a token in as possible in a pool without exceeding a max price impact",
+		Long: `Swap as much of the token in as possible in the pool without exceeding the max price impact.
+The part of the token in that would exceed the max price impact is not swapped.`,
+		Example: "osmosisd tx poolmanager swap-exact-amount-in-with-max-price-impact 5 2000000uosmo uion 0.01 --external-price 0.5 --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+		CustomFlagOverrides: map[string]string{
+			"ExternalPrice": FlagExternalPrice,
+			"Affiliate":     FlagAffiliate,
+		},
+		Flags: osmocli.FlagDesc{
+			OptionalFlags: []*flag.FlagSet{FlagSetExternalPrice(), FlagSetAffiliate()},
+		},
+	}, &types.MsgSwapExactAmountInWithMaxPriceImpact{}
+}
+
 func NewMsgNewSplitRouteSwapExactAmountOut(fs *flag.FlagSet) ([]types.SwapAmountOutSplitRoute, error) {
 	routesFile, _ := fs.GetString(FlagRoutesFile)
 	if routesFile == "" {
@@ -236,14 +291,100 @@ func NewBuildSwapExactAmountOutMsg(clientCtx client.Context, args []string, fs *
 	if !ok {
 		return nil, errors.New("invalid token in max amount")
 	}
+
+	affiliate, err := fs.GetString(FlagAffiliate)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgSwapExactAmountOut{
 		Sender:           clientCtx.GetFromAddress().String(),
 		Routes:           routes,
 		TokenInMaxAmount: tokenInMaxAmount,
 		TokenOut:         tokenOut,
+		Affiliate:        affiliate,
+	}, nil
+}
+
+func NewBuildPlaceConditionalSwapMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	tokenInStr, tokenOutMinAmountStr, triggerPriceStr, expiryStr := args[0], args[1], args[2], args[3]
+	routes, err := swapAmountInRoutes(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(tokenInStr)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutMinAmount, ok := osmomath.NewIntFromString(tokenOutMinAmountStr)
+	if !ok {
+		return nil, errors.New("invalid token out min amount")
+	}
+
+	triggerPrice, err := osmomath.NewDecFromStr(triggerPriceStr)
+	if err != nil {
+		return nil, err
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expiryStr)
+	if err != nil {
+		return nil, err
+	}
+
+	trigger, err := conditionalSwapTrigger(fs, triggerPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPlaceConditionalSwap{
+		Sender:            clientCtx.GetFromAddress().String(),
+		Routes:            routes,
+		TokenIn:           tokenIn,
+		TokenOutMinAmount: tokenOutMinAmount,
+		Trigger:           trigger,
+		Expiry:            expiry,
 	}, nil
 }
 
+func conditionalSwapTrigger(fs *flag.FlagSet, price osmomath.Dec) (types.SwapTrigger, error) {
+	trigger := types.SwapTrigger{Price: price}
+
+	direction, err := fs.GetString(FlagTriggerDirection)
+	if err != nil {
+		return types.SwapTrigger{}, err
+	}
+	switch direction {
+	case "at-or-below":
+		trigger.Direction = types.TriggerDirectionAtOrBelow
+	case "at-or-above":
+		trigger.Direction = types.TriggerDirectionAtOrAbove
+	default:
+		return types.SwapTrigger{}, fmt.Errorf("invalid trigger direction (%s), expected at-or-below or at-or-above", direction)
+	}
+
+	priceSource, err := fs.GetString(FlagPriceSource)
+	if err != nil {
+		return types.SwapTrigger{}, err
+	}
+	switch priceSource {
+	case "spot":
+		trigger.PriceSource = types.PriceSourceSpot
+	case "arithmetic-twap":
+		trigger.PriceSource = types.PriceSourceArithmeticTwap
+	default:
+		return types.SwapTrigger{}, fmt.Errorf("invalid price source (%s), expected spot or arithmetic-twap", priceSource)
+	}
+
+	trigger.TwapDuration, err = fs.GetDuration(FlagTwapDuration)
+	if err != nil {
+		return types.SwapTrigger{}, err
+	}
+
+	return trigger, nil
+}
+
 func NewCreatePoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-pool [flags]",
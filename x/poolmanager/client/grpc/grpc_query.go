@@ -31,6 +31,26 @@ func (q Querier) TradingPairTakerFee(grpcCtx context.Context,
 	return q.Q.TradingPairTakerFee(ctx, *req)
 }
 
+func (q Querier) TraderVolume(grpcCtx context.Context,
+	req *queryproto.TraderVolumeRequest,
+) (*queryproto.TraderVolumeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TraderVolume(ctx, *req)
+}
+
+func (q Querier) TraderTakerFeeTier(grpcCtx context.Context,
+	req *queryproto.TraderTakerFeeTierRequest,
+) (*queryproto.TraderTakerFeeTierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TraderTakerFeeTier(ctx, *req)
+}
+
 func (q Querier) TotalVolumeForPool(grpcCtx context.Context,
 	req *queryproto.TotalVolumeForPoolRequest,
 ) (*queryproto.TotalVolumeForPoolResponse, error) {
@@ -91,6 +111,16 @@ func (q Querier) SpotPrice(grpcCtx context.Context,
 	return q.Q.SpotPrice(ctx, *req)
 }
 
+func (q Querier) SimulateSwapExactAmountIn(grpcCtx context.Context,
+	req *queryproto.SimulateSwapExactAmountInRequest,
+) (*queryproto.SimulateSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.SimulateSwapExactAmountIn(ctx, *req)
+}
+
 func (q Querier) RegisteredAlloyedPoolFromPoolId(grpcCtx context.Context,
 	req *queryproto.RegisteredAlloyedPoolFromPoolIdRequest,
 ) (*queryproto.RegisteredAlloyedPoolFromPoolIdResponse, error) {
@@ -221,6 +251,36 @@ func (q Querier) EstimateSinglePoolSwapExactAmountIn(grpcCtx context.Context,
 	return q.Q.EstimateSinglePoolSwapExactAmountIn(ctx, *req)
 }
 
+func (q Querier) EstimateBestSplitSwapExactAmountIn(grpcCtx context.Context,
+	req *queryproto.EstimateBestSplitSwapExactAmountInRequest,
+) (*queryproto.EstimateBestSplitSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateBestSplitSwapExactAmountIn(ctx, *req)
+}
+
+func (q Querier) ConditionalSwaps(grpcCtx context.Context,
+	req *queryproto.ConditionalSwapsRequest,
+) (*queryproto.ConditionalSwapsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.ConditionalSwaps(ctx, *req)
+}
+
+func (q Querier) ConditionalSwap(grpcCtx context.Context,
+	req *queryproto.ConditionalSwapRequest,
+) (*queryproto.ConditionalSwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.ConditionalSwap(ctx, *req)
+}
+
 func (q Querier) AllTakerFeeShareAgreements(grpcCtx context.Context,
 	req *queryproto.AllTakerFeeShareAgreementsRequest,
 ) (*queryproto.AllTakerFeeShareAgreementsResponse, error) {
@@ -261,3 +321,13 @@ func (q Querier) AllPools(grpcCtx context.Context,
 	return q.Q.AllPools(ctx, *req)
 }
 
+func (q Querier) AffiliateRebates(grpcCtx context.Context,
+	req *queryproto.AffiliateRebatesRequest,
+) (*queryproto.AffiliateRebatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.AffiliateRebates(ctx, *req)
+}
+
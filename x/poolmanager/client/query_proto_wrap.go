@@ -7,7 +7,6 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
-	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v31/x/poolmanager"
 	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/client/queryprotov2"
@@ -94,6 +93,32 @@ func (q Querier) EstimateSwapExactAmountInWithPrimitiveTypes(ctx sdk.Context, re
 	}, nil
 }
 
+// EstimateBestSplitSwapExactAmountIn estimates the best split of the token in across routes to the token out denom.
+func (q Querier) EstimateBestSplitSwapExactAmountIn(ctx sdk.Context, req queryproto.EstimateBestSplitSwapExactAmountInRequest) (*queryproto.EstimateBestSplitSwapExactAmountInResponse, error) {
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token out denom: %s", err.Error())
+	}
+
+	routes, tokenOutAmount, err := q.K.EstimateBestSplitSwapExactAmountIn(ctx, tokenIn, req.TokenOutDenom, req.MaxRoutes)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.EstimateBestSplitSwapExactAmountInResponse{
+		Routes:         routes,
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
 // EstimateSwapExactAmountOut estimates token output amount for a swap.
 func (q Querier) EstimateSwapExactAmountOut(ctx sdk.Context, req queryproto.EstimateSwapExactAmountOutRequest) (*queryproto.EstimateSwapExactAmountOutResponse, error) {
 	if req.TokenOut == "" {
@@ -351,58 +376,11 @@ func (q Querier) EstimateTradeBasedOnPriceImpact(
 		return nil, status.Error(codes.InvalidArgument, "invalid to coin denom")
 	}
 
-	swapModule, err := q.K.GetPoolModule(ctx, req.PoolId)
+	res, err := q.K.EstimateTradeBasedOnPriceImpact(ctx, req)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-
-	poolI, poolErr := swapModule.GetPool(ctx, req.PoolId)
-	if poolErr != nil {
-		return nil, status.Error(codes.Internal, poolErr.Error())
-	}
-
-	spotPriceBigDec, err := swapModule.CalculateSpotPrice(ctx, req.PoolId, req.FromCoin.Denom, req.ToCoinDenom)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	// Convert to normal Dec
-	spotPrice := spotPriceBigDec.Dec()
-
-	// If ExternalPrice is specified we need to adjust the maxPriceImpact based on the deviation between spot and
-	// external price.
-	adjustedMaxPriceImpact := req.MaxPriceImpact
-	if !req.ExternalPrice.IsZero() {
-		priceDeviation := spotPrice.Sub(req.ExternalPrice).Quo(req.ExternalPrice)
-		adjustedMaxPriceImpact = adjustedMaxPriceImpact.Sub(priceDeviation)
-
-		// If the adjusted max price impact is negative or zero it means the difference between spot and external
-		// already exceeds the max price impact.
-		if adjustedMaxPriceImpact.IsZero() || adjustedMaxPriceImpact.IsNegative() {
-			return &queryproto.EstimateTradeBasedOnPriceImpactResponse{
-				InputCoin:  sdk.NewCoin(req.FromCoin.Denom, osmomath.ZeroInt()),
-				OutputCoin: sdk.NewCoin(req.ToCoinDenom, osmomath.ZeroInt()),
-			}, nil
-		}
-	}
-
-	// Process the estimates according to the pool type.
-	switch poolI.GetType() {
-	case types.Balancer:
-		return q.K.EstimateTradeBasedOnPriceImpactBalancerPool(
-			ctx, req, spotPrice, adjustedMaxPriceImpact, swapModule, poolI,
-		)
-	case types.Stableswap:
-		return q.K.EstimateTradeBasedOnPriceImpactStableSwapPool(
-			ctx, req, spotPrice, adjustedMaxPriceImpact, swapModule, poolI,
-		)
-	case types.Concentrated:
-		return q.K.EstimateTradeBasedOnPriceImpactConcentratedLiquidity(
-			ctx, req, spotPrice, adjustedMaxPriceImpact, swapModule, poolI,
-		)
-	default:
-		return nil, status.Error(codes.Internal, "pool type not supported")
-	}
+	return res, nil
 }
 
 func (q Querier) AllTakerFeeShareAgreements(ctx sdk.Context, req queryproto.AllTakerFeeShareAgreementsRequest) (*queryproto.AllTakerFeeShareAgreementsResponse, error) {
@@ -422,6 +400,8 @@ func (q Querier) TakerFeeShareAgreementFromDenom(ctx sdk.Context, req queryproto
 	}
 	return &queryproto.TakerFeeShareAgreementFromDenomResponse{
 		TakerFeeShareAgreement: takerFeeShareAgreement,
+		CurrentSkimPercent:     takerFeeShareAgreement.SkimPercentAt(ctx.BlockTime()),
+		SkimmedTotal:           q.K.GetTakerFeeShareSkimmedTotal(ctx, req.Denom),
 	}, nil
 }
 
@@ -479,3 +459,89 @@ func (q Querier) AllRegisteredAlloyedPools(ctx sdk.Context, req queryproto.AllRe
 		ContractStates: contractStates,
 	}, nil
 }
+
+// ConditionalSwap returns the pending conditional swap with the given id.
+func (q Querier) ConditionalSwap(ctx sdk.Context, req queryproto.ConditionalSwapRequest) (*queryproto.ConditionalSwapResponse, error) {
+	conditionalSwap, err := q.K.GetConditionalSwap(ctx, req.ConditionalSwapId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &queryproto.ConditionalSwapResponse{
+		ConditionalSwap: conditionalSwap,
+	}, nil
+}
+
+// ConditionalSwaps returns the pending conditional swaps, optionally filtered by sender.
+func (q Querier) ConditionalSwaps(ctx sdk.Context, req queryproto.ConditionalSwapsRequest) (*queryproto.ConditionalSwapsResponse, error) {
+	conditionalSwaps, pageRes, err := q.K.GetConditionalSwaps(ctx, req.Sender, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.ConditionalSwapsResponse{
+		ConditionalSwaps: conditionalSwaps,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// TraderVolume returns the swap volume of a trader over the last days, tracked for the taker fee tiers.
+func (q Querier) TraderVolume(ctx sdk.Context, req queryproto.TraderVolumeRequest) (*queryproto.TraderVolumeResponse, error) {
+	trader, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.TraderVolumeResponse{
+		Volume: q.K.GetTraderVolume(ctx, trader),
+	}, nil
+}
+
+// TraderTakerFeeTier returns the taker fee tier of a trader given its swap volume over the last days.
+func (q Querier) TraderTakerFeeTier(ctx sdk.Context, req queryproto.TraderTakerFeeTierRequest) (*queryproto.TraderTakerFeeTierResponse, error) {
+	trader, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	volume, tier, found := q.K.GetTraderTakerFeeTier(ctx, trader)
+	res := &queryproto.TraderTakerFeeTierResponse{Volume: volume}
+	if found {
+		res.Tier = &tier
+	}
+	return res, nil
+}
+
+// AffiliateRebates returns the taker fee rebates received by an affiliate frontend.
+func (q Querier) AffiliateRebates(ctx sdk.Context, req queryproto.AffiliateRebatesRequest) (*queryproto.AffiliateRebatesResponse, error) {
+	affiliate, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.AffiliateRebatesResponse{
+		Rebates: q.K.GetAffiliateRebates(ctx, affiliate),
+	}, nil
+}
+
+func (q Querier) SimulateSwapExactAmountIn(ctx sdk.Context, req queryproto.SimulateSwapExactAmountInRequest) (*queryproto.SimulateSwapExactAmountInResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	tokenOutAmount, hops, err := q.K.SimulateSwapExactAmountIn(ctx, sender, req.Routes, tokenIn)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.SimulateSwapExactAmountInResponse{
+		TokenOutAmount: tokenOutAmount,
+		Hops:           hops,
+	}, nil
+}
@@ -0,0 +1,430 @@
+package poolmanager
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+// PlaceConditionalSwap charges the placement fee, escrows the token in of the sender and stores a conditional swap
+// of it along the given routes. The conditional swap executes at the first end block where its trigger is met,
+// and is refunded once the block time passes its expiry. The placement fee is sent to the community pool and is
+// not refunded, so that pending conditional swaps can't be spammed to delay the processing of the others.
+// Returns the id of the placed conditional swap.
+// Returns error if:
+// - the expiry is not after the block time.
+// - the route price can not be computed, e.g. one of the pools does not exist or does not hold the route denoms.
+// - the sender does not have enough funds to pay the placement fee and escrow the token in.
+func (k Keeper) PlaceConditionalSwap(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount osmomath.Int,
+	trigger types.SwapTrigger,
+	expiry time.Time,
+) (uint64, error) {
+	if !expiry.After(ctx.BlockTime()) {
+		return 0, types.ConditionalSwapExpiredError{Expiry: expiry, BlockTime: ctx.BlockTime()}
+	}
+
+	// Price the route with the spot price regardless of the trigger's price source, to fail
+	// early on routes that can never execute. The twap may not be available yet for recent pools.
+	if _, err := k.routeSpotPrice(ctx, routes, tokenIn.Denom); err != nil {
+		return 0, err
+	}
+
+	var placementFee sdk.Coins
+	k.GetParam(ctx, types.KeyConditionalSwapPlacementFee, &placementFee)
+	if !placementFee.IsZero() {
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, placementFee, sender); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ConditionalSwapEscrowName, sdk.NewCoins(tokenIn)); err != nil {
+		return 0, err
+	}
+
+	conditionalSwapId := k.getNextConditionalSwapIdAndIncrement(ctx)
+	conditionalSwap := types.ConditionalSwap{
+		Id:                conditionalSwapId,
+		Sender:            sender.String(),
+		Routes:            routes,
+		TokenIn:           tokenIn,
+		TokenOutMinAmount: tokenOutMinAmount,
+		Trigger:           trigger,
+		Expiry:            expiry,
+	}
+	k.setConditionalSwap(ctx, conditionalSwap)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtConditionalSwapPlaced,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyConditionalSwapId, strconv.FormatUint(conditionalSwapId, 10)),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, tokenIn.String()),
+		),
+	})
+
+	return conditionalSwapId, nil
+}
+
+// CancelConditionalSwap removes the pending conditional swap with the given id and refunds its escrowed token in.
+// Returns the refunded token in.
+// Returns error if the conditional swap does not exist or if the sender is not the one who placed it.
+func (k Keeper) CancelConditionalSwap(ctx sdk.Context, sender sdk.AccAddress, conditionalSwapId uint64) (sdk.Coin, error) {
+	conditionalSwap, err := k.GetConditionalSwap(ctx, conditionalSwapId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if conditionalSwap.Sender != sender.String() {
+		return sdk.Coin{}, types.UnauthorizedConditionalSwapCancelError{ConditionalSwapId: conditionalSwapId, Sender: sender.String()}
+	}
+
+	if err := k.refundConditionalSwap(ctx, conditionalSwap, types.TypeEvtConditionalSwapCancelled); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return conditionalSwap.TokenIn, nil
+}
+
+// GetConditionalSwap returns the pending conditional swap with the given id.
+// Returns error if it does not exist.
+func (k Keeper) GetConditionalSwap(ctx sdk.Context, conditionalSwapId uint64) (types.ConditionalSwap, error) {
+	store := ctx.KVStore(k.storeKey)
+	conditionalSwap := types.ConditionalSwap{}
+	found, err := osmoutils.Get(store, types.FormatConditionalSwapKey(conditionalSwapId), &conditionalSwap)
+	if err != nil {
+		return types.ConditionalSwap{}, err
+	}
+	if !found {
+		return types.ConditionalSwap{}, types.ConditionalSwapNotFoundError{ConditionalSwapId: conditionalSwapId}
+	}
+	return conditionalSwap, nil
+}
+
+// GetConditionalSwaps returns the pending conditional swaps in the order they were placed, paginated.
+// If sender is non-empty, only the conditional swaps placed by it are returned.
+func (k Keeper) GetConditionalSwaps(ctx sdk.Context, sender string, pagination *query.PageRequest) ([]types.ConditionalSwap, *query.PageResponse, error) {
+	conditionalSwaps := []types.ConditionalSwap{}
+
+	if sender == "" {
+		conditionalSwapStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConditionalSwapPrefix)
+		pageRes, err := query.Paginate(conditionalSwapStore, pagination, func(_, value []byte) error {
+			conditionalSwap, err := parseConditionalSwap(value)
+			if err != nil {
+				return err
+			}
+			conditionalSwaps = append(conditionalSwaps, conditionalSwap)
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return conditionalSwaps, pageRes, nil
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The sender index keys are suffixed by the big endian conditional swap id.
+	senderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FormatConditionalSwapBySenderPrefix(senderAddr))
+	pageRes, err := query.Paginate(senderStore, pagination, func(key, _ []byte) error {
+		if len(key) != 8 {
+			return fmt.Errorf("invalid conditional swap sender index key (%v)", key)
+		}
+		conditionalSwap, err := k.GetConditionalSwap(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		conditionalSwaps = append(conditionalSwaps, conditionalSwap)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return conditionalSwaps, pageRes, nil
+}
+
+// GetNextConditionalSwapId returns the id of the next conditional swap to be placed.
+func (k Keeper) GetNextConditionalSwapId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	nextConditionalSwapId := gogotypes.UInt64Value{}
+	found, err := osmoutils.Get(store, types.KeyNextConditionalSwapId, &nextConditionalSwapId)
+	if err != nil {
+		panic(err)
+	}
+	// Conditional swap ids start at 1, so that the zero value is never a valid id.
+	if !found {
+		return 1
+	}
+	return nextConditionalSwapId.Value
+}
+
+// SetNextConditionalSwapId sets the id of the next conditional swap to be placed.
+func (k Keeper) SetNextConditionalSwapId(ctx sdk.Context, conditionalSwapId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyNextConditionalSwapId, &gogotypes.UInt64Value{Value: conditionalSwapId})
+}
+
+// getNextConditionalSwapIdAndIncrement returns the next conditional swap id, and increments the corresponding state entry.
+func (k Keeper) getNextConditionalSwapIdAndIncrement(ctx sdk.Context) uint64 {
+	nextConditionalSwapId := k.GetNextConditionalSwapId(ctx)
+	k.SetNextConditionalSwapId(ctx, nextConditionalSwapId+1)
+	return nextConditionalSwapId
+}
+
+// getLastCheckedConditionalSwapId returns the id of the last conditional swap whose trigger was checked in end block.
+func (k Keeper) getLastCheckedConditionalSwapId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	lastCheckedConditionalSwapId := gogotypes.UInt64Value{}
+	_, err := osmoutils.Get(store, types.KeyLastCheckedConditionalSwapId, &lastCheckedConditionalSwapId)
+	if err != nil {
+		panic(err)
+	}
+	return lastCheckedConditionalSwapId.Value
+}
+
+// setLastCheckedConditionalSwapId sets the id of the last conditional swap whose trigger was checked in end block.
+func (k Keeper) setLastCheckedConditionalSwapId(ctx sdk.Context, conditionalSwapId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyLastCheckedConditionalSwapId, &gogotypes.UInt64Value{Value: conditionalSwapId})
+}
+
+// setConditionalSwap stores the conditional swap along with its sender and expiry indexes.
+func (k Keeper) setConditionalSwap(ctx sdk.Context, conditionalSwap types.ConditionalSwap) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.FormatConditionalSwapKey(conditionalSwap.Id), &conditionalSwap)
+	store.Set(types.FormatConditionalSwapBySenderKey(sdk.MustAccAddressFromBech32(conditionalSwap.Sender), conditionalSwap.Id), []byte{})
+	store.Set(types.FormatConditionalSwapByExpiryKey(conditionalSwap.Expiry, conditionalSwap.Id), []byte{})
+}
+
+// deleteConditionalSwap removes the conditional swap along with its sender and expiry indexes.
+func (k Keeper) deleteConditionalSwap(ctx sdk.Context, conditionalSwap types.ConditionalSwap) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FormatConditionalSwapKey(conditionalSwap.Id))
+	store.Delete(types.FormatConditionalSwapBySenderKey(sdk.MustAccAddressFromBech32(conditionalSwap.Sender), conditionalSwap.Id))
+	store.Delete(types.FormatConditionalSwapByExpiryKey(conditionalSwap.Expiry, conditionalSwap.Id))
+}
+
+// getAllConditionalSwaps returns all the pending conditional swaps in the order they were placed.
+func (k Keeper) getAllConditionalSwaps(ctx sdk.Context) ([]types.ConditionalSwap, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.ConditionalSwapPrefix, parseConditionalSwap)
+}
+
+func parseConditionalSwap(bz []byte) (types.ConditionalSwap, error) {
+	conditionalSwap := types.ConditionalSwap{}
+	if err := conditionalSwap.Unmarshal(bz); err != nil {
+		return types.ConditionalSwap{}, err
+	}
+	return conditionalSwap, nil
+}
+
+// refundConditionalSwap sends the escrowed token in of the conditional swap back to its sender,
+// removes the conditional swap and emits an event of the given type.
+func (k Keeper) refundConditionalSwap(ctx sdk.Context, conditionalSwap types.ConditionalSwap, eventType string) error {
+	sender := sdk.MustAccAddressFromBech32(conditionalSwap.Sender)
+	escrowAddress := authtypes.NewModuleAddress(types.ConditionalSwapEscrowName)
+	if err := k.bankKeeper.SendCoins(ctx, escrowAddress, sender, sdk.NewCoins(conditionalSwap.TokenIn)); err != nil {
+		return err
+	}
+
+	k.deleteConditionalSwap(ctx, conditionalSwap)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyConditionalSwapId, strconv.FormatUint(conditionalSwap.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeySender, conditionalSwap.Sender),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, conditionalSwap.TokenIn.String()),
+		),
+	})
+
+	return nil
+}
+
+// executeConditionalSwap swaps the escrowed token in of the conditional swap along its routes if its trigger is met,
+// and removes the conditional swap. The token in is released to the sender which then swaps it, so that the
+// taker fee and the traded volume are those of the sender, and the token out is received by the sender.
+// Returns error if the conditional swap expired.
+// Returns ErrConditionalSwapTriggerNotMet if the route price is not on the trigger direction's side of the trigger price.
+func (k Keeper) executeConditionalSwap(ctx sdk.Context, conditionalSwap types.ConditionalSwap) error {
+	// Expired conditional swaps left over by the per block limit are refunded in the next blocks.
+	if ctx.BlockTime().After(conditionalSwap.Expiry) {
+		return types.ConditionalSwapExpiredError{Expiry: conditionalSwap.Expiry, BlockTime: ctx.BlockTime()}
+	}
+
+	routePrice, err := k.routePrice(ctx, conditionalSwap.Routes, conditionalSwap.TokenIn.Denom, conditionalSwap.Trigger)
+	if err != nil {
+		return err
+	}
+	if !conditionalSwap.Trigger.IsMet(routePrice) {
+		return types.ErrConditionalSwapTriggerNotMet
+	}
+
+	sender := sdk.MustAccAddressFromBech32(conditionalSwap.Sender)
+	escrowAddress := authtypes.NewModuleAddress(types.ConditionalSwapEscrowName)
+	if err := k.bankKeeper.SendCoins(ctx, escrowAddress, sender, sdk.NewCoins(conditionalSwap.TokenIn)); err != nil {
+		return err
+	}
+
+	tokenOutAmount, err := k.RouteExactAmountIn(ctx, sender, conditionalSwap.Routes, conditionalSwap.TokenIn, conditionalSwap.TokenOutMinAmount)
+	if err != nil {
+		return err
+	}
+	tokenOut := sdk.NewCoin(conditionalSwap.Routes[len(conditionalSwap.Routes)-1].TokenOutDenom, tokenOutAmount)
+
+	k.deleteConditionalSwap(ctx, conditionalSwap)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtConditionalSwapExecuted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyConditionalSwapId, strconv.FormatUint(conditionalSwap.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeySender, conditionalSwap.Sender),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, conditionalSwap.TokenIn.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, tokenOut.String()),
+		),
+	})
+
+	return nil
+}
+
+// routePrice returns the price of the token out of the routes in units of the token in, from the trigger's price source.
+// It is the product of the prices of each hop of the routes.
+func (k Keeper) routePrice(ctx sdk.Context, routes []types.SwapAmountInRoute, tokenInDenom string, trigger types.SwapTrigger) (osmomath.Dec, error) {
+	if trigger.PriceSource != types.PriceSourceArithmeticTwap {
+		return k.routeSpotPrice(ctx, routes, tokenInDenom)
+	}
+
+	startTime := ctx.BlockTime().Add(-trigger.TwapDuration)
+	price := osmomath.OneDec()
+	hopTokenInDenom := tokenInDenom
+	for _, route := range routes {
+		hopPrice, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, route.PoolId, route.TokenOutDenom, hopTokenInDenom, startTime)
+		if err != nil {
+			return osmomath.Dec{}, err
+		}
+		price = price.Mul(hopPrice)
+		hopTokenInDenom = route.TokenOutDenom
+	}
+	return price, nil
+}
+
+// routeSpotPrice returns the spot price of the token out of the routes in units of the token in.
+// It is the product of the spot prices of each hop of the routes.
+func (k Keeper) routeSpotPrice(ctx sdk.Context, routes []types.SwapAmountInRoute, tokenInDenom string) (osmomath.Dec, error) {
+	price := osmomath.OneBigDec()
+	hopTokenInDenom := tokenInDenom
+	for _, route := range routes {
+		hopPrice, err := k.RouteCalculateSpotPrice(ctx, route.PoolId, hopTokenInDenom, route.TokenOutDenom)
+		if err != nil {
+			return osmomath.Dec{}, err
+		}
+		price = price.Mul(hopPrice)
+		hopTokenInDenom = route.TokenOutDenom
+	}
+	return price.Dec(), nil
+}
+
+// MaxExpiredConditionalSwapsRefundedPerBlock is the maximum number of expired conditional swaps refunded each end block.
+// It doesn't depend on MaxConditionalSwapsPerBlock, so that expired conditional swaps are refunded even when their
+// execution is disabled.
+const MaxExpiredConditionalSwapsRefundedPerBlock = uint64(100)
+
+// processConditionalSwaps refunds up to MaxExpiredConditionalSwapsRefundedPerBlock expired conditional swaps,
+// then checks the triggers of up to MaxConditionalSwapsPerBlock pending ones and executes those that are met.
+// Triggers are checked round-robin, resuming after the last conditional swap checked in the previous block.
+// Conditional swaps whose execution fails, e.g. because the token out min amount is not met, remain pending.
+func (k Keeper) processConditionalSwaps(ctx sdk.Context) {
+	for _, conditionalSwap := range k.getExpiredConditionalSwaps(ctx, MaxExpiredConditionalSwapsRefundedPerBlock) {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.refundConditionalSwap(cacheCtx, conditionalSwap, types.TypeEvtConditionalSwapExpired)
+		})
+		if err != nil {
+			ctx.Logger().Error(fmt.Errorf("unable to refund expired conditional swap %d: %w", conditionalSwap.Id, err).Error())
+		}
+	}
+
+	var maxConditionalSwapsPerBlock uint64
+	k.GetParam(ctx, types.KeyMaxConditionalSwapsPerBlock, &maxConditionalSwapsPerBlock)
+	if maxConditionalSwapsPerBlock == 0 {
+		return
+	}
+
+	conditionalSwaps := k.getConditionalSwapsToCheck(ctx, maxConditionalSwapsPerBlock)
+	for _, conditionalSwap := range conditionalSwaps {
+		_ = osmoutils.ApplyFuncIfNoErrorLogToDebug(ctx, func(cacheCtx sdk.Context) error {
+			return k.executeConditionalSwap(cacheCtx, conditionalSwap)
+		})
+	}
+	if len(conditionalSwaps) > 0 {
+		k.setLastCheckedConditionalSwapId(ctx, conditionalSwaps[len(conditionalSwaps)-1].Id)
+	}
+}
+
+// getExpiredConditionalSwaps returns up to limit conditional swaps whose expiry is before the block time,
+// in the order they expired.
+func (k Keeper) getExpiredConditionalSwaps(ctx sdk.Context, limit uint64) []types.ConditionalSwap {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ConditionalSwapByExpiryPrefix, types.FormatConditionalSwapByExpiryPrefix(ctx.BlockTime()))
+	defer iterator.Close()
+
+	conditionalSwaps := []types.ConditionalSwap{}
+	for ; iterator.Valid() && uint64(len(conditionalSwaps)) < limit; iterator.Next() {
+		key := iterator.Key()
+		conditionalSwapId := sdk.BigEndianToUint64(key[len(key)-8:])
+		conditionalSwap, err := k.GetConditionalSwap(ctx, conditionalSwapId)
+		if err != nil {
+			panic(err)
+		}
+		conditionalSwaps = append(conditionalSwaps, conditionalSwap)
+	}
+	return conditionalSwaps
+}
+
+// getConditionalSwapsToCheck returns up to limit pending conditional swaps, starting after the last checked one
+// and wrapping around to the oldest ones, so that every conditional swap is eventually checked.
+func (k Keeper) getConditionalSwapsToCheck(ctx sdk.Context, limit uint64) []types.ConditionalSwap {
+	store := ctx.KVStore(k.storeKey)
+	resumeKey := types.FormatConditionalSwapKey(k.getLastCheckedConditionalSwapId(ctx) + 1)
+
+	conditionalSwaps := gatherConditionalSwaps(store, resumeKey, storetypes.PrefixEndBytes(types.ConditionalSwapPrefix), limit)
+	if remaining := limit - uint64(len(conditionalSwaps)); remaining > 0 {
+		conditionalSwaps = append(conditionalSwaps, gatherConditionalSwaps(store, types.ConditionalSwapPrefix, resumeKey, remaining)...)
+	}
+	return conditionalSwaps
+}
+
+// gatherConditionalSwaps returns up to limit conditional swaps stored in [start, end).
+func gatherConditionalSwaps(store storetypes.KVStore, start, end []byte, limit uint64) []types.ConditionalSwap {
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	conditionalSwaps := []types.ConditionalSwap{}
+	for ; iterator.Valid() && uint64(len(conditionalSwaps)) < limit; iterator.Next() {
+		conditionalSwap, err := parseConditionalSwap(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+		conditionalSwaps = append(conditionalSwaps, conditionalSwap)
+	}
+	return conditionalSwaps
+}
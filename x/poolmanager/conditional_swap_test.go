@@ -0,0 +1,331 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v31/x/txfees/types"
+)
+
+const (
+	conditionalSwapDenomIn  = "foo"
+	conditionalSwapDenomOut = "bar"
+)
+
+var (
+	conditionalSwapTokenIn = sdk.NewCoin(conditionalSwapDenomIn, osmomath.NewInt(1_000_000))
+	// The pool holds both denoms in equal amounts, so the route spot price is 1.
+	conditionalSwapPoolCoins = sdk.NewCoins(
+		sdk.NewCoin(conditionalSwapDenomIn, osmomath.NewInt(1_000_000_000)),
+		sdk.NewCoin(conditionalSwapDenomOut, osmomath.NewInt(1_000_000_000)),
+	)
+	metSpotTrigger = types.SwapTrigger{
+		Price:     osmomath.NewDec(2),
+		Direction: types.TriggerDirectionAtOrBelow,
+	}
+	unmetSpotTrigger = types.SwapTrigger{
+		Price:     osmomath.NewDec(2),
+		Direction: types.TriggerDirectionAtOrAbove,
+	}
+)
+
+// setupConditionalSwapPool creates the pool of the conditional swap route and funds the sender with the token in,
+// and with the placement fee of a few conditional swaps.
+func (s *KeeperTestSuite) setupConditionalSwapPool() []types.SwapAmountInRoute {
+	s.createBalancerPoolsFromCoins([]sdk.Coins{conditionalSwapPoolCoins})
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(conditionalSwapTokenIn))
+	s.FundAcc(s.TestAccs[1], types.DefaultConditionalSwapPlacementFee.MulInt(osmomath.NewInt(3)))
+	return []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: conditionalSwapDenomOut}}
+}
+
+func (s *KeeperTestSuite) placeConditionalSwap(routes []types.SwapAmountInRoute, trigger types.SwapTrigger, expiry time.Time) uint64 {
+	conditionalSwapId, err := s.App.PoolManagerKeeper.PlaceConditionalSwap(s.Ctx, s.TestAccs[1], routes, conditionalSwapTokenIn, osmomath.OneInt(), trigger, expiry)
+	s.Require().NoError(err)
+	return conditionalSwapId
+}
+
+func (s *KeeperTestSuite) TestPlaceConditionalSwap() {
+	tests := map[string]struct {
+		routes      []types.SwapAmountInRoute
+		tokenIn     sdk.Coin
+		expiryDelta time.Duration
+		expectedErr bool
+	}{
+		"valid conditional swap": {
+			routes:      []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: conditionalSwapDenomOut}},
+			tokenIn:     conditionalSwapTokenIn,
+			expiryDelta: time.Hour,
+		},
+		"expiry at the block time": {
+			routes:      []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: conditionalSwapDenomOut}},
+			tokenIn:     conditionalSwapTokenIn,
+			expiryDelta: 0,
+			expectedErr: true,
+		},
+		"non-existent pool": {
+			routes:      []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: conditionalSwapDenomOut}},
+			tokenIn:     conditionalSwapTokenIn,
+			expiryDelta: time.Hour,
+			expectedErr: true,
+		},
+		"insufficient funds": {
+			routes:      []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: conditionalSwapDenomOut}},
+			tokenIn:     conditionalSwapTokenIn.AddAmount(osmomath.OneInt()),
+			expiryDelta: time.Hour,
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.setupConditionalSwapPool()
+			expiry := s.Ctx.BlockTime().Add(tc.expiryDelta)
+			feePoolBefore, err := s.App.DistrKeeper.FeePool.Get(s.Ctx)
+			s.Require().NoError(err)
+
+			conditionalSwapId, err := s.App.PoolManagerKeeper.PlaceConditionalSwap(s.Ctx, s.TestAccs[1], tc.routes, tc.tokenIn, osmomath.OneInt(), metSpotTrigger, expiry)
+			if tc.expectedErr {
+				s.Require().Error(err)
+				s.Require().Equal(uint64(1), s.App.PoolManagerKeeper.GetNextConditionalSwapId(s.Ctx))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), conditionalSwapId)
+			s.Require().Equal(uint64(2), s.App.PoolManagerKeeper.GetNextConditionalSwapId(s.Ctx))
+
+			// The placement fee is paid to the community pool.
+			feePool, err := s.App.DistrKeeper.FeePool.Get(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewDecCoinsFromCoins(types.DefaultConditionalSwapPlacementFee...), feePool.CommunityPool.Sub(feePoolBefore.CommunityPool))
+
+			// The token in is escrowed.
+			escrowAddress := authtypes.NewModuleAddress(types.ConditionalSwapEscrowName)
+			s.Require().Equal(tc.tokenIn, s.App.BankKeeper.GetBalance(s.Ctx, escrowAddress, conditionalSwapDenomIn))
+			s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], conditionalSwapDenomIn).IsZero())
+
+			conditionalSwap, err := s.App.PoolManagerKeeper.GetConditionalSwap(s.Ctx, conditionalSwapId)
+			s.Require().NoError(err)
+			s.Require().Equal(s.TestAccs[1].String(), conditionalSwap.Sender)
+			s.Require().Equal(tc.routes, conditionalSwap.Routes)
+			s.Require().Equal(tc.tokenIn, conditionalSwap.TokenIn)
+			s.Require().True(expiry.Equal(conditionalSwap.Expiry))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelConditionalSwap() {
+	tests := map[string]struct {
+		senderIndex       int
+		conditionalSwapId uint64
+		expectedErr       bool
+	}{
+		"sender cancels": {
+			senderIndex:       1,
+			conditionalSwapId: 1,
+		},
+		"another address cancels": {
+			senderIndex:       2,
+			conditionalSwapId: 1,
+			expectedErr:       true,
+		},
+		"non-existent conditional swap": {
+			senderIndex:       1,
+			conditionalSwapId: 2,
+			expectedErr:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			routes := s.setupConditionalSwapPool()
+			s.placeConditionalSwap(routes, unmetSpotTrigger, s.Ctx.BlockTime().Add(time.Hour))
+			sender := s.TestAccs[tc.senderIndex]
+
+			refundedTokenIn, err := s.App.PoolManagerKeeper.CancelConditionalSwap(s.Ctx, sender, tc.conditionalSwapId)
+			if tc.expectedErr {
+				s.Require().Error(err)
+				// The conditional swap is still pending.
+				_, err = s.App.PoolManagerKeeper.GetConditionalSwap(s.Ctx, 1)
+				s.Require().NoError(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(conditionalSwapTokenIn, refundedTokenIn)
+			s.Require().Equal(conditionalSwapTokenIn, s.App.BankKeeper.GetBalance(s.Ctx, sender, conditionalSwapDenomIn))
+
+			_, err = s.App.PoolManagerKeeper.GetConditionalSwap(s.Ctx, tc.conditionalSwapId)
+			s.Require().ErrorIs(err, types.ConditionalSwapNotFoundError{ConditionalSwapId: tc.conditionalSwapId})
+			conditionalSwaps, _, err := s.App.PoolManagerKeeper.GetConditionalSwaps(s.Ctx, sender.String(), nil)
+			s.Require().NoError(err)
+			s.Require().Empty(conditionalSwaps)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestConditionalSwapsEndBlock() {
+	tests := map[string]struct {
+		trigger            types.SwapTrigger
+		blockTimeDelta     time.Duration
+		expectExecuted     bool
+		expectRefunded     bool
+		maxSwapsPerBlock   uint64
+		tokenOutMinAmount  osmomath.Int
+		expectStillPending bool
+	}{
+		"trigger met: executed": {
+			trigger:          metSpotTrigger,
+			maxSwapsPerBlock: types.DefaultMaxConditionalSwapsPerBlock,
+			expectExecuted:   true,
+		},
+		"trigger met at the expiry: executed": {
+			trigger:          metSpotTrigger,
+			blockTimeDelta:   time.Hour,
+			maxSwapsPerBlock: types.DefaultMaxConditionalSwapsPerBlock,
+			expectExecuted:   true,
+		},
+		"trigger not met: pending": {
+			trigger:            unmetSpotTrigger,
+			maxSwapsPerBlock:   types.DefaultMaxConditionalSwapsPerBlock,
+			expectStillPending: true,
+		},
+		"token out min amount not met: pending": {
+			trigger:            metSpotTrigger,
+			maxSwapsPerBlock:   types.DefaultMaxConditionalSwapsPerBlock,
+			tokenOutMinAmount:  conditionalSwapTokenIn.Amount,
+			expectStillPending: true,
+		},
+		"expired: refunded": {
+			trigger:          metSpotTrigger,
+			blockTimeDelta:   time.Hour + time.Second,
+			maxSwapsPerBlock: types.DefaultMaxConditionalSwapsPerBlock,
+			expectRefunded:   true,
+		},
+		"processing disabled: pending": {
+			trigger:            metSpotTrigger,
+			maxSwapsPerBlock:   0,
+			expectStillPending: true,
+		},
+		"processing disabled and expired: refunded": {
+			trigger:          metSpotTrigger,
+			blockTimeDelta:   time.Hour + time.Second,
+			maxSwapsPerBlock: 0,
+			expectRefunded:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.App.PoolManagerKeeper.SetParam(s.Ctx, types.KeyMaxConditionalSwapsPerBlock, tc.maxSwapsPerBlock)
+			routes := s.setupConditionalSwapPool()
+			tokenOutMinAmount := osmomath.OneInt()
+			if !tc.tokenOutMinAmount.IsNil() {
+				tokenOutMinAmount = tc.tokenOutMinAmount
+			}
+			conditionalSwapId, err := s.App.PoolManagerKeeper.PlaceConditionalSwap(s.Ctx, s.TestAccs[1], routes, conditionalSwapTokenIn, tokenOutMinAmount, tc.trigger, s.Ctx.BlockTime().Add(time.Hour))
+			s.Require().NoError(err)
+
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(tc.blockTimeDelta))
+			s.App.PoolManagerKeeper.EndBlock(s.Ctx)
+
+			_, err = s.App.PoolManagerKeeper.GetConditionalSwap(s.Ctx, conditionalSwapId)
+			senderTokenIn := s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], conditionalSwapDenomIn)
+			senderTokenOut := s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], conditionalSwapDenomOut)
+			if tc.expectStillPending {
+				s.Require().NoError(err)
+				s.Require().True(senderTokenIn.IsZero())
+				s.Require().True(senderTokenOut.IsZero())
+				return
+			}
+			s.Require().Error(err)
+
+			if tc.expectRefunded {
+				s.Require().Equal(conditionalSwapTokenIn, senderTokenIn)
+				s.Require().True(senderTokenOut.IsZero())
+			}
+			if tc.expectExecuted {
+				s.Require().True(senderTokenIn.IsZero())
+				s.Require().True(senderTokenOut.Amount.IsPositive())
+			}
+
+			// Nothing is left in escrow.
+			escrowAddress := authtypes.NewModuleAddress(types.ConditionalSwapEscrowName)
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, escrowAddress).IsZero())
+		})
+	}
+}
+
+// TestConditionalSwapsEndBlock_SenderSwaps tests that the sender of the conditional swap is the sender of its swap,
+// so that its taker fee exemption applies instead of the escrow's taker fee.
+func (s *KeeperTestSuite) TestConditionalSwapsEndBlock_SenderSwaps() {
+	s.SetupTest()
+	routes := s.setupConditionalSwapPool()
+	s.App.PoolManagerKeeper.SetDenomPairTakerFee(s.Ctx, conditionalSwapDenomIn, conditionalSwapDenomOut, osmomath.MustNewDecFromStr("0.01"))
+	s.App.PoolManagerKeeper.SetParam(s.Ctx, types.KeyReducedTakerFeeByWhitelist, []string{s.TestAccs[1].String()})
+	conditionalSwapId := s.placeConditionalSwap(routes, metSpotTrigger, s.Ctx.BlockTime().Add(time.Hour))
+
+	s.App.PoolManagerKeeper.EndBlock(s.Ctx)
+
+	_, err := s.App.PoolManagerKeeper.GetConditionalSwap(s.Ctx, conditionalSwapId)
+	s.Require().Error(err)
+	takerFeeCollector := s.App.AccountKeeper.GetModuleAddress(txfeestypes.TakerFeeCollectorName)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, takerFeeCollector, conditionalSwapDenomIn).IsZero())
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], conditionalSwapDenomOut).Amount.IsPositive())
+}
+
+// TestConditionalSwapsEndBlock_RoundRobin tests that triggers are checked for at most the maximum number
+// of conditional swaps per block, resuming after the last checked one and wrapping around.
+func (s *KeeperTestSuite) TestConditionalSwapsEndBlock_RoundRobin() {
+	s.SetupTest()
+	s.App.PoolManagerKeeper.SetParam(s.Ctx, types.KeyMaxConditionalSwapsPerBlock, uint64(2))
+	routes := s.setupConditionalSwapPool()
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(conditionalSwapDenomIn, conditionalSwapTokenIn.Amount.MulRaw(2))))
+	expiry := s.Ctx.BlockTime().Add(time.Hour)
+
+	// The first conditional swap never executes, the two next ones do.
+	s.placeConditionalSwap(routes, unmetSpotTrigger, expiry)
+	s.placeConditionalSwap(routes, metSpotTrigger, expiry)
+	s.placeConditionalSwap(routes, metSpotTrigger, expiry)
+
+	pendingIds := func() []uint64 {
+		conditionalSwaps, _, err := s.App.PoolManagerKeeper.GetConditionalSwaps(s.Ctx, s.TestAccs[1].String(), nil)
+		s.Require().NoError(err)
+		ids := []uint64{}
+		for _, conditionalSwap := range conditionalSwaps {
+			ids = append(ids, conditionalSwap.Id)
+		}
+		return ids
+	}
+
+	// Conditional swaps 1 and 2 are checked.
+	s.App.PoolManagerKeeper.EndBlock(s.Ctx)
+	s.Require().Equal([]uint64{1, 3}, pendingIds())
+
+	// Conditional swap 3 is checked, then the checks wrap around to conditional swap 1.
+	s.App.PoolManagerKeeper.EndBlock(s.Ctx)
+	s.Require().Equal([]uint64{1}, pendingIds())
+}
+
+func (s *KeeperTestSuite) TestConditionalSwapsGenesis() {
+	s.SetupTest()
+	routes := s.setupConditionalSwapPool()
+	s.placeConditionalSwap(routes, unmetSpotTrigger, s.Ctx.BlockTime().Add(time.Hour))
+
+	genesis := s.App.PoolManagerKeeper.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.ConditionalSwaps, 1)
+	s.Require().Equal(uint64(2), genesis.NextConditionalSwapId)
+
+	s.SetupTest()
+	s.App.PoolManagerKeeper.InitGenesis(s.Ctx, genesis)
+
+	conditionalSwaps, _, err := s.App.PoolManagerKeeper.GetConditionalSwaps(s.Ctx, s.TestAccs[1].String(), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(genesis.ConditionalSwaps, conditionalSwaps)
+	s.Require().Equal(uint64(2), s.App.PoolManagerKeeper.GetNextConditionalSwapId(s.Ctx))
+}
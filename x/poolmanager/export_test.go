@@ -154,3 +154,15 @@ func (k *Keeper) SetCacheTrackers(takerFeeShareAgreement map[string]types.TakerF
 func (k Keeper) FundCommunityPoolIfNotWhitelisted(ctx sdk.Context, sender sdk.AccAddress) error {
 	return k.fundCommunityPoolIfNotWhitelisted(ctx, sender)
 }
+
+func (k Keeper) SetTraderVolume(ctx sdk.Context, traderVolume types.TraderVolume) {
+	k.setTraderVolume(ctx, traderVolume)
+}
+
+func (k Keeper) PruneTraderVolumes(ctx sdk.Context) {
+	k.pruneTraderVolumes(ctx)
+}
+
+func (k Keeper) SetTakerFeeShareSkimmedTotal(ctx sdk.Context, takerFeeShareDenom string, skimmedTotal sdk.Coins) {
+	k.setTakerFeeShareSkimmedTotal(ctx, takerFeeShareDenom, skimmedTotal)
+}
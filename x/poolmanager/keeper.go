@@ -28,6 +28,7 @@ type Keeper struct {
 	stakingKeeper        types.StakingKeeper
 	protorevKeeper       types.ProtorevKeeper
 	wasmKeeper           types.WasmKeeper
+	twapKeeper           types.TwapKeeper
 
 	// routes is a map to get the pool module by id.
 	routes map[types.PoolType]types.PoolModuleI
@@ -159,6 +160,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, denomPairTakerFee := range genState.DenomPairTakerFeeStore {
 		k.SetDenomPairTakerFee(ctx, denomPairTakerFee.TokenInDenom, denomPairTakerFee.TokenOutDenom, denomPairTakerFee.TakerFee)
 	}
+
+	// Set the pending conditional swaps, whose tokens in are already escrowed.
+	if genState.NextConditionalSwapId != 0 {
+		k.SetNextConditionalSwapId(ctx, genState.NextConditionalSwapId)
+	}
+	for _, conditionalSwap := range genState.ConditionalSwaps {
+		k.setConditionalSwap(ctx, conditionalSwap)
+	}
+
+	// Set the swap volumes of traders tracked for the taker fee tiers.
+	for _, traderVolume := range genState.TraderVolumes {
+		k.setTraderVolume(ctx, traderVolume)
+	}
+
+	// Set the taker fee rebates received by affiliates.
+	for _, affiliateRebates := range genState.AffiliateRebates {
+		k.setAffiliateRebates(ctx, affiliateRebates)
+	}
 }
 
 // ExportGenesis returns the poolmanager module's exported genesis.
@@ -184,6 +203,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	conditionalSwaps, err := k.getAllConditionalSwaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	traderVolumes, err := k.getAllTraderVolumes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	affiliateRebates, err := k.getAllAffiliateRebates(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	// Export KVStore values to the genesis state so they can be imported in init genesis.
 	takerFeesTracker := types.TakerFeesTracker{
 		TakerFeesToStakers:         k.GetTakerFeeTrackerForStakers(ctx),
@@ -198,6 +232,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		TakerFeesTracker:       &takerFeesTracker,
 		PoolVolumes:            poolVolumes,
 		DenomPairTakerFeeStore: denomPairTakerFees,
+		ConditionalSwaps:       conditionalSwaps,
+		NextConditionalSwapId:  k.GetNextConditionalSwapId(ctx),
+		TraderVolumes:          traderVolumes,
+		AffiliateRebates:       affiliateRebates,
 	}
 }
 
@@ -235,6 +273,11 @@ func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
 	k.wasmKeeper = wasmKeeper
 }
 
+// SetTwapKeeper sets twap keeper
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) {
+	k.twapKeeper = twapKeeper
+}
+
 // BeginBlock sets the poolmanager caches if they are empty
 func (k *Keeper) BeginBlock(ctx sdk.Context) {
 	// Here, the only time in which these caches are empty is during the start up of the node.
@@ -255,22 +298,34 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) {
 // AlloyedAssetCompositionUpdateRate is the rate in blocks at which the taker fee share alloy composition is updated in the end block.
 var AlloyedAssetCompositionUpdateRate = int64(700)
 
-// EndBlock updates the taker fee share alloy composition for all registered alloyed pools
-// if the current block height is a multiple of the alloyedAssetCompositionUpdateRate.
+// EndBlock removes the taker fee share agreements that reached their end time or skim cap, and updates
+// the taker fee share alloy composition for all registered alloyed pools if the current block height is a multiple
+// of the alloyedAssetCompositionUpdateRate. It then refunds the expired conditional swaps and executes the triggered ones, up to the
+// maximum number of conditional swaps per block. The trader volumes are pruned of the days outside of the volume window as well.
 func (k *Keeper) EndBlock(ctx sdk.Context) {
+	k.expireTakerFeeShareAgreements(ctx)
+	k.pruneTraderVolumes(ctx)
+
 	if ctx.BlockHeight()%AlloyedAssetCompositionUpdateRate == 0 {
-		registeredAlloyPoolIds, err := k.getAllRegisteredAlloyedPoolsIdArray(ctx)
+		k.updateTakerFeeShareAlloyCompositions(ctx)
+	}
+
+	k.processConditionalSwaps(ctx)
+}
+
+// updateTakerFeeShareAlloyCompositions recalculates and sets the taker fee share alloy composition of all registered alloyed pools.
+func (k *Keeper) updateTakerFeeShareAlloyCompositions(ctx sdk.Context) {
+	registeredAlloyPoolIds, err := k.getAllRegisteredAlloyedPoolsIdArray(ctx)
+	if err != nil {
+		ctx.Logger().Error(fmt.Errorf("unable to get all registered alloyed pools: %w", err).Error())
+		return
+	}
+	for _, id := range registeredAlloyPoolIds {
+		err := k.recalculateAndSetTakerFeeShareAlloyComposition(ctx, id)
 		if err != nil {
-			ctx.Logger().Error(fmt.Errorf("unable to get all registered alloyed pools: %w", err).Error())
-			return
-		}
-		for _, id := range registeredAlloyPoolIds {
-			err := k.recalculateAndSetTakerFeeShareAlloyComposition(ctx, id)
-			if err != nil {
-				ctx.Logger().Error(fmt.Errorf(
-					"%s for pool id %d: %v", types.ErrSetRegisteredAlloyedPool, id, err,
-				).Error())
-			}
+			ctx.Logger().Error(fmt.Errorf(
+				"%s for pool id %d: %v", types.ErrSetRegisteredAlloyedPool, id, err,
+			).Error())
 		}
 	}
 }
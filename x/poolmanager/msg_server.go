@@ -2,6 +2,7 @@ package poolmanager
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,7 +30,12 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 		return nil, err
 	}
 
-	tokenOutAmount, err := server.keeper.RouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount)
+	affiliate, err := parseAffiliate(msg.Affiliate)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := server.keeper.routeExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount, affiliate)
 	if err != nil {
 		return nil, err
 	}
@@ -39,6 +45,29 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 	return &types.MsgSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
 }
 
+func (server msgServer) SwapExactAmountInWithMaxPriceImpact(goCtx context.Context, msg *types.MsgSwapExactAmountInWithMaxPriceImpact) (*types.MsgSwapExactAmountInWithMaxPriceImpactResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	affiliate, err := parseAffiliate(msg.Affiliate)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, tokenOutAmount, err := server.keeper.swapExactAmountInWithMaxPriceImpact(ctx, sender, msg.PoolId, msg.TokenIn, msg.TokenOutDenom, msg.MaxPriceImpact, msg.ExternalPrice, affiliate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled elsewhere
+
+	return &types.MsgSwapExactAmountInWithMaxPriceImpactResponse{TokenInAmount: tokenInAmount, TokenOutAmount: tokenOutAmount}, nil
+}
+
 // TODO: spec and tests, including events
 func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.MsgSwapExactAmountOut) (*types.MsgSwapExactAmountOutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -48,7 +77,12 @@ func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
-	tokenInAmount, err := server.keeper.RouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut)
+	affiliate, err := parseAffiliate(msg.Affiliate)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, err := server.keeper.routeExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut, affiliate)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +100,12 @@ func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *
 		return nil, err
 	}
 
-	tokenOutAmount, err := server.keeper.SplitRouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount)
+	affiliate, err := parseAffiliate(msg.Affiliate)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := server.keeper.splitRouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount, affiliate)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +123,12 @@ func (server msgServer) SplitRouteSwapExactAmountOut(goCtx context.Context, msg
 		return nil, err
 	}
 
-	tokenInAmount, err := server.keeper.SplitRouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenOutDenom, msg.TokenInMaxAmount)
+	affiliate, err := parseAffiliate(msg.Affiliate)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, err := server.keeper.splitRouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenOutDenom, msg.TokenInMaxAmount, affiliate)
 	if err != nil {
 		return nil, err
 	}
@@ -121,10 +165,9 @@ func (server msgServer) SetTakerFeeShareAgreementForDenom(goCtx context.Context,
 		return nil, err
 	}
 
-	takerFeeShareAgreement := types.TakerFeeShareAgreement{
-		Denom:       msg.Denom,
-		SkimPercent: msg.SkimPercent,
-		SkimAddress: msg.SkimAddress,
+	takerFeeShareAgreement := msg.TakerFeeShareAgreement()
+	if takerFeeShareAgreement.IsExpiredAt(ctx.BlockTime()) {
+		return nil, fmt.Errorf("taker fee share agreement end time (%s) must be after the block time (%s)", takerFeeShareAgreement.EndTime, ctx.BlockTime())
 	}
 
 	err := server.keeper.SetTakerFeeShareAgreementForDenom(ctx, takerFeeShareAgreement)
@@ -170,3 +213,43 @@ func (server msgServer) SetRegisteredAlloyedPool(goCtx context.Context, msg *typ
 
 	return &types.MsgSetRegisteredAlloyedPoolResponse{}, nil
 }
+
+func (server msgServer) PlaceConditionalSwap(goCtx context.Context, msg *types.MsgPlaceConditionalSwap) (*types.MsgPlaceConditionalSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	conditionalSwapId, err := server.keeper.PlaceConditionalSwap(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount, msg.Trigger, msg.Expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPlaceConditionalSwapResponse{ConditionalSwapId: conditionalSwapId}, nil
+}
+
+func (server msgServer) CancelConditionalSwap(goCtx context.Context, msg *types.MsgCancelConditionalSwap) (*types.MsgCancelConditionalSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	refundedTokenIn, err := server.keeper.CancelConditionalSwap(ctx, sender, msg.ConditionalSwapId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelConditionalSwapResponse{RefundedTokenIn: refundedTokenIn}, nil
+}
+
+// parseAffiliate returns the affiliate address of a swap msg, or nil if unset.
+func parseAffiliate(affiliate string) (sdk.AccAddress, error) {
+	if affiliate == "" {
+		return nil, nil
+	}
+	return sdk.AccAddressFromBech32(affiliate)
+}
@@ -3,6 +3,7 @@ package poolmanager_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"google.golang.org/grpc/status"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	appparams "github.com/osmosis-labs/osmosis/v31/app/params"
@@ -454,3 +455,86 @@ func (s *KeeperTestSuite) TestSetRegisteredAlloyedPoolMsg() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSwapExactAmountInWithMaxPriceImpactMsg() {
+	testcases := map[string]struct {
+		poolId         uint64
+		tokenIn        sdk.Coin
+		maxPriceImpact osmomath.Dec
+		externalPrice  osmomath.Dec
+
+		expectPartialSwap bool
+		expectedError     error
+	}{
+		"valid case: full token in within the max price impact": {
+			tokenIn:        sdk.NewCoin("baz", osmomath.NewInt(10_000)),
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+		},
+		"valid case: part of the token in within the max price impact": {
+			tokenIn:        sdk.NewCoin("baz", osmomath.NewInt(5_000_000)),
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+
+			expectPartialSwap: true,
+		},
+		"error: spot price already deviates from the external price beyond the max price impact": {
+			tokenIn:        sdk.NewCoin("baz", osmomath.NewInt(10_000)),
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+			externalPrice:  osmomath.MustNewDecFromStr("0.1"),
+
+			expectedError: types.NoTradeWithinMaxPriceImpactError{PoolId: 1, MaxPriceImpact: osmomath.MustNewDecFromStr("0.05")},
+		},
+		"error: pool does not exist": {
+			poolId:         2,
+			tokenIn:        sdk.NewCoin("baz", osmomath.NewInt(10_000)),
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+
+			expectedError: types.FailedToFindRouteError{PoolId: 2},
+		},
+	}
+
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.Setup()
+			sender := s.TestAccs[0]
+			poolId := s.PrepareBalancerPool()
+			if tc.poolId != 0 {
+				poolId = tc.poolId
+			}
+			s.FundAcc(sender, sdk.NewCoins(tc.tokenIn))
+			balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+
+			msgServer := poolmanagerKeeper.NewMsgServerImpl(s.App.PoolManagerKeeper)
+
+			response, err := msgServer.SwapExactAmountInWithMaxPriceImpact(s.Ctx, &types.MsgSwapExactAmountInWithMaxPriceImpact{
+				Sender:         sender.String(),
+				PoolId:         poolId,
+				TokenIn:        tc.tokenIn,
+				TokenOutDenom:  "bar",
+				MaxPriceImpact: tc.maxPriceImpact,
+				ExternalPrice:  tc.externalPrice,
+			})
+			if tc.expectedError != nil {
+				s.Require().ErrorContains(err, tc.expectedError.Error())
+				// Txs return the module errors rather than gRPC status errors.
+				_, isStatusErr := status.FromError(err)
+				s.Require().False(isStatusErr)
+				s.Require().Nil(response)
+				s.Require().Equal(balancesBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+				return
+			}
+			s.Require().NoError(err)
+
+			if tc.expectPartialSwap {
+				s.Require().True(response.TokenInAmount.LT(tc.tokenIn.Amount))
+			} else {
+				s.Require().Equal(tc.tokenIn.Amount, response.TokenInAmount)
+			}
+			s.Require().True(response.TokenOutAmount.IsPositive())
+
+			// Only the token in amount swapped leaves the sender.
+			balancesAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+			s.Require().Equal(balancesBefore.AmountOf("baz").Sub(response.TokenInAmount), balancesAfter.AmountOf("baz"))
+			s.Require().Equal(balancesBefore.AmountOf("bar").Add(response.TokenOutAmount), balancesAfter.AmountOf("bar"))
+		})
+	}
+}
@@ -6,9 +6,6 @@ import (
 	"math/big"
 	"strings"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -56,6 +53,19 @@ func (k Keeper) RouteExactAmountIn(
 	route []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 	tokenOutMinAmount osmomath.Int,
+) (tokenOutAmount osmomath.Int, err error) {
+	return k.routeExactAmountIn(ctx, sender, route, tokenIn, tokenOutMinAmount, nil)
+}
+
+// routeExactAmountIn is RouteExactAmountIn rebating a share of the taker fees charged to the given affiliate,
+// if whitelisted. The affiliate may be nil.
+func (k Keeper) routeExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount osmomath.Int,
+	affiliate sdk.AccAddress,
 ) (tokenOutAmount osmomath.Int, err error) {
 	// Ensure that provided route is not empty and has valid denom format.
 	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
@@ -64,6 +74,7 @@ func (k Keeper) RouteExactAmountIn(
 
 	totalTakerFeesCharged := sdk.Coins{}
 	denomsInvolvedInRoute := []string{tokenIn.Denom}
+	routeTokenIn := tokenIn
 
 	// Iterate through the route and execute a series of swaps through each pool.
 	for i, routeStep := range route {
@@ -92,12 +103,21 @@ func (k Keeper) RouteExactAmountIn(
 		}
 	}
 
+	// Rebate the affiliate, and skim the remaining taker fees
+	totalTakerFeesCharged, err = k.rebateTakerFeesToAffiliate(ctx, sender, affiliate, totalTakerFeesCharged)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
 	// Run taker fee skim logic
 	err = k.TakerFeeSkim(ctx, denomsInvolvedInRoute, totalTakerFeesCharged)
 	if err != nil {
 		return osmomath.Int{}, err
 	}
 
+	// Track the swap volume of the sender once for the whole route, in the token in of its first pool.
+	k.trackTraderVolume(ctx, sender, routeTokenIn)
+
 	return tokenOutAmount, nil
 }
 
@@ -122,6 +142,19 @@ func (k Keeper) SplitRouteExactAmountIn(
 	routes []types.SwapAmountInSplitRoute,
 	tokenInDenom string,
 	tokenOutMinAmount osmomath.Int,
+) (osmomath.Int, error) {
+	return k.splitRouteExactAmountIn(ctx, sender, routes, tokenInDenom, tokenOutMinAmount, nil)
+}
+
+// splitRouteExactAmountIn is SplitRouteExactAmountIn rebating a share of the taker fees charged to the given affiliate,
+// if whitelisted. The affiliate may be nil.
+func (k Keeper) splitRouteExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount osmomath.Int,
+	affiliate sdk.AccAddress,
 ) (osmomath.Int, error) {
 	if err := types.ValidateSwapAmountInSplitRoute(routes); err != nil {
 		return osmomath.Int{}, err
@@ -136,12 +169,13 @@ func (k Keeper) SplitRouteExactAmountIn(
 	)
 
 	for _, multihopRoute := range routes {
-		tokenOutAmount, err := k.RouteExactAmountIn(
+		tokenOutAmount, err := k.routeExactAmountIn(
 			ctx,
 			sender,
 			types.SwapAmountInRoutes(multihopRoute.Pools),
 			sdk.NewCoin(tokenInDenom, multihopRoute.TokenInAmount),
-			multihopStartTokenOutMinAmount)
+			multihopStartTokenOutMinAmount,
+			affiliate)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
@@ -365,6 +399,18 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	route []types.SwapAmountOutRoute,
 	tokenInMaxAmount osmomath.Int,
 	tokenOut sdk.Coin,
+) (tokenInAmount osmomath.Int, err error) {
+	return k.routeExactAmountOut(ctx, sender, route, tokenInMaxAmount, tokenOut, nil)
+}
+
+// routeExactAmountOut is RouteExactAmountOut rebating a share of the taker fees charged to the given affiliate,
+// if whitelisted. The affiliate may be nil.
+func (k Keeper) routeExactAmountOut(ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountOutRoute,
+	tokenInMaxAmount osmomath.Int,
+	tokenOut sdk.Coin,
+	affiliate sdk.AccAddress,
 ) (tokenInAmount osmomath.Int, err error) {
 	isMultiHopRouted, routeSpreadFactor, sumOfSpreadFactors := false, osmomath.Dec{}, osmomath.Dec{}
 	// Ensure that provided route is not empty and has valid denom format.
@@ -456,12 +502,21 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		}
 	}
 
+	// Rebate the affiliate, and skim the remaining taker fees
+	totalTakerFeesCharged, err = k.rebateTakerFeesToAffiliate(ctx, sender, affiliate, totalTakerFeesCharged)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
 	// Run taker fee skim logic
 	err = k.TakerFeeSkim(ctx, denomsInvolvedInRoute, totalTakerFeesCharged)
 	if err != nil {
 		return osmomath.Int{}, err
 	}
 
+	// Track the swap volume of the sender once for the whole route, in the token in of its first pool, including the taker fee.
+	k.trackTraderVolume(ctx, sender, sdk.NewCoin(route[0].TokenInDenom, tokenInAmount))
+
 	return tokenInAmount, nil
 }
 
@@ -486,6 +541,19 @@ func (k Keeper) SplitRouteExactAmountOut(
 	route []types.SwapAmountOutSplitRoute,
 	tokenOutDenom string,
 	tokenInMaxAmount osmomath.Int,
+) (osmomath.Int, error) {
+	return k.splitRouteExactAmountOut(ctx, sender, route, tokenOutDenom, tokenInMaxAmount, nil)
+}
+
+// splitRouteExactAmountOut is SplitRouteExactAmountOut rebating a share of the taker fees charged to the given affiliate,
+// if whitelisted. The affiliate may be nil.
+func (k Keeper) splitRouteExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountOutSplitRoute,
+	tokenOutDenom string,
+	tokenInMaxAmount osmomath.Int,
+	affiliate sdk.AccAddress,
 ) (osmomath.Int, error) {
 	if err := types.ValidateSwapAmountOutSplitRoute(route); err != nil {
 		return osmomath.Int{}, err
@@ -501,12 +569,13 @@ func (k Keeper) SplitRouteExactAmountOut(
 	)
 
 	for _, multihopRoute := range route {
-		tokenOutAmount, err := k.RouteExactAmountOut(
+		tokenOutAmount, err := k.routeExactAmountOut(
 			ctx,
 			sender,
 			types.SwapAmountOutRoutes(multihopRoute.Pools),
 			multihopStartTokenInMaxAmount,
-			sdk.NewCoin(tokenOutDenom, multihopRoute.TokenOutAmount))
+			sdk.NewCoin(tokenOutDenom, multihopRoute.TokenOutAmount),
+			affiliate)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
@@ -749,14 +818,25 @@ func (k Keeper) TotalLiquidity(ctx sdk.Context) (sdk.Coins, error) {
 // CONTRACT: `volumeGenerated` corresponds to one of the denoms in the pool
 // CONTRACT: pool with `poolId` exists
 func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin) {
+	volumeInOsmo, ok := k.osmoVolume(ctx, volumeGenerated)
+	if !ok {
+		return
+	}
+
+	// Add this new volume to the global tracked volume for the pool ID
+	k.addVolume(ctx, poolId, volumeInOsmo)
+}
+
+// osmoVolume returns the given volume denominated in OSMO, priced at the spot price of the most liquid
+// OSMO-paired pool of its denom. Returns false if the volume cannot be priced in OSMO.
+func (k Keeper) osmoVolume(ctx sdk.Context, volumeGenerated sdk.Coin) (sdk.Coin, bool) {
 	// If the denom is already denominated in uosmo, we can just use it directly
 	OSMO, err := k.stakingKeeper.BondDenom(ctx)
 	if err != nil {
 		panic(err)
 	}
 	if volumeGenerated.Denom == OSMO {
-		k.addVolume(ctx, poolId, volumeGenerated)
-		return
+		return volumeGenerated, true
 	}
 
 	// Get the most liquid OSMO-paired pool with `volumeGenerated`'s denom using `GetPoolForDenomPair`
@@ -772,7 +852,7 @@ func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.
 	// We simply do not track volume in these cases. Importantly, volume splitting gauge logic should prevent a gauge from being
 	// created for such a pool that includes such a token, although it is okay to no-op in these cases regardless.
 	if err != nil {
-		return
+		return sdk.Coin{}, false
 	}
 
 	// Since we want to ultimately multiply the volume by this spot price, we want to quote OSMO in terms of the input token.
@@ -783,15 +863,13 @@ func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.
 	// That being said, if there is an error finding the spot price, we fail quietly and leave tracked volume unchanged.
 	// This is because we do not want to escalate an issue with finding spot price to locking all swaps involving the given asset.
 	if err != nil {
-		return
+		return sdk.Coin{}, false
 	}
 
 	// Multiply `volumeGenerated.Amount.ToDec()` by this spot price.
 	// While rounding does not particularly matter here, we round down to ensure that we do not overcount volume.
 	volumeInOsmo := osmomath.BigDecFromSDKInt(volumeGenerated.Amount).Mul(osmoPerInputToken).Dec().TruncateInt()
-
-	// Add this new volume to the global tracked volume for the pool ID
-	k.addVolume(ctx, poolId, sdk.NewCoin(OSMO, volumeInOsmo))
+	return sdk.NewCoin(OSMO, volumeInOsmo), true
 }
 
 // addVolume adds the given volume to the global tracked volume for the given pool ID.
@@ -844,6 +922,123 @@ func (k Keeper) GetOsmoVolumeForPool(ctx sdk.Context, poolId uint64) osmomath.In
 	return totalVolume.AmountOf(OSMO)
 }
 
+// EstimateTradeBasedOnPriceImpact estimates the largest part of req.FromCoin that can be swapped in the pool
+// without exceeding the max price impact, adjusted by the deviation of the spot price from the external price if set.
+// Returns an empty trade if no trade stays within the max price impact.
+// It is used by both the query and SwapExactAmountInWithMaxPriceImpact, so it returns the module errors,
+// which the query converts to gRPC status errors.
+func (k Keeper) EstimateTradeBasedOnPriceImpact(
+	ctx sdk.Context,
+	req queryproto.EstimateTradeBasedOnPriceImpactRequest,
+) (*queryproto.EstimateTradeBasedOnPriceImpactResponse, error) {
+	swapModule, err := k.GetPoolModule(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	poolI, poolErr := swapModule.GetPool(ctx, req.PoolId)
+	if poolErr != nil {
+		return nil, poolErr
+	}
+
+	spotPriceBigDec, err := swapModule.CalculateSpotPrice(ctx, req.PoolId, req.FromCoin.Denom, req.ToCoinDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to normal Dec
+	spotPrice := spotPriceBigDec.Dec()
+
+	// If ExternalPrice is specified we need to adjust the maxPriceImpact based on the deviation between spot and
+	// external price.
+	adjustedMaxPriceImpact := req.MaxPriceImpact
+	if !req.ExternalPrice.IsZero() {
+		priceDeviation := spotPrice.Sub(req.ExternalPrice).Quo(req.ExternalPrice)
+		adjustedMaxPriceImpact = adjustedMaxPriceImpact.Sub(priceDeviation)
+
+		// If the adjusted max price impact is negative or zero it means the difference between spot and external
+		// already exceeds the max price impact.
+		if adjustedMaxPriceImpact.IsZero() || adjustedMaxPriceImpact.IsNegative() {
+			return &queryproto.EstimateTradeBasedOnPriceImpactResponse{
+				InputCoin:  sdk.NewCoin(req.FromCoin.Denom, osmomath.ZeroInt()),
+				OutputCoin: sdk.NewCoin(req.ToCoinDenom, osmomath.ZeroInt()),
+			}, nil
+		}
+	}
+
+	// Process the estimates according to the pool type.
+	switch poolI.GetType() {
+	case types.Balancer:
+		return k.EstimateTradeBasedOnPriceImpactBalancerPool(
+			ctx, req, spotPrice, adjustedMaxPriceImpact, swapModule, poolI,
+		)
+	case types.Stableswap:
+		return k.EstimateTradeBasedOnPriceImpactStableSwapPool(
+			ctx, req, spotPrice, adjustedMaxPriceImpact, swapModule, poolI,
+		)
+	case types.Concentrated:
+		return k.EstimateTradeBasedOnPriceImpactConcentratedLiquidity(
+			ctx, req, spotPrice, adjustedMaxPriceImpact, swapModule, poolI,
+		)
+	default:
+		return nil, types.InvalidPoolTypeError{PoolType: poolI.GetType()}
+	}
+}
+
+// SwapExactAmountInWithMaxPriceImpact swaps the largest part of tokenIn in the pool whose price impact stays within
+// maxPriceImpact, as estimated by EstimateTradeBasedOnPriceImpact, through the same route as RouteExactAmountIn.
+// The rest of tokenIn is not swapped and stays with the sender. externalPrice is optional, and ignored when zero.
+// Returns the amount of token in swapped and the token out amount.
+func (k Keeper) SwapExactAmountInWithMaxPriceImpact(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxPriceImpact, externalPrice osmomath.Dec,
+) (tokenInAmount, tokenOutAmount osmomath.Int, err error) {
+	return k.swapExactAmountInWithMaxPriceImpact(ctx, sender, poolId, tokenIn, tokenOutDenom, maxPriceImpact, externalPrice, nil)
+}
+
+// swapExactAmountInWithMaxPriceImpact is SwapExactAmountInWithMaxPriceImpact rebating a share of the taker fees charged
+// to the given affiliate, if whitelisted. The affiliate may be nil.
+func (k Keeper) swapExactAmountInWithMaxPriceImpact(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxPriceImpact, externalPrice osmomath.Dec,
+	affiliate sdk.AccAddress,
+) (tokenInAmount, tokenOutAmount osmomath.Int, err error) {
+	if externalPrice.IsNil() {
+		externalPrice = osmomath.ZeroDec()
+	}
+
+	trade, err := k.EstimateTradeBasedOnPriceImpact(ctx, queryproto.EstimateTradeBasedOnPriceImpactRequest{
+		FromCoin:       tokenIn,
+		ToCoinDenom:    tokenOutDenom,
+		PoolId:         poolId,
+		MaxPriceImpact: maxPriceImpact,
+		ExternalPrice:  externalPrice,
+	})
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	if !trade.InputCoin.IsPositive() {
+		return osmomath.Int{}, osmomath.Int{}, types.NoTradeWithinMaxPriceImpactError{PoolId: poolId, MaxPriceImpact: maxPriceImpact}
+	}
+
+	// The price impact is already bounded by the estimate, so only a positive token out is required.
+	route := []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}
+	tokenOutAmount, err = k.routeExactAmountIn(ctx, sender, route, trade.InputCoin, osmomath.OneInt(), affiliate)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	return trade.InputCoin.Amount, tokenOutAmount, nil
+}
+
 // EstimateTradeBasedOnPriceImpactBalancerPool estimates a trade based on price impact for a balancer pool type.
 // For a balancer pool if an amount entered is greater than the total pool liquidity the trade estimated would be
 // the full liquidity of the other token. If the amount is small it would return a close 1:1 trade of the
@@ -863,7 +1058,7 @@ func (k Keeper) EstimateTradeBasedOnPriceImpactBalancerPool(
 				OutputCoin: sdk.NewCoin(req.ToCoinDenom, osmomath.ZeroInt()),
 			}, nil
 		}
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 	if tokenOut.IsZero() {
 		return &queryproto.EstimateTradeBasedOnPriceImpactResponse{
@@ -886,7 +1081,7 @@ func (k Keeper) EstimateTradeBasedOnPriceImpactBalancerPool(
 					OutputCoin: sdk.NewCoin(req.ToCoinDenom, osmomath.ZeroInt()),
 				}, nil
 			}
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, err
 		}
 
 		return &queryproto.EstimateTradeBasedOnPriceImpactResponse{
@@ -929,7 +1124,7 @@ func (k Keeper) EstimateTradeBasedOnPriceImpactBalancerPool(
 					OutputCoin: sdk.NewCoin(req.ToCoinDenom, osmomath.ZeroInt()),
 				}, nil
 			}
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, err
 		}
 		if tokenOut.IsZero() {
 			return &queryproto.EstimateTradeBasedOnPriceImpactResponse{
@@ -959,7 +1154,7 @@ func (k Keeper) EstimateTradeBasedOnPriceImpactBalancerPool(
 		ctx, poolI, currFromCoin, req.ToCoinDenom, poolI.GetSpreadFactor(ctx),
 	)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &queryproto.EstimateTradeBasedOnPriceImpactResponse{
@@ -1004,7 +1199,7 @@ func (k Keeper) EstimateTradeBasedOnPriceImpactStableSwapPool(
 				ctx, poolI, req.FromCoin, req.ToCoinDenom, poolI.GetSpreadFactor(ctx),
 			)
 			if err != nil {
-				return nil, status.Error(codes.Internal, err.Error())
+				return nil, err
 			}
 
 			return &queryproto.EstimateTradeBasedOnPriceImpactResponse{
@@ -1078,7 +1273,7 @@ func (k Keeper) EstimateTradeBasedOnPriceImpactStableSwapPool(
 		ctx, poolI, currFromCoin, req.ToCoinDenom, poolI.GetSpreadFactor(ctx),
 	)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &queryproto.EstimateTradeBasedOnPriceImpactResponse{
@@ -1117,7 +1312,7 @@ func (k Keeper) EstimateTradeBasedOnPriceImpactConcentratedLiquidity(
 				ctx, poolI, req.FromCoin, req.ToCoinDenom, poolI.GetSpreadFactor(ctx),
 			)
 			if err != nil {
-				return nil, status.Error(codes.Internal, err.Error())
+				return nil, err
 			}
 
 			return &queryproto.EstimateTradeBasedOnPriceImpactResponse{
@@ -1186,7 +1381,7 @@ func (k Keeper) EstimateTradeBasedOnPriceImpactConcentratedLiquidity(
 		ctx, poolI, currFromCoin, req.ToCoinDenom, poolI.GetSpreadFactor(ctx),
 	)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &queryproto.EstimateTradeBasedOnPriceImpactResponse{
@@ -109,10 +109,37 @@ func (k *Keeper) SetTakerFeeShareAgreementForDenom(ctx sdk.Context, takerFeeShar
 
 	store.Set(key, bz)
 
+	// A new agreement starts with a fresh skim cap.
+	k.deleteTakerFeeShareSkimmedTotal(ctx, takerFeeShare.Denom)
+
 	// Set cache value
 	k.cachedTakerFeeShareAgreementMap[takerFeeShare.Denom] = takerFeeShare
 
-	// Check if this denom is in the registered alloyed pools, if so we need to recalculate the taker fee share composition
+	return k.recalculateTakerFeeShareAlloyCompositionsWithDenom(ctx, takerFeeShare.Denom)
+}
+
+// deleteTakerFeeShareAgreementForDenom deletes the taker fee share agreement of the denom from the store and cache,
+// along with its skimmed total.
+func (k *Keeper) deleteTakerFeeShareAgreementForDenom(ctx sdk.Context, takerFeeShareDenom string) error {
+	ctx.KVStore(k.storeKey).Delete(types.FormatTakerFeeShareAgreementKey(takerFeeShareDenom))
+	k.deleteTakerFeeShareSkimmedTotal(ctx, takerFeeShareDenom)
+
+	takerFeeShare, found := k.cachedTakerFeeShareAgreementMap[takerFeeShareDenom]
+	delete(k.cachedTakerFeeShareAgreementMap, takerFeeShareDenom)
+
+	if err := k.recalculateTakerFeeShareAlloyCompositionsWithDenom(ctx, takerFeeShareDenom); err != nil {
+		// Restore the cache value, since the store writes are discarded by the caller on error.
+		if found {
+			k.cachedTakerFeeShareAgreementMap[takerFeeShareDenom] = takerFeeShare
+		}
+		return err
+	}
+	return nil
+}
+
+// recalculateTakerFeeShareAlloyCompositionsWithDenom recalculates the taker fee share composition of the registered alloyed pools
+// containing the denom, after its taker fee share agreement changed.
+func (k *Keeper) recalculateTakerFeeShareAlloyCompositionsWithDenom(ctx sdk.Context, takerFeeShareDenom string) error {
 	poolIds, err := k.getAllRegisteredAlloyedPoolsIdArray(ctx)
 	if err != nil {
 		return err
@@ -124,8 +151,8 @@ func (k *Keeper) SetTakerFeeShareAgreementForDenom(ctx sdk.Context, takerFeeShar
 		}
 		poolDenoms := pool.GetPoolDenoms(ctx)
 		for _, denom := range poolDenoms {
-			if denom == takerFeeShare.Denom {
-				// takerFeeShare.Denom is one of the poolDenoms
+			if denom == takerFeeShareDenom {
+				// takerFeeShareDenom is one of the poolDenoms
 				err := k.recalculateAndSetTakerFeeShareAlloyComposition(ctx, poolId)
 				if err != nil {
 					return err
@@ -138,6 +165,31 @@ func (k *Keeper) SetTakerFeeShareAgreementForDenom(ctx sdk.Context, takerFeeShar
 	return nil
 }
 
+// GetTakerFeeShareSkimmedTotal returns the cumulative taker fees skimmed so far for the taker fee share agreement of the denom.
+// Only tracked for agreements with a skim cap.
+func (k Keeper) GetTakerFeeShareSkimmedTotal(ctx sdk.Context, takerFeeShareDenom string) sdk.Coins {
+	skimmedTotal := types.TakerFeeSkimAccumulator{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FormatTakerFeeShareSkimmedTotalKey(takerFeeShareDenom), &skimmedTotal)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return sdk.Coins{}
+	}
+	return skimmedTotal.SkimmedTakerFees
+}
+
+func (k Keeper) setTakerFeeShareSkimmedTotal(ctx sdk.Context, takerFeeShareDenom string, skimmedTotal sdk.Coins) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatTakerFeeShareSkimmedTotalKey(takerFeeShareDenom), &types.TakerFeeSkimAccumulator{
+		Denom:            takerFeeShareDenom,
+		SkimmedTakerFees: skimmedTotal,
+	})
+}
+
+func (k Keeper) deleteTakerFeeShareSkimmedTotal(ctx sdk.Context, takerFeeShareDenom string) {
+	ctx.KVStore(k.storeKey).Delete(types.FormatTakerFeeShareSkimmedTotalKey(takerFeeShareDenom))
+}
+
 //
 // Taker Fee Share Accumulators
 //
@@ -539,8 +591,7 @@ func (k Keeper) calculateTakerFeeShareAgreements(totalPoolLiquidity []sdk.Coin,
 	totalAlloyedLiquidity := types.ZeroDec
 	var assetsWithShareAgreement []sdk.Coin
 	var takerFeeShareAgreements []types.TakerFeeShareAgreement
-	var skimAddresses []string
-	var skimPercents []osmomath.Dec
+	var shareAgreements []types.TakerFeeShareAgreement
 
 	for _, coin := range totalPoolLiquidity {
 		normalizationFactor := normalizationFactors[coin.Denom]
@@ -552,8 +603,7 @@ func (k Keeper) calculateTakerFeeShareAgreements(totalPoolLiquidity []sdk.Coin,
 			continue
 		}
 		assetsWithShareAgreement = append(assetsWithShareAgreement, coin)
-		skimAddresses = append(skimAddresses, takerFeeShareAgreement.SkimAddress)
-		skimPercents = append(skimPercents, takerFeeShareAgreement.SkimPercent)
+		shareAgreements = append(shareAgreements, takerFeeShareAgreement)
 	}
 
 	if totalAlloyedLiquidity.IsZero() {
@@ -563,11 +613,25 @@ func (k Keeper) calculateTakerFeeShareAgreements(totalPoolLiquidity []sdk.Coin,
 	for i, coin := range assetsWithShareAgreement {
 		normalizationFactor := normalizationFactors[coin.Denom]
 		normalizedAmount := coin.Amount.ToLegacyDec().Quo(normalizationFactor)
-		scaledSkim := normalizedAmount.Quo(totalAlloyedLiquidity).Mul(skimPercents[i])
+		liquidityShare := normalizedAmount.Quo(totalAlloyedLiquidity)
+
+		// The schedule of the underlying agreement is scaled by the same share, while its times and skim cap are kept as is.
+		var scaledSchedule []types.SkimPercentStep
+		for _, step := range shareAgreements[i].SkimPercentSchedule {
+			scaledSchedule = append(scaledSchedule, types.SkimPercentStep{
+				StartTime:   step.StartTime,
+				SkimPercent: liquidityShare.Mul(step.SkimPercent),
+			})
+		}
+
 		takerFeeShareAgreements = append(takerFeeShareAgreements, types.TakerFeeShareAgreement{
-			Denom:       coin.Denom,
-			SkimPercent: scaledSkim,
-			SkimAddress: skimAddresses[i],
+			Denom:               coin.Denom,
+			SkimPercent:         liquidityShare.Mul(shareAgreements[i].SkimPercent),
+			SkimAddress:         shareAgreements[i].SkimAddress,
+			StartTime:           shareAgreements[i].StartTime,
+			EndTime:             shareAgreements[i].EndTime,
+			SkimPercentSchedule: scaledSchedule,
+			SkimCap:             shareAgreements[i].SkimCap,
 		})
 	}
 
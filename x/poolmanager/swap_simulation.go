@@ -0,0 +1,112 @@
+package poolmanager
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	cltypes "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+// SimulateSwapExactAmountIn executes the swap route from the sender on a cache context, through the same
+// SwapExactAmountIn used by RouteExactAmountIn, and returns the token out amount along with the breakdown of every hop.
+// None of the state changes of the simulation are committed. The sender must hold the token in.
+func (k Keeper) SimulateSwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+) (tokenOutAmount osmomath.Int, hops []queryproto.SwapHopSimulation, err error) {
+	// recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			tokenOutAmount, hops = osmomath.Int{}, nil
+			if isErr, d := osmoutils.IsOutOfGasError(r); isErr {
+				err = fmt.Errorf("function SimulateSwapExactAmountIn failed due to lack of gas: %v", d)
+			} else {
+				err = fmt.Errorf("function SimulateSwapExactAmountIn failed due to internal reason: %v", r)
+			}
+		}
+	}()
+
+	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
+		return osmomath.Int{}, nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	hops = make([]queryproto.SwapHopSimulation, 0, len(route))
+	for _, routeStep := range route {
+		hop, err := k.simulateSwapHop(cacheCtx, sender, routeStep, tokenIn)
+		if err != nil {
+			return osmomath.Int{}, nil, err
+		}
+		hops = append(hops, hop)
+
+		// Chain output of current pool as the input for the next routed pool
+		tokenIn = hop.TokenOut
+	}
+
+	return tokenIn.Amount, hops, nil
+}
+
+// simulateSwapHop swaps the token in through a single hop of a route, and returns its breakdown.
+func (k Keeper) simulateSwapHop(ctx sdk.Context, sender sdk.AccAddress, routeStep types.SwapAmountInRoute, tokenIn sdk.Coin) (queryproto.SwapHopSimulation, error) {
+	_, pool, err := k.GetPoolModuleAndPool(ctx, routeStep.PoolId)
+	if err != nil {
+		return queryproto.SwapHopSimulation{}, err
+	}
+	spreadFactor := pool.GetSpreadFactor(ctx)
+
+	spotPriceBefore, err := k.RouteCalculateSpotPrice(ctx, routeStep.PoolId, routeStep.TokenOutDenom, tokenIn.Denom)
+	if err != nil {
+		return queryproto.SwapHopSimulation{}, err
+	}
+
+	// Swap on a fresh event manager, to count the ticks crossed from the events of the swap.
+	hopCtx := ctx.WithEventManager(sdk.NewEventManager())
+	tokenOutAmount, takerFeeCharged, err := k.SwapExactAmountIn(hopCtx, sender, routeStep.PoolId, tokenIn, routeStep.TokenOutDenom, osmomath.OneInt())
+	if err != nil {
+		return queryproto.SwapHopSimulation{}, err
+	}
+	ticksCrossed := uint64(0)
+	for _, event := range hopCtx.EventManager().Events() {
+		if event.Type == cltypes.TypeEvtCrossTick {
+			ticksCrossed++
+		}
+	}
+
+	spotPriceAfter, err := k.RouteCalculateSpotPrice(ctx, routeStep.PoolId, routeStep.TokenOutDenom, tokenIn.Denom)
+	if err != nil {
+		return queryproto.SwapHopSimulation{}, err
+	}
+
+	tokenInAfterTakerFee := tokenIn.Amount.Sub(takerFeeCharged.Amount)
+	spreadFee := osmomath.NewDecFromInt(tokenInAfterTakerFee).Mul(spreadFactor).TruncateInt()
+
+	return queryproto.SwapHopSimulation{
+		PoolId:          routeStep.PoolId,
+		TokenIn:         tokenIn,
+		TokenOut:        sdk.NewCoin(routeStep.TokenOutDenom, tokenOutAmount),
+		SpreadFactor:    spreadFactor,
+		SpreadFee:       sdk.NewCoin(tokenIn.Denom, spreadFee),
+		TakerFee:        sdk.NewCoin(tokenIn.Denom, takerFeeCharged.Amount),
+		SpotPriceBefore: spotPriceBefore,
+		SpotPriceAfter:  spotPriceAfter,
+		PriceImpact:     calcPriceImpact(spotPriceBefore, tokenInAfterTakerFee.Sub(spreadFee), tokenOutAmount),
+		TicksCrossed:    ticksCrossed,
+	}, nil
+}
+
+// calcPriceImpact returns the relative difference between the spot price before a swap and its execution price,
+// given the token in amount net of fees and the token out amount.
+func calcPriceImpact(spotPriceBefore osmomath.BigDec, tokenInAmount, tokenOutAmount osmomath.Int) osmomath.Dec {
+	if !spotPriceBefore.IsPositive() || !tokenInAmount.IsPositive() {
+		return osmomath.ZeroDec()
+	}
+	executionPrice := osmomath.BigDecFromSDKInt(tokenOutAmount).Quo(osmomath.BigDecFromSDKInt(tokenInAmount))
+	return osmomath.OneBigDec().Sub(executionPrice.Quo(spotPriceBefore)).Dec()
+}
@@ -0,0 +1,102 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+// validates that simulating a multihop swap returns the same token out as the swap estimate,
+// with the breakdown of every hop, and leaves the state unchanged.
+func (s *KeeperTestSuite) TestSimulateSwapExactAmountIn() {
+	s.SetupTest()
+	sender := s.TestAccs[0]
+	poolManager := s.App.PoolManagerKeeper
+	queryClient := queryproto.NewQueryClient(s.QueryHelper)
+	takerFee := osmomath.MustNewDecFromStr("0.01")
+
+	balancerPoolId := s.PrepareBalancerPool()
+	clPool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition("bar", "quux")
+	poolManager.SetDenomPairTakerFee(s.Ctx, "baz", "bar", takerFee)
+
+	tokenIn := sdk.NewCoin("baz", osmomath.NewInt(1_000_000))
+	routes := []types.SwapAmountInRoute{
+		{PoolId: balancerPoolId, TokenOutDenom: "bar"},
+		{PoolId: clPool.GetId(), TokenOutDenom: "quux"},
+	}
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+	expectedTokenOutAmount, err := poolManager.MultihopEstimateOutGivenExactAmountIn(s.Ctx, routes, tokenIn)
+	s.Require().NoError(err)
+	balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+	spotPriceBefore, err := poolManager.RouteCalculateSpotPrice(s.Ctx, balancerPoolId, "bar", "baz")
+	s.Require().NoError(err)
+
+	// System under test.
+	res, err := queryClient.SimulateSwapExactAmountIn(s.Ctx, &queryproto.SimulateSwapExactAmountInRequest{
+		Sender:  sender.String(),
+		TokenIn: tokenIn.String(),
+		Routes:  routes,
+	})
+	s.Require().NoError(err)
+
+	s.Require().Equal(expectedTokenOutAmount, res.TokenOutAmount)
+	s.Require().Len(res.Hops, 2)
+
+	balancerHop := res.Hops[0]
+	s.Require().Equal(balancerPoolId, balancerHop.PoolId)
+	s.Require().Equal(tokenIn, balancerHop.TokenIn)
+	s.Require().Equal(sdk.NewCoin("baz", osmomath.NewInt(10_000)), balancerHop.TakerFee)
+	s.Require().Equal(spotPriceBefore, balancerHop.SpotPriceBefore)
+	s.Require().True(balancerHop.SpotPriceAfter.LT(balancerHop.SpotPriceBefore))
+	s.Require().True(balancerHop.PriceImpact.IsPositive())
+	s.Require().Zero(balancerHop.TicksCrossed)
+
+	clHop := res.Hops[1]
+	s.Require().Equal(clPool.GetId(), clHop.PoolId)
+	s.Require().Equal(balancerHop.TokenOut, clHop.TokenIn)
+	s.Require().Equal(sdk.NewCoin("quux", expectedTokenOutAmount), clHop.TokenOut)
+	s.Require().Zero(clHop.TicksCrossed)
+
+	// The simulation does not change the state.
+	s.Require().Equal(balancesBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+	spotPriceAfter, err := poolManager.RouteCalculateSpotPrice(s.Ctx, balancerPoolId, "bar", "baz")
+	s.Require().NoError(err)
+	s.Require().Equal(spotPriceBefore, spotPriceAfter)
+
+	// The sender must hold the token in.
+	_, err = queryClient.SimulateSwapExactAmountIn(s.Ctx, &queryproto.SimulateSwapExactAmountInRequest{
+		Sender:  s.TestAccs[1].String(),
+		TokenIn: tokenIn.String(),
+		Routes:  routes,
+	})
+	s.Require().Error(err)
+}
+
+// validates that simulating a swap through a concentrated liquidity pool counts the initialized ticks crossed.
+func (s *KeeperTestSuite) TestSimulateSwapExactAmountInTicksCrossed() {
+	s.SetupTest()
+	sender := s.TestAccs[0]
+	clPool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition("bar", "quux")
+
+	// A narrow position around the current tick, whose lower tick is crossed by the swap.
+	narrowPositionCoins := sdk.NewCoins(sdk.NewCoin("bar", osmomath.NewInt(1_000_000)), sdk.NewCoin("quux", osmomath.NewInt(1_000_000)))
+	s.FundAcc(sender, narrowPositionCoins)
+	_, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, clPool.GetId(), sender, narrowPositionCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), -int64(apptesting.DefaultTickSpacing), int64(apptesting.DefaultTickSpacing))
+	s.Require().NoError(err)
+
+	tokenIn := sdk.NewCoin("bar", apptesting.DefaultCoinAmount.QuoRaw(100))
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+	// System under test.
+	tokenOutAmount, hops, err := s.App.PoolManagerKeeper.SimulateSwapExactAmountIn(s.Ctx, sender, []types.SwapAmountInRoute{{PoolId: clPool.GetId(), TokenOutDenom: "quux"}}, tokenIn)
+	s.Require().NoError(err)
+
+	s.Require().True(tokenOutAmount.IsPositive())
+	s.Require().Len(hops, 1)
+	s.Require().Equal(uint64(1), hops[0].TicksCrossed)
+	s.Require().True(hops[0].SpotPriceAfter.LT(hops[0].SpotPriceBefore))
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -127,6 +128,8 @@ func (k Keeper) GetAllTradingPairTakerFees(ctx sdk.Context) ([]types.DenomPairTa
 // module account. It returns the tokenIn after the taker fee has been extracted.
 // If the sender is in the taker fee reduced whitelisted, it returns the tokenIn without extracting the taker fee.
 // In the future, we might charge a lower taker fee as opposed to no fee at all.
+// Otherwise, if taker fee tiers are set, the taker fee is discounted by the tier of the sender's swap volume
+// before the route. The route is added to the sender's swap volume once by the router, see trackTraderVolume.
 // TODO: Gas optimize this function, its expensive in both gas and CPU.
 func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, sdk.Coin, error) {
 	takerFeeModuleAccountName := txfeestypes.TakerFeeCollectorName
@@ -144,6 +147,13 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	takerFeeTiers := k.GetTakerFeeTiers(ctx)
+	if len(takerFeeTiers) > 0 {
+		if tier, found := types.GetTakerFeeTier(takerFeeTiers, k.GetTraderVolume(ctx, sender)); found {
+			takerFee = takerFee.Mul(osmomath.OneDec().Sub(tier.Discount))
+		}
+	}
+
 	var tokenInAfterTakerFee sdk.Coin
 	var takerFeeCoin sdk.Coin
 	if exactIn {
@@ -241,10 +251,13 @@ func (k Keeper) processShareAgreements(ctx sdk.Context, shareAgreements []types.
 		return nil
 	}
 
+	blockTime := ctx.BlockTime()
 	percentageOfTakerFeeToSkim := osmomath.ZeroDec()
-	for _, agreement := range shareAgreements {
-		// Add up the percentage of the taker fee that should be skimmed off.
-		percentageOfTakerFeeToSkim.AddMut(agreement.SkimPercent)
+	skimPercents := make([]osmomath.Dec, len(shareAgreements))
+	for i, agreement := range shareAgreements {
+		// Add up the percentage of the taker fee that should be skimmed off, following the schedule of the agreement.
+		skimPercents[i] = agreement.SkimPercentAt(blockTime)
+		percentageOfTakerFeeToSkim.AddMut(skimPercents[i])
 	}
 
 	// Validate the total percentage of taker fees to skim.
@@ -254,8 +267,9 @@ func (k Keeper) processShareAgreements(ctx sdk.Context, shareAgreements []types.
 
 	// For each taker fee coin, calculate the amount to skim off and increase the accumulator for the underlying denomShareAgreement denom / taker fee denom pair.
 	for _, takerFeeCoin := range totalTakerFees {
-		for _, agreement := range shareAgreements {
-			amountToSkim := osmomath.NewDecFromInt(takerFeeCoin.Amount).Mul(agreement.SkimPercent).TruncateInt()
+		for i, agreement := range shareAgreements {
+			amountToSkim := osmomath.NewDecFromInt(takerFeeCoin.Amount).Mul(skimPercents[i]).TruncateInt()
+			amountToSkim = k.capTakerFeeSkim(ctx, agreement, takerFeeCoin.Denom, amountToSkim)
 			// Increase the accumulator for the underlying denomShareAgreement denom / taker fee denom pair.
 			if err := k.increaseTakerFeeShareDenomsToAccruedValue(ctx, agreement.Denom, takerFeeCoin.Denom, amountToSkim); err != nil {
 				return err
@@ -266,6 +280,65 @@ func (k Keeper) processShareAgreements(ctx sdk.Context, shareAgreements []types.
 	return nil
 }
 
+// capTakerFeeSkim limits the amount of taker fees to skim for the agreement so that the cumulative value skimmed
+// does not exceed its skim cap, and adds the value skimmed to the skimmed total of the agreement.
+// Taker fees in other denoms than the skim cap are valued in the skim cap denom, and are not skimmed if they
+// cannot be valued, since they could not be counted against the cap.
+// No-op for agreements without a skim cap.
+func (k Keeper) capTakerFeeSkim(ctx sdk.Context, agreement types.TakerFeeShareAgreement, takerFeeDenom string, amountToSkim osmomath.Int) osmomath.Int {
+	if len(agreement.SkimCap) == 0 || !amountToSkim.IsPositive() {
+		return amountToSkim
+	}
+
+	capCoin := agreement.SkimCap[0]
+	skimmedTotal := k.GetTakerFeeShareSkimmedTotal(ctx, agreement.Denom)
+	remaining := capCoin.Amount.Sub(skimmedTotal.AmountOf(capCoin.Denom))
+	if !remaining.IsPositive() {
+		return osmomath.ZeroInt()
+	}
+
+	skimValue, ok := k.valueInDenom(ctx, sdk.NewCoin(takerFeeDenom, amountToSkim), capCoin.Denom)
+	if !ok {
+		return osmomath.ZeroInt()
+	}
+	if skimValue.GT(remaining) {
+		// Only skim the share of the taker fees worth the remaining cap.
+		amountToSkim = amountToSkim.Mul(remaining).Quo(skimValue)
+		skimValue = remaining
+	}
+	if skimValue.IsPositive() {
+		k.setTakerFeeShareSkimmedTotal(ctx, agreement.Denom, skimmedTotal.Add(sdk.NewCoin(capCoin.Denom, skimValue)))
+	}
+	return amountToSkim
+}
+
+// valueInDenom returns the value of the given coin in the given denom. Coins in other denoms are valued through OSMO,
+// using the same spot prices as the volume tracking. Rounds down.
+// Returns false if either denom has no OSMO-paired pool or its spot price cannot be calculated.
+func (k Keeper) valueInDenom(ctx sdk.Context, coin sdk.Coin, denom string) (osmomath.Int, bool) {
+	if coin.Denom == denom {
+		return coin.Amount, true
+	}
+
+	osmoValue, ok := k.osmoVolume(ctx, coin)
+	if !ok {
+		return osmomath.Int{}, false
+	}
+	if osmoValue.Denom == denom {
+		return osmoValue.Amount, true
+	}
+
+	osmoPairedPoolId, err := k.protorevKeeper.GetPoolForDenomPair(ctx, osmoValue.Denom, denom)
+	if err != nil {
+		return osmomath.Int{}, false
+	}
+	denomPerOsmo, err := k.RouteCalculateSpotPrice(ctx, osmoPairedPoolId, denom, osmoValue.Denom)
+	if err != nil {
+		return osmomath.Int{}, false
+	}
+	return osmomath.BigDecFromSDKInt(osmoValue.Amount).Mul(denomPerOsmo).Dec().TruncateInt(), true
+}
+
 // validatePercentage validates the total percentage of taker fees to skim.
 func (k Keeper) validatePercentage(percentage osmomath.Dec) error {
 	if percentage.GT(types.OneDec) || percentage.LT(types.ZeroDec) {
@@ -273,3 +346,72 @@ func (k Keeper) validatePercentage(percentage osmomath.Dec) error {
 	}
 	return nil
 }
+
+// expireTakerFeeShareAgreements removes the taker fee share agreements that reached their end time or their skim cap.
+// The taker fees accrued so far for an expired agreement are sent to its skim address right away, since they can
+// no longer be distributed at the end of the epoch once the agreement is removed.
+func (k *Keeper) expireTakerFeeShareAgreements(ctx sdk.Context) {
+	blockTime := ctx.BlockTime()
+
+	// Sort the denoms, since map iteration is non-deterministic.
+	denoms := make([]string, 0, len(k.cachedTakerFeeShareAgreementMap))
+	for denom := range k.cachedTakerFeeShareAgreementMap {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	for _, denom := range denoms {
+		agreement := k.cachedTakerFeeShareAgreementMap[denom]
+		if !agreement.IsExpiredAt(blockTime) && (len(agreement.SkimCap) == 0 || !agreement.IsSkimCapReached(k.GetTakerFeeShareSkimmedTotal(ctx, denom))) {
+			continue
+		}
+
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.expireTakerFeeShareAgreement(cacheCtx, agreement)
+		})
+		if err != nil {
+			ctx.Logger().Error(fmt.Errorf("unable to expire taker fee share agreement for denom %s: %w", denom, err).Error())
+		}
+	}
+}
+
+// expireTakerFeeShareAgreement pays out the taker fees accrued for the agreement to its skim address and removes the agreement.
+func (k *Keeper) expireTakerFeeShareAgreement(ctx sdk.Context, agreement types.TakerFeeShareAgreement) error {
+	skimAddress, err := sdk.AccAddressFromBech32(agreement.SkimAddress)
+	if err != nil {
+		return err
+	}
+
+	takerFeeShareAccumulators, err := k.GetAllTakerFeeShareAccumulators(ctx)
+	if err != nil {
+		return err
+	}
+	payout := sdk.Coins{}
+	for _, accumulator := range takerFeeShareAccumulators {
+		if accumulator.Denom == agreement.Denom {
+			payout = payout.Add(accumulator.SkimmedTakerFees...)
+		}
+	}
+	if !payout.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, txfeestypes.TakerFeeCollectorName, skimAddress, payout); err != nil {
+			return err
+		}
+	}
+	k.DeleteAllTakerFeeShareAccumulatorsForTakerFeeShareDenom(ctx, agreement.Denom)
+
+	if err := k.deleteTakerFeeShareAgreementForDenom(ctx, agreement.Denom); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTakerFeeShareAgreementExpired,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyTakerFeeShareDenom, agreement.Denom),
+			sdk.NewAttribute(types.AttributeKeyTakerFeeShareSkimAddress, agreement.SkimAddress),
+			sdk.NewAttribute(types.AttributeKeyTakerFeeSharePayout, payout.String()),
+		),
+	})
+
+	return nil
+}
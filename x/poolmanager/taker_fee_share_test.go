@@ -0,0 +1,269 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v31/x/txfees/types"
+)
+
+// validates that the taker fees skimmed for an agreement follow its start and end times,
+// its skim percent schedule, and are clamped to its skim cap.
+func (s *KeeperTestSuite) TestProcessShareAgreementsWithSchedule() {
+	var (
+		blockTime      = time.Unix(1_700_000_000, 0).UTC()
+		past           = blockTime.Add(-time.Hour)
+		future         = blockTime.Add(time.Hour)
+		totalTakerFees = sdk.NewCoins(sdk.NewCoin(denomA, osmomath.NewInt(1_000_000)))
+	)
+
+	tests := map[string]struct {
+		agreement            types.TakerFeeShareAgreement
+		priorSkimmedTotal    sdk.Coins
+		expectedSkimmed      osmomath.Int
+		expectedSkimmedTotal sdk.Coins
+	}{
+		"no schedule: skim percent": {
+			agreement:       types.TakerFeeShareAgreement{Denom: denomA, SkimPercent: osmomath.MustNewDecFromStr("0.1")},
+			expectedSkimmed: osmomath.NewInt(100_000),
+		},
+		"before start time: nothing skimmed": {
+			agreement:       types.TakerFeeShareAgreement{Denom: denomA, SkimPercent: osmomath.MustNewDecFromStr("0.1"), StartTime: &future},
+			expectedSkimmed: osmomath.ZeroInt(),
+		},
+		"after end time: nothing skimmed": {
+			agreement:       types.TakerFeeShareAgreement{Denom: denomA, SkimPercent: osmomath.MustNewDecFromStr("0.1"), EndTime: &past},
+			expectedSkimmed: osmomath.ZeroInt(),
+		},
+		"schedule: last started step": {
+			agreement: types.TakerFeeShareAgreement{
+				Denom:       denomA,
+				SkimPercent: osmomath.MustNewDecFromStr("0.1"),
+				StartTime:   &past,
+				EndTime:     &future,
+				SkimPercentSchedule: []types.SkimPercentStep{
+					{StartTime: past, SkimPercent: osmomath.MustNewDecFromStr("0.05")},
+					{StartTime: blockTime, SkimPercent: osmomath.MustNewDecFromStr("0.02")},
+					{StartTime: future.Add(-time.Minute), SkimPercent: osmomath.MustNewDecFromStr("0.01")},
+				},
+			},
+			expectedSkimmed: osmomath.NewInt(20_000),
+		},
+		"skim cap: clamped to the remaining cap": {
+			agreement: types.TakerFeeShareAgreement{
+				Denom:       denomA,
+				SkimPercent: osmomath.MustNewDecFromStr("0.1"),
+				SkimCap:     sdk.NewCoins(sdk.NewCoin(denomA, osmomath.NewInt(150_000))),
+			},
+			priorSkimmedTotal:    sdk.NewCoins(sdk.NewCoin(denomA, osmomath.NewInt(100_000))),
+			expectedSkimmed:      osmomath.NewInt(50_000),
+			expectedSkimmedTotal: sdk.NewCoins(sdk.NewCoin(denomA, osmomath.NewInt(150_000))),
+		},
+		"skim cap: below the remaining cap": {
+			agreement: types.TakerFeeShareAgreement{
+				Denom:       denomA,
+				SkimPercent: osmomath.MustNewDecFromStr("0.1"),
+				SkimCap:     sdk.NewCoins(sdk.NewCoin(denomA, osmomath.NewInt(1_000_000))),
+			},
+			expectedSkimmed:      osmomath.NewInt(100_000),
+			expectedSkimmedTotal: sdk.NewCoins(sdk.NewCoin(denomA, osmomath.NewInt(100_000))),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(blockTime)
+			poolManager := s.App.PoolManagerKeeper
+			if tc.priorSkimmedTotal != nil {
+				poolManager.SetTakerFeeShareSkimmedTotal(s.Ctx, denomA, tc.priorSkimmedTotal)
+			}
+
+			// System under test.
+			err := poolManager.ProcessShareAgreements(s.Ctx, []types.TakerFeeShareAgreement{tc.agreement}, totalTakerFees)
+			s.Require().NoError(err)
+
+			skimmed, err := poolManager.GetTakerFeeShareDenomsToAccruedValue(s.Ctx, denomA, denomA)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedSkimmed, skimmed)
+
+			expectedSkimmedTotal := tc.expectedSkimmedTotal
+			if expectedSkimmedTotal == nil {
+				expectedSkimmedTotal = sdk.Coins{}
+			}
+			s.Require().Equal(expectedSkimmedTotal, poolManager.GetTakerFeeShareSkimmedTotal(s.Ctx, denomA))
+		})
+	}
+}
+
+// validates that the skim cap of an agreement caps the cumulative value of the taker fees skimmed
+// in all denoms, valuing taker fees in other denoms than the skim cap through their OSMO-paired pools.
+func (s *KeeperTestSuite) TestProcessShareAgreementsSkimCapValue() {
+	var (
+		skimPercent = osmomath.MustNewDecFromStr("0.1")
+		// 10 foo corresponds to 1 osmo (spot price = 0.1)
+		osmoPairedPoolCoins = sdk.NewCoins(
+			sdk.NewCoin(FOO, osmomath.NewInt(1_000_000_000)),
+			sdk.NewCoin(UOSMO, osmomath.NewInt(100_000_000)),
+		)
+	)
+
+	tests := map[string]struct {
+		totalTakerFees       sdk.Coins
+		skimCap              sdk.Coins
+		noOsmoPairedPool     bool
+		expectedSkimmed      sdk.Coins
+		expectedSkimmedTotal sdk.Coins
+	}{
+		"non-cap denom: valued in the cap denom": {
+			totalTakerFees:       sdk.NewCoins(sdk.NewCoin(FOO, osmomath.NewInt(1_000_000))),
+			skimCap:              sdk.NewCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000))),
+			expectedSkimmed:      sdk.NewCoins(sdk.NewCoin(FOO, osmomath.NewInt(100_000))),
+			expectedSkimmedTotal: sdk.NewCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(10_000))),
+		},
+		"non-cap denom: clamped to the value of the remaining cap": {
+			totalTakerFees:       sdk.NewCoins(sdk.NewCoin(FOO, osmomath.NewInt(1_000_000))),
+			skimCap:              sdk.NewCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(5_000))),
+			expectedSkimmed:      sdk.NewCoins(sdk.NewCoin(FOO, osmomath.NewInt(50_000))),
+			expectedSkimmedTotal: sdk.NewCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(5_000))),
+		},
+		"cap and non-cap denoms: cap shared by all denoms": {
+			totalTakerFees: sdk.NewCoins(
+				sdk.NewCoin(FOO, osmomath.NewInt(1_000_000)),
+				sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)),
+			),
+			skimCap: sdk.NewCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(60_000))),
+			// 100_000 foo worth 10_000 osmo are skimmed first, leaving 50_000 osmo of the cap.
+			expectedSkimmed: sdk.NewCoins(
+				sdk.NewCoin(FOO, osmomath.NewInt(100_000)),
+				sdk.NewCoin(UOSMO, osmomath.NewInt(50_000)),
+			),
+			expectedSkimmedTotal: sdk.NewCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(60_000))),
+		},
+		"non-OSMO cap denom: valued through OSMO": {
+			totalTakerFees:       sdk.NewCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000))),
+			skimCap:              sdk.NewCoins(sdk.NewCoin(FOO, osmomath.NewInt(500_000))),
+			expectedSkimmed:      sdk.NewCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(50_000))),
+			expectedSkimmedTotal: sdk.NewCoins(sdk.NewCoin(FOO, osmomath.NewInt(500_000))),
+		},
+		"non-cap denom without OSMO-paired pool: nothing skimmed": {
+			totalTakerFees:       sdk.NewCoins(sdk.NewCoin(FOO, osmomath.NewInt(1_000_000))),
+			skimCap:              sdk.NewCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000))),
+			noOsmoPairedPool:     true,
+			expectedSkimmed:      sdk.NewCoins(),
+			expectedSkimmedTotal: sdk.Coins{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolManager := s.App.PoolManagerKeeper
+			if !tc.noOsmoPairedPool {
+				osmoPairedPoolId := s.CreatePoolFromTypeWithCoins(types.Balancer, osmoPairedPoolCoins)
+				s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, UOSMO, FOO, osmoPairedPoolId)
+			}
+			agreement := types.TakerFeeShareAgreement{Denom: denomA, SkimPercent: skimPercent, SkimCap: tc.skimCap}
+
+			// System under test.
+			err := poolManager.ProcessShareAgreements(s.Ctx, []types.TakerFeeShareAgreement{agreement}, tc.totalTakerFees)
+			s.Require().NoError(err)
+
+			for _, takerFeeCoin := range tc.totalTakerFees {
+				skimmed, err := poolManager.GetTakerFeeShareDenomsToAccruedValue(s.Ctx, denomA, takerFeeCoin.Denom)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedSkimmed.AmountOf(takerFeeCoin.Denom), skimmed)
+			}
+			s.Require().Equal(tc.expectedSkimmedTotal, poolManager.GetTakerFeeShareSkimmedTotal(s.Ctx, denomA))
+		})
+	}
+}
+
+// validates that agreements reaching their end time or skim cap are removed in EndBlock,
+// with the taker fees accrued for them paid out to their skim address.
+func (s *KeeperTestSuite) TestExpireTakerFeeShareAgreements() {
+	var (
+		blockTime = time.Unix(1_700_000_000, 0).UTC()
+		endTime   = blockTime.Add(time.Hour)
+		accrued   = osmomath.NewInt(1_000)
+	)
+
+	tests := map[string]struct {
+		blockTime    time.Time
+		skimCap      sdk.Coins
+		skimmedTotal sdk.Coins
+
+		expectExpired bool
+	}{
+		"before end time: not expired": {
+			blockTime: blockTime,
+		},
+		"at end time: expired": {
+			blockTime:     endTime,
+			expectExpired: true,
+		},
+		"skim cap not reached: not expired": {
+			blockTime:    blockTime,
+			skimCap:      sdk.NewCoins(sdk.NewCoin(denomA, osmomath.NewInt(2_000))),
+			skimmedTotal: sdk.NewCoins(sdk.NewCoin(denomA, accrued)),
+		},
+		"skim cap reached: expired": {
+			blockTime:     blockTime,
+			skimCap:       sdk.NewCoins(sdk.NewCoin(denomA, accrued)),
+			skimmedTotal:  sdk.NewCoins(sdk.NewCoin(denomA, accrued)),
+			expectExpired: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			skimAddress := s.TestAccs[2]
+			poolManager := s.App.PoolManagerKeeper
+
+			err := poolManager.SetTakerFeeShareAgreementForDenom(s.Ctx, types.TakerFeeShareAgreement{
+				Denom:       denomA,
+				SkimPercent: osmomath.MustNewDecFromStr("0.1"),
+				SkimAddress: skimAddress.String(),
+				EndTime:     &endTime,
+				SkimCap:     tc.skimCap,
+			})
+			s.Require().NoError(err)
+			if tc.skimmedTotal != nil {
+				poolManager.SetTakerFeeShareSkimmedTotal(s.Ctx, denomA, tc.skimmedTotal)
+			}
+			err = poolManager.IncreaseTakerFeeShareDenomsToAccruedValue(s.Ctx, denomA, denomA, accrued)
+			s.Require().NoError(err)
+			s.FundModuleAcc(txfeestypes.TakerFeeCollectorName, sdk.NewCoins(sdk.NewCoin(denomA, accrued)))
+			balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, skimAddress, denomA)
+			s.Ctx = s.Ctx.WithBlockTime(tc.blockTime).WithEventManager(sdk.NewEventManager())
+
+			// System under test.
+			poolManager.EndBlock(s.Ctx)
+
+			balanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, skimAddress, denomA)
+			_, found := poolManager.GetTakerFeeShareAgreementFromDenom(denomA)
+			_, foundInStore := poolManager.GetTakerFeeShareAgreementFromDenomNoCache(s.Ctx, denomA)
+			accumulators, err := poolManager.GetAllTakerFeeShareAccumulators(s.Ctx)
+			s.Require().NoError(err)
+
+			if !tc.expectExpired {
+				s.Require().True(found)
+				s.Require().True(foundInStore)
+				s.Require().Equal(balanceBefore, balanceAfter)
+				s.Require().Len(accumulators, 1)
+				return
+			}
+
+			s.Require().False(found)
+			s.Require().False(foundInStore)
+			s.Require().Equal(accrued, balanceAfter.Amount.Sub(balanceBefore.Amount))
+			s.Require().Empty(accumulators)
+			s.Require().Equal(sdk.Coins{}, poolManager.GetTakerFeeShareSkimmedTotal(s.Ctx, denomA))
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtTakerFeeShareAgreementExpired, 1)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package poolmanager
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+// GetTakerFeeTiers returns the taker fee tiers discounting the taker fee of traders by their swap volume.
+func (k Keeper) GetTakerFeeTiers(ctx sdk.Context) []types.TakerFeeTier {
+	takerFeeTiers := []types.TakerFeeTier{}
+	k.paramSpace.Get(ctx, types.KeyTakerFeeTiers, &takerFeeTiers)
+	return takerFeeTiers
+}
+
+// GetTraderVolume returns the swap volume of the trader over the last TraderVolumeWindowDays days, in OSMO.
+func (k Keeper) GetTraderVolume(ctx sdk.Context, trader sdk.AccAddress) osmomath.Int {
+	return k.getTraderVolume(ctx, trader).Volume(types.VolumeDay(ctx.BlockTime()))
+}
+
+// GetTraderTakerFeeTier returns the swap volume of the trader over the last TraderVolumeWindowDays days, in OSMO,
+// and the taker fee tier it gives the trader. Returns false if the volume is below the min volume of every tier.
+func (k Keeper) GetTraderTakerFeeTier(ctx sdk.Context, trader sdk.AccAddress) (osmomath.Int, types.TakerFeeTier, bool) {
+	volume := k.GetTraderVolume(ctx, trader)
+	tier, found := types.GetTakerFeeTier(k.GetTakerFeeTiers(ctx), volume)
+	return volume, tier, found
+}
+
+// trackTraderVolume converts the token in of a route into OSMO units and adds it to the swap volume of the trader
+// in the current day, if taker fee tiers are set and the trader is not in the taker fee reduced whitelist.
+// It is called once per route, so that multi hop routes count once towards the swap volume.
+// Fails quietly if the token in cannot be priced in OSMO, as trackVolume does.
+func (k Keeper) trackTraderVolume(ctx sdk.Context, trader sdk.AccAddress, tokenIn sdk.Coin) {
+	if len(k.GetTakerFeeTiers(ctx)) == 0 {
+		return
+	}
+
+	reducedFeeWhitelist := []string{}
+	k.paramSpace.Get(ctx, types.KeyReducedTakerFeeByWhitelist, &reducedFeeWhitelist)
+	if osmoutils.Contains(reducedFeeWhitelist, trader.String()) {
+		return
+	}
+
+	volumeInOsmo, ok := k.osmoVolume(ctx, tokenIn)
+	if !ok || !volumeInOsmo.Amount.IsPositive() {
+		return
+	}
+
+	traderVolume := k.getTraderVolume(ctx, trader)
+	traderVolume.AddVolume(types.VolumeDay(ctx.BlockTime()), volumeInOsmo.Amount)
+	k.setTraderVolume(ctx, traderVolume)
+}
+
+// NumTraderVolumesToPrunePerBlock is the number of trader volumes checked for days outside of the volume window per block.
+var NumTraderVolumesToPrunePerBlock = 100
+
+// pruneTraderVolumes removes the days outside of the volume window from up to NumTraderVolumesToPrunePerBlock trader volumes,
+// deleting the trader volumes left without any day. It resumes from the trader volume following the last one checked, so that
+// all of them are checked over consecutive blocks and the volumes of traders that stopped swapping are eventually removed.
+func (k Keeper) pruneTraderVolumes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	start := store.Get(types.KeyTraderVolumePruningCursor)
+	if start == nil {
+		start = types.TraderVolumePrefix
+	}
+
+	day := types.VolumeDay(ctx.BlockTime())
+	prunedTraderVolumes := []types.TraderVolume{}
+	var nextKey []byte
+
+	iter := store.Iterator(start, storetypes.PrefixEndBytes(types.TraderVolumePrefix))
+	checked := 0
+	for ; iter.Valid(); iter.Next() {
+		if checked == NumTraderVolumesToPrunePerBlock {
+			nextKey = iter.Key()
+			break
+		}
+		checked++
+
+		traderVolume, err := parseTraderVolume(iter.Value())
+		if err != nil {
+			ctx.Logger().Error(fmt.Errorf("unable to parse trader volume: %w", err).Error())
+			continue
+		}
+		if traderVolume.PruneVolumes(day) {
+			prunedTraderVolumes = append(prunedTraderVolumes, traderVolume)
+		}
+	}
+	iter.Close()
+
+	for _, traderVolume := range prunedTraderVolumes {
+		if len(traderVolume.DailyVolumes) == 0 {
+			store.Delete(types.FormatTraderVolumeKey(sdk.MustAccAddressFromBech32(traderVolume.Address)))
+			continue
+		}
+		k.setTraderVolume(ctx, traderVolume)
+	}
+
+	if nextKey == nil {
+		store.Delete(types.KeyTraderVolumePruningCursor)
+		return
+	}
+	store.Set(types.KeyTraderVolumePruningCursor, nextKey)
+}
+
+// getTraderVolume returns the swap volumes of the trader in the days with swaps.
+func (k Keeper) getTraderVolume(ctx sdk.Context, trader sdk.AccAddress) types.TraderVolume {
+	traderVolume := types.TraderVolume{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FormatTraderVolumeKey(trader), &traderVolume)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return types.TraderVolume{Address: trader.String()}
+	}
+	return traderVolume
+}
+
+func (k Keeper) setTraderVolume(ctx sdk.Context, traderVolume types.TraderVolume) {
+	trader := sdk.MustAccAddressFromBech32(traderVolume.Address)
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatTraderVolumeKey(trader), &traderVolume)
+}
+
+// getAllTraderVolumes returns the swap volumes of all the traders tracked.
+func (k Keeper) getAllTraderVolumes(ctx sdk.Context) ([]types.TraderVolume, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.TraderVolumePrefix, parseTraderVolume)
+}
+
+func parseTraderVolume(bz []byte) (types.TraderVolume, error) {
+	traderVolume := types.TraderVolume{}
+	if err := traderVolume.Unmarshal(bz); err != nil {
+		return types.TraderVolume{}, err
+	}
+	return traderVolume, nil
+}
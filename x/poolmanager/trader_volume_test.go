@@ -0,0 +1,293 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/app/apptesting"
+	appparams "github.com/osmosis-labs/osmosis/v31/app/params"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+var testTakerFeeTiers = []types.TakerFeeTier{
+	{MinVolume: osmomath.NewInt(1_000_000), Discount: osmomath.MustNewDecFromStr("0.25")},
+	{MinVolume: osmomath.NewInt(10_000_000), Discount: osmomath.MustNewDecFromStr("0.5")},
+}
+
+// validates that the taker fee is discounted by the tier of the sender's swap volume before the swap,
+// and that charging the taker fee of a hop does not add to the sender's swap volume.
+func (s *KeeperTestSuite) TestChargeTakerFeeWithTiers() {
+	var (
+		takerFee = osmomath.MustNewDecFromStr("0.01")
+		amount   = osmomath.NewInt(1_000_000)
+	)
+
+	tests := map[string]struct {
+		takerFeeTiers []types.TakerFeeTier
+		priorVolume   osmomath.Int
+		exactIn       bool
+
+		expectedTakerFee osmomath.Dec
+	}{
+		"no tiers": {
+			priorVolume:      osmomath.NewInt(10_000_000),
+			exactIn:          true,
+			expectedTakerFee: takerFee,
+		},
+		"volume below every tier": {
+			takerFeeTiers:    testTakerFeeTiers,
+			priorVolume:      osmomath.NewInt(999_999),
+			exactIn:          true,
+			expectedTakerFee: takerFee,
+		},
+		"volume at the first tier": {
+			takerFeeTiers:    testTakerFeeTiers,
+			priorVolume:      osmomath.NewInt(1_000_000),
+			exactIn:          true,
+			expectedTakerFee: osmomath.MustNewDecFromStr("0.0075"),
+		},
+		"volume above the last tier": {
+			takerFeeTiers:    testTakerFeeTiers,
+			priorVolume:      osmomath.NewInt(20_000_000),
+			exactIn:          true,
+			expectedTakerFee: osmomath.MustNewDecFromStr("0.005"),
+		},
+		"exact out": {
+			takerFeeTiers:    testTakerFeeTiers,
+			priorVolume:      osmomath.NewInt(10_000_000),
+			exactIn:          false,
+			expectedTakerFee: osmomath.MustNewDecFromStr("0.005"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolManager := s.App.PoolManagerKeeper
+			sender := s.TestAccs[0]
+			tokenIn := sdk.NewCoin(appparams.BaseCoinUnit, amount)
+
+			poolManager.SetParam(s.Ctx, types.KeyTakerFeeTiers, tc.takerFeeTiers)
+			poolManager.SetDenomPairTakerFee(s.Ctx, tokenIn.Denom, apptesting.USDC, takerFee)
+			poolManager.SetTraderVolume(s.Ctx, types.TraderVolume{
+				Address:      sender.String(),
+				DailyVolumes: []types.DailyVolume{{Day: types.VolumeDay(s.Ctx.BlockTime()), Amount: tc.priorVolume}},
+			})
+			s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(tokenIn.Denom, amount.MulRaw(2))))
+
+			// System under test.
+			tokenInAfterTakerFee, takerFeeCoin, err := poolManager.ChargeTakerFee(s.Ctx, tokenIn, apptesting.USDC, sender, tc.exactIn)
+			s.Require().NoError(err)
+
+			var expectedTokenInAfterTakerFee, expectedTakerFeeCoin sdk.Coin
+			if tc.exactIn {
+				expectedTokenInAfterTakerFee, expectedTakerFeeCoin = poolmanager.CalcTakerFeeExactIn(tokenIn, tc.expectedTakerFee)
+			} else {
+				expectedTokenInAfterTakerFee, expectedTakerFeeCoin = poolmanager.CalcTakerFeeExactOut(tokenIn, tc.expectedTakerFee)
+			}
+			s.Require().Equal(expectedTokenInAfterTakerFee, tokenInAfterTakerFee)
+			s.Require().Equal(expectedTakerFeeCoin, takerFeeCoin)
+			s.Require().Equal(tc.priorVolume, poolManager.GetTraderVolume(s.Ctx, sender))
+		})
+	}
+}
+
+// validates that a route adds its token in to the sender's swap volume once, whatever its number of hops.
+func (s *KeeperTestSuite) TestRouteTracksTraderVolume() {
+	var (
+		takerFee = osmomath.MustNewDecFromStr("0.01")
+		amount   = osmomath.NewInt(1_000_000)
+	)
+
+	tests := map[string]struct {
+		takerFeeTiers []types.TakerFeeTier
+		whitelisted   bool
+		exactIn       bool
+		// cyclic routes osmo to usdc and back to osmo.
+		cyclic bool
+
+		expectVolume bool
+	}{
+		"exact in": {
+			takerFeeTiers: testTakerFeeTiers,
+			exactIn:       true,
+			expectVolume:  true,
+		},
+		"exact in cyclic route: counted once": {
+			takerFeeTiers: testTakerFeeTiers,
+			exactIn:       true,
+			cyclic:        true,
+			expectVolume:  true,
+		},
+		"exact out": {
+			takerFeeTiers: testTakerFeeTiers,
+			exactIn:       false,
+			expectVolume:  true,
+		},
+		"exact out cyclic route: counted once": {
+			takerFeeTiers: testTakerFeeTiers,
+			exactIn:       false,
+			cyclic:        true,
+			expectVolume:  true,
+		},
+		"no tiers: not tracked": {
+			exactIn: true,
+		},
+		"whitelisted sender: not tracked": {
+			takerFeeTiers: testTakerFeeTiers,
+			whitelisted:   true,
+			exactIn:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolManager := s.App.PoolManagerKeeper
+			sender := s.TestAccs[0]
+			tokenIn := sdk.NewCoin(appparams.BaseCoinUnit, amount)
+
+			poolManager.SetParam(s.Ctx, types.KeyTakerFeeTiers, tc.takerFeeTiers)
+			if tc.whitelisted {
+				poolManager.SetParam(s.Ctx, types.KeyReducedTakerFeeByWhitelist, []string{sender.String()})
+			}
+			poolManager.SetDenomPairTakerFee(s.Ctx, tokenIn.Denom, apptesting.USDC, takerFee)
+			firstPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(tokenIn.Denom, 1_000_000_000), sdk.NewInt64Coin(apptesting.USDC, 1_000_000_000))
+			secondPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(tokenIn.Denom, 1_000_000_000), sdk.NewInt64Coin(apptesting.USDC, 1_000_000_000))
+			s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(tokenIn.Denom, amount.MulRaw(10))))
+
+			// System under test.
+			var expectedVolume osmomath.Int
+			if tc.exactIn {
+				route := []types.SwapAmountInRoute{{PoolId: firstPoolId, TokenOutDenom: apptesting.USDC}}
+				if tc.cyclic {
+					route = append(route, types.SwapAmountInRoute{PoolId: secondPoolId, TokenOutDenom: tokenIn.Denom})
+				}
+				_, err := poolManager.RouteExactAmountIn(s.Ctx, sender, route, tokenIn, osmomath.OneInt())
+				s.Require().NoError(err)
+				expectedVolume = tokenIn.Amount
+			} else {
+				route := []types.SwapAmountOutRoute{{PoolId: firstPoolId, TokenInDenom: tokenIn.Denom}}
+				tokenOut := sdk.NewCoin(apptesting.USDC, amount)
+				if tc.cyclic {
+					route = append(route, types.SwapAmountOutRoute{PoolId: secondPoolId, TokenInDenom: apptesting.USDC})
+					tokenOut = sdk.NewCoin(tokenIn.Denom, amount)
+				}
+				tokenInAmount, err := poolManager.RouteExactAmountOut(s.Ctx, sender, route, amount.MulRaw(10), tokenOut)
+				s.Require().NoError(err)
+				expectedVolume = tokenInAmount
+			}
+
+			if !tc.expectVolume {
+				expectedVolume = osmomath.ZeroInt()
+			}
+			s.Require().Equal(expectedVolume, poolManager.GetTraderVolume(s.Ctx, sender))
+		})
+	}
+}
+
+// validates that swap volume older than the volume window is not counted and pruned.
+func (s *KeeperTestSuite) TestTraderVolumeWindow() {
+	s.SetupTest()
+	poolManager := s.App.PoolManagerKeeper
+	sender := s.TestAccs[0]
+	today := types.VolumeDay(s.Ctx.BlockTime())
+
+	poolManager.SetParam(s.Ctx, types.KeyTakerFeeTiers, testTakerFeeTiers)
+	poolManager.SetDenomPairTakerFee(s.Ctx, appparams.BaseCoinUnit, apptesting.USDC, osmomath.MustNewDecFromStr("0.01"))
+	poolManager.SetTraderVolume(s.Ctx, types.TraderVolume{
+		Address: sender.String(),
+		DailyVolumes: []types.DailyVolume{
+			{Day: today - types.TraderVolumeWindowDays, Amount: osmomath.NewInt(5_000_000)},
+			{Day: today - types.TraderVolumeWindowDays + 1, Amount: osmomath.NewInt(3_000_000)},
+		},
+	})
+	s.Require().Equal(osmomath.NewInt(3_000_000), poolManager.GetTraderVolume(s.Ctx, sender))
+
+	// A day later, the oldest volume in the window leaves it too.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(24 * time.Hour))
+	s.Require().True(poolManager.GetTraderVolume(s.Ctx, sender).IsZero())
+
+	tokenIn := sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(1_000_000))
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(tokenIn.Denom, 1_000_000_000), sdk.NewInt64Coin(apptesting.USDC, 1_000_000_000))
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+	_, err := poolManager.RouteExactAmountIn(s.Ctx, sender, []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: apptesting.USDC}}, tokenIn, osmomath.OneInt())
+	s.Require().NoError(err)
+
+	genesis := poolManager.ExportGenesis(s.Ctx)
+	s.Require().Equal([]types.TraderVolume{{
+		Address:      sender.String(),
+		DailyVolumes: []types.DailyVolume{{Day: today + 1, Amount: tokenIn.Amount}},
+	}}, genesis.TraderVolumes)
+}
+
+// validates that the trader volumes are pruned of the days outside of the volume window over consecutive blocks,
+// and deleted when no day is left.
+func (s *KeeperTestSuite) TestPruneTraderVolumes() {
+	s.SetupTest()
+	poolManager := s.App.PoolManagerKeeper
+	today := types.VolumeDay(s.Ctx.BlockTime())
+	staleTrader, activeTrader := s.TestAccs[0], s.TestAccs[1]
+
+	poolManager.SetTraderVolume(s.Ctx, types.TraderVolume{
+		Address:      staleTrader.String(),
+		DailyVolumes: []types.DailyVolume{{Day: today - types.TraderVolumeWindowDays, Amount: osmomath.NewInt(5_000_000)}},
+	})
+	poolManager.SetTraderVolume(s.Ctx, types.TraderVolume{
+		Address: activeTrader.String(),
+		DailyVolumes: []types.DailyVolume{
+			{Day: today - types.TraderVolumeWindowDays, Amount: osmomath.NewInt(5_000_000)},
+			{Day: today, Amount: osmomath.NewInt(3_000_000)},
+		},
+	})
+
+	// Prune one trader volume per block.
+	defaultNumTraderVolumesToPrunePerBlock := poolmanager.NumTraderVolumesToPrunePerBlock
+	poolmanager.NumTraderVolumesToPrunePerBlock = 1
+	defer func() { poolmanager.NumTraderVolumesToPrunePerBlock = defaultNumTraderVolumesToPrunePerBlock }()
+
+	// Each trader volume is checked in its own block.
+	poolManager.PruneTraderVolumes(s.Ctx)
+	poolManager.PruneTraderVolumes(s.Ctx)
+
+	s.Require().Equal([]types.TraderVolume{{
+		Address:      activeTrader.String(),
+		DailyVolumes: []types.DailyVolume{{Day: today, Amount: osmomath.NewInt(3_000_000)}},
+	}}, poolManager.ExportGenesis(s.Ctx).TraderVolumes)
+}
+
+func (s *KeeperTestSuite) TestTraderTakerFeeTierQuery() {
+	s.SetupTest()
+	poolManager := s.App.PoolManagerKeeper
+	queryClient := queryproto.NewQueryClient(s.QueryHelper)
+	trader := s.TestAccs[0]
+
+	poolManager.SetParam(s.Ctx, types.KeyTakerFeeTiers, testTakerFeeTiers)
+
+	// No volume gives no tier.
+	res, err := queryClient.TraderTakerFeeTier(s.Ctx, &queryproto.TraderTakerFeeTierRequest{Address: trader.String()})
+	s.Require().NoError(err)
+	s.Require().True(res.Volume.IsZero())
+	s.Require().Nil(res.Tier)
+
+	poolManager.SetTraderVolume(s.Ctx, types.TraderVolume{
+		Address:      trader.String(),
+		DailyVolumes: []types.DailyVolume{{Day: types.VolumeDay(s.Ctx.BlockTime()), Amount: osmomath.NewInt(1_500_000)}},
+	})
+
+	volumeRes, err := queryClient.TraderVolume(s.Ctx, &queryproto.TraderVolumeRequest{Address: trader.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(1_500_000), volumeRes.Volume)
+
+	res, err = queryClient.TraderTakerFeeTier(s.Ctx, &queryproto.TraderTakerFeeTierRequest{Address: trader.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(1_500_000), res.Volume)
+	s.Require().Equal(&testTakerFeeTiers[0], res.Tier)
+
+	_, err = queryClient.TraderVolume(s.Ctx, &queryproto.TraderVolumeRequest{Address: "invalid"})
+	s.Require().Error(err)
+}
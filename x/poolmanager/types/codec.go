@@ -14,6 +14,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSwapExactAmountOut{}, "osmosis/poolmanager/swap-exact-amount-out", nil)
 	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountIn{}, "osmosis/poolmanager/split-amount-in", nil)
 	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountOut{}, "osmosis/poolmanager/split-amount-out", nil)
+	cdc.RegisterConcrete(&MsgPlaceConditionalSwap{}, "osmosis/poolmanager/place-conditional-swap", nil)
+	cdc.RegisterConcrete(&MsgCancelConditionalSwap{}, "osmosis/poolmanager/cancel-conditional-swap", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountInWithMaxPriceImpact{}, "osmosis/poolmanager/swap-price-impact", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -23,6 +26,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSwapExactAmountOut{},
 		&MsgSplitRouteSwapExactAmountIn{},
 		&MsgSplitRouteSwapExactAmountOut{},
+		&MsgPlaceConditionalSwap{},
+		&MsgCancelConditionalSwap{},
+		&MsgSwapExactAmountInWithMaxPriceImpact{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
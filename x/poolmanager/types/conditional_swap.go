@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// Validate validates the swap trigger, returns nil on success, error otherwise.
+func (t SwapTrigger) Validate() error {
+	if t.Price.IsNil() || !t.Price.IsPositive() {
+		return fmt.Errorf("trigger price must be positive, was (%s)", t.Price)
+	}
+
+	if _, ok := TriggerDirection_name[int32(t.Direction)]; !ok {
+		return fmt.Errorf("invalid trigger direction (%d)", t.Direction)
+	}
+
+	switch t.PriceSource {
+	case PriceSourceSpot:
+		if t.TwapDuration != 0 {
+			return fmt.Errorf("twap duration must not be set for the spot price source, was (%s)", t.TwapDuration)
+		}
+	case PriceSourceArithmeticTwap:
+		if t.TwapDuration <= 0 {
+			return fmt.Errorf("twap duration must be positive for the arithmetic twap price source, was (%s)", t.TwapDuration)
+		}
+	default:
+		return fmt.Errorf("invalid trigger price source (%d)", t.PriceSource)
+	}
+
+	return nil
+}
+
+// IsMet returns true if the route price is on the trigger direction's side of the trigger price.
+func (t SwapTrigger) IsMet(routePrice osmomath.Dec) bool {
+	if t.Direction == TriggerDirectionAtOrAbove {
+		return routePrice.GTE(t.Price)
+	}
+	return routePrice.LTE(t.Price)
+}
+
+// Validate validates the conditional swap, returns nil on success, error otherwise.
+func (c ConditionalSwap) Validate() error {
+	if c.Id == 0 {
+		return fmt.Errorf("conditional swap id cannot be 0")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(c.Sender); err != nil {
+		return InvalidSenderError{Sender: c.Sender}
+	}
+
+	if err := SwapAmountInRoutes(c.Routes).Validate(); err != nil {
+		return err
+	}
+
+	if !c.TokenIn.IsValid() || !c.TokenIn.IsPositive() {
+		return fmt.Errorf("invalid conditional swap token in (%s)", c.TokenIn)
+	}
+
+	if c.TokenOutMinAmount.IsNil() || !c.TokenOutMinAmount.IsPositive() {
+		return nonPositiveAmountError{c.TokenOutMinAmount.String()}
+	}
+
+	if err := c.Trigger.Validate(); err != nil {
+		return err
+	}
+
+	if c.Expiry.IsZero() {
+		return fmt.Errorf("conditional swap expiry must be set")
+	}
+
+	return nil
+}
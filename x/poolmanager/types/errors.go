@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 )
@@ -24,6 +25,8 @@ var (
 	ErrSetRegisteredAlloyedPool                  = errors.New("error setting registered alloyed pool")
 	ErrInvalidKeyFormat                          = errors.New("invalid key format")
 	ErrTotalAlloyedLiquidityIsZero               = errors.New("totalAlloyedLiquidity is zero")
+	ErrConditionalSwapTriggerNotMet              = errors.New("conditional swap trigger not met")
+	ErrSameTokenInAndOutDenom                    = errors.New("token in and token out denoms must differ")
 )
 
 type nonPositiveAmountError struct {
@@ -42,6 +45,15 @@ func (e FailedToFindRouteError) Error() string {
 	return fmt.Sprintf("failed to find route for pool id (%d)", e.PoolId)
 }
 
+type NoRouteFoundError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+}
+
+func (e NoRouteFoundError) Error() string {
+	return fmt.Sprintf("no route found from (%s) to (%s)", e.TokenInDenom, e.TokenOutDenom)
+}
+
 type UndefinedRouteError struct {
 	PoolType PoolType
 	PoolId   uint64
@@ -204,3 +216,38 @@ type InvalidTakerFeeSharePercentageError struct {
 func (e InvalidTakerFeeSharePercentageError) Error() string {
 	return fmt.Sprintf("invalid taker fee share percentage: %s, must be between 0 and 1", e.Percentage)
 }
+
+type ConditionalSwapNotFoundError struct {
+	ConditionalSwapId uint64
+}
+
+func (e ConditionalSwapNotFoundError) Error() string {
+	return fmt.Sprintf("conditional swap with id (%d) not found", e.ConditionalSwapId)
+}
+
+type UnauthorizedConditionalSwapCancelError struct {
+	ConditionalSwapId uint64
+	Sender            string
+}
+
+func (e UnauthorizedConditionalSwapCancelError) Error() string {
+	return fmt.Sprintf("sender (%s) is not the sender of conditional swap (%d)", e.Sender, e.ConditionalSwapId)
+}
+
+type ConditionalSwapExpiredError struct {
+	Expiry    time.Time
+	BlockTime time.Time
+}
+
+func (e ConditionalSwapExpiredError) Error() string {
+	return fmt.Sprintf("conditional swap expiry (%s) must be after the block time (%s)", e.Expiry, e.BlockTime)
+}
+
+type NoTradeWithinMaxPriceImpactError struct {
+	PoolId         uint64
+	MaxPriceImpact osmomath.Dec
+}
+
+func (e NoTradeWithinMaxPriceImpactError) Error() string {
+	return fmt.Sprintf("no trade in pool (%d) stays within the max price impact (%s)", e.PoolId, e.MaxPriceImpact)
+}
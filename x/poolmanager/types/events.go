@@ -4,6 +4,12 @@ const (
 	AttributeValueCategory               = ModuleName
 	TypeEvtPoolCreated                   = "pool_created"
 	TypeEvtSplitRouteSwapExactIn         = "split_route_swap_exact_in"
+	TypeEvtConditionalSwapPlaced         = "conditional_swap_placed"
+	TypeEvtConditionalSwapExecuted       = "conditional_swap_executed"
+	TypeEvtConditionalSwapCancelled      = "conditional_swap_cancelled"
+	TypeEvtConditionalSwapExpired        = "conditional_swap_expired"
+	TypeEvtAffiliateRebate               = "affiliate_rebate"
+	TypeEvtTakerFeeShareAgreementExpired = "taker_fee_share_agreement_expired"
 	AttributeKeyTokensIn                 = "tokens_in"
 	AttributeKeyTokensOut                = "tokens_out"
 	AttributeKeyPoolId                   = "pool_id"
@@ -13,4 +19,8 @@ const (
 	AttributeKeyTakerFeeShareDenom       = "taker_fee_share_denom"
 	AttributeKeyTakerFeeShareSkimPercent = "taker_fee_share_skim_percent"
 	AttributeKeyTakerFeeShareSkimAddress = "taker_fee_share_skim_address"
+	AttributeKeyTakerFeeSharePayout      = "taker_fee_share_payout"
+	AttributeKeyConditionalSwapId        = "conditional_swap_id"
+	AttributeKeyAffiliate                = "affiliate"
+	AttributeKeyRebate                   = "rebate"
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	context "context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -118,8 +119,20 @@ type StakingKeeper interface {
 
 type ProtorevKeeper interface {
 	GetPoolForDenomPair(ctx sdk.Context, baseDenom, denomToMatch string) (uint64, error)
+	GetAllBaseDenomNames(ctx sdk.Context) ([]string, error)
 }
 
 type WasmKeeper interface {
 	QuerySmart(ctx context.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error)
 }
+
+// TwapKeeper defines the contract needed to price the routes of conditional swaps with twaps.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(
+		ctx sdk.Context,
+		poolId uint64,
+		baseAssetDenom string,
+		quoteAssetDenom string,
+		startTime time.Time,
+	) (osmomath.Dec, error)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -17,6 +18,7 @@ func DefaultGenesis() *GenesisState {
 			TakerFeesToBurn:            sdk.NewCoins(),
 			HeightAccountingStartsFrom: 0,
 		},
+		NextConditionalSwapId: 1,
 	}
 }
 
@@ -29,5 +31,36 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	for _, conditionalSwap := range gs.ConditionalSwaps {
+		if err := conditionalSwap.Validate(); err != nil {
+			return err
+		}
+		if conditionalSwap.Id >= gs.NextConditionalSwapId {
+			return fmt.Errorf("conditional swap id (%d) must be less than the next conditional swap id (%d)", conditionalSwap.Id, gs.NextConditionalSwapId)
+		}
+	}
+	seenTraders := make(map[string]bool, len(gs.TraderVolumes))
+	for _, traderVolume := range gs.TraderVolumes {
+		if err := traderVolume.Validate(); err != nil {
+			return err
+		}
+		if seenTraders[traderVolume.Address] {
+			return fmt.Errorf("duplicate trader volume for address %s", traderVolume.Address)
+		}
+		seenTraders[traderVolume.Address] = true
+	}
+	seenAffiliates := make(map[string]bool, len(gs.AffiliateRebates))
+	for _, affiliateRebates := range gs.AffiliateRebates {
+		if _, err := sdk.AccAddressFromBech32(affiliateRebates.Address); err != nil {
+			return fmt.Errorf("invalid affiliate address (%s): %w", affiliateRebates.Address, err)
+		}
+		if err := affiliateRebates.Rebates.Validate(); err != nil {
+			return fmt.Errorf("invalid rebates of affiliate %s: %w", affiliateRebates.Address, err)
+		}
+		if seenAffiliates[affiliateRebates.Address] {
+			return fmt.Errorf("duplicate affiliate rebates for address %s", affiliateRebates.Address)
+		}
+		seenAffiliates[affiliateRebates.Address] = true
+	}
 	return nil
 }
@@ -46,6 +46,14 @@ type Params struct {
 	// 819. Any asset can now be used as a quote asset in concentrated liquidity
 	// pools.
 	AuthorizedQuoteDenoms []string `protobuf:"bytes,3,rep,name=authorized_quote_denoms,json=authorizedQuoteDenoms,proto3" json:"authorized_quote_denoms,omitempty" yaml:"authorized_quote_denoms",deprecated:"true"` // Deprecated: Do not use.
+	// max_conditional_swaps_per_block is the maximum number of pending
+	// conditional swaps whose trigger is checked each end block. Zero disables
+	// the execution of conditional swaps, expired ones are still refunded.
+	MaxConditionalSwapsPerBlock uint64 `protobuf:"varint,4,opt,name=max_conditional_swaps_per_block,json=maxConditionalSwapsPerBlock,proto3" json:"max_conditional_swaps_per_block,omitempty" yaml:"max_conditional_swaps_per_block"`
+	// conditional_swap_placement_fee is the fee paid to the community pool to
+	// place a conditional swap. It is not refunded when the conditional swap is
+	// cancelled or expires.
+	ConditionalSwapPlacementFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=conditional_swap_placement_fee,json=conditionalSwapPlacementFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"conditional_swap_placement_fee" yaml:"conditional_swap_placement_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -103,6 +111,20 @@ func (m *Params) GetAuthorizedQuoteDenoms() []string {
 	return nil
 }
 
+func (m *Params) GetMaxConditionalSwapsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxConditionalSwapsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetConditionalSwapPlacementFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ConditionalSwapPlacementFee
+	}
+	return nil
+}
+
 // GenesisState defines the poolmanager module's genesis state.
 type GenesisState struct {
 	// the next_pool_id
@@ -115,6 +137,15 @@ type GenesisState struct {
 	TakerFeesTracker       *TakerFeesTracker   `protobuf:"bytes,4,opt,name=taker_fees_tracker,json=takerFeesTracker,proto3" json:"taker_fees_tracker,omitempty"`
 	PoolVolumes            []*PoolVolume       `protobuf:"bytes,5,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes,omitempty"`
 	DenomPairTakerFeeStore []DenomPairTakerFee `protobuf:"bytes,6,rep,name=denom_pair_taker_fee_store,json=denomPairTakerFeeStore,proto3" json:"denom_pair_taker_fee_store"`
+	// conditional_swaps are the pending conditional swaps.
+	ConditionalSwaps []ConditionalSwap `protobuf:"bytes,7,rep,name=conditional_swaps,json=conditionalSwaps,proto3" json:"conditional_swaps"`
+	// next_conditional_swap_id is the id of the next conditional swap placed.
+	NextConditionalSwapId uint64 `protobuf:"varint,8,opt,name=next_conditional_swap_id,json=nextConditionalSwapId,proto3" json:"next_conditional_swap_id,omitempty"`
+	// trader_volumes are the swap volumes of traders tracked for the taker fee
+	// tiers.
+	TraderVolumes []TraderVolume `protobuf:"bytes,9,rep,name=trader_volumes,json=traderVolumes,proto3" json:"trader_volumes"`
+	// affiliate_rebates are the taker fee rebates accrued by each affiliate.
+	AffiliateRebates []AffiliateRebates `protobuf:"bytes,10,rep,name=affiliate_rebates,json=affiliateRebates,proto3" json:"affiliate_rebates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -192,6 +223,34 @@ func (m *GenesisState) GetDenomPairTakerFeeStore() []DenomPairTakerFee {
 	return nil
 }
 
+func (m *GenesisState) GetConditionalSwaps() []ConditionalSwap {
+	if m != nil {
+		return m.ConditionalSwaps
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextConditionalSwapId() uint64 {
+	if m != nil {
+		return m.NextConditionalSwapId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTraderVolumes() []TraderVolume {
+	if m != nil {
+		return m.TraderVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAffiliateRebates() []AffiliateRebates {
+	if m != nil {
+		return m.AffiliateRebates
+	}
+	return nil
+}
+
 // TakerFeeParams consolidates the taker fee parameters for the poolmanager.
 type TakerFeeParams struct {
 	// default_taker_fee is the fee used when creating a new pool that doesn't
@@ -240,6 +299,16 @@ type TakerFeeParams struct {
 	// smoothing. Staking rewards are accumulated in a buffer and distributed as
 	// (buffer_balance / daily_staking_rewards_smoothing_factor) per day epoch.
 	DailyStakingRewardsSmoothingFactor uint64 `protobuf:"varint,8,opt,name=daily_staking_rewards_smoothing_factor,json=dailyStakingRewardsSmoothingFactor,proto3" json:"daily_staking_rewards_smoothing_factor,omitempty" yaml:"daily_staking_rewards_smoothing_factor"`
+	// taker_fee_tiers are the tiers discounting the taker fee of traders by
+	// their swap volume over the last 30 days, in the bond denom. They are
+	// ordered by strictly increasing min volume, and a trader gets the tier
+	// with the highest min volume below its volume. Trader volumes are only
+	// tracked while tiers are set.
+	TakerFeeTiers []TakerFeeTier `protobuf:"bytes,9,rep,name=taker_fee_tiers,json=takerFeeTiers,proto3" json:"taker_fee_tiers" yaml:"taker_fee_tiers"`
+	// taker_fee_affiliates are the frontends whitelisted by governance to
+	// receive a share of the taker fee charged on the swaps they are set as the
+	// affiliate of.
+	TakerFeeAffiliates []TakerFeeAffiliate `protobuf:"bytes,10,rep,name=taker_fee_affiliates,json=takerFeeAffiliates,proto3" json:"taker_fee_affiliates" yaml:"taker_fee_affiliates"`
 }
 
 func (m *TakerFeeParams) Reset()         { *m = TakerFeeParams{} }
@@ -324,6 +393,110 @@ func (m *TakerFeeParams) GetDailyStakingRewardsSmoothingFactor() uint64 {
 	return 0
 }
 
+func (m *TakerFeeParams) GetTakerFeeTiers() []TakerFeeTier {
+	if m != nil {
+		return m.TakerFeeTiers
+	}
+	return nil
+}
+
+func (m *TakerFeeParams) GetTakerFeeAffiliates() []TakerFeeAffiliate {
+	if m != nil {
+		return m.TakerFeeAffiliates
+	}
+	return nil
+}
+
+// TakerFeeAffiliate is a frontend receiving a share of the taker fee charged on
+// the swaps it is set as the affiliate of.
+type TakerFeeAffiliate struct {
+	// address is the address of the frontend receiving the rebates.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// rebate_share is the fraction of the taker fee rebated to the frontend.
+	RebateShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rebate_share,json=rebateShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rebate_share" yaml:"rebate_share"`
+}
+
+func (m *TakerFeeAffiliate) Reset()         { *m = TakerFeeAffiliate{} }
+func (m *TakerFeeAffiliate) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeAffiliate) ProtoMessage()    {}
+func (*TakerFeeAffiliate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{3}
+}
+func (m *TakerFeeAffiliate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeAffiliate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeAffiliate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeAffiliate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeAffiliate.Merge(m, src)
+}
+func (m *TakerFeeAffiliate) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeAffiliate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeAffiliate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeAffiliate proto.InternalMessageInfo
+
+func (m *TakerFeeAffiliate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// TakerFeeTier discounts the taker fee of the traders whose swap volume is at
+// least its min volume.
+type TakerFeeTier struct {
+	MinVolume cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min_volume,json=minVolume,proto3,customtype=cosmossdk.io/math.Int" json:"min_volume" yaml:"min_volume"`
+	// discount is the fraction of the taker fee waived for the tier.
+	Discount cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount" yaml:"discount"`
+}
+
+func (m *TakerFeeTier) Reset()         { *m = TakerFeeTier{} }
+func (m *TakerFeeTier) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeTier) ProtoMessage()    {}
+func (*TakerFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{4}
+}
+func (m *TakerFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeTier.Merge(m, src)
+}
+func (m *TakerFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeTier proto.InternalMessageInfo
+
 // TakerFeeDistributionPercentage defines what percent of the taker fee category
 // gets distributed to the available categories.
 type TakerFeeDistributionPercentage struct {
@@ -336,7 +509,7 @@ func (m *TakerFeeDistributionPercentage) Reset()         { *m = TakerFeeDistribu
 func (m *TakerFeeDistributionPercentage) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeDistributionPercentage) ProtoMessage()    {}
 func (*TakerFeeDistributionPercentage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{3}
+	return fileDescriptor_aa099d9fbdf68b35, []int{5}
 }
 func (m *TakerFeeDistributionPercentage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,7 +549,7 @@ func (m *TakerFeesTracker) Reset()         { *m = TakerFeesTracker{} }
 func (m *TakerFeesTracker) String() string { return proto.CompactTextString(m) }
 func (*TakerFeesTracker) ProtoMessage()    {}
 func (*TakerFeesTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{4}
+	return fileDescriptor_aa099d9fbdf68b35, []int{6}
 }
 func (m *TakerFeesTracker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +619,7 @@ func (m *PoolVolume) Reset()         { *m = PoolVolume{} }
 func (m *PoolVolume) String() string { return proto.CompactTextString(m) }
 func (*PoolVolume) ProtoMessage()    {}
 func (*PoolVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{5}
+	return fileDescriptor_aa099d9fbdf68b35, []int{7}
 }
 func (m *PoolVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,6 +666,8 @@ func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.poolmanager.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolmanager.v1beta1.GenesisState")
 	proto.RegisterType((*TakerFeeParams)(nil), "osmosis.poolmanager.v1beta1.TakerFeeParams")
+	proto.RegisterType((*TakerFeeAffiliate)(nil), "osmosis.poolmanager.v1beta1.TakerFeeAffiliate")
+	proto.RegisterType((*TakerFeeTier)(nil), "osmosis.poolmanager.v1beta1.TakerFeeTier")
 	proto.RegisterType((*TakerFeeDistributionPercentage)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDistributionPercentage")
 	proto.RegisterType((*TakerFeesTracker)(nil), "osmosis.poolmanager.v1beta1.TakerFeesTracker")
 	proto.RegisterType((*PoolVolume)(nil), "osmosis.poolmanager.v1beta1.PoolVolume")
@@ -503,83 +678,108 @@ func init() {
 }
 
 var fileDescriptor_aa099d9fbdf68b35 = []byte{
-	// 1211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1f, 0xce, 0xd6, 0x6e, 0xda, 0x4c, 0xfa, 0x8f, 0xdb, 0xf9, 0x93, 0x76, 0x9b, 0x14, 0xaf, 0xb5,
-	0x2d, 0xe0, 0x0a, 0xb2, 0x6e, 0x52, 0xa9, 0x48, 0x85, 0x1e, 0xb2, 0x89, 0x8c, 0x40, 0xa1, 0x4d,
-	0xd7, 0x11, 0x48, 0x70, 0x18, 0x8d, 0x77, 0xc7, 0xf6, 0xca, 0xbb, 0x3b, 0x66, 0x66, 0x36, 0x89,
-	0x39, 0x82, 0xe0, 0x8c, 0xd4, 0x2b, 0x67, 0x0e, 0xdc, 0x38, 0xf0, 0x1d, 0x7a, 0xec, 0x11, 0x71,
-	0xd8, 0xa2, 0xe4, 0x1b, 0xf8, 0x13, 0xa0, 0x9d, 0x59, 0xbf, 0xac, 0x93, 0xb8, 0x06, 0x4e, 0xf6,
-	0xce, 0x3c, 0xcf, 0x33, 0xcf, 0xef, 0x65, 0x5e, 0xc0, 0x7d, 0xca, 0x43, 0xca, 0x7d, 0x5e, 0xeb,
-	0x51, 0x1a, 0x84, 0x38, 0xc2, 0x6d, 0xc2, 0x6a, 0x87, 0x9b, 0x4d, 0x22, 0xf0, 0x66, 0xad, 0x4d,
-	0x22, 0xc2, 0x7d, 0x6e, 0xf5, 0x18, 0x15, 0x14, 0xae, 0x67, 0x50, 0x6b, 0x02, 0x6a, 0x65, 0xd0,
-	0xb5, 0xb7, 0xda, 0xb4, 0x4d, 0x25, 0xae, 0x96, 0xfe, 0x53, 0x94, 0xb5, 0xdb, 0x6d, 0x4a, 0xdb,
-	0x01, 0xa9, 0xc9, 0xaf, 0x66, 0xdc, 0xaa, 0xe1, 0xa8, 0x3f, 0x9c, 0x72, 0xa5, 0x1c, 0x52, 0x1c,
-	0xf5, 0x91, 0x4d, 0x95, 0xa7, 0x59, 0x5e, 0xcc, 0xb0, 0xf0, 0x69, 0x34, 0x9c, 0x57, 0xe8, 0x5a,
-	0x13, 0x73, 0x32, 0xf2, 0xea, 0x52, 0x7f, 0x38, 0x6f, 0xcd, 0x8a, 0x29, 0xa4, 0x5e, 0x1c, 0x10,
-	0xc4, 0x68, 0x2c, 0x48, 0x86, 0xbf, 0x37, 0x0b, 0x2f, 0x8e, 0x15, 0xca, 0xfc, 0xb1, 0x00, 0x16,
-	0xf7, 0x31, 0xc3, 0x21, 0x87, 0x2f, 0x34, 0x70, 0x23, 0xc5, 0x22, 0x97, 0x11, 0x69, 0x0c, 0xb5,
-	0x08, 0xd1, 0xb5, 0x4a, 0xa1, 0xba, 0xbc, 0x75, 0xdb, 0xca, 0x62, 0x49, 0xdd, 0x0d, 0xd3, 0x63,
-	0xed, 0x50, 0x3f, 0xb2, 0xf7, 0x5e, 0x26, 0xc6, 0xc2, 0x20, 0x31, 0xf4, 0x3e, 0x0e, 0x83, 0xc7,
-	0xe6, 0x19, 0x05, 0xf3, 0xd7, 0xd7, 0x46, 0xb5, 0xed, 0x8b, 0x4e, 0xdc, 0xb4, 0x5c, 0x1a, 0x66,
-	0x49, 0xc9, 0x7e, 0x36, 0xb8, 0xd7, 0xad, 0x89, 0x7e, 0x8f, 0x70, 0x29, 0xc6, 0x9d, 0x52, 0xca,
-	0xdf, 0xc9, 0xe8, 0x75, 0x42, 0xe0, 0x21, 0xb8, 0x2e, 0x70, 0x97, 0xb0, 0x54, 0x0a, 0xf5, 0xa4,
-	0x53, 0xfd, 0x52, 0x45, 0xab, 0x2e, 0x6f, 0xbd, 0x6f, 0xcd, 0x28, 0x9d, 0x75, 0x90, 0x92, 0xea,
-	0x84, 0xa8, 0xe0, 0x6c, 0x23, 0x73, 0x79, 0x4b, 0xb9, 0x9c, 0x96, 0x34, 0x9d, 0x15, 0x91, 0x23,
-	0xc0, 0x08, 0xdc, 0xc2, 0xb1, 0xe8, 0x50, 0xe6, 0x7f, 0x4b, 0x3c, 0xf4, 0x4d, 0x4c, 0x05, 0x41,
-	0x1e, 0x89, 0x68, 0xc8, 0xf5, 0x42, 0xa5, 0x50, 0x5d, 0xb2, 0x1f, 0x0d, 0x12, 0xe3, 0x81, 0x52,
-	0xbb, 0x00, 0x68, 0x7e, 0xe0, 0x91, 0x1e, 0x23, 0x2e, 0x16, 0xc4, 0x7b, 0x6c, 0x0a, 0x16, 0x13,
-	0x53, 0xd7, 0x9c, 0xd5, 0x31, 0xfa, 0x79, 0x0a, 0xde, 0x55, 0xd8, 0xd7, 0x05, 0x70, 0xed, 0x13,
-	0xd5, 0x99, 0x0d, 0x81, 0x05, 0x81, 0x15, 0x70, 0x2d, 0x22, 0xc7, 0x02, 0xc9, 0x84, 0xfa, 0x9e,
-	0xae, 0x55, 0xb4, 0x6a, 0xd1, 0x01, 0xe9, 0xd8, 0x3e, 0xa5, 0xc1, 0xa7, 0x1e, 0xdc, 0x06, 0x8b,
-	0xb9, 0x84, 0xdc, 0x9d, 0x99, 0x90, 0x2c, 0x11, 0xc5, 0x34, 0x11, 0x4e, 0x46, 0x84, 0xcf, 0xc0,
-	0xb2, 0xd4, 0x97, 0x8d, 0xa3, 0x22, 0x5b, 0xde, 0xaa, 0xce, 0xd4, 0xf9, 0x5c, 0xb6, 0x9a, 0x93,
-	0x12, 0x32, 0x31, 0x90, 0xc2, 0xe4, 0x00, 0x87, 0x5f, 0x03, 0x38, 0xca, 0x2d, 0x47, 0x82, 0x61,
-	0xb7, 0x4b, 0x98, 0x5e, 0x94, 0xfe, 0x36, 0xe6, 0x2a, 0x18, 0x3f, 0x50, 0x24, 0xe7, 0xba, 0x98,
-	0x1a, 0x81, 0x9f, 0x81, 0x6b, 0xd2, 0xed, 0x21, 0x0d, 0xe2, 0x90, 0x70, 0xfd, 0xb2, 0xb4, 0xfb,
-	0xde, 0xec, 0xb0, 0x29, 0x0d, 0xbe, 0x90, 0x78, 0x47, 0x86, 0xaa, 0xfe, 0x73, 0xd8, 0x03, 0x6b,
-	0xb2, 0x4a, 0xa8, 0x87, 0x7d, 0x86, 0xc6, 0xfd, 0xc0, 0x05, 0x65, 0x44, 0x5f, 0x94, 0xca, 0xd6,
-	0x4c, 0x65, 0x59, 0xb8, 0x7d, 0xec, 0xb3, 0xa1, 0xf3, 0x2c, 0x1d, 0x37, 0xbd, 0xe9, 0x89, 0x46,
-	0xaa, 0x69, 0x7e, 0x7f, 0x15, 0xac, 0xe4, 0xbb, 0x12, 0x36, 0xc1, 0x0d, 0x8f, 0xb4, 0x70, 0x1c,
-	0x88, 0xb1, 0x03, 0x59, 0xe8, 0x25, 0xfb, 0x51, 0xaa, 0xf5, 0x67, 0x62, 0xac, 0xab, 0x8d, 0xc2,
-	0xbd, 0xae, 0xe5, 0xd3, 0x5a, 0x88, 0x45, 0xc7, 0xda, 0x23, 0x6d, 0xec, 0xf6, 0x77, 0x89, 0x7b,
-	0x92, 0x18, 0xa5, 0x5d, 0xc5, 0x1f, 0x0a, 0x3b, 0x25, 0x2f, 0x3f, 0x00, 0x7f, 0xd6, 0x80, 0x3c,
-	0xe3, 0x26, 0x62, 0xf4, 0x7c, 0x2e, 0x98, 0xdf, 0x8c, 0xd3, 0x3d, 0x96, 0xf5, 0xce, 0x47, 0x73,
-	0xd5, 0x66, 0x77, 0x82, 0xb8, 0x4f, 0x98, 0x4b, 0x22, 0x81, 0xdb, 0xc4, 0xae, 0xa4, 0x5e, 0x4f,
-	0x12, 0x43, 0x7f, 0xc6, 0x43, 0x7a, 0x1e, 0xd6, 0xd1, 0xe9, 0x05, 0x33, 0xf0, 0x17, 0x0d, 0x18,
-	0x11, 0x8d, 0xd0, 0x2c, 0x8b, 0x85, 0xff, 0x6e, 0xf1, 0x6e, 0x66, 0x71, 0xfd, 0x29, 0x8d, 0x2e,
-	0x74, 0xb9, 0x1e, 0x5d, 0x3c, 0x09, 0x77, 0x40, 0x09, 0x7b, 0xa1, 0x1f, 0x21, 0xec, 0x79, 0x8c,
-	0x70, 0x4e, 0xb8, 0x5e, 0x94, 0x07, 0xc1, 0xda, 0x20, 0x31, 0x6e, 0x66, 0x07, 0x41, 0x1e, 0x60,
-	0x3a, 0x2b, 0x72, 0x64, 0x7b, 0x38, 0x00, 0x7f, 0xd3, 0xc0, 0x23, 0x97, 0x86, 0x61, 0x1c, 0xf9,
-	0xa2, 0xaf, 0xb6, 0xb6, 0xea, 0x42, 0x41, 0x11, 0x3f, 0xc2, 0x3d, 0x94, 0xa6, 0xe2, 0xa8, 0xe3,
-	0x0b, 0x12, 0xf8, 0x5c, 0x10, 0x0f, 0x61, 0xce, 0x89, 0xe0, 0x48, 0x50, 0xfd, 0xb2, 0x6c, 0x8b,
-	0xed, 0x41, 0x62, 0x3c, 0x51, 0x8b, 0xfd, 0x3b, 0x1d, 0xd3, 0xb1, 0x46, 0xc4, 0x74, 0x6f, 0xc8,
-	0x2e, 0x3e, 0xa0, 0x8d, 0x23, 0xdc, 0x7b, 0x4a, 0xa3, 0x2f, 0xc7, 0x94, 0x6d, 0xc9, 0x38, 0xa0,
-	0xf0, 0x00, 0xac, 0x32, 0xe2, 0xc5, 0x2e, 0xf1, 0x64, 0x65, 0x46, 0xaa, 0x72, 0x93, 0x2c, 0xd9,
-	0x95, 0x41, 0x62, 0xdc, 0x51, 0x8e, 0xce, 0x85, 0x99, 0xce, 0xff, 0xb3, 0xf1, 0x3a, 0x21, 0x23,
-	0x7d, 0x18, 0x81, 0xf2, 0xb9, 0x01, 0x8c, 0xe5, 0xaf, 0x48, 0xf9, 0xfb, 0x83, 0xc4, 0x78, 0x67,
-	0x46, 0xc0, 0x13, 0xeb, 0xac, 0x9f, 0x0d, 0x6c, 0xbc, 0xde, 0x0f, 0x1a, 0x78, 0xd7, 0xc3, 0x7e,
-	0xd0, 0x47, 0x5c, 0xe0, 0xae, 0x1f, 0xb5, 0x11, 0x23, 0x47, 0x98, 0x79, 0x1c, 0xf1, 0x90, 0x52,
-	0xd1, 0x49, 0x47, 0x5a, 0xd8, 0x15, 0x94, 0xe9, 0x57, 0xd3, 0x93, 0xd6, 0xde, 0x1c, 0x24, 0xc6,
-	0x86, 0x5a, 0x78, 0x3e, 0x9e, 0xe9, 0x28, 0x60, 0x43, 0xe1, 0x1c, 0x05, 0x6b, 0x0c, 0x51, 0x75,
-	0x05, 0xfa, 0xfd, 0x12, 0x28, 0xcf, 0xee, 0x55, 0xd8, 0x02, 0xa5, 0xa9, 0xb5, 0xb2, 0x33, 0xe1,
-	0xc9, 0x1c, 0x67, 0xc2, 0xb8, 0x19, 0xa7, 0x34, 0x4c, 0x67, 0x85, 0xe7, 0x9c, 0x41, 0x17, 0xac,
-	0xe4, 0x53, 0x2a, 0xcf, 0x82, 0x25, 0xfb, 0xe3, 0xf9, 0x96, 0x59, 0x3d, 0xaf, 0x2a, 0xa6, 0xf3,
-	0xbf, 0x5c, 0x15, 0x60, 0x1d, 0x14, 0x9b, 0x31, 0x53, 0x7b, 0x78, 0xc9, 0xde, 0x9a, 0x4f, 0x7a,
-	0x59, 0x49, 0xa7, 0x44, 0xd3, 0x91, 0x7c, 0xf3, 0xbb, 0x02, 0xb8, 0x3e, 0x7d, 0x45, 0x40, 0x07,
-	0xac, 0x4e, 0xde, 0x36, 0x54, 0xd6, 0x88, 0x30, 0xfe, 0xe6, 0x57, 0x8b, 0x3a, 0xaa, 0xe1, 0xf8,
-	0x8a, 0xa1, 0x0d, 0x45, 0x85, 0x08, 0xdc, 0xc9, 0x6b, 0x9e, 0xc9, 0xd1, 0x5c, 0xd2, 0xfa, 0x84,
-	0xf4, 0x4e, 0x2e, 0x23, 0x5d, 0xf0, 0x76, 0x87, 0xf8, 0xed, 0x8e, 0x40, 0xd8, 0x75, 0x69, 0x1c,
-	0x89, 0xb4, 0x48, 0x5c, 0x60, 0x26, 0x38, 0x6a, 0x31, 0x1a, 0xca, 0x54, 0x15, 0xec, 0xea, 0x20,
-	0x31, 0xee, 0xa9, 0x3c, 0xcc, 0x84, 0x9b, 0xce, 0x9a, 0x9a, 0xdf, 0x1e, 0x4d, 0x37, 0xe4, 0x6c,
-	0x9d, 0xd1, 0x10, 0xee, 0xe5, 0xef, 0x63, 0x8a, 0x64, 0x31, 0x8a, 0xf3, 0xc5, 0x50, 0x9a, 0x88,
-	0xc1, 0x4e, 0x8b, 0xf0, 0x42, 0x03, 0x60, 0x7c, 0xa1, 0xc2, 0x5b, 0xe0, 0x4a, 0xfe, 0x75, 0xb2,
-	0xd8, 0x53, 0x2f, 0x93, 0x20, 0x7b, 0x56, 0xa8, 0x8b, 0xfa, 0xcd, 0x29, 0x7b, 0x90, 0x2e, 0xf7,
-	0x8f, 0xde, 0x89, 0x60, 0x7c, 0x97, 0xdb, 0xcf, 0x5f, 0x9e, 0x94, 0xb5, 0x57, 0x27, 0x65, 0xed,
-	0xaf, 0x93, 0xb2, 0xf6, 0xd3, 0x69, 0x79, 0xe1, 0xd5, 0x69, 0x79, 0xe1, 0x8f, 0xd3, 0xf2, 0xc2,
-	0x57, 0x1f, 0x4e, 0xe8, 0x65, 0x97, 0xc7, 0x46, 0x80, 0x9b, 0x7c, 0xf8, 0x51, 0x3b, 0x7c, 0xb8,
-	0x59, 0x3b, 0xce, 0xbd, 0x90, 0xe5, 0x22, 0xcd, 0x45, 0xf9, 0x3a, 0x7e, 0xf8, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xd2, 0x09, 0xcf, 0xd3, 0x49, 0x0c, 0x00, 0x00,
+	// 1611 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x4d, 0x6f, 0x14, 0x47,
+	0x1a, 0x76, 0x63, 0x63, 0xe3, 0xb2, 0xf1, 0xd8, 0x05, 0x86, 0xc6, 0x66, 0xa7, 0xad, 0x86, 0x65,
+	0xcd, 0x2e, 0x9e, 0xc1, 0x46, 0x32, 0x12, 0xbb, 0x1c, 0x3c, 0xb6, 0xbc, 0x22, 0x22, 0x60, 0x7a,
+	0x2c, 0xa2, 0x24, 0x8a, 0x3a, 0x35, 0xdd, 0x35, 0x33, 0x2d, 0x77, 0x77, 0x0d, 0x55, 0x35, 0xc6,
+	0xce, 0x31, 0x52, 0xee, 0x91, 0xb8, 0xe6, 0x90, 0x53, 0x0e, 0xb9, 0x45, 0x09, 0x87, 0xfc, 0x03,
+	0x8e, 0x1c, 0xa3, 0x1c, 0x3a, 0x91, 0xf9, 0x07, 0xf3, 0x03, 0xa2, 0xa8, 0x3e, 0x7a, 0x3e, 0xda,
+	0x76, 0x7b, 0x48, 0x4e, 0x78, 0xaa, 0x9e, 0xe7, 0xa9, 0xb7, 0xde, 0x7a, 0xde, 0xb7, 0xaa, 0x01,
+	0xb7, 0x09, 0x8b, 0x08, 0x0b, 0x58, 0xb9, 0x45, 0x48, 0x18, 0xa1, 0x18, 0x35, 0x30, 0x2d, 0xef,
+	0xaf, 0xd6, 0x30, 0x47, 0xab, 0xe5, 0x06, 0x8e, 0x31, 0x0b, 0x58, 0xa9, 0x45, 0x09, 0x27, 0x70,
+	0x51, 0x43, 0x4b, 0x7d, 0xd0, 0x92, 0x86, 0x2e, 0x5c, 0x6e, 0x90, 0x06, 0x91, 0xb8, 0xb2, 0xf8,
+	0x4b, 0x51, 0x16, 0xae, 0x35, 0x08, 0x69, 0x84, 0xb8, 0x2c, 0x7f, 0xd5, 0xda, 0xf5, 0x32, 0x8a,
+	0x0f, 0xd3, 0x29, 0x4f, 0xca, 0xb9, 0x8a, 0xa3, 0x7e, 0xe8, 0xa9, 0x62, 0x96, 0xe5, 0xb7, 0x29,
+	0xe2, 0x01, 0x89, 0xd3, 0x79, 0x85, 0x2e, 0xd7, 0x10, 0xc3, 0xdd, 0x58, 0x3d, 0x12, 0xa4, 0xf3,
+	0xa5, 0xbc, 0x3d, 0x45, 0xc4, 0x6f, 0x87, 0xd8, 0xa5, 0xa4, 0xcd, 0xb1, 0xc6, 0xdf, 0xcc, 0xc3,
+	0xf3, 0x03, 0x8d, 0x5a, 0xcb, 0x43, 0x79, 0x24, 0xf6, 0x03, 0x11, 0x22, 0x0a, 0x5d, 0xf6, 0x12,
+	0xb5, 0x34, 0xe7, 0x6e, 0xae, 0x32, 0x45, 0xde, 0x1e, 0xf6, 0xdd, 0x7d, 0x12, 0xb6, 0xa3, 0x34,
+	0x96, 0xd5, 0x5c, 0x06, 0xda, 0xc3, 0xd4, 0xad, 0x63, 0xec, 0xb2, 0x26, 0xa2, 0x9a, 0x62, 0xff,
+	0x74, 0x1e, 0x8c, 0xef, 0x20, 0x8a, 0x22, 0x06, 0x5f, 0x19, 0x60, 0x4e, 0x10, 0x5d, 0x8f, 0x62,
+	0x99, 0x31, 0x01, 0x36, 0x8d, 0xa5, 0xd1, 0xe5, 0xa9, 0xb5, 0x6b, 0x25, 0x9d, 0x64, 0x91, 0xb6,
+	0xf4, 0xdc, 0x4a, 0x9b, 0x24, 0x88, 0x2b, 0x8f, 0xdf, 0x24, 0xd6, 0x48, 0x27, 0xb1, 0xcc, 0x43,
+	0x14, 0x85, 0x0f, 0xec, 0x63, 0x0a, 0xf6, 0xf7, 0xbf, 0x59, 0xcb, 0x8d, 0x80, 0x37, 0xdb, 0xb5,
+	0x92, 0x47, 0x22, 0x7d, 0x5a, 0xfa, 0x9f, 0x15, 0xe6, 0xef, 0x95, 0xf9, 0x61, 0x0b, 0x33, 0x29,
+	0xc6, 0x9c, 0x82, 0xe0, 0x6f, 0x6a, 0xfa, 0x36, 0xc6, 0x70, 0x1f, 0xcc, 0xf6, 0x22, 0x6f, 0xc9,
+	0x48, 0xcd, 0x73, 0x4b, 0xc6, 0xf2, 0xd4, 0xda, 0x7f, 0x4a, 0x39, 0x9e, 0x2a, 0xed, 0x0a, 0xd2,
+	0x36, 0xc6, 0x6a, 0x73, 0x15, 0x4b, 0x47, 0x79, 0x55, 0x45, 0x99, 0x95, 0xb4, 0x9d, 0x19, 0x3e,
+	0x40, 0x80, 0x31, 0xb8, 0x8a, 0xda, 0xbc, 0x49, 0x68, 0xf0, 0x05, 0xf6, 0xdd, 0x17, 0x6d, 0xc2,
+	0xb1, 0xeb, 0xe3, 0x98, 0x44, 0xcc, 0x1c, 0x5d, 0x1a, 0x5d, 0x9e, 0xac, 0xac, 0x77, 0x12, 0xeb,
+	0xae, 0x52, 0x3b, 0x05, 0x68, 0xdf, 0xf1, 0x71, 0x8b, 0x62, 0x0f, 0x71, 0xec, 0x3f, 0xb0, 0x39,
+	0x6d, 0x63, 0xdb, 0x34, 0x9c, 0xf9, 0x1e, 0xfa, 0x99, 0x00, 0x6f, 0x49, 0x2c, 0x6c, 0x01, 0x2b,
+	0x42, 0x07, 0x6e, 0xd6, 0x0b, 0xcc, 0x6d, 0x61, 0xea, 0xd6, 0x42, 0xe2, 0xed, 0x99, 0x63, 0x4b,
+	0xc6, 0xf2, 0x58, 0xe5, 0xdf, 0x9d, 0xc4, 0xba, 0xa5, 0xd6, 0x3d, 0x83, 0x60, 0x3b, 0x8b, 0x11,
+	0x3a, 0xd8, 0xec, 0x01, 0xaa, 0x62, 0x7e, 0x07, 0xd3, 0x8a, 0x98, 0x85, 0x3f, 0x1b, 0xa0, 0x98,
+	0x65, 0xbb, 0xad, 0x10, 0x79, 0x38, 0xc2, 0x31, 0x97, 0x87, 0x7f, 0xfe, 0xac, 0xc3, 0xff, 0x58,
+	0xa7, 0xf5, 0x9f, 0x2a, 0xa0, 0x7c, 0xb9, 0xf7, 0x73, 0xc2, 0xa2, 0x37, 0x18, 0xf8, 0x4e, 0x2a,
+	0xb5, 0x8d, 0xb1, 0xfd, 0xe3, 0x38, 0x98, 0xfe, 0xbf, 0x6a, 0x30, 0x55, 0x8e, 0x38, 0x86, 0x4b,
+	0x60, 0x3a, 0xc6, 0x07, 0xdc, 0x95, 0xf6, 0x0b, 0x7c, 0xd3, 0x10, 0xb9, 0x72, 0x80, 0x18, 0xdb,
+	0x21, 0x24, 0x7c, 0xe4, 0xc3, 0x0d, 0x30, 0x3e, 0x60, 0x9f, 0x1b, 0xb9, 0xf6, 0xd1, 0xb6, 0x19,
+	0x13, 0xfb, 0x73, 0x34, 0x11, 0x3e, 0x05, 0x53, 0x52, 0x5f, 0xd6, 0xbf, 0xf2, 0xc1, 0xd4, 0xda,
+	0x72, 0xae, 0xce, 0x87, 0xb2, 0x63, 0x38, 0x82, 0xa0, 0xc5, 0x80, 0x80, 0xc9, 0x01, 0x06, 0x3f,
+	0x05, 0xb0, 0xeb, 0x44, 0xe6, 0xaa, 0x9a, 0xa6, 0xf2, 0x9c, 0xa7, 0xd6, 0x56, 0x86, 0xb2, 0x37,
+	0xdb, 0x55, 0x24, 0x67, 0x96, 0x67, 0x46, 0xe0, 0x07, 0x60, 0x5a, 0x46, 0xab, 0x5a, 0x04, 0xd3,
+	0x87, 0xf9, 0xaf, 0xfc, 0x6d, 0x13, 0x12, 0x3e, 0x97, 0x78, 0x47, 0x6e, 0x55, 0xfd, 0x2d, 0xdc,
+	0xb9, 0x20, 0x3d, 0xed, 0xb6, 0x50, 0x40, 0xdd, 0xbe, 0x56, 0xc2, 0x09, 0xc5, 0xe6, 0xb8, 0x54,
+	0x2e, 0xe5, 0x2a, 0x4b, 0x9b, 0xef, 0xa0, 0x80, 0xa6, 0x91, 0xeb, 0x74, 0x5c, 0xf1, 0xb3, 0x13,
+	0x55, 0xa1, 0x09, 0x5d, 0x30, 0x77, 0xcc, 0xda, 0xe6, 0x84, 0x5c, 0xe8, 0x4e, 0xee, 0x42, 0x19,
+	0xbf, 0xeb, 0x65, 0x66, 0x33, 0x6e, 0x62, 0xf0, 0x3e, 0x30, 0xa5, 0x63, 0x8e, 0x79, 0x36, 0xf0,
+	0xcd, 0x0b, 0xd2, 0x3d, 0xf3, 0x62, 0x3e, 0x23, 0xf7, 0xc8, 0x87, 0xcf, 0xc1, 0x0c, 0xa7, 0xc8,
+	0xc7, 0xb4, 0x9b, 0xd9, 0x49, 0x19, 0xd6, 0xed, 0xfc, 0x03, 0x93, 0x14, 0x95, 0x4f, 0x1d, 0xd3,
+	0x45, 0xde, 0x37, 0xc6, 0xe0, 0xe7, 0x60, 0x0e, 0xd5, 0xeb, 0x41, 0x18, 0x20, 0x8e, 0x5d, 0x8a,
+	0x6b, 0x48, 0x78, 0x0c, 0x48, 0xe9, 0x7c, 0x2f, 0x6c, 0xa4, 0x2c, 0x47, 0x91, 0xd2, 0x2d, 0xa3,
+	0xcc, 0xb8, 0xfd, 0xc7, 0x24, 0x98, 0x19, 0xec, 0x8b, 0xb0, 0x06, 0xe6, 0x7c, 0x5c, 0x47, 0xed,
+	0x90, 0xf7, 0x4e, 0x55, 0x16, 0xcf, 0x64, 0x65, 0x5d, 0xa8, 0xfc, 0x9a, 0x58, 0x8b, 0xaa, 0x40,
+	0x99, 0xbf, 0x57, 0x0a, 0x48, 0x39, 0x42, 0xbc, 0x59, 0x7a, 0x8c, 0x1b, 0xc8, 0x3b, 0xdc, 0xc2,
+	0xde, 0x51, 0x62, 0x15, 0xb6, 0x14, 0x3f, 0x15, 0x76, 0x0a, 0xfe, 0xe0, 0x00, 0xfc, 0xc6, 0x00,
+	0xf2, 0xfa, 0xef, 0xf3, 0x8d, 0x1f, 0x30, 0x4e, 0x83, 0x5a, 0x5b, 0x24, 0x56, 0xd7, 0xe3, 0x7f,
+	0x87, 0xf2, 0xfb, 0x56, 0x1f, 0x71, 0x07, 0x53, 0x0f, 0xc7, 0x1c, 0x35, 0x70, 0x65, 0x49, 0xc4,
+	0x7a, 0x94, 0x58, 0xe6, 0x53, 0x16, 0x91, 0x93, 0xb0, 0x8e, 0x49, 0x4e, 0x99, 0x81, 0xdf, 0x19,
+	0xc0, 0x8a, 0x49, 0xec, 0xe6, 0x85, 0x38, 0xfa, 0xf7, 0x43, 0xbc, 0xa1, 0x43, 0x5c, 0x7c, 0x42,
+	0xe2, 0x53, 0xa3, 0x5c, 0x8c, 0x4f, 0x9f, 0x84, 0x9b, 0xa0, 0x80, 0xfc, 0x28, 0x88, 0x5d, 0xe4,
+	0xfb, 0x14, 0x33, 0x86, 0x99, 0x39, 0x26, 0xaf, 0xa2, 0x85, 0x4e, 0x62, 0x5d, 0xd1, 0x57, 0xd1,
+	0x20, 0xc0, 0x76, 0x66, 0xe4, 0xc8, 0x46, 0x3a, 0x00, 0x7f, 0x30, 0xc0, 0xba, 0x47, 0xa2, 0xa8,
+	0x1d, 0x07, 0xfc, 0x50, 0xb5, 0x4b, 0x55, 0xd9, 0x9c, 0x28, 0xfb, 0x8b, 0x54, 0xbc, 0x6c, 0x06,
+	0x1c, 0x87, 0x01, 0xe3, 0xd8, 0x77, 0x11, 0x63, 0x98, 0x33, 0x97, 0x13, 0xf3, 0xbc, 0xb4, 0xc5,
+	0x46, 0x27, 0xb1, 0x1e, 0xa6, 0xed, 0xfe, 0xaf, 0xe8, 0xd8, 0x4e, 0xa9, 0x4b, 0x14, 0xfd, 0x46,
+	0x76, 0x86, 0x5d, 0x22, 0xaa, 0xeb, 0x09, 0x89, 0x3f, 0xea, 0x51, 0x36, 0x24, 0x63, 0x97, 0xc0,
+	0x5d, 0x30, 0x4f, 0xb1, 0xdf, 0xf6, 0xb0, 0x2f, 0x4f, 0xa6, 0xab, 0x2a, 0x1b, 0xcf, 0x64, 0x65,
+	0xa9, 0x93, 0x58, 0xd7, 0x55, 0x44, 0x27, 0xc2, 0x6c, 0xe7, 0x92, 0x1e, 0xdf, 0xc6, 0xb8, 0xab,
+	0x0f, 0x63, 0x71, 0xfd, 0x9d, 0xb0, 0x81, 0x9e, 0xfc, 0x84, 0x94, 0xbf, 0xdd, 0x7f, 0xbf, 0xe5,
+	0xe1, 0x6d, 0x71, 0x67, 0x65, 0x37, 0xd6, 0x5b, 0xef, 0x2b, 0x03, 0xdc, 0xf2, 0x51, 0x10, 0x1e,
+	0xba, 0x8c, 0xa3, 0xbd, 0x20, 0x6e, 0xb8, 0x14, 0xbf, 0x44, 0xd4, 0x67, 0x2e, 0x8b, 0x08, 0xe1,
+	0x4d, 0x31, 0x52, 0x47, 0x1e, 0x27, 0x54, 0xf5, 0x9f, 0xca, 0x6a, 0x27, 0xb1, 0x56, 0xd4, 0xc2,
+	0xc3, 0xf1, 0x6c, 0x47, 0x01, 0xab, 0x0a, 0xe7, 0x28, 0x58, 0x35, 0x45, 0x6d, 0x4b, 0x10, 0x7c,
+	0x01, 0x0a, 0x3d, 0x97, 0xf3, 0x00, 0xd3, 0x21, 0x1b, 0x98, 0xb6, 0xe4, 0x6e, 0x80, 0x69, 0xa5,
+	0xa8, 0xef, 0xfd, 0x2b, 0xd9, 0xe7, 0x94, 0xd4, 0xb3, 0x9d, 0x8b, 0xbc, 0x0f, 0xcd, 0xc4, 0xd6,
+	0x2f, 0xf7, 0x30, 0xdd, 0xbe, 0x94, 0xb6, 0xb7, 0xd2, 0x50, 0x0b, 0x77, 0xdb, 0x9c, 0x2a, 0xa5,
+	0x4e, 0x62, 0x2d, 0x66, 0x57, 0xef, 0x29, 0xdb, 0x0e, 0xe4, 0x59, 0x1e, 0xb3, 0xbf, 0x35, 0xc0,
+	0xdc, 0x31, 0x39, 0x78, 0x07, 0x4c, 0xe8, 0x82, 0xd1, 0x9d, 0x0f, 0x76, 0x12, 0x6b, 0x26, 0xad,
+	0x27, 0x39, 0x61, 0x3b, 0x29, 0x04, 0x7e, 0x06, 0xa6, 0x55, 0x73, 0x56, 0xef, 0x68, 0xd9, 0xbd,
+	0x26, 0x2b, 0x0f, 0x86, 0x68, 0x96, 0x9d, 0xc4, 0xba, 0x94, 0xda, 0xb4, 0x27, 0x60, 0x3b, 0x53,
+	0xea, 0x67, 0x55, 0xfe, 0x7a, 0x6d, 0x80, 0xe9, 0xfe, 0x54, 0xc3, 0x67, 0x00, 0x88, 0x92, 0x56,
+	0x77, 0x8d, 0x0e, 0x70, 0x4d, 0xaf, 0x36, 0x7f, 0x7c, 0xb5, 0x47, 0x31, 0xef, 0x24, 0xd6, 0x9c,
+	0x7e, 0x20, 0x76, 0x89, 0xb6, 0x33, 0x19, 0x05, 0xb1, 0xba, 0x6a, 0xa0, 0x03, 0x2e, 0xf8, 0x01,
+	0xf3, 0x48, 0x3b, 0xe6, 0x3a, 0xfc, 0xf5, 0xe1, 0xc2, 0x2f, 0x68, 0x37, 0x6a, 0xb2, 0xed, 0x74,
+	0x75, 0xec, 0xd7, 0xe7, 0x40, 0x31, 0xbf, 0x03, 0xc2, 0x3a, 0x28, 0x64, 0x1c, 0xac, 0xb7, 0xf3,
+	0x70, 0xb8, 0xd5, 0xb5, 0xd9, 0x32, 0x1a, 0xb6, 0x33, 0xc3, 0x06, 0xfc, 0x0e, 0x3d, 0x30, 0x33,
+	0x58, 0xa8, 0x7a, 0x93, 0xff, 0x1b, 0x6e, 0x99, 0xf9, 0x93, 0x6a, 0xdd, 0x76, 0x2e, 0x0e, 0xd4,
+	0x36, 0xdc, 0x06, 0x63, 0xb5, 0x36, 0x55, 0x37, 0x43, 0xef, 0x40, 0xce, 0x90, 0x9e, 0x52, 0xd2,
+	0x82, 0x68, 0x3b, 0x92, 0x6f, 0x7f, 0x39, 0x0a, 0x66, 0xb3, 0x8f, 0x39, 0xe8, 0x80, 0xf9, 0xfe,
+	0x77, 0x21, 0x91, 0x95, 0x2f, 0x0a, 0xf5, 0xcc, 0xaf, 0x31, 0x75, 0xf5, 0xc3, 0xde, 0x63, 0x90,
+	0x54, 0x15, 0x15, 0xba, 0xe0, 0xfa, 0xa0, 0xe6, 0xb1, 0x1c, 0x0d, 0x25, 0x6d, 0xf6, 0x49, 0x6f,
+	0x0e, 0x64, 0x64, 0x0f, 0xfc, 0xa3, 0x89, 0x83, 0x46, 0x93, 0xbb, 0xc8, 0x93, 0x9e, 0x10, 0x87,
+	0xc4, 0x38, 0xa2, 0x9c, 0xb9, 0x75, 0x4a, 0x22, 0x99, 0xaa, 0xd1, 0xca, 0x72, 0x27, 0xb1, 0x6e,
+	0xaa, 0x3c, 0xe4, 0xc2, 0x6d, 0x67, 0x41, 0xcd, 0x6f, 0x74, 0xa7, 0xab, 0x72, 0x76, 0x9b, 0x92,
+	0x08, 0x3e, 0x1e, 0x7c, 0x39, 0x13, 0x57, 0x1e, 0xc6, 0xd8, 0x70, 0x7b, 0x28, 0xf4, 0xed, 0xa1,
+	0x22, 0x0e, 0xe1, 0x95, 0x01, 0x40, 0xef, 0xe9, 0x0b, 0xaf, 0x82, 0x89, 0xc1, 0xef, 0x88, 0xf1,
+	0x96, 0xfa, 0x86, 0x08, 0xf5, 0x07, 0x80, 0x2e, 0xc6, 0x33, 0x53, 0x76, 0x57, 0x2c, 0xf7, 0x5e,
+	0x5f, 0x3d, 0xa0, 0xf7, 0xea, 0xae, 0x3c, 0x7b, 0x73, 0x54, 0x34, 0xde, 0x1e, 0x15, 0x8d, 0xdf,
+	0x8f, 0x8a, 0xc6, 0xd7, 0xef, 0x8a, 0x23, 0x6f, 0xdf, 0x15, 0x47, 0x7e, 0x79, 0x57, 0x1c, 0xf9,
+	0xe4, 0x7e, 0x9f, 0x9e, 0x6e, 0x9d, 0x2b, 0x21, 0xaa, 0xb1, 0xf4, 0x47, 0x79, 0xff, 0xde, 0x6a,
+	0xf9, 0x60, 0xe0, 0xbf, 0x01, 0xe4, 0x22, 0xb5, 0x71, 0xf9, 0xd5, 0x7f, 0xef, 0xcf, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0xe1, 0xe5, 0xab, 0xeb, 0xba, 0x11, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -602,6 +802,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConditionalSwapPlacementFee) > 0 {
+		for iNdEx := len(m.ConditionalSwapPlacementFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConditionalSwapPlacementFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxConditionalSwapsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxConditionalSwapsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.AuthorizedQuoteDenoms) > 0 {
 		for iNdEx := len(m.AuthorizedQuoteDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AuthorizedQuoteDenoms[iNdEx])
@@ -658,6 +877,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AffiliateRebates) > 0 {
+		for iNdEx := len(m.AffiliateRebates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AffiliateRebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TraderVolumes) > 0 {
+		for iNdEx := len(m.TraderVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraderVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextConditionalSwapId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextConditionalSwapId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ConditionalSwaps) > 0 {
+		for iNdEx := len(m.ConditionalSwaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConditionalSwaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.DenomPairTakerFeeStore) > 0 {
 		for iNdEx := len(m.DenomPairTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -750,6 +1016,34 @@ func (m *TakerFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TakerFeeAffiliates) > 0 {
+		for iNdEx := len(m.TakerFeeAffiliates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeeAffiliates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TakerFeeTiers) > 0 {
+		for iNdEx := len(m.TakerFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.DailyStakingRewardsSmoothingFactor != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.DailyStakingRewardsSmoothingFactor))
 		i--
@@ -822,6 +1116,89 @@ func (m *TakerFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TakerFeeAffiliate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeAffiliate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeAffiliate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RebateShare.Size()
+		i -= size
+		if _, err := m.RebateShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinVolume.Size()
+		i -= size
+		if _, err := m.MinVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TakerFeeDistributionPercentage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1018,6 +1395,15 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.MaxConditionalSwapsPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxConditionalSwapsPerBlock))
+	}
+	if len(m.ConditionalSwapPlacementFee) > 0 {
+		for _, e := range m.ConditionalSwapPlacementFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1054,6 +1440,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ConditionalSwaps) > 0 {
+		for _, e := range m.ConditionalSwaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextConditionalSwapId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextConditionalSwapId))
+	}
+	if len(m.TraderVolumes) > 0 {
+		for _, e := range m.TraderVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AffiliateRebates) > 0 {
+		for _, e := range m.AffiliateRebates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1094,18 +1501,58 @@ func (m *TakerFeeParams) Size() (n int) {
 	if m.DailyStakingRewardsSmoothingFactor != 0 {
 		n += 1 + sovGenesis(uint64(m.DailyStakingRewardsSmoothingFactor))
 	}
+	if len(m.TakerFeeTiers) > 0 {
+		for _, e := range m.TakerFeeTiers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TakerFeeAffiliates) > 0 {
+		for _, e := range m.TakerFeeAffiliates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *TakerFeeDistributionPercentage) Size() (n int) {
+func (m *TakerFeeAffiliate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.StakingRewards.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.CommunityPool.Size()
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.RebateShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TakerFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinVolume.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Discount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TakerFeeDistributionPercentage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakingRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CommunityPool.Size()
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Burn.Size()
 	n += 1 + l + sovGenesis(uint64(l))
@@ -1294,6 +1741,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AuthorizedQuoteDenoms = append(m.AuthorizedQuoteDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConditionalSwapsPerBlock", wireType)
+			}
+			m.MaxConditionalSwapsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConditionalSwapsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalSwapPlacementFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConditionalSwapPlacementFee = append(m.ConditionalSwapPlacementFee, types.Coin{})
+			if err := m.ConditionalSwapPlacementFee[len(m.ConditionalSwapPlacementFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1534,6 +2034,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalSwaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConditionalSwaps = append(m.ConditionalSwaps, ConditionalSwap{})
+			if err := m.ConditionalSwaps[len(m.ConditionalSwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextConditionalSwapId", wireType)
+			}
+			m.NextConditionalSwapId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextConditionalSwapId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderVolumes = append(m.TraderVolumes, TraderVolume{})
+			if err := m.TraderVolumes[len(m.TraderVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateRebates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AffiliateRebates = append(m.AffiliateRebates, AffiliateRebates{})
+			if err := m.AffiliateRebates[len(m.AffiliateRebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1831,6 +2452,308 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeeTiers = append(m.TakerFeeTiers, TakerFeeTier{})
+			if err := m.TakerFeeTiers[len(m.TakerFeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeAffiliates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeeAffiliates = append(m.TakerFeeAffiliates, TakerFeeAffiliate{})
+			if err := m.TakerFeeAffiliates[len(m.TakerFeeAffiliates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeAffiliate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeAffiliate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeAffiliate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebateShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RebateShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/gogoproto/proto"
 )
 
@@ -17,6 +19,10 @@ const (
 	StoreKey = ModuleName
 
 	RouterKey = ModuleName
+
+	// ConditionalSwapEscrowName is the name of the module account that escrows the tokens in
+	// of pending conditional swaps.
+	ConditionalSwapEscrowName = "conditional_swap_escrow"
 )
 
 var (
@@ -60,6 +66,34 @@ var (
 
 	// KeyTakerFeeBurnProtoRevArray defines key to store the taker fee for burn tracker coin array.
 	KeyTakerFeeBurnProtoRevArray = []byte{0x0D}
+
+	// KeyNextConditionalSwapId defines key to store the next conditional swap ID to be used.
+	KeyNextConditionalSwapId = []byte{0x0E}
+
+	// ConditionalSwapPrefix defines prefix to store pending conditional swaps by ID.
+	ConditionalSwapPrefix = []byte{0x0F}
+
+	// ConditionalSwapBySenderPrefix defines prefix to index pending conditional swaps by sender.
+	ConditionalSwapBySenderPrefix = []byte{0x10}
+
+	// ConditionalSwapByExpiryPrefix defines prefix to index pending conditional swaps by expiry.
+	ConditionalSwapByExpiryPrefix = []byte{0x11}
+
+	// KeyLastCheckedConditionalSwapId defines key to store the ID of the last conditional swap
+	// whose trigger was checked, from which checks resume in the next end block.
+	KeyLastCheckedConditionalSwapId = []byte{0x12}
+
+	// TraderVolumePrefix defines prefix to store the swap volumes of traders over the last days.
+	TraderVolumePrefix = []byte{0x13}
+
+	// AffiliateRebatesPrefix defines prefix to store the taker fee rebates received by affiliates.
+	AffiliateRebatesPrefix = []byte{0x14}
+
+	// KeyTakerFeeShareSkimmedTotal defines the key to store the cumulative taker fees skimmed for capped taker fee share agreements.
+	KeyTakerFeeShareSkimmedTotal = []byte{0x15}
+
+	// KeyTraderVolumePruningCursor defines the key to store the key of the next trader volume to prune.
+	KeyTraderVolumePruningCursor = []byte{0x16}
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
@@ -139,6 +173,11 @@ func FormatTakerFeeShareAgreementKey(denom string) []byte {
 	return []byte(fmt.Sprintf("%s%s%s", KeyTakerFeeShare, KeySeparator, denom))
 }
 
+// FormatTakerFeeShareSkimmedTotalKey generates a key for the cumulative taker fees skimmed for the taker fee share agreement of a denom.
+func FormatTakerFeeShareSkimmedTotalKey(denom string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s", KeyTakerFeeShareSkimmedTotal, KeySeparator, denom))
+}
+
 // FormatRegisteredAlloyPoolKey generates a key for a registered alloy pool with a specific pool ID and alloyed denomination.
 // The key is used to store and retrieve the registered alloy pool data.
 func FormatRegisteredAlloyPoolKey(poolId uint64, alloyedDenom string) []byte {
@@ -150,3 +189,40 @@ func FormatRegisteredAlloyPoolKey(poolId uint64, alloyedDenom string) []byte {
 func FormatRegisteredAlloyPoolKeyPoolIdOnly(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d", KeyRegisteredAlloyPool, KeySeparator, poolId))
 }
+
+// FormatConditionalSwapKey generates a key for the conditional swap with the given ID.
+// IDs are big endian encoded so that conditional swaps are iterated in the order they were placed.
+func FormatConditionalSwapKey(id uint64) []byte {
+	return append(append([]byte{}, ConditionalSwapPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// FormatConditionalSwapBySenderPrefix generates the prefix of the conditional swaps of the given sender.
+func FormatConditionalSwapBySenderPrefix(sender sdk.AccAddress) []byte {
+	return append(append([]byte{}, ConditionalSwapBySenderPrefix...), address.MustLengthPrefix(sender)...)
+}
+
+// FormatConditionalSwapBySenderKey generates a key for the sender index of the conditional swap with the given ID.
+func FormatConditionalSwapBySenderKey(sender sdk.AccAddress, id uint64) []byte {
+	return append(FormatConditionalSwapBySenderPrefix(sender), sdk.Uint64ToBigEndian(id)...)
+}
+
+// FormatConditionalSwapByExpiryPrefix generates the prefix of the conditional swaps expiring at the given time.
+// Iterating up to the prefix of a time yields the conditional swaps expiring strictly before it.
+func FormatConditionalSwapByExpiryPrefix(expiry time.Time) []byte {
+	return append(append([]byte{}, ConditionalSwapByExpiryPrefix...), sdk.FormatTimeBytes(expiry)...)
+}
+
+// FormatConditionalSwapByExpiryKey generates a key for the expiry index of the conditional swap with the given ID.
+func FormatConditionalSwapByExpiryKey(expiry time.Time, id uint64) []byte {
+	return append(FormatConditionalSwapByExpiryPrefix(expiry), sdk.Uint64ToBigEndian(id)...)
+}
+
+// FormatTraderVolumeKey generates a key for the swap volume of the given trader.
+func FormatTraderVolumeKey(trader sdk.AccAddress) []byte {
+	return append(append([]byte{}, TraderVolumePrefix...), address.MustLengthPrefix(trader)...)
+}
+
+// FormatAffiliateRebatesKey generates a key for the taker fee rebates received by the given affiliate.
+func FormatAffiliateRebatesKey(affiliate sdk.AccAddress) []byte {
+	return append(append([]byte{}, AffiliateRebatesPrefix...), address.MustLengthPrefix(affiliate)...)
+}
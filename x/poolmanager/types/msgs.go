@@ -17,6 +17,9 @@ const (
 	TypeMsgSetDenomPairTakerFee                  = "set_denom_pair_taker_fee"
 	TypeMsgSetTakerFeeShareAgreementForDenomPair = "set_taker_fee_share_agreement_for_denom_pair"
 	TypeMsgSetRegisteredAlloyedPool              = "set_registered_alloyed_pool"
+	TypeMsgPlaceConditionalSwap                  = "place_conditional_swap"
+	TypeMsgCancelConditionalSwap                 = "cancel_conditional_swap"
+	TypeMsgSwapExactAmountInWithMaxPriceImpact   = "swap_exact_amount_in_with_max_price_impact"
 )
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -43,6 +46,10 @@ func (msg MsgSwapExactAmountIn) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
 	}
 
+	if err := validateAffiliate(msg.Affiliate); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -77,6 +84,10 @@ func (msg MsgSwapExactAmountOut) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenInMaxAmount.String()}
 	}
 
+	if err := validateAffiliate(msg.Affiliate); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -111,6 +122,10 @@ func (msg MsgSplitRouteSwapExactAmountIn) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
 	}
 
+	if err := validateAffiliate(msg.Affiliate); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -145,6 +160,10 @@ func (msg MsgSplitRouteSwapExactAmountOut) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenInMaxAmount.String()}
 	}
 
+	if err := validateAffiliate(msg.Affiliate); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -204,6 +223,10 @@ func (msg MsgSetTakerFeeShareAgreementForDenom) ValidateBasic() error {
 		return err
 	}
 
+	if err := msg.TakerFeeShareAgreement().ValidateSchedule(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -215,6 +238,19 @@ func (msg MsgSetTakerFeeShareAgreementForDenom) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+// TakerFeeShareAgreement returns the taker fee share agreement set by the msg.
+func (msg MsgSetTakerFeeShareAgreementForDenom) TakerFeeShareAgreement() TakerFeeShareAgreement {
+	return TakerFeeShareAgreement{
+		Denom:               msg.Denom,
+		SkimPercent:         msg.SkimPercent,
+		SkimAddress:         msg.SkimAddress,
+		StartTime:           msg.StartTime,
+		EndTime:             msg.EndTime,
+		SkimPercentSchedule: msg.SkimPercentSchedule,
+		SkimCap:             msg.SkimCap,
+	}
+}
+
 var _ sdk.Msg = &MsgSetRegisteredAlloyedPool{}
 
 func (msg MsgSetRegisteredAlloyedPool) Route() string { return RouterKey }
@@ -242,3 +278,128 @@ func (msg MsgSetRegisteredAlloyedPool) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgPlaceConditionalSwap{}
+
+func (msg MsgPlaceConditionalSwap) Route() string { return RouterKey }
+func (msg MsgPlaceConditionalSwap) Type() string  { return TypeMsgPlaceConditionalSwap }
+
+func (msg MsgPlaceConditionalSwap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	err = SwapAmountInRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
+	}
+
+	if err := msg.Trigger.Validate(); err != nil {
+		return err
+	}
+
+	if msg.Expiry.IsZero() {
+		return fmt.Errorf("conditional swap expiry must be set")
+	}
+
+	return nil
+}
+
+func (msg MsgPlaceConditionalSwap) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelConditionalSwap{}
+
+func (msg MsgCancelConditionalSwap) Route() string { return RouterKey }
+func (msg MsgCancelConditionalSwap) Type() string  { return TypeMsgCancelConditionalSwap }
+
+func (msg MsgCancelConditionalSwap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if msg.ConditionalSwapId == 0 {
+		return fmt.Errorf("invalid conditional swap id: %d", msg.ConditionalSwapId)
+	}
+
+	return nil
+}
+
+func (msg MsgCancelConditionalSwap) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSwapExactAmountInWithMaxPriceImpact{}
+
+func (msg MsgSwapExactAmountInWithMaxPriceImpact) Route() string { return RouterKey }
+func (msg MsgSwapExactAmountInWithMaxPriceImpact) Type() string {
+	return TypeMsgSwapExactAmountInWithMaxPriceImpact
+}
+
+func (msg MsgSwapExactAmountInWithMaxPriceImpact) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	err = SwapAmountInRoutes([]SwapAmountInRoute{{PoolId: msg.PoolId, TokenOutDenom: msg.TokenOutDenom}}).Validate()
+	if err != nil {
+		return err
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	if msg.MaxPriceImpact.IsNil() || !msg.MaxPriceImpact.IsPositive() || msg.MaxPriceImpact.GT(OneDec) {
+		return fmt.Errorf("max price impact must be in (0, 1], was (%s)", msg.MaxPriceImpact)
+	}
+
+	if !msg.ExternalPrice.IsNil() && msg.ExternalPrice.IsNegative() {
+		return fmt.Errorf("external price must be non-negative, was (%s)", msg.ExternalPrice)
+	}
+
+	if err := validateAffiliate(msg.Affiliate); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (msg MsgSwapExactAmountInWithMaxPriceImpact) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// validateAffiliate validates the optional affiliate of a swap msg.
+func validateAffiliate(affiliate string) error {
+	if affiliate == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(affiliate); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid affiliate address (%s)", err)
+	}
+	return nil
+}
@@ -725,6 +725,19 @@ func TestMsgSetTakerFeeShareAgreementForDenom(t *testing.T) {
 			}),
 			expectError: true,
 		},
+		"valid skim cap": {
+			msg: createMsg(func(msg types.MsgSetTakerFeeShareAgreementForDenom) types.MsgSetTakerFeeShareAgreementForDenom {
+				msg.SkimCap = sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000_000))
+				return msg
+			}),
+		},
+		"invalid skim cap (more than one denom)": {
+			msg: createMsg(func(msg types.MsgSetTakerFeeShareAgreementForDenom) types.MsgSetTakerFeeShareAgreementForDenom {
+				msg.SkimCap = sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 1_000_000))
+				return msg
+			}),
+			expectError: true,
+		},
 	}
 
 	for name, tc := range tests {
@@ -801,3 +814,108 @@ func TestMsgSetRegisteredAlloyedPool(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSwapExactAmountInWithMaxPriceImpact(t *testing.T) {
+	createMsg := func(after func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+		properMsg := types.MsgSwapExactAmountInWithMaxPriceImpact{
+			Sender:         addr1,
+			PoolId:         1,
+			TokenIn:        sdk.NewCoin("test", osmomath.NewInt(100)),
+			TokenOutDenom:  "uatom",
+			MaxPriceImpact: osmomath.MustNewDecFromStr("0.01"),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), types.TypeMsgSwapExactAmountInWithMaxPriceImpact)
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := map[string]struct {
+		msg         types.MsgSwapExactAmountInWithMaxPriceImpact
+		expectError bool
+	}{
+		"valid": {
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				// Do nothing
+				return msg
+			}),
+		},
+		"valid with external price": {
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.ExternalPrice = osmomath.MustNewDecFromStr("1.5")
+				return msg
+			}),
+		},
+		"invalid sender": {
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.Sender = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid token out denom": {
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.TokenOutDenom = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero token in": {
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.TokenIn.Amount = osmomath.ZeroInt()
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero max price impact": {
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.MaxPriceImpact = osmomath.ZeroDec()
+				return msg
+			}),
+			expectError: true,
+		},
+		"max price impact greater than one": {
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.MaxPriceImpact = osmomath.MustNewDecFromStr("1.01")
+				return msg
+			}),
+			expectError: true,
+		},
+		"negative external price": {
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.ExternalPrice = osmomath.MustNewDecFromStr("-1")
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid affiliate": {
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.Affiliate = "invalid"
+				return msg
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -23,6 +23,10 @@ var (
 	KeyReducedTakerFeeByWhitelist                     = []byte("ReducedTakerFeeByWhitelist")
 	KeyCommunityPoolDenomWhitelist                    = []byte("CommunityPoolDenomWhitelist")
 	KeyDailyStakingRewardsSmoothingFactor             = []byte("DailyStakingRewardsSmoothingFactor")
+	KeyMaxConditionalSwapsPerBlock                    = []byte("MaxConditionalSwapsPerBlock")
+	KeyConditionalSwapPlacementFee                    = []byte("ConditionalSwapPlacementFee")
+	KeyTakerFeeTiers                                  = []byte("TakerFeeTiers")
+	KeyTakerFeeAffiliates                             = []byte("TakerFeeAffiliates")
 
 	ZeroDec = osmomath.ZeroDec()
 	OneDec  = osmomath.OneDec()
@@ -30,8 +34,15 @@ var (
 
 const (
 	maxSmoothingFactor = 5000
+
+	// DefaultMaxConditionalSwapsPerBlock is the default maximum number of conditional swaps
+	// checked each end block.
+	DefaultMaxConditionalSwapsPerBlock = uint64(100)
 )
 
+// DefaultConditionalSwapPlacementFee is the default fee paid to the community pool to place a conditional swap.
+var DefaultConditionalSwapPlacementFee = sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000_000)} // 1 OSMO
+
 // ParamTable for gamm module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -78,6 +89,8 @@ func DefaultParams() Params {
 			ReducedFeeWhitelist:                            []string{},
 			CommunityPoolDenomWhitelist:                    []string{},
 			DailyStakingRewardsSmoothingFactor:             1, // No smoothing by default (1 = distribute all immediately)
+			TakerFeeTiers:                                  []TakerFeeTier{},
+			TakerFeeAffiliates:                             []TakerFeeAffiliate{},
 		},
 		AuthorizedQuoteDenoms: []string{
 			appparams.BaseCoinUnit,
@@ -85,6 +98,8 @@ func DefaultParams() Params {
 			"ibc/0CD3A0285E1341859B5E86B6AB7682F023D03E97607CCC1DC95706411D866DF7", // DAI
 			"ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", // USDC
 		},
+		MaxConditionalSwapsPerBlock: DefaultMaxConditionalSwapsPerBlock,
+		ConditionalSwapPlacementFee: DefaultConditionalSwapPlacementFee,
 	}
 }
 
@@ -120,6 +135,18 @@ func (p Params) Validate() error {
 	if err := validateDailyStakingRewardsSmoothingFactor(p.TakerFeeParams.DailyStakingRewardsSmoothingFactor); err != nil {
 		return err
 	}
+	if err := validateMaxConditionalSwapsPerBlock(p.MaxConditionalSwapsPerBlock); err != nil {
+		return err
+	}
+	if err := validateConditionalSwapPlacementFee(p.ConditionalSwapPlacementFee); err != nil {
+		return err
+	}
+	if err := validateTakerFeeTiers(p.TakerFeeParams.TakerFeeTiers); err != nil {
+		return err
+	}
+	if err := validateTakerFeeAffiliates(p.TakerFeeParams.TakerFeeAffiliates); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -137,6 +164,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyReducedTakerFeeByWhitelist, &p.TakerFeeParams.ReducedFeeWhitelist, osmoutils.ValidateAddressList),
 		paramtypes.NewParamSetPair(KeyCommunityPoolDenomWhitelist, &p.TakerFeeParams.CommunityPoolDenomWhitelist, validateCommunityPoolDenomWhitelist),
 		paramtypes.NewParamSetPair(KeyDailyStakingRewardsSmoothingFactor, &p.TakerFeeParams.DailyStakingRewardsSmoothingFactor, validateDailyStakingRewardsSmoothingFactor),
+		paramtypes.NewParamSetPair(KeyMaxConditionalSwapsPerBlock, &p.MaxConditionalSwapsPerBlock, validateMaxConditionalSwapsPerBlock),
+		paramtypes.NewParamSetPair(KeyConditionalSwapPlacementFee, &p.ConditionalSwapPlacementFee, validateConditionalSwapPlacementFee),
+		paramtypes.NewParamSetPair(KeyTakerFeeTiers, &p.TakerFeeParams.TakerFeeTiers, validateTakerFeeTiers),
+		paramtypes.NewParamSetPair(KeyTakerFeeAffiliates, &p.TakerFeeParams.TakerFeeAffiliates, validateTakerFeeAffiliates),
 	}
 }
 
@@ -315,3 +346,76 @@ func validateDailyStakingRewardsSmoothingFactor(i interface{}) error {
 
 	return nil
 }
+
+// validateMaxConditionalSwapsPerBlock validates the maximum number of conditional swaps
+// checked each end block. Zero disables the execution of conditional swaps.
+func validateMaxConditionalSwapsPerBlock(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateConditionalSwapPlacementFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Validate() != nil {
+		return fmt.Errorf("invalid conditional swap placement fee: %+v", i)
+	}
+
+	return nil
+}
+
+// validateTakerFeeTiers validates that the taker fee tiers are ordered by strictly increasing min volume,
+// and that their discounts are between 0 and 1.
+func validateTakerFeeTiers(i interface{}) error {
+	tiers, ok := i.([]TakerFeeTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, tier := range tiers {
+		if tier.MinVolume.IsNil() || tier.MinVolume.IsNegative() {
+			return fmt.Errorf("taker fee tier %d min volume must be non-negative", i)
+		}
+		if i > 0 && !tier.MinVolume.GT(tiers[i-1].MinVolume) {
+			return fmt.Errorf("taker fee tier %d min volume (%s) must be greater than the min volume of the previous tier (%s)", i, tier.MinVolume, tiers[i-1].MinVolume)
+		}
+		if tier.Discount.IsNil() || tier.Discount.IsNegative() || tier.Discount.GT(OneDec) {
+			return fmt.Errorf("taker fee tier %d discount must be between 0 and 1", i)
+		}
+	}
+
+	return nil
+}
+
+// validateTakerFeeAffiliates validates that the taker fee affiliates have valid and unique addresses,
+// and that their rebate shares are between 0 and 1.
+func validateTakerFeeAffiliates(i interface{}) error {
+	affiliates, ok := i.([]TakerFeeAffiliate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenAddresses := make(map[string]bool, len(affiliates))
+	for _, affiliate := range affiliates {
+		if _, err := sdk.AccAddressFromBech32(affiliate.Address); err != nil {
+			return fmt.Errorf("invalid taker fee affiliate address (%s): %w", affiliate.Address, err)
+		}
+		if seenAddresses[affiliate.Address] {
+			return fmt.Errorf("duplicate taker fee affiliate address (%s)", affiliate.Address)
+		}
+		seenAddresses[affiliate.Address] = true
+
+		if affiliate.RebateShare.IsNil() || affiliate.RebateShare.IsNegative() || affiliate.RebateShare.GT(OneDec) {
+			return fmt.Errorf("taker fee affiliate %s rebate share must be between 0 and 1", affiliate.Address)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// SkimPercentAt returns the skim percent of the agreement at the given time, following its schedule.
+// Returns zero before the start time and from the end time of the agreement.
+func (a TakerFeeShareAgreement) SkimPercentAt(t time.Time) osmomath.Dec {
+	if a.StartTime != nil && t.Before(*a.StartTime) {
+		return ZeroDec
+	}
+	if a.IsExpiredAt(t) {
+		return ZeroDec
+	}
+
+	skimPercent := a.SkimPercent
+	for _, step := range a.SkimPercentSchedule {
+		if t.Before(step.StartTime) {
+			break
+		}
+		skimPercent = step.SkimPercent
+	}
+	return skimPercent
+}
+
+// IsExpiredAt returns true if the agreement has reached its end time at the given time.
+func (a TakerFeeShareAgreement) IsExpiredAt(t time.Time) bool {
+	return a.EndTime != nil && !t.Before(*a.EndTime)
+}
+
+// IsSkimCapReached returns true if the cumulative value of the taker fees skimmed reaches the skim cap of the agreement.
+func (a TakerFeeShareAgreement) IsSkimCapReached(skimmedTotal sdk.Coins) bool {
+	for _, capCoin := range a.SkimCap {
+		if skimmedTotal.AmountOf(capCoin.Denom).GTE(capCoin.Amount) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateSchedule validates the start and end times, skim percent schedule and skim cap of the agreement,
+// returns nil on success, error otherwise.
+func (a TakerFeeShareAgreement) ValidateSchedule() error {
+	if a.StartTime != nil && a.EndTime != nil && !a.EndTime.After(*a.StartTime) {
+		return fmt.Errorf("taker fee share agreement end time (%s) must be after its start time (%s)", a.EndTime, a.StartTime)
+	}
+
+	for i, step := range a.SkimPercentSchedule {
+		if step.SkimPercent.IsNil() || step.SkimPercent.IsNegative() || step.SkimPercent.GT(OneDec) {
+			return fmt.Errorf("invalid skim percent schedule step %d skim percent: %s", i, step.SkimPercent)
+		}
+		if i > 0 && !step.StartTime.After(a.SkimPercentSchedule[i-1].StartTime) {
+			return fmt.Errorf("skim percent schedule steps must be ordered by strictly increasing start time")
+		}
+		if a.EndTime != nil && !step.StartTime.Before(*a.EndTime) {
+			return fmt.Errorf("skim percent schedule step %d start time (%s) must be before the end time (%s)", i, step.StartTime, a.EndTime)
+		}
+	}
+
+	if err := a.SkimCap.Validate(); err != nil {
+		return fmt.Errorf("invalid skim cap: %w", err)
+	}
+	if len(a.SkimCap) > 1 {
+		return fmt.Errorf("skim cap must be in a single denom, got %s", a.SkimCap)
+	}
+
+	return nil
+}
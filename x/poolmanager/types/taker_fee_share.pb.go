@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -43,6 +47,21 @@ type TakerFeeShareAgreement struct {
 	// skim_address is the address belonging to the respective denom
 	// that the skimmed taker fees will be sent to at the end of each epoch.
 	SkimAddress string `protobuf:"bytes,3,opt,name=skim_address,json=skimAddress,proto3" json:"skim_address,omitempty" yaml:"skim_address"`
+	// start_time is the time from which taker fees are skimmed. Taker fees are
+	// skimmed right away if unset.
+	StartTime *time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty" yaml:"start_time"`
+	// end_time is the time at which the agreement expires. The agreement never
+	// expires by time if unset.
+	EndTime *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// skim_percent_schedule are the steps replacing the skim percent from their
+	// start time, ordered by strictly increasing start time. The skim_percent is
+	// used before the first step.
+	SkimPercentSchedule []SkimPercentStep `protobuf:"bytes,6,rep,name=skim_percent_schedule,json=skimPercentSchedule,proto3" json:"skim_percent_schedule" yaml:"skim_percent_schedule"`
+	// skim_cap is the cap on the cumulative value of the taker fees skimmed for
+	// the agreement, in a single denom. Taker fees in other denoms are valued in
+	// the cap denom through their OSMO-paired pools. The agreement expires once
+	// the cap is reached. Taker fees are not capped if unset.
+	SkimCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=skim_cap,json=skimCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"skim_cap" yaml:"skim_cap"`
 }
 
 func (m *TakerFeeShareAgreement) Reset()         { *m = TakerFeeShareAgreement{} }
@@ -92,6 +111,83 @@ func (m *TakerFeeShareAgreement) GetSkimAddress() string {
 	return ""
 }
 
+func (m *TakerFeeShareAgreement) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *TakerFeeShareAgreement) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *TakerFeeShareAgreement) GetSkimPercentSchedule() []SkimPercentStep {
+	if m != nil {
+		return m.SkimPercentSchedule
+	}
+	return nil
+}
+
+func (m *TakerFeeShareAgreement) GetSkimCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SkimCap
+	}
+	return nil
+}
+
+// SkimPercentStep is a step of the skim percent schedule of a taker fee share
+// agreement.
+type SkimPercentStep struct {
+	// start_time is the time from which the step skim percent is used.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// skim_percent is the percentage of taker fees skimmed from the start time.
+	SkimPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=skim_percent,json=skimPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"skim_percent" yaml:"skim_percent"`
+}
+
+func (m *SkimPercentStep) Reset()         { *m = SkimPercentStep{} }
+func (m *SkimPercentStep) String() string { return proto.CompactTextString(m) }
+func (*SkimPercentStep) ProtoMessage()    {}
+func (*SkimPercentStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6ab99820fcb49, []int{1}
+}
+func (m *SkimPercentStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SkimPercentStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SkimPercentStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SkimPercentStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkimPercentStep.Merge(m, src)
+}
+func (m *SkimPercentStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *SkimPercentStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkimPercentStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SkimPercentStep proto.InternalMessageInfo
+
+func (m *SkimPercentStep) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
 // TakerFeeSkimAccumulator accumulates the total skimmed taker fees for each
 // denom that has a taker fee share agreement.
 type TakerFeeSkimAccumulator struct {
@@ -105,7 +201,7 @@ func (m *TakerFeeSkimAccumulator) Reset()         { *m = TakerFeeSkimAccumulator
 func (m *TakerFeeSkimAccumulator) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeSkimAccumulator) ProtoMessage()    {}
 func (*TakerFeeSkimAccumulator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eda6ab99820fcb49, []int{1}
+	return fileDescriptor_eda6ab99820fcb49, []int{2}
 }
 func (m *TakerFeeSkimAccumulator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -168,7 +264,7 @@ func (m *AlloyContractTakerFeeShareState) Reset()         { *m = AlloyContractTa
 func (m *AlloyContractTakerFeeShareState) String() string { return proto.CompactTextString(m) }
 func (*AlloyContractTakerFeeShareState) ProtoMessage()    {}
 func (*AlloyContractTakerFeeShareState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eda6ab99820fcb49, []int{2}
+	return fileDescriptor_eda6ab99820fcb49, []int{3}
 }
 func (m *AlloyContractTakerFeeShareState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,10 +307,68 @@ func (m *AlloyContractTakerFeeShareState) GetTakerFeeShareAgreements() []TakerFe
 	return nil
 }
 
+// AffiliateRebates accumulates the taker fee rebates received by an affiliate
+// frontend.
+type AffiliateRebates struct {
+	// address is the address of the affiliate.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// rebates are the total taker fee rebates received by the affiliate.
+	Rebates github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rebates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rebates" yaml:"rebates"`
+}
+
+func (m *AffiliateRebates) Reset()         { *m = AffiliateRebates{} }
+func (m *AffiliateRebates) String() string { return proto.CompactTextString(m) }
+func (*AffiliateRebates) ProtoMessage()    {}
+func (*AffiliateRebates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6ab99820fcb49, []int{4}
+}
+func (m *AffiliateRebates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AffiliateRebates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AffiliateRebates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AffiliateRebates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AffiliateRebates.Merge(m, src)
+}
+func (m *AffiliateRebates) XXX_Size() int {
+	return m.Size()
+}
+func (m *AffiliateRebates) XXX_DiscardUnknown() {
+	xxx_messageInfo_AffiliateRebates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AffiliateRebates proto.InternalMessageInfo
+
+func (m *AffiliateRebates) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AffiliateRebates) GetRebates() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rebates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TakerFeeShareAgreement)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareAgreement")
+	proto.RegisterType((*SkimPercentStep)(nil), "osmosis.poolmanager.v1beta1.SkimPercentStep")
 	proto.RegisterType((*TakerFeeSkimAccumulator)(nil), "osmosis.poolmanager.v1beta1.TakerFeeSkimAccumulator")
 	proto.RegisterType((*AlloyContractTakerFeeShareState)(nil), "osmosis.poolmanager.v1beta1.AlloyContractTakerFeeShareState")
+	proto.RegisterType((*AffiliateRebates)(nil), "osmosis.poolmanager.v1beta1.AffiliateRebates")
 }
 
 func init() {
@@ -222,39 +376,54 @@ func init() {
 }
 
 var fileDescriptor_eda6ab99820fcb49 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0x6f, 0xba, 0x28, 0x98, 0x5d, 0xb0, 0x44, 0xb1, 0xdd, 0x16, 0x92, 0x35, 0x07, 0xa9, 0x87,
-	0x9d, 0xa1, 0xf6, 0x20, 0xf4, 0xd6, 0xae, 0xec, 0x49, 0x41, 0xbb, 0x9e, 0x04, 0x09, 0x93, 0xc9,
-	0x33, 0x0d, 0xcd, 0x64, 0xca, 0xcc, 0x74, 0xb1, 0x9f, 0xc0, 0xab, 0x27, 0x2f, 0x7e, 0x03, 0x3f,
-	0xc9, 0x1e, 0xf7, 0x28, 0x2b, 0x44, 0x69, 0xbf, 0x41, 0x3f, 0x81, 0x24, 0x33, 0xa9, 0x6d, 0x59,
-	0xc5, 0x53, 0xf2, 0xfe, 0xfc, 0x7e, 0xef, 0xbd, 0xdf, 0x7b, 0x63, 0xf7, 0xb8, 0x64, 0x5c, 0x26,
-	0x12, 0xcf, 0x38, 0x4f, 0x19, 0xc9, 0x48, 0x0c, 0x02, 0x5f, 0xf6, 0x42, 0x50, 0xa4, 0x87, 0x15,
-	0x99, 0x82, 0x08, 0x3e, 0x00, 0x04, 0x72, 0x42, 0x04, 0xa0, 0x99, 0xe0, 0x8a, 0x3b, 0x1d, 0x03,
-	0x41, 0x5b, 0x10, 0x64, 0x20, 0xed, 0x87, 0x31, 0x8f, 0x79, 0x99, 0x87, 0x8b, 0x3f, 0x0d, 0x69,
-	0xbb, 0xb4, 0xc4, 0xe0, 0x90, 0x48, 0xd8, 0xb0, 0x53, 0x9e, 0x64, 0x3a, 0xee, 0xff, 0xb0, 0xec,
-	0x47, 0x6f, 0x8b, 0x62, 0xe7, 0x00, 0x17, 0x45, 0xa9, 0x61, 0x2c, 0x00, 0x18, 0x64, 0xca, 0x79,
-	0x62, 0xdf, 0x89, 0x20, 0xe3, 0xac, 0x65, 0x9d, 0x58, 0xdd, 0x7b, 0xa3, 0xc6, 0x3a, 0xf7, 0x8e,
-	0x16, 0x84, 0xa5, 0x03, 0xbf, 0x74, 0xfb, 0x63, 0x1d, 0x76, 0xde, 0xdb, 0x47, 0x72, 0x9a, 0xb0,
-	0x60, 0x06, 0x82, 0x42, 0xa6, 0x5a, 0xf5, 0x32, 0x7d, 0x70, 0x95, 0x7b, 0xb5, 0x9b, 0xdc, 0xeb,
-	0xe8, 0x06, 0x64, 0x34, 0x45, 0x09, 0xc7, 0x8c, 0xa8, 0x09, 0x7a, 0x09, 0x31, 0xa1, 0x8b, 0x17,
-	0x40, 0xd7, 0xb9, 0xf7, 0x40, 0x33, 0x6e, 0x13, 0xf8, 0xe3, 0xc3, 0xc2, 0x7c, 0xad, 0x2d, 0x67,
-	0x60, 0xe8, 0x49, 0x14, 0x09, 0x90, 0xb2, 0x75, 0x50, 0xd2, 0x37, 0xf7, 0xb0, 0x26, 0x6a, 0xb0,
-	0x43, 0x63, 0xdd, 0x58, 0x76, 0x73, 0x33, 0x5d, 0xe1, 0xa7, 0x74, 0xce, 0xe6, 0x29, 0x51, 0x5c,
-	0xfc, 0xf7, 0x78, 0x5f, 0x2c, 0xdb, 0x29, 0x38, 0x19, 0x44, 0xc1, 0x66, 0x2d, 0xb2, 0x55, 0x3f,
-	0x39, 0xe8, 0x1e, 0x3e, 0x3b, 0x46, 0x7a, 0x3c, 0x54, 0xe8, 0x5b, 0xad, 0x02, 0x9d, 0xf1, 0x24,
-	0x1b, 0xbd, 0x2a, 0x04, 0x58, 0xe7, 0xde, 0xf1, 0x9f, 0x2e, 0x77, 0x29, 0xfc, 0x6f, 0x3f, 0xbd,
-	0x6e, 0x9c, 0xa8, 0xc9, 0x3c, 0x44, 0x94, 0x33, 0x6c, 0x36, 0xa5, 0x3f, 0xa7, 0x32, 0x9a, 0x62,
-	0xb5, 0x98, 0x81, 0x2c, 0xd9, 0xe4, 0xb8, 0x61, 0x08, 0xaa, 0x71, 0xa4, 0xff, 0xa9, 0x6e, 0x7b,
-	0xc3, 0x34, 0xe5, 0x8b, 0x33, 0x9e, 0x29, 0x41, 0xa8, 0xda, 0xd9, 0xe3, 0x85, 0x22, 0x0a, 0x9c,
-	0x73, 0xbb, 0x41, 0x4d, 0x74, 0x23, 0xa0, 0x9e, 0xb7, 0xb3, 0xce, 0xbd, 0xa6, 0x6e, 0x6d, 0x3f,
-	0xc3, 0x1f, 0xdf, 0xaf, 0x5c, 0x46, 0x48, 0xe7, 0xab, 0x65, 0xb7, 0xf7, 0x6e, 0x32, 0x20, 0xd5,
-	0xa5, 0x54, 0x62, 0xf4, 0xd1, 0x3f, 0xee, 0x13, 0xdd, 0x7e, 0x65, 0xa3, 0xa7, 0x46, 0xa6, 0xc7,
-	0xba, 0x97, 0xbf, 0x17, 0xf1, 0xc7, 0x4d, 0x75, 0x2b, 0x85, 0x1c, 0xbd, 0xb9, 0x5a, 0xba, 0xd6,
-	0xf5, 0xd2, 0xb5, 0x7e, 0x2d, 0x5d, 0xeb, 0xf3, 0xca, 0xad, 0x5d, 0xaf, 0xdc, 0xda, 0xf7, 0x95,
-	0x5b, 0x7b, 0xf7, 0x7c, 0x4b, 0x5f, 0xd3, 0xdc, 0x69, 0x4a, 0x42, 0x59, 0x19, 0xf8, 0xb2, 0xdf,
-	0xc3, 0x1f, 0x77, 0x9e, 0x60, 0x29, 0x7a, 0x78, 0xb7, 0x7c, 0x1e, 0xfd, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xb3, 0x8f, 0xb1, 0x63, 0xa6, 0x03, 0x00, 0x00,
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x4e, 0xdb, 0x48,
+	0x18, 0x8f, 0x61, 0x21, 0x30, 0xa0, 0x4d, 0xd6, 0xec, 0x6e, 0x42, 0xd8, 0x8d, 0x59, 0x6b, 0x55,
+	0xa5, 0x12, 0xd8, 0x0a, 0x1c, 0x2a, 0x71, 0x4b, 0x52, 0x71, 0x6a, 0xab, 0xd6, 0xe1, 0x50, 0x55,
+	0xaa, 0xa2, 0x89, 0xfd, 0xc5, 0xb1, 0x62, 0x7b, 0x2c, 0xcf, 0x84, 0x36, 0x0f, 0xd0, 0xf6, 0xca,
+	0xa9, 0x97, 0xbe, 0x41, 0xdf, 0xa0, 0x6f, 0x80, 0x7a, 0xe2, 0x58, 0x71, 0x30, 0x15, 0xbc, 0x41,
+	0x9e, 0xa0, 0xb2, 0x67, 0x6c, 0x92, 0x94, 0x92, 0x72, 0xe8, 0x29, 0x99, 0x99, 0xef, 0xf7, 0xfb,
+	0xbe, 0xef, 0xf7, 0xfd, 0x31, 0xaa, 0x13, 0xea, 0x11, 0xea, 0x50, 0x3d, 0x20, 0xc4, 0xf5, 0xb0,
+	0x8f, 0x6d, 0x08, 0xf5, 0xe3, 0x7a, 0x17, 0x18, 0xae, 0xeb, 0x0c, 0x0f, 0x20, 0xec, 0xf4, 0x00,
+	0x3a, 0xb4, 0x8f, 0x43, 0xd0, 0x82, 0x90, 0x30, 0x22, 0x6f, 0x09, 0x88, 0x36, 0x01, 0xd1, 0x04,
+	0xa4, 0xf2, 0xa7, 0x4d, 0x6c, 0x92, 0xd8, 0xe9, 0xf1, 0x3f, 0x0e, 0xa9, 0x54, 0xcd, 0x04, 0xa3,
+	0x77, 0x31, 0x85, 0x8c, 0xdd, 0x24, 0x8e, 0x2f, 0xde, 0x15, 0x9b, 0x10, 0xdb, 0x05, 0x3d, 0x39,
+	0x75, 0x87, 0x3d, 0x9d, 0x39, 0x1e, 0x50, 0x86, 0xbd, 0x80, 0x1b, 0xa8, 0x6f, 0x96, 0xd0, 0xdf,
+	0x47, 0x71, 0x34, 0x87, 0x00, 0xed, 0x38, 0x96, 0x86, 0x1d, 0x02, 0x78, 0xe0, 0x33, 0xf9, 0x1e,
+	0x5a, 0xb2, 0xc0, 0x27, 0x5e, 0x59, 0xda, 0x96, 0x6a, 0xab, 0xcd, 0xe2, 0x38, 0x52, 0xd6, 0x47,
+	0xd8, 0x73, 0x0f, 0xd4, 0xe4, 0x5a, 0x35, 0xf8, 0xb3, 0xfc, 0x12, 0xad, 0xd3, 0x81, 0xe3, 0x75,
+	0x02, 0x08, 0x4d, 0xf0, 0x59, 0x79, 0x21, 0x31, 0x3f, 0x38, 0x8d, 0x94, 0xdc, 0x79, 0xa4, 0x6c,
+	0xf1, 0x08, 0xa9, 0x35, 0xd0, 0x1c, 0xa2, 0x7b, 0x98, 0xf5, 0xb5, 0x47, 0x60, 0x63, 0x73, 0xf4,
+	0x10, 0xcc, 0x71, 0xa4, 0x6c, 0x70, 0xc6, 0x49, 0x02, 0xd5, 0x58, 0x8b, 0x8f, 0x4f, 0xf9, 0x49,
+	0x3e, 0x10, 0xf4, 0xd8, 0xb2, 0x42, 0xa0, 0xb4, 0xbc, 0x98, 0xd0, 0x97, 0x66, 0xb0, 0xe2, 0x55,
+	0x60, 0x1b, 0xfc, 0x24, 0x1f, 0x21, 0x44, 0x19, 0x0e, 0x59, 0x27, 0x4e, 0xbb, 0xfc, 0xdb, 0xb6,
+	0x54, 0x5b, 0xdb, 0xab, 0x68, 0x5c, 0x13, 0x2d, 0xd5, 0x44, 0x3b, 0x4a, 0x35, 0x69, 0x6e, 0x8e,
+	0x23, 0xe5, 0x0f, 0xc1, 0x9a, 0xe1, 0xd4, 0x93, 0x0b, 0x45, 0x32, 0x56, 0x93, 0x8b, 0xd8, 0x54,
+	0x7e, 0x82, 0x56, 0xc0, 0xb7, 0x38, 0xe7, 0xd2, 0x5c, 0xce, 0x38, 0xd2, 0x02, 0xe7, 0x4c, 0x51,
+	0x9c, 0x31, 0x0f, 0xbe, 0x95, 0xf0, 0xbd, 0x95, 0xd0, 0x5f, 0x93, 0x02, 0x74, 0xa8, 0xd9, 0x07,
+	0x6b, 0xe8, 0x42, 0x79, 0x79, 0x7b, 0xb1, 0xb6, 0xb6, 0xb7, 0xa3, 0xdd, 0xd2, 0x18, 0x5a, 0xfb,
+	0x5a, 0xab, 0x36, 0x83, 0xa0, 0xf9, 0x7f, 0x2c, 0xfc, 0x38, 0x52, 0xfe, 0xf9, 0x5e, 0xd9, 0x8c,
+	0x58, 0x35, 0x36, 0x26, 0x24, 0x6e, 0x8b, 0x5b, 0x79, 0x84, 0x56, 0x12, 0x73, 0x13, 0x07, 0xe5,
+	0x7c, 0xe2, 0x7a, 0x53, 0xe3, 0xe5, 0xd3, 0xe2, 0x06, 0xcb, 0x5c, 0xb6, 0x88, 0xe3, 0x37, 0x5b,
+	0xc2, 0x4f, 0x61, 0xc2, 0x8f, 0x89, 0x03, 0xf5, 0xe3, 0x85, 0x52, 0xb3, 0x1d, 0xd6, 0x1f, 0x76,
+	0x35, 0x93, 0x78, 0xba, 0x68, 0x50, 0xfe, 0xb3, 0x4b, 0xad, 0x81, 0xce, 0x46, 0x01, 0xd0, 0x84,
+	0x83, 0x1a, 0xf9, 0x18, 0xd6, 0xc2, 0x81, 0xfa, 0x59, 0x42, 0x85, 0x99, 0x4c, 0xe4, 0xe7, 0x53,
+	0xd5, 0x93, 0xe6, 0x2a, 0xfd, 0xaf, 0x88, 0x68, 0x7e, 0x05, 0x7f, 0x6d, 0xcb, 0xaa, 0xe7, 0x12,
+	0x2a, 0x65, 0x43, 0x15, 0xb7, 0xa3, 0x69, 0x0e, 0xbd, 0xa1, 0x8b, 0x19, 0x09, 0x7f, 0x7a, 0xaa,
+	0xde, 0x4b, 0x48, 0x8e, 0x39, 0x3d, 0xb0, 0x3a, 0xd9, 0xba, 0xa0, 0xe5, 0x85, 0x79, 0x65, 0x79,
+	0x2c, 0x44, 0xd8, 0xbc, 0x8e, 0x72, 0x9a, 0xe2, 0x6e, 0x05, 0x2a, 0x0a, 0x82, 0x34, 0x1d, 0xaa,
+	0xbe, 0x5b, 0x40, 0x4a, 0xc3, 0x75, 0xc9, 0xa8, 0x45, 0x7c, 0x16, 0x62, 0x93, 0x4d, 0xad, 0x8f,
+	0x36, 0xc3, 0x0c, 0xe4, 0x43, 0x54, 0x34, 0xc5, 0x6b, 0x36, 0xb7, 0x3c, 0xdf, 0xad, 0x71, 0xa4,
+	0x94, 0x78, 0x68, 0xb3, 0x16, 0xaa, 0x51, 0x48, 0xaf, 0xd2, 0xf9, 0xfd, 0x20, 0xa1, 0xca, 0xcc,
+	0xae, 0xec, 0xe0, 0x74, 0x41, 0xa5, 0x62, 0xec, 0xdf, 0x3a, 0x1e, 0x37, 0x2f, 0xb7, 0xe6, 0x7d,
+	0x21, 0xd3, 0x7f, 0x3c, 0x96, 0x1f, 0x3b, 0x51, 0x8d, 0x12, 0xbb, 0x91, 0x82, 0xaa, 0x9f, 0x24,
+	0x54, 0x6c, 0xf4, 0x7a, 0x8e, 0xeb, 0x60, 0x06, 0x06, 0x74, 0x31, 0x03, 0x2a, 0xef, 0xa0, 0xfc,
+	0x74, 0xc6, 0xf2, 0x38, 0x52, 0x7e, 0xe7, 0x5e, 0xb2, 0x44, 0x53, 0x13, 0xf9, 0x15, 0xca, 0x87,
+	0x1c, 0x38, 0xbf, 0xb2, 0x4d, 0x11, 0xb2, 0x20, 0x13, 0xb8, 0x3b, 0xce, 0x9b, 0x40, 0x35, 0x9f,
+	0x9d, 0x5e, 0x56, 0xa5, 0xb3, 0xcb, 0xaa, 0xf4, 0xf5, 0xb2, 0x2a, 0x9d, 0x5c, 0x55, 0x73, 0x67,
+	0x57, 0xd5, 0xdc, 0x97, 0xab, 0x6a, 0xee, 0xc5, 0x83, 0x09, 0x32, 0x21, 0xec, 0xae, 0x8b, 0xbb,
+	0x34, 0x3d, 0xe8, 0xc7, 0xfb, 0x75, 0xfd, 0xf5, 0xd4, 0x67, 0x2d, 0xf1, 0xd0, 0x5d, 0x4e, 0x46,
+	0x72, 0xff, 0xdb, 0x00, 0xca, 0x39, 0x32, 0x81, 0xfa, 0x06, 0x00, 0x00,
 }
 
 func (m *TakerFeeShareAgreement) Marshal() (dAtA []byte, err error) {
@@ -277,6 +446,54 @@ func (m *TakerFeeShareAgreement) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.SkimCap) > 0 {
+		for iNdEx := len(m.SkimCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SkimCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTakerFeeShare(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SkimPercentSchedule) > 0 {
+		for iNdEx := len(m.SkimPercentSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SkimPercentSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTakerFeeShare(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.EndTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTakerFeeShare(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTakerFeeShare(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.SkimAddress) > 0 {
 		i -= len(m.SkimAddress)
 		copy(dAtA[i:], m.SkimAddress)
@@ -304,6 +521,47 @@ func (m *TakerFeeShareAgreement) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *SkimPercentStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SkimPercentStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SkimPercentStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SkimPercent.Size()
+		i -= size
+		if _, err := m.SkimPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTakerFeeShare(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTakerFeeShare(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TakerFeeSkimAccumulator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -392,6 +650,50 @@ func (m *AlloyContractTakerFeeShareState) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *AffiliateRebates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AffiliateRebates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AffiliateRebates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rebates) > 0 {
+		for iNdEx := len(m.Rebates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTakerFeeShare(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTakerFeeShare(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTakerFeeShare(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTakerFeeShare(v)
 	base := offset
@@ -419,6 +721,39 @@ func (m *TakerFeeShareAgreement) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTakerFeeShare(uint64(l))
 	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovTakerFeeShare(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovTakerFeeShare(uint64(l))
+	}
+	if len(m.SkimPercentSchedule) > 0 {
+		for _, e := range m.SkimPercentSchedule {
+			l = e.Size()
+			n += 1 + l + sovTakerFeeShare(uint64(l))
+		}
+	}
+	if len(m.SkimCap) > 0 {
+		for _, e := range m.SkimCap {
+			l = e.Size()
+			n += 1 + l + sovTakerFeeShare(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SkimPercentStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTakerFeeShare(uint64(l))
+	l = m.SkimPercent.Size()
+	n += 1 + l + sovTakerFeeShare(uint64(l))
 	return n
 }
 
@@ -460,6 +795,25 @@ func (m *AlloyContractTakerFeeShareState) Size() (n int) {
 	return n
 }
 
+func (m *AffiliateRebates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTakerFeeShare(uint64(l))
+	}
+	if len(m.Rebates) > 0 {
+		for _, e := range m.Rebates {
+			l = e.Size()
+			n += 1 + l + sovTakerFeeShare(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTakerFeeShare(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -593,33 +947,290 @@ func (m *TakerFeeShareAgreement) Unmarshal(dAtA []byte) error {
 			}
 			m.SkimAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTakerFeeShare(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerFeeShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTakerFeeShare
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TakerFeeSkimAccumulator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerFeeShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkimPercentSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerFeeShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkimPercentSchedule = append(m.SkimPercentSchedule, SkimPercentStep{})
+			if err := m.SkimPercentSchedule[len(m.SkimPercentSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkimCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerFeeShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkimCap = append(m.SkimCap, types.Coin{})
+			if err := m.SkimCap[len(m.SkimCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTakerFeeShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SkimPercentStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTakerFeeShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SkimPercentStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SkimPercentStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerFeeShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkimPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerFeeShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SkimPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTakerFeeShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeSkimAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowTakerFeeShare
@@ -846,6 +1457,122 @@ func (m *AlloyContractTakerFeeShareState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AffiliateRebates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTakerFeeShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AffiliateRebates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AffiliateRebates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerFeeShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerFeeShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rebates = append(m.Rebates, types.Coin{})
+			if err := m.Rebates[len(m.Rebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTakerFeeShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTakerFeeShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTakerFeeShare(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
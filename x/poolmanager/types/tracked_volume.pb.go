@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -69,8 +70,114 @@ func (m *TrackedVolume) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// TraderVolume is the swap volume of a trader over the last days, used to
+// determine the taker fee tier of the trader.
+type TraderVolume struct {
+	// address is the address of the trader.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// daily_volumes are the swap volumes of the trader in each day with swaps,
+	// ordered by day. Days outside of the volume window are pruned.
+	DailyVolumes []DailyVolume `protobuf:"bytes,2,rep,name=daily_volumes,json=dailyVolumes,proto3" json:"daily_volumes" yaml:"daily_volumes"`
+}
+
+func (m *TraderVolume) Reset()         { *m = TraderVolume{} }
+func (m *TraderVolume) String() string { return proto.CompactTextString(m) }
+func (*TraderVolume) ProtoMessage()    {}
+func (*TraderVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a2e3e91de3baf1a, []int{1}
+}
+func (m *TraderVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraderVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraderVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TraderVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraderVolume.Merge(m, src)
+}
+func (m *TraderVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraderVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraderVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraderVolume proto.InternalMessageInfo
+
+func (m *TraderVolume) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TraderVolume) GetDailyVolumes() []DailyVolume {
+	if m != nil {
+		return m.DailyVolumes
+	}
+	return nil
+}
+
+// DailyVolume is the swap volume of a trader in a day, in the bond denom.
+type DailyVolume struct {
+	// day is the number of days since the unix epoch.
+	Day    int64                 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty" yaml:"day"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *DailyVolume) Reset()         { *m = DailyVolume{} }
+func (m *DailyVolume) String() string { return proto.CompactTextString(m) }
+func (*DailyVolume) ProtoMessage()    {}
+func (*DailyVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a2e3e91de3baf1a, []int{2}
+}
+func (m *DailyVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DailyVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DailyVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DailyVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DailyVolume.Merge(m, src)
+}
+func (m *DailyVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *DailyVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_DailyVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DailyVolume proto.InternalMessageInfo
+
+func (m *DailyVolume) GetDay() int64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TrackedVolume)(nil), "osmosis.poolmanager.v1beta1.TrackedVolume")
+	proto.RegisterType((*TraderVolume)(nil), "osmosis.poolmanager.v1beta1.TraderVolume")
+	proto.RegisterType((*DailyVolume)(nil), "osmosis.poolmanager.v1beta1.DailyVolume")
 }
 
 func init() {
@@ -78,23 +185,33 @@ func init() {
 }
 
 var fileDescriptor_0a2e3e91de3baf1a = []byte{
-	// 255 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xc8, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc8, 0xcf, 0xcf, 0xc9, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2,
-	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x29, 0x4a, 0x4c, 0xce, 0x4e, 0x4d, 0x89,
-	0x2f, 0xcb, 0xcf, 0x29, 0xcd, 0x4d, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x86, 0xea,
-	0xd0, 0x43, 0xd2, 0xa1, 0x07, 0xd5, 0x21, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa7, 0x0f,
-	0x62, 0x41, 0xb4, 0x48, 0xc9, 0x25, 0x83, 0xf5, 0xe8, 0x27, 0x25, 0x16, 0xa7, 0xc2, 0x0d, 0x4f,
-	0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0x95, 0x70, 0xf1, 0x86, 0x40, 0xac, 0x0a, 0x03, 0xdb, 0x24,
-	0x94, 0xcc, 0xc5, 0x96, 0x98, 0x9b, 0x5f, 0x9a, 0x57, 0x22, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d,
-	0x24, 0xa9, 0x07, 0x31, 0x41, 0x0f, 0x64, 0x02, 0xcc, 0x32, 0x3d, 0xe7, 0xfc, 0xcc, 0x3c, 0x27,
-	0x83, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0xdd, 0x97, 0xd7, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x5a, 0x07, 0xa1, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x4b, 0x2a, 0x0b,
-	0x52, 0x8b, 0xc1, 0x1a, 0x8a, 0x83, 0xa0, 0x46, 0x3b, 0x05, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x39, 0x92, 0x59, 0x50, 0xdf, 0xea, 0xe6, 0x24, 0x26, 0x15, 0xc3,
-	0x38, 0xfa, 0x65, 0xc6, 0x86, 0xfa, 0x15, 0x28, 0x41, 0x06, 0xb6, 0x20, 0x89, 0x0d, 0xec, 0x1f,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x56, 0x6c, 0xa6, 0x56, 0x01, 0x00, 0x00,
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xbd, 0xee, 0xd3, 0x30,
+	0x14, 0xc5, 0x93, 0x7f, 0xa5, 0x22, 0xdc, 0x8f, 0x21, 0x2a, 0x52, 0x29, 0x28, 0xa9, 0x32, 0x65,
+	0xa0, 0x76, 0x4b, 0x07, 0x24, 0xc6, 0x80, 0x90, 0x18, 0x89, 0x2a, 0x06, 0x16, 0xe4, 0xc4, 0x56,
+	0x1a, 0xe5, 0xc3, 0x55, 0xec, 0x16, 0xf2, 0x16, 0xbc, 0x06, 0x3c, 0x49, 0xc7, 0x8e, 0x88, 0x21,
+	0xa0, 0xf6, 0x0d, 0xf2, 0x04, 0x28, 0xb6, 0x53, 0xda, 0x85, 0x29, 0x89, 0xee, 0x3d, 0xe7, 0x77,
+	0xef, 0xb9, 0x01, 0x4b, 0xc6, 0x73, 0xc6, 0x13, 0x8e, 0x76, 0x8c, 0x65, 0x39, 0x2e, 0x70, 0x4c,
+	0x4b, 0x74, 0x58, 0x85, 0x54, 0xe0, 0x15, 0x12, 0x25, 0x8e, 0x52, 0x4a, 0x3e, 0x1f, 0x58, 0xb6,
+	0xcf, 0x29, 0xdc, 0x95, 0x4c, 0x30, 0xeb, 0x99, 0x56, 0xc0, 0x1b, 0x05, 0xd4, 0x8a, 0xd9, 0x24,
+	0x66, 0x31, 0x93, 0x7d, 0xa8, 0x7d, 0x53, 0x92, 0x99, 0x1d, 0x49, 0x0d, 0x0a, 0x31, 0xa7, 0x57,
+	0xf3, 0x88, 0x25, 0x85, 0xaa, 0xbb, 0x02, 0x8c, 0x36, 0x0a, 0xf5, 0x51, 0x92, 0xac, 0x08, 0xf4,
+	0x71, 0xce, 0xf6, 0x85, 0x98, 0x9a, 0xf3, 0x9e, 0x37, 0x78, 0xf9, 0x14, 0x2a, 0x07, 0xd8, 0x3a,
+	0x74, 0x30, 0xf8, 0x86, 0x25, 0x85, 0xbf, 0x3c, 0xd6, 0x8e, 0xf1, 0xe3, 0xb7, 0xe3, 0xc5, 0x89,
+	0xd8, 0xee, 0x43, 0x18, 0xb1, 0x1c, 0x69, 0x9c, 0x7a, 0x2c, 0x38, 0x49, 0x91, 0xa8, 0x76, 0x94,
+	0x4b, 0x01, 0x0f, 0xb4, 0xb5, 0xfb, 0xdd, 0x04, 0xc3, 0x4d, 0x89, 0x09, 0x2d, 0x35, 0xf5, 0x05,
+	0x78, 0x84, 0x09, 0x29, 0x29, 0xe7, 0x53, 0x73, 0x6e, 0x7a, 0x8f, 0x7d, 0xab, 0xa9, 0x9d, 0x71,
+	0x85, 0xf3, 0xec, 0xb5, 0xab, 0x0b, 0x6e, 0xd0, 0xb5, 0x58, 0x29, 0x18, 0x11, 0x9c, 0x64, 0x95,
+	0x4e, 0x87, 0x4f, 0x1f, 0xe4, 0xa8, 0x1e, 0xfc, 0x4f, 0x3e, 0xf0, 0x6d, 0xab, 0x50, 0x38, 0xff,
+	0x79, 0x3b, 0x79, 0x53, 0x3b, 0x13, 0x45, 0xb8, 0x33, 0x73, 0x83, 0x21, 0xf9, 0xd7, 0xca, 0xdd,
+	0x2f, 0x60, 0x70, 0x23, 0xb5, 0xe6, 0xa0, 0x47, 0x70, 0x25, 0xa7, 0xec, 0xf9, 0xe3, 0xa6, 0x76,
+	0x40, 0xe7, 0x51, 0xb9, 0x41, 0x5b, 0xb2, 0xde, 0x5d, 0x13, 0x7c, 0x90, 0xab, 0xc0, 0x16, 0xf6,
+	0xab, 0x76, 0x9e, 0xa8, 0x50, 0x38, 0x49, 0x61, 0xc2, 0x50, 0x8e, 0xc5, 0x16, 0xbe, 0x2f, 0x44,
+	0x53, 0x3b, 0x23, 0xbd, 0xa7, 0xca, 0xa6, 0x0b, 0xc9, 0xff, 0x70, 0x3c, 0xdb, 0xe6, 0xe9, 0x6c,
+	0x9b, 0x7f, 0xce, 0xb6, 0xf9, 0xed, 0x62, 0x1b, 0xa7, 0x8b, 0x6d, 0xfc, 0xbc, 0xd8, 0xc6, 0xa7,
+	0x57, 0x37, 0x81, 0xeb, 0x95, 0x17, 0x19, 0x0e, 0x79, 0xf7, 0x81, 0x0e, 0xeb, 0x15, 0xfa, 0x7a,
+	0xf7, 0x5f, 0xc9, 0x2b, 0x84, 0x7d, 0x79, 0xf4, 0xf5, 0xdf, 0x01, 0x00, 0xf9, 0x7b, 0xab, 0x3c,
+	0x7b, 0x02, 0x00, 0x00,
 }
 
 func (m *TrackedVolume) Marshal() (dAtA []byte, err error) {
@@ -134,6 +251,88 @@ func (m *TrackedVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TraderVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraderVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraderVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DailyVolumes) > 0 {
+		for iNdEx := len(m.DailyVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackedVolume(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTrackedVolume(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DailyVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DailyVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DailyVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrackedVolume(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Day != 0 {
+		i = encodeVarintTrackedVolume(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTrackedVolume(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTrackedVolume(v)
 	base := offset
@@ -160,6 +359,39 @@ func (m *TrackedVolume) Size() (n int) {
 	return n
 }
 
+func (m *TraderVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTrackedVolume(uint64(l))
+	}
+	if len(m.DailyVolumes) > 0 {
+		for _, e := range m.DailyVolumes {
+			l = e.Size()
+			n += 1 + l + sovTrackedVolume(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DailyVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Day != 0 {
+		n += 1 + sovTrackedVolume(uint64(m.Day))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTrackedVolume(uint64(l))
+	return n
+}
+
 func sovTrackedVolume(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -250,6 +482,225 @@ func (m *TrackedVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TraderVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackedVolume
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraderVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraderVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackedVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackedVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyVolumes = append(m.DailyVolumes, DailyVolume{})
+			if err := m.DailyVolumes[len(m.DailyVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackedVolume(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DailyVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackedVolume
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DailyVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DailyVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackedVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackedVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackedVolume(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTrackedVolume(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
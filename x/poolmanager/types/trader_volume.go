@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+const (
+	// TraderVolumeWindowDays is the number of days, including the current day, over which
+	// the swap volume of a trader is summed to determine its taker fee tier.
+	TraderVolumeWindowDays = 30
+
+	secondsPerDay = 24 * 60 * 60
+)
+
+// VolumeDay returns the day of the given time, as the number of days since the unix epoch.
+func VolumeDay(t time.Time) int64 {
+	return t.Unix() / secondsPerDay
+}
+
+// Volume returns the swap volume of the trader over the volume window ending at the given day.
+func (tv TraderVolume) Volume(day int64) osmomath.Int {
+	volume := osmomath.ZeroInt()
+	for _, dailyVolume := range tv.DailyVolumes {
+		if isInVolumeWindow(dailyVolume.Day, day) {
+			volume = volume.Add(dailyVolume.Amount)
+		}
+	}
+	return volume
+}
+
+// AddVolume adds the amount to the swap volume of the trader in the given day,
+// and prunes the days outside of the volume window ending at it.
+func (tv *TraderVolume) AddVolume(day int64, amount osmomath.Int) {
+	tv.PruneVolumes(day)
+
+	if n := len(tv.DailyVolumes); n > 0 && tv.DailyVolumes[n-1].Day == day {
+		tv.DailyVolumes[n-1].Amount = tv.DailyVolumes[n-1].Amount.Add(amount)
+	} else {
+		tv.DailyVolumes = append(tv.DailyVolumes, DailyVolume{Day: day, Amount: amount})
+	}
+}
+
+// PruneVolumes removes the days outside of the volume window ending at the given day.
+// Returns true if any day was removed.
+func (tv *TraderVolume) PruneVolumes(day int64) bool {
+	dailyVolumes := make([]DailyVolume, 0, len(tv.DailyVolumes)+1)
+	for _, dailyVolume := range tv.DailyVolumes {
+		if isInVolumeWindow(dailyVolume.Day, day) {
+			dailyVolumes = append(dailyVolumes, dailyVolume)
+		}
+	}
+
+	pruned := len(dailyVolumes) != len(tv.DailyVolumes)
+	tv.DailyVolumes = dailyVolumes
+	return pruned
+}
+
+// Validate validates the trader volume, returns nil on success, error otherwise.
+func (tv TraderVolume) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(tv.Address); err != nil {
+		return fmt.Errorf("invalid trader address (%s): %w", tv.Address, err)
+	}
+
+	for i, dailyVolume := range tv.DailyVolumes {
+		if dailyVolume.Amount.IsNil() || dailyVolume.Amount.IsNegative() {
+			return fmt.Errorf("daily volume of trader %s must be non-negative, was (%s)", tv.Address, dailyVolume.Amount)
+		}
+		if i > 0 && dailyVolume.Day <= tv.DailyVolumes[i-1].Day {
+			return fmt.Errorf("daily volumes of trader %s must be ordered by strictly increasing day", tv.Address)
+		}
+	}
+
+	return nil
+}
+
+// isInVolumeWindow returns true if the volume day is in the volume window ending at the given day.
+func isInVolumeWindow(volumeDay, day int64) bool {
+	return volumeDay > day-TraderVolumeWindowDays && volumeDay <= day
+}
+
+// GetTakerFeeTier returns the tier with the highest min volume at most the given volume.
+// Returns false if the volume is below the min volume of every tier.
+// The tiers are expected to be ordered by strictly increasing min volume.
+func GetTakerFeeTier(tiers []TakerFeeTier, volume osmomath.Int) (TakerFeeTier, bool) {
+	for i := len(tiers) - 1; i >= 0; i-- {
+		if volume.GTE(tiers[i].MinVolume) {
+			return tiers[i], true
+		}
+	}
+	return TakerFeeTier{}, false
+}
@@ -7,24 +7,29 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,6 +43,9 @@ type MsgSwapExactAmountIn struct {
 	Routes            []SwapAmountInRoute   `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenIn           types.Coin            `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
 	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// affiliate is the optional frontend receiving a rebate of the taker fee,
+	// if whitelisted by governance.
+	Affiliate string `protobuf:"bytes,5,opt,name=affiliate,proto3" json:"affiliate,omitempty" yaml:"affiliate"`
 }
 
 func (m *MsgSwapExactAmountIn) Reset()         { *m = MsgSwapExactAmountIn{} }
@@ -94,6 +102,13 @@ func (m *MsgSwapExactAmountIn) GetTokenIn() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapExactAmountIn) GetAffiliate() string {
+	if m != nil {
+		return m.Affiliate
+	}
+	return ""
+}
+
 type MsgSwapExactAmountInResponse struct {
 	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
@@ -137,6 +152,9 @@ type MsgSplitRouteSwapExactAmountIn struct {
 	Routes            []SwapAmountInSplitRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenInDenom      string                   `protobuf:"bytes,3,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
 	TokenOutMinAmount cosmossdk_io_math.Int    `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// affiliate is the optional frontend receiving a rebate of the taker fee,
+	// if whitelisted by governance.
+	Affiliate string `protobuf:"bytes,5,opt,name=affiliate,proto3" json:"affiliate,omitempty" yaml:"affiliate"`
 }
 
 func (m *MsgSplitRouteSwapExactAmountIn) Reset()         { *m = MsgSplitRouteSwapExactAmountIn{} }
@@ -193,6 +211,13 @@ func (m *MsgSplitRouteSwapExactAmountIn) GetTokenInDenom() string {
 	return ""
 }
 
+func (m *MsgSplitRouteSwapExactAmountIn) GetAffiliate() string {
+	if m != nil {
+		return m.Affiliate
+	}
+	return ""
+}
+
 type MsgSplitRouteSwapExactAmountInResponse struct {
 	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
@@ -238,6 +263,9 @@ type MsgSwapExactAmountOut struct {
 	Routes           []SwapAmountOutRoute  `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenInMaxAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=token_in_max_amount,json=tokenInMaxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_max_amount" yaml:"token_in_max_amount"`
 	TokenOut         types.Coin            `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	// affiliate is the optional frontend receiving a rebate of the taker fee,
+	// if whitelisted by governance.
+	Affiliate string `protobuf:"bytes,5,opt,name=affiliate,proto3" json:"affiliate,omitempty" yaml:"affiliate"`
 }
 
 func (m *MsgSwapExactAmountOut) Reset()         { *m = MsgSwapExactAmountOut{} }
@@ -294,6 +322,13 @@ func (m *MsgSwapExactAmountOut) GetTokenOut() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapExactAmountOut) GetAffiliate() string {
+	if m != nil {
+		return m.Affiliate
+	}
+	return ""
+}
+
 type MsgSwapExactAmountOutResponse struct {
 	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
 }
@@ -337,6 +372,9 @@ type MsgSplitRouteSwapExactAmountOut struct {
 	Routes           []SwapAmountOutSplitRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenOutDenom    string                    `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
 	TokenInMaxAmount cosmossdk_io_math.Int     `protobuf:"bytes,4,opt,name=token_in_max_amount,json=tokenInMaxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_max_amount" yaml:"token_in_max_amount"`
+	// affiliate is the optional frontend receiving a rebate of the taker fee,
+	// if whitelisted by governance.
+	Affiliate string `protobuf:"bytes,5,opt,name=affiliate,proto3" json:"affiliate,omitempty" yaml:"affiliate"`
 }
 
 func (m *MsgSplitRouteSwapExactAmountOut) Reset()         { *m = MsgSplitRouteSwapExactAmountOut{} }
@@ -393,6 +431,13 @@ func (m *MsgSplitRouteSwapExactAmountOut) GetTokenOutDenom() string {
 	return ""
 }
 
+func (m *MsgSplitRouteSwapExactAmountOut) GetAffiliate() string {
+	if m != nil {
+		return m.Affiliate
+	}
+	return ""
+}
+
 type MsgSplitRouteSwapExactAmountOutResponse struct {
 	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
 }
@@ -544,6 +589,16 @@ type MsgSetTakerFeeShareAgreementForDenom struct {
 	// skim_address is the address belonging to the respective bridge provider
 	// that the skimmed taker fees will be sent to at the end of each epoch.
 	SkimAddress string `protobuf:"bytes,4,opt,name=skim_address,json=skimAddress,proto3" json:"skim_address,omitempty" yaml:"skim_address"`
+	// start_time is the optional time from which taker fees are skimmed.
+	StartTime *time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty" yaml:"start_time"`
+	// end_time is the optional time at which the agreement expires.
+	EndTime *time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// skim_percent_schedule are the optional steps replacing the skim percent
+	// from their start time.
+	SkimPercentSchedule []SkimPercentStep `protobuf:"bytes,7,rep,name=skim_percent_schedule,json=skimPercentSchedule,proto3" json:"skim_percent_schedule" yaml:"skim_percent_schedule"`
+	// skim_cap is the optional cap on the cumulative value of the taker fees
+	// skimmed for the agreement, in a single denom, after which it expires.
+	SkimCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=skim_cap,json=skimCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"skim_cap" yaml:"skim_cap"`
 }
 
 func (m *MsgSetTakerFeeShareAgreementForDenom) Reset()         { *m = MsgSetTakerFeeShareAgreementForDenom{} }
@@ -600,6 +655,34 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) GetSkimAddress() string {
 	return ""
 }
 
+func (m *MsgSetTakerFeeShareAgreementForDenom) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *MsgSetTakerFeeShareAgreementForDenom) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *MsgSetTakerFeeShareAgreementForDenom) GetSkimPercentSchedule() []SkimPercentStep {
+	if m != nil {
+		return m.SkimPercentSchedule
+	}
+	return nil
+}
+
+func (m *MsgSetTakerFeeShareAgreementForDenom) GetSkimCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SkimCap
+	}
+	return nil
+}
+
 type MsgSetTakerFeeShareAgreementForDenomResponse struct {
 }
 
@@ -732,6 +815,364 @@ func (m *MsgSetRegisteredAlloyedPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetRegisteredAlloyedPoolResponse proto.InternalMessageInfo
 
+// ===================== MsgPlaceConditionalSwap
+type MsgPlaceConditionalSwap struct {
+	Sender string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Routes []SwapAmountInRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+	// token_in is escrowed until the swap executes, is cancelled or expires.
+	TokenIn           types.Coin            `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// trigger is the condition on the route price under which the swap
+	// executes.
+	Trigger SwapTrigger `protobuf:"bytes,5,opt,name=trigger,proto3" json:"trigger" yaml:"trigger"`
+	// expiry is the time after which the swap no longer executes, and token_in
+	// is refunded to the sender.
+	Expiry time.Time `protobuf:"bytes,6,opt,name=expiry,proto3,stdtime" json:"expiry" yaml:"expiry"`
+}
+
+func (m *MsgPlaceConditionalSwap) Reset()         { *m = MsgPlaceConditionalSwap{} }
+func (m *MsgPlaceConditionalSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceConditionalSwap) ProtoMessage()    {}
+func (*MsgPlaceConditionalSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{14}
+}
+func (m *MsgPlaceConditionalSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceConditionalSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceConditionalSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceConditionalSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceConditionalSwap.Merge(m, src)
+}
+func (m *MsgPlaceConditionalSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceConditionalSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceConditionalSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceConditionalSwap proto.InternalMessageInfo
+
+func (m *MsgPlaceConditionalSwap) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgPlaceConditionalSwap) GetRoutes() []SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *MsgPlaceConditionalSwap) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgPlaceConditionalSwap) GetTrigger() SwapTrigger {
+	if m != nil {
+		return m.Trigger
+	}
+	return SwapTrigger{}
+}
+
+func (m *MsgPlaceConditionalSwap) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+type MsgPlaceConditionalSwapResponse struct {
+	ConditionalSwapId uint64 `protobuf:"varint,1,opt,name=conditional_swap_id,json=conditionalSwapId,proto3" json:"conditional_swap_id,omitempty" yaml:"conditional_swap_id"`
+}
+
+func (m *MsgPlaceConditionalSwapResponse) Reset()         { *m = MsgPlaceConditionalSwapResponse{} }
+func (m *MsgPlaceConditionalSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceConditionalSwapResponse) ProtoMessage()    {}
+func (*MsgPlaceConditionalSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{15}
+}
+func (m *MsgPlaceConditionalSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceConditionalSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceConditionalSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceConditionalSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceConditionalSwapResponse.Merge(m, src)
+}
+func (m *MsgPlaceConditionalSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceConditionalSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceConditionalSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceConditionalSwapResponse proto.InternalMessageInfo
+
+func (m *MsgPlaceConditionalSwapResponse) GetConditionalSwapId() uint64 {
+	if m != nil {
+		return m.ConditionalSwapId
+	}
+	return 0
+}
+
+// ===================== MsgCancelConditionalSwap
+type MsgCancelConditionalSwap struct {
+	Sender            string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	ConditionalSwapId uint64 `protobuf:"varint,2,opt,name=conditional_swap_id,json=conditionalSwapId,proto3" json:"conditional_swap_id,omitempty" yaml:"conditional_swap_id"`
+}
+
+func (m *MsgCancelConditionalSwap) Reset()         { *m = MsgCancelConditionalSwap{} }
+func (m *MsgCancelConditionalSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelConditionalSwap) ProtoMessage()    {}
+func (*MsgCancelConditionalSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{16}
+}
+func (m *MsgCancelConditionalSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelConditionalSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelConditionalSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelConditionalSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelConditionalSwap.Merge(m, src)
+}
+func (m *MsgCancelConditionalSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelConditionalSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelConditionalSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelConditionalSwap proto.InternalMessageInfo
+
+func (m *MsgCancelConditionalSwap) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCancelConditionalSwap) GetConditionalSwapId() uint64 {
+	if m != nil {
+		return m.ConditionalSwapId
+	}
+	return 0
+}
+
+type MsgCancelConditionalSwapResponse struct {
+	RefundedTokenIn types.Coin `protobuf:"bytes,1,opt,name=refunded_token_in,json=refundedTokenIn,proto3" json:"refunded_token_in" yaml:"refunded_token_in"`
+}
+
+func (m *MsgCancelConditionalSwapResponse) Reset()         { *m = MsgCancelConditionalSwapResponse{} }
+func (m *MsgCancelConditionalSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelConditionalSwapResponse) ProtoMessage()    {}
+func (*MsgCancelConditionalSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{17}
+}
+func (m *MsgCancelConditionalSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelConditionalSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelConditionalSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelConditionalSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelConditionalSwapResponse.Merge(m, src)
+}
+func (m *MsgCancelConditionalSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelConditionalSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelConditionalSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelConditionalSwapResponse proto.InternalMessageInfo
+
+func (m *MsgCancelConditionalSwapResponse) GetRefundedTokenIn() types.Coin {
+	if m != nil {
+		return m.RefundedTokenIn
+	}
+	return types.Coin{}
+}
+
+// ===================== MsgSwapExactAmountInWithMaxPriceImpact
+type MsgSwapExactAmountInWithMaxPriceImpact struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// token_in is the maximum amount to swap. The part of it that would exceed
+	// max_price_impact is not swapped and stays with the sender.
+	TokenIn       types.Coin `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutDenom string     `protobuf:"bytes,4,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	// max_price_impact is the maximum deviation between the spot price of the
+	// pool and the execution price of the swap.
+	MaxPriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact" yaml:"max_price_impact"`
+	// external_price is an optional reference price of token_out_denom quoted
+	// in the token in. The deviation of the spot price of the pool from it is
+	// deducted from max_price_impact, to protect against a manipulated spot
+	// price.
+	ExternalPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=external_price,json=externalPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"external_price" yaml:"external_price"`
+	// affiliate is the optional frontend receiving a rebate of the taker fee,
+	// if whitelisted by governance.
+	Affiliate string `protobuf:"bytes,7,opt,name=affiliate,proto3" json:"affiliate,omitempty" yaml:"affiliate"`
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) Reset() {
+	*m = MsgSwapExactAmountInWithMaxPriceImpact{}
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactAmountInWithMaxPriceImpact) ProtoMessage()    {}
+func (*MsgSwapExactAmountInWithMaxPriceImpact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{18}
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpact.Merge(m, src)
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpact proto.InternalMessageInfo
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) GetAffiliate() string {
+	if m != nil {
+		return m.Affiliate
+	}
+	return ""
+}
+
+type MsgSwapExactAmountInWithMaxPriceImpactResponse struct {
+	// token_in_amount is the amount of the token in swapped.
+	TokenInAmount  cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
+	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) Reset() {
+	*m = MsgSwapExactAmountInWithMaxPriceImpactResponse{}
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSwapExactAmountInWithMaxPriceImpactResponse) ProtoMessage() {}
+func (*MsgSwapExactAmountInWithMaxPriceImpactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{19}
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpactResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpactResponse.Merge(m, src)
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpactResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpactResponse proto.InternalMessageInfo
+
 type DenomPairTakerFee struct {
 	// DEPRECATED: Now that we are using uni-directional trading pairs, we are
 	// using tokenInDenom and tokenOutDenom instead of denom0 and denom1 to
@@ -747,7 +1188,7 @@ func (m *DenomPairTakerFee) Reset()         { *m = DenomPairTakerFee{} }
 func (m *DenomPairTakerFee) String() string { return proto.CompactTextString(m) }
 func (*DenomPairTakerFee) ProtoMessage()    {}
 func (*DenomPairTakerFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{14}
+	return fileDescriptor_acd130b4825d67dc, []int{20}
 }
 func (m *DenomPairTakerFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,6 +1262,12 @@ func init() {
 	proto.RegisterType((*MsgSetTakerFeeShareAgreementForDenomResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetTakerFeeShareAgreementForDenomResponse")
 	proto.RegisterType((*MsgSetRegisteredAlloyedPool)(nil), "osmosis.poolmanager.v1beta1.MsgSetRegisteredAlloyedPool")
 	proto.RegisterType((*MsgSetRegisteredAlloyedPoolResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetRegisteredAlloyedPoolResponse")
+	proto.RegisterType((*MsgPlaceConditionalSwap)(nil), "osmosis.poolmanager.v1beta1.MsgPlaceConditionalSwap")
+	proto.RegisterType((*MsgPlaceConditionalSwapResponse)(nil), "osmosis.poolmanager.v1beta1.MsgPlaceConditionalSwapResponse")
+	proto.RegisterType((*MsgCancelConditionalSwap)(nil), "osmosis.poolmanager.v1beta1.MsgCancelConditionalSwap")
+	proto.RegisterType((*MsgCancelConditionalSwapResponse)(nil), "osmosis.poolmanager.v1beta1.MsgCancelConditionalSwapResponse")
+	proto.RegisterType((*MsgSwapExactAmountInWithMaxPriceImpact)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInWithMaxPriceImpact")
+	proto.RegisterType((*MsgSwapExactAmountInWithMaxPriceImpactResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInWithMaxPriceImpactResponse")
 	proto.RegisterType((*DenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFee")
 }
 
@@ -829,89 +1276,127 @@ func init() {
 }
 
 var fileDescriptor_acd130b4825d67dc = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x53, 0xdb, 0x46,
-	0x14, 0x47, 0x98, 0x10, 0xd8, 0x24, 0x04, 0x2b, 0x50, 0x1c, 0x93, 0x5a, 0x54, 0x90, 0x94, 0xa4,
-	0x48, 0x8e, 0x81, 0x99, 0x10, 0x43, 0x27, 0xc1, 0xa1, 0x99, 0x61, 0x1a, 0x0f, 0x44, 0xc9, 0xa9,
-	0x33, 0x1d, 0xcf, 0xda, 0xda, 0x18, 0x15, 0x4b, 0xeb, 0x91, 0xd6, 0x04, 0x6e, 0x6d, 0x9a, 0x69,
-	0xa7, 0x4c, 0x0f, 0x3d, 0xf5, 0xda, 0x99, 0x7e, 0x82, 0xe4, 0xd2, 0xcf, 0x90, 0x63, 0x8e, 0x9d,
-	0x1e, 0x3c, 0x2d, 0x1c, 0xd2, 0xb3, 0x3f, 0x41, 0x67, 0xb5, 0x2b, 0xd9, 0x16, 0xb2, 0x6c, 0xc3,
-	0x94, 0x4b, 0x22, 0x69, 0xdf, 0xef, 0xfd, 0xf9, 0xbd, 0xdf, 0xbe, 0x5d, 0x03, 0xe6, 0xb0, 0x63,
-	0x62, 0xc7, 0x70, 0xd2, 0x55, 0x8c, 0x2b, 0x26, 0xb4, 0x60, 0x19, 0xd9, 0xe9, 0xbd, 0x4c, 0x11,
-	0x11, 0x98, 0x49, 0x93, 0x7d, 0xb5, 0x6a, 0x63, 0x82, 0xc5, 0x69, 0x6e, 0xa5, 0xb6, 0x58, 0xa9,
-	0xdc, 0x2a, 0x39, 0x51, 0xc6, 0x65, 0xec, 0xda, 0xa5, 0xe9, 0x13, 0x83, 0x24, 0xe3, 0xd0, 0x34,
-	0x2c, 0x9c, 0x76, 0xff, 0xe5, 0x9f, 0x52, 0x25, 0xd7, 0x4d, 0xba, 0x08, 0x1d, 0xe4, 0xc7, 0x28,
-	0x61, 0xc3, 0xe2, 0xeb, 0x0b, 0x51, 0xb9, 0x38, 0x2f, 0x61, 0xb5, 0x60, 0xe3, 0x1a, 0x41, 0xdc,
-	0x7a, 0x8a, 0x7b, 0x33, 0x9d, 0x72, 0x7a, 0x2f, 0x43, 0xff, 0x63, 0x0b, 0xf2, 0x8f, 0x31, 0x30,
-	0x91, 0x77, 0xca, 0xcf, 0x5e, 0xc2, 0xea, 0x17, 0xfb, 0xb0, 0x44, 0xd6, 0x4d, 0x5c, 0xb3, 0xc8,
-	0xa6, 0x25, 0xde, 0x06, 0xc3, 0x0e, 0xb2, 0x74, 0x64, 0x27, 0x84, 0x19, 0x61, 0x7e, 0x34, 0x17,
-	0x6f, 0xd4, 0xa5, 0x2b, 0x07, 0xd0, 0xac, 0x64, 0x65, 0xf6, 0x5d, 0xd6, 0xb8, 0x81, 0xf8, 0x04,
-	0x0c, 0xbb, 0xb1, 0x9c, 0xc4, 0xe0, 0x4c, 0x6c, 0xfe, 0xd2, 0xa2, 0xaa, 0x46, 0x30, 0xa0, 0xd2,
-	0x50, 0x5e, 0x14, 0x8d, 0xc2, 0x72, 0x43, 0xef, 0xea, 0xd2, 0x80, 0xc6, 0x7d, 0x88, 0x79, 0x30,
-	0x42, 0xf0, 0x2e, 0xb2, 0x0a, 0x86, 0x95, 0x88, 0xcd, 0x08, 0xf3, 0x97, 0x16, 0xaf, 0xab, 0x2c,
-	0x7b, 0x95, 0x72, 0xe1, 0xfb, 0x79, 0x84, 0x0d, 0x2b, 0x37, 0x45, 0xa1, 0x8d, 0xba, 0x74, 0x95,
-	0x65, 0xe6, 0x01, 0x65, 0xed, 0xa2, 0xfb, 0xb8, 0x69, 0x89, 0x26, 0x98, 0x60, 0x5f, 0x71, 0x8d,
-	0x14, 0x4c, 0xc3, 0x2a, 0x40, 0x37, 0x76, 0x62, 0xc8, 0xad, 0x6a, 0x8d, 0xe2, 0xff, 0xaa, 0x4b,
-	0x93, 0x2c, 0x82, 0xa3, 0xef, 0xaa, 0x06, 0x4e, 0x9b, 0x90, 0xec, 0xa8, 0x9b, 0x16, 0x69, 0xd4,
-	0xa5, 0xe9, 0x56, 0xc7, 0xed, 0x2e, 0x64, 0x2d, 0xee, 0x7e, 0xde, 0xaa, 0x91, 0xbc, 0x61, 0xb1,
-	0x92, 0xb2, 0x2b, 0xaf, 0x3e, 0xbc, 0xb9, 0xc3, 0x89, 0x39, 0xfc, 0xf0, 0xe6, 0xce, 0x7c, 0x58,
-	0x9b, 0x68, 0x7b, 0x14, 0x44, 0xe9, 0x56, 0x98, 0x2b, 0xc5, 0xb0, 0xe4, 0x57, 0x02, 0xb8, 0x11,
-	0xd6, 0x09, 0x0d, 0x39, 0x55, 0x6c, 0x39, 0x48, 0x2c, 0x82, 0xf1, 0x66, 0x1a, 0xbc, 0x0a, 0xd6,
-	0x9b, 0x95, 0x6e, 0x55, 0x4c, 0x05, 0xab, 0xf0, 0x2a, 0x18, 0xf3, 0x2a, 0x60, 0xd1, 0xe4, 0xef,
-	0x63, 0x20, 0x45, 0x93, 0xa8, 0x56, 0x0c, 0xe2, 0x36, 0xe7, 0x4c, 0xc2, 0x78, 0x1a, 0x10, 0xc6,
-	0x52, 0xcf, 0xc2, 0x68, 0x26, 0x10, 0x50, 0xc7, 0x03, 0x30, 0xe6, 0x35, 0xb9, 0xa0, 0x23, 0x0b,
-	0x9b, 0xae, 0x46, 0x46, 0x73, 0xd7, 0x1b, 0x75, 0x69, 0xb2, 0x5d, 0x04, 0x6c, 0x5d, 0xd6, 0x2e,
-	0x73, 0x29, 0x6c, 0xd0, 0xd7, 0xf3, 0xd6, 0xc3, 0x52, 0x40, 0x0f, 0xb3, 0xa1, 0x7a, 0xa0, 0xd5,
-	0xb6, 0x48, 0xe1, 0x67, 0x01, 0xdc, 0x8a, 0xee, 0xc2, 0xb9, 0x8a, 0xe2, 0x30, 0x06, 0x26, 0x4f,
-	0x2a, 0x73, 0xab, 0x46, 0xfa, 0xd1, 0x42, 0x3e, 0xa0, 0x85, 0x74, 0x8f, 0x5a, 0xd8, 0xaa, 0x85,
-	0xea, 0xe0, 0x1b, 0x70, 0xcd, 0xef, 0xb3, 0x09, 0xf7, 0xbd, 0xd2, 0x99, 0x18, 0x56, 0xbb, 0x95,
-	0x9e, 0x0c, 0x28, 0xa5, 0xe9, 0x41, 0xd6, 0xc6, 0xb9, 0x5c, 0xf2, 0x70, 0x9f, 0x65, 0x20, 0x6e,
-	0x83, 0x51, 0x9f, 0x24, 0x57, 0x27, 0x91, 0x23, 0x29, 0xc1, 0x47, 0xd2, 0x78, 0x80, 0x5e, 0x59,
-	0x1b, 0xf1, 0x78, 0xcd, 0xde, 0x0f, 0xa8, 0xe2, 0x76, 0x6f, 0x53, 0x82, 0x7a, 0xf9, 0x56, 0x00,
-	0x1f, 0x87, 0x36, 0xc3, 0x97, 0x44, 0x01, 0x5c, 0xf5, 0x0b, 0x6b, 0x53, 0xc4, 0xbd, 0x6e, 0xb4,
-	0x7c, 0x14, 0xa0, 0xc5, 0xa3, 0xe4, 0x0a, 0xa7, 0x84, 0xeb, 0xe1, 0x87, 0x18, 0x90, 0xa2, 0xe4,
-	0xd9, 0xa7, 0x32, 0xb4, 0x80, 0x32, 0x96, 0x7b, 0x57, 0x46, 0xc7, 0x31, 0x91, 0xf3, 0x38, 0xa0,
-	0xba, 0x6e, 0x9d, 0x13, 0xc9, 0x60, 0x99, 0xbe, 0x81, 0x57, 0xe6, 0x56, 0x8d, 0xb0, 0x49, 0xd1,
-	0x41, 0x62, 0x43, 0xff, 0x83, 0xc4, 0xb2, 0xcb, 0x01, 0x41, 0xcc, 0x75, 0x1d, 0x13, 0x54, 0x0b,
-	0x87, 0x02, 0xf8, 0xb4, 0x4b, 0x23, 0xce, 0x4f, 0x15, 0x3f, 0x0d, 0x82, 0x29, 0x9a, 0x0c, 0x62,
-	0xf4, 0x6d, 0x43, 0xc3, 0x7e, 0x0e, 0x77, 0x91, 0xfd, 0x18, 0xa1, 0x7e, 0xd4, 0xf0, 0x5a, 0x00,
-	0x13, 0x6e, 0x3f, 0x0a, 0x55, 0x68, 0xd8, 0x05, 0x42, 0x5d, 0x14, 0x5e, 0x20, 0xd4, 0xd3, 0xdd,
-	0xe2, 0x44, 0xe4, 0xdc, 0x2c, 0xdf, 0x8d, 0x7c, 0x6e, 0x87, 0x79, 0x96, 0xb5, 0xb8, 0x1e, 0xc4,
-	0x65, 0xd7, 0x02, 0x0d, 0x09, 0xbd, 0x6e, 0x39, 0x88, 0x28, 0x2e, 0x54, 0xa1, 0x1e, 0x15, 0xd7,
-	0xa3, 0x42, 0x3d, 0xae, 0xb2, 0x0d, 0x12, 0x42, 0x85, 0xdf, 0x8f, 0x04, 0xb8, 0xe8, 0xd4, 0x4a,
-	0x25, 0xe4, 0x38, 0x2e, 0x27, 0x23, 0x9a, 0xf7, 0x2a, 0xff, 0x33, 0x08, 0xe6, 0x18, 0xda, 0x03,
-	0x3d, 0xdb, 0x81, 0x36, 0x5a, 0x2f, 0xdb, 0x08, 0x99, 0xc8, 0x22, 0x8f, 0xb1, 0xcd, 0x04, 0xda,
-	0x07, 0xab, 0xb7, 0xc0, 0x05, 0xb6, 0x0b, 0x06, 0x5d, 0xcb, 0xf1, 0x46, 0x5d, 0xba, 0xdc, 0xc2,
-	0x88, 0xac, 0xb1, 0x65, 0xf1, 0x6b, 0x70, 0xd9, 0xd9, 0x35, 0xcc, 0x42, 0x15, 0xd9, 0x25, 0xe4,
-	0xcf, 0xd3, 0x2c, 0x97, 0xc8, 0xf4, 0x49, 0x89, 0x3c, 0x41, 0x65, 0x58, 0x3a, 0xd8, 0x40, 0xa5,
-	0x46, 0x5d, 0xba, 0xc6, 0x63, 0xb7, 0x38, 0x90, 0xb5, 0x4b, 0xf4, 0x75, 0x9b, 0xbd, 0x89, 0x59,
-	0xee, 0x1e, 0xea, 0xba, 0x4d, 0x2b, 0x67, 0x7b, 0x69, 0x2a, 0x80, 0xe5, 0xab, 0x1c, 0xbb, 0xce,
-	0xde, 0xb2, 0x5f, 0x06, 0x3a, 0xb2, 0xda, 0xa9, 0x23, 0x7e, 0x1b, 0x14, 0x87, 0xf2, 0xa6, 0x40,
-	0x8f, 0x38, 0xe5, 0x05, 0xb6, 0x59, 0xbf, 0x64, 0x15, 0x2c, 0xf4, 0x42, 0xb1, 0xd7, 0x2d, 0xf9,
-	0x0f, 0x01, 0x4c, 0x33, 0x80, 0x86, 0xca, 0x86, 0x43, 0x90, 0x8d, 0xf4, 0xf5, 0x4a, 0x05, 0x1f,
-	0x20, 0x7d, 0x1b, 0xe3, 0x4a, 0x3f, 0xad, 0xf8, 0x0c, 0x5c, 0xa4, 0x19, 0x17, 0x0c, 0xdd, 0x6d,
-	0xc6, 0x50, 0x4e, 0x6c, 0xd4, 0xa5, 0x31, 0x66, 0xcb, 0x17, 0x64, 0x6d, 0x98, 0x3e, 0x6d, 0xea,
-	0xd9, 0x07, 0x81, 0xa2, 0xd3, 0x9d, 0x8a, 0xb6, 0xfd, 0xb4, 0x14, 0xc8, 0xf2, 0x52, 0xa8, 0x89,
-	0x7c, 0x13, 0xcc, 0x46, 0xe4, 0xed, 0xd7, 0xf7, 0xef, 0x20, 0x88, 0x9f, 0xdc, 0xb6, 0x9f, 0x83,
-	0x61, 0x97, 0xae, 0xbb, 0xbc, 0xaa, 0x9b, 0x8d, 0xba, 0x24, 0xb5, 0xc8, 0xe6, 0xae, 0xbc, 0xa0,
-	0xa3, 0xaa, 0x8d, 0x4a, 0x90, 0x20, 0x3d, 0x2b, 0x13, 0xbb, 0x86, 0xe4, 0x84, 0xa0, 0x71, 0x90,
-	0x0f, 0xcf, 0x70, 0xd5, 0x05, 0xe1, 0x99, 0x28, 0x78, 0x46, 0x7c, 0x0e, 0x46, 0x9b, 0xbb, 0x3f,
-	0xd6, 0x36, 0xab, 0xba, 0x08, 0xd1, 0x3b, 0x7a, 0x9b, 0x3b, 0x7c, 0x84, 0x34, 0x6b, 0x6a, 0xbb,
-	0x0f, 0x72, 0x09, 0xf6, 0x7c, 0x7d, 0x7c, 0x08, 0xda, 0x4f, 0x89, 0xc4, 0x85, 0x3e, 0x8f, 0x95,
-	0xc5, 0xb7, 0x23, 0x20, 0x96, 0x77, 0xca, 0xe2, 0x77, 0x02, 0x88, 0x9f, 0xbc, 0x5d, 0x67, 0x22,
-	0xe7, 0x5b, 0xd8, 0xef, 0x83, 0xe4, 0xfd, 0xbe, 0x21, 0xfe, 0x10, 0x7a, 0x2d, 0x00, 0x31, 0xe4,
-	0xf0, 0x5e, 0xec, 0xd3, 0xe3, 0x56, 0x8d, 0x24, 0xb3, 0xfd, 0x63, 0xfc, 0x34, 0x7e, 0x13, 0xc0,
-	0x74, 0xd4, 0x4f, 0x8e, 0xd5, 0xae, 0xbe, 0x3b, 0x83, 0x93, 0x8f, 0xce, 0x00, 0xf6, 0x33, 0xfc,
-	0x5d, 0x00, 0x37, 0x22, 0xef, 0x3b, 0x6b, 0xa7, 0x8e, 0x42, 0xc9, 0xdb, 0x38, 0x0b, 0xda, 0x4f,
-	0xf2, 0x50, 0x00, 0x13, 0xa1, 0xc7, 0xef, 0x72, 0x57, 0xf7, 0x21, 0xa8, 0xe4, 0xda, 0x69, 0x50,
-	0x7e, 0x32, 0x6f, 0x05, 0xf0, 0x49, 0xf7, 0x23, 0x6c, 0xbd, 0x87, 0x18, 0xd1, 0x2e, 0x92, 0x9b,
-	0x67, 0x76, 0xe1, 0xe7, 0xfc, 0xab, 0x00, 0x12, 0x1d, 0x47, 0xfc, 0x4a, 0x0f, 0x71, 0x42, 0x91,
-	0xc9, 0x87, 0xa7, 0x45, 0x7a, 0x89, 0xe5, 0x9e, 0xbe, 0x3b, 0x4a, 0x09, 0xef, 0x8f, 0x52, 0xc2,
-	0xdf, 0x47, 0x29, 0xe1, 0x97, 0xe3, 0xd4, 0xc0, 0xfb, 0xe3, 0xd4, 0xc0, 0x9f, 0xc7, 0xa9, 0x81,
-	0xaf, 0xee, 0x95, 0x0d, 0xb2, 0x53, 0x2b, 0xaa, 0x25, 0x6c, 0x7a, 0x67, 0x83, 0x52, 0x81, 0x45,
-	0xc7, 0x3f, 0x28, 0xf6, 0x96, 0x32, 0xe9, 0xfd, 0xb6, 0xe3, 0x82, 0x1c, 0x54, 0x91, 0x53, 0x1c,
-	0x76, 0xff, 0xfe, 0xb3, 0xf4, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x77, 0x59, 0x36, 0x1f, 0xd4,
-	0x12, 0x00, 0x00,
+	// 1917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6c, 0xdb, 0xd6,
+	0x19, 0x0f, 0x2d, 0xc7, 0x7f, 0x5e, 0x12, 0xc7, 0x52, 0xec, 0x9a, 0x91, 0x33, 0xd1, 0x63, 0x92,
+	0xce, 0x4d, 0x43, 0xb1, 0x72, 0x52, 0x34, 0x95, 0xdd, 0xb5, 0x96, 0xb3, 0x02, 0x46, 0xeb, 0xda,
+	0xa5, 0x0d, 0x0c, 0x28, 0x30, 0x10, 0xcf, 0xe4, 0x33, 0xcd, 0x59, 0xfc, 0x33, 0xf2, 0x29, 0xb5,
+	0x6f, 0x5b, 0x51, 0x0c, 0x5b, 0xba, 0x01, 0x3d, 0xed, 0x3a, 0x60, 0xc7, 0x9d, 0xba, 0xcb, 0x02,
+	0x6c, 0xc0, 0xce, 0xbd, 0x0c, 0x28, 0x76, 0x1a, 0x76, 0x50, 0x87, 0xe4, 0xd0, 0x9d, 0x05, 0xec,
+	0x3e, 0xbc, 0x3f, 0xa4, 0x24, 0x8a, 0x12, 0x25, 0x39, 0x0d, 0x30, 0xa0, 0x17, 0x5b, 0x7c, 0x7c,
+	0xdf, 0xef, 0xfb, 0xf3, 0x7e, 0xdf, 0xc7, 0xef, 0x7b, 0xe0, 0x96, 0x17, 0x3a, 0x5e, 0x68, 0x87,
+	0xaa, 0xef, 0x79, 0x75, 0x07, 0xba, 0xd0, 0x42, 0x81, 0xfa, 0xa8, 0x72, 0x88, 0x30, 0xac, 0xa8,
+	0xf8, 0xb4, 0xec, 0x07, 0x1e, 0xf6, 0x0a, 0xcb, 0x7c, 0x57, 0xb9, 0x63, 0x57, 0x99, 0xef, 0x2a,
+	0x2e, 0x58, 0x9e, 0xe5, 0xd1, 0x7d, 0x2a, 0xf9, 0xc5, 0x44, 0x8a, 0x79, 0xe8, 0xd8, 0xae, 0xa7,
+	0xd2, 0xbf, 0x7c, 0xa9, 0x64, 0x50, 0x18, 0xf5, 0x10, 0x86, 0x28, 0xd6, 0x61, 0x78, 0xb6, 0xcb,
+	0xdf, 0xdf, 0x1d, 0x64, 0x4b, 0xf8, 0x31, 0xf4, 0xf5, 0xc0, 0x6b, 0x60, 0xc4, 0x77, 0x2f, 0x71,
+	0x34, 0x27, 0xb4, 0xd4, 0x47, 0x15, 0xf2, 0x8f, 0xbf, 0x90, 0x2c, 0xcf, 0xb3, 0xea, 0x48, 0xa5,
+	0x4f, 0x87, 0x8d, 0x23, 0x15, 0xdb, 0x0e, 0x0a, 0x31, 0x74, 0x7c, 0xbe, 0x61, 0x6d, 0x90, 0x1e,
+	0xc3, 0x73, 0x4d, 0x1b, 0xdb, 0x9e, 0x0b, 0xeb, 0x3a, 0xd1, 0xc9, 0x65, 0x2a, 0x03, 0xe3, 0x04,
+	0x4f, 0x50, 0xa0, 0x1f, 0x21, 0xa4, 0x87, 0xc7, 0x30, 0xe0, 0x06, 0xca, 0x7f, 0xcd, 0x81, 0x85,
+	0x9d, 0xd0, 0xda, 0xff, 0x18, 0xfa, 0x3f, 0x3a, 0x85, 0x06, 0xde, 0x74, 0xbc, 0x86, 0x8b, 0xb7,
+	0xdd, 0xc2, 0x2b, 0x60, 0x2a, 0x44, 0xae, 0x89, 0x02, 0x51, 0x58, 0x11, 0x56, 0x67, 0x6b, 0xf9,
+	0x56, 0x53, 0xba, 0x72, 0x06, 0x9d, 0x7a, 0x55, 0x66, 0xeb, 0xb2, 0xc6, 0x37, 0x14, 0xde, 0x07,
+	0x53, 0xd4, 0xe7, 0x50, 0x9c, 0x58, 0xc9, 0xad, 0x5e, 0x5a, 0x2b, 0x97, 0x07, 0x9c, 0x44, 0x99,
+	0xa8, 0x8a, 0xb4, 0x68, 0x44, 0xac, 0x36, 0xf9, 0x65, 0x53, 0xba, 0xa0, 0x71, 0x8c, 0xc2, 0x0e,
+	0x98, 0xc1, 0xde, 0x09, 0x72, 0x75, 0xdb, 0x15, 0x73, 0x2b, 0xc2, 0xea, 0xa5, 0xb5, 0xeb, 0x65,
+	0x16, 0xc5, 0x32, 0x39, 0x93, 0x18, 0x67, 0xcb, 0xb3, 0xdd, 0xda, 0x12, 0x11, 0x6d, 0x35, 0xa5,
+	0xab, 0xcc, 0xb2, 0x48, 0x50, 0xd6, 0xa6, 0xe9, 0xcf, 0x6d, 0xb7, 0xe0, 0x80, 0x05, 0xb6, 0xea,
+	0x35, 0xb0, 0xee, 0xd8, 0xae, 0x0e, 0xa9, 0x6e, 0x71, 0x92, 0x7a, 0xb5, 0x41, 0xe4, 0xff, 0xd5,
+	0x94, 0x16, 0x99, 0x86, 0xd0, 0x3c, 0x29, 0xdb, 0x9e, 0xea, 0x40, 0x7c, 0x5c, 0xde, 0x76, 0x71,
+	0xab, 0x29, 0x2d, 0x77, 0x02, 0x77, 0x43, 0xc8, 0x5a, 0x9e, 0x2e, 0xef, 0x36, 0xf0, 0x8e, 0xed,
+	0x32, 0x97, 0x0a, 0x6b, 0x60, 0x16, 0x1e, 0x1d, 0xd9, 0x75, 0x1b, 0x62, 0x24, 0x5e, 0xa4, 0x3a,
+	0x16, 0x5a, 0x4d, 0x69, 0x9e, 0xc1, 0xc4, 0xaf, 0x64, 0xad, 0xbd, 0xad, 0xfa, 0xe0, 0x93, 0x6f,
+	0xbe, 0xb8, 0xc3, 0x83, 0xf9, 0xf8, 0x9b, 0x2f, 0xee, 0xac, 0xa6, 0x1d, 0x23, 0x39, 0x66, 0x05,
+	0x91, 0x23, 0x52, 0x98, 0x7a, 0xc5, 0x76, 0xe5, 0x4f, 0x04, 0x70, 0x23, 0xed, 0xf4, 0x34, 0x14,
+	0xfa, 0x9e, 0x1b, 0xa2, 0xc2, 0x21, 0x98, 0x6f, 0x9b, 0xce, 0x3d, 0x67, 0xe7, 0xf9, 0x20, 0xcb,
+	0xf3, 0xa5, 0xa4, 0xe7, 0x91, 0xd7, 0x73, 0x91, 0xd7, 0x4c, 0x9b, 0xfc, 0x24, 0x07, 0x4a, 0xc4,
+	0x08, 0xbf, 0x6e, 0x63, 0x7a, 0xa0, 0xe7, 0x22, 0xd3, 0x87, 0x09, 0x32, 0xdd, 0x1b, 0x9a, 0x4c,
+	0x6d, 0x03, 0x12, 0x8c, 0x7a, 0x1b, 0xcc, 0x45, 0xc4, 0xd0, 0x4d, 0xe4, 0x7a, 0x0e, 0xe5, 0xd5,
+	0x6c, 0xed, 0x7a, 0xab, 0x29, 0x2d, 0x76, 0x13, 0x87, 0xbd, 0x97, 0xb5, 0xcb, 0x9c, 0x3e, 0x0f,
+	0xc9, 0xe3, 0xff, 0x03, 0x87, 0xee, 0x25, 0x38, 0x74, 0x33, 0x95, 0x43, 0x24, 0x42, 0x1d, 0xf4,
+	0xf9, 0x8d, 0x00, 0x5e, 0x1e, 0x7c, 0x72, 0x2f, 0x94, 0x48, 0x7f, 0xcb, 0x81, 0xc5, 0x5e, 0x36,
+	0xef, 0x36, 0xf0, 0x28, 0xfc, 0xd9, 0x49, 0xf0, 0x47, 0x1d, 0x92, 0x3f, 0xbb, 0x8d, 0x54, 0xee,
+	0xfc, 0x14, 0x5c, 0x8b, 0xb9, 0xe1, 0xc0, 0xd3, 0xc8, 0x75, 0x46, 0xa0, 0xf5, 0x2c, 0xd7, 0x8b,
+	0x09, 0x76, 0xb5, 0x11, 0x64, 0x6d, 0x9e, 0x53, 0x6c, 0x07, 0x9e, 0xf2, 0x73, 0xdf, 0x03, 0xb3,
+	0x71, 0x90, 0xc4, 0xc9, 0xac, 0xd2, 0x27, 0xf2, 0xd2, 0x37, 0x9f, 0x08, 0xaf, 0xac, 0xcd, 0x44,
+	0x71, 0x1d, 0x8b, 0x49, 0x6f, 0x26, 0x98, 0xf4, 0xca, 0x70, 0xd5, 0x88, 0x68, 0xfe, 0xb9, 0x00,
+	0xbe, 0x97, 0x7a, 0x80, 0x31, 0x8d, 0x74, 0x70, 0x35, 0x0e, 0x46, 0x17, 0x8b, 0xde, 0xc8, 0x0a,
+	0xe5, 0x4b, 0x89, 0x50, 0x46, 0x61, 0xbc, 0xc2, 0xc3, 0xc8, 0x39, 0xf4, 0x97, 0x1c, 0x90, 0x06,
+	0x51, 0x7a, 0x44, 0x36, 0x69, 0x09, 0x36, 0xdd, 0x1f, 0x9e, 0x4d, 0x7d, 0xcb, 0x51, 0x0d, 0x5c,
+	0x6d, 0xe7, 0x42, 0x67, 0x3d, 0x2a, 0x26, 0xdd, 0x8c, 0x37, 0x44, 0x6e, 0xee, 0x36, 0x30, 0xab,
+	0x48, 0x7d, 0x68, 0x39, 0xf9, 0x6d, 0xd0, 0x72, 0x1c, 0x12, 0xdd, 0x4f, 0x90, 0xe8, 0x56, 0x66,
+	0x39, 0x22, 0xfc, 0x79, 0x2c, 0x80, 0x1f, 0x64, 0x1c, 0xde, 0x8b, 0x63, 0xd2, 0xaf, 0x27, 0xc0,
+	0x12, 0x31, 0x06, 0xb1, 0x90, 0xef, 0x41, 0x3b, 0x38, 0x20, 0x2d, 0xd4, 0xbb, 0x08, 0x8d, 0xc2,
+	0xa0, 0x4f, 0x05, 0xb0, 0x40, 0xcf, 0x50, 0xf7, 0xa1, 0x1d, 0xe8, 0x71, 0x17, 0x36, 0x54, 0xaf,
+	0xd4, 0xa3, 0xb9, 0x76, 0x93, 0x67, 0x3d, 0xff, 0xa6, 0xa4, 0x21, 0xcb, 0x5a, 0xde, 0x4c, 0xca,
+	0x55, 0x37, 0x12, 0x07, 0x92, 0xda, 0xc6, 0x86, 0x08, 0x2b, 0x54, 0x54, 0x21, 0x88, 0x0a, 0x45,
+	0x54, 0x08, 0xe2, 0x3a, 0x90, 0xfa, 0x84, 0x22, 0x3e, 0x0f, 0x11, 0x4c, 0x87, 0x0d, 0xc3, 0x40,
+	0x61, 0x48, 0x63, 0x32, 0xa3, 0x45, 0x8f, 0xf2, 0x67, 0x53, 0xe0, 0x16, 0x93, 0x8e, 0x84, 0xf6,
+	0x49, 0x03, 0xba, 0x69, 0x05, 0x08, 0x39, 0xc8, 0xc5, 0xef, 0x7a, 0x01, 0x23, 0xf5, 0x08, 0x51,
+	0x7d, 0x19, 0x5c, 0x64, 0x99, 0x33, 0x41, 0x77, 0xce, 0xb7, 0x9a, 0xd2, 0xe5, 0x8e, 0x88, 0xc8,
+	0x1a, 0x7b, 0x5d, 0xf8, 0x09, 0xb8, 0x1c, 0x9e, 0xd8, 0x8e, 0xee, 0xa3, 0xc0, 0x40, 0x71, 0xdd,
+	0xae, 0x72, 0x8a, 0x2c, 0xf7, 0x52, 0xe4, 0x7d, 0x64, 0x41, 0xe3, 0xec, 0x21, 0x32, 0x5a, 0x4d,
+	0xe9, 0x1a, 0xd7, 0xdd, 0x01, 0x20, 0x6b, 0x97, 0xc8, 0xe3, 0x1e, 0x7b, 0x2a, 0x54, 0x39, 0x3c,
+	0x34, 0xcd, 0x80, 0x78, 0xce, 0xf2, 0x6f, 0x29, 0x21, 0xcb, 0xdf, 0x72, 0xd9, 0x4d, 0xf6, 0x54,
+	0x38, 0x00, 0x20, 0xc4, 0x30, 0xc0, 0x3a, 0xe9, 0xfc, 0x69, 0x5e, 0x5d, 0x5a, 0x2b, 0x96, 0xd9,
+	0x58, 0x50, 0x8e, 0xc6, 0x82, 0xf2, 0x41, 0x34, 0x16, 0xd0, 0x6e, 0x25, 0xcf, 0x51, 0x63, 0x39,
+	0xf9, 0xf3, 0xaf, 0x25, 0x41, 0x9b, 0xa5, 0x0b, 0x64, 0x6b, 0xe1, 0x03, 0x30, 0x83, 0x5c, 0x93,
+	0x61, 0x4e, 0x65, 0x62, 0x2e, 0xb5, 0x5b, 0xe7, 0x48, 0x8a, 0x21, 0x4e, 0x23, 0xd7, 0xa4, 0x78,
+	0xbf, 0x14, 0xc0, 0x62, 0x67, 0x00, 0xf4, 0xd0, 0x38, 0x46, 0x66, 0xa3, 0x8e, 0xc4, 0x69, 0xca,
+	0xdf, 0xbb, 0x83, 0x0b, 0x62, 0x3b, 0x56, 0xfb, 0x18, 0xf9, 0xb5, 0x5b, 0x9c, 0xbd, 0x37, 0x7a,
+	0x23, 0x1b, 0x03, 0xcb, 0xda, 0xb5, 0x8e, 0x10, 0xef, 0xf3, 0xd5, 0xc2, 0x19, 0x98, 0xa1, 0xdb,
+	0x0d, 0xe8, 0x8b, 0x33, 0x2b, 0xb9, 0xc1, 0xdf, 0xc6, 0xad, 0xee, 0xb1, 0x20, 0x12, 0x94, 0xff,
+	0xf8, 0xb5, 0xb4, 0x6a, 0xd9, 0xf8, 0xb8, 0x71, 0x58, 0x36, 0x3c, 0x47, 0xe5, 0xc3, 0x19, 0xfb,
+	0xa7, 0x84, 0xe6, 0x89, 0x8a, 0xcf, 0x7c, 0x14, 0x52, 0x8c, 0x50, 0x9b, 0x26, 0x62, 0x5b, 0xd0,
+	0xaf, 0xbe, 0x97, 0xc8, 0x9d, 0xf5, 0x7e, 0xb9, 0x13, 0x27, 0x8c, 0x42, 0x47, 0x2c, 0x05, 0x46,
+	0x14, 0x57, 0x8e, 0xbc, 0x80, 0x65, 0x96, 0x5c, 0x06, 0x77, 0x87, 0x49, 0x86, 0x28, 0xaf, 0xe4,
+	0x3f, 0x0b, 0x60, 0x99, 0x09, 0x68, 0xc8, 0xb2, 0x43, 0x8c, 0x02, 0x64, 0x6e, 0xd6, 0xeb, 0xde,
+	0x19, 0x32, 0xf7, 0x3c, 0xaf, 0x3e, 0x4a, 0xd2, 0xbc, 0x0a, 0xa6, 0x89, 0xc5, 0xba, 0x6d, 0xd2,
+	0xb4, 0x99, 0xac, 0x15, 0x5a, 0x4d, 0x69, 0x8e, 0xed, 0xe5, 0x2f, 0x64, 0x6d, 0x8a, 0xfc, 0xda,
+	0x36, 0xab, 0x6f, 0x27, 0x9c, 0x56, 0xfb, 0x39, 0x1d, 0xc4, 0x66, 0x29, 0x90, 0xd9, 0xa5, 0x90,
+	0x2d, 0xf2, 0x6d, 0x70, 0x73, 0x80, 0xdd, 0xb1, 0x7f, 0x4f, 0x26, 0x69, 0x99, 0xdd, 0xab, 0x43,
+	0x03, 0x6d, 0xb5, 0xc7, 0x5a, 0x52, 0xf9, 0xbf, 0x9b, 0x41, 0xc7, 0x9b, 0x1f, 0x3e, 0x02, 0xd3,
+	0x38, 0xb0, 0x2d, 0x0b, 0x05, 0xbc, 0xac, 0xac, 0x66, 0x06, 0xe3, 0x80, 0xed, 0xaf, 0xbd, 0xc4,
+	0x7d, 0xe1, 0xac, 0xe0, 0x30, 0xc4, 0x15, 0xf6, 0x8b, 0xb4, 0xd7, 0xe8, 0xd4, 0xb7, 0x83, 0xb3,
+	0x21, 0xaa, 0xcb, 0x75, 0x0e, 0xc6, 0x8f, 0x8c, 0xc9, 0xb1, 0xfa, 0xc2, 0x41, 0xaa, 0xd5, 0x04,
+	0xcb, 0xee, 0xa4, 0xb1, 0xcc, 0x27, 0xdc, 0x50, 0x3a, 0xee, 0x3c, 0x14, 0xd2, 0x7e, 0xca, 0x3f,
+	0x03, 0x52, 0x1f, 0xe2, 0xc4, 0x1f, 0xa5, 0x0f, 0xc0, 0xb5, 0xe4, 0x55, 0x09, 0x61, 0xbf, 0x40,
+	0xd9, 0x5f, 0x6a, 0x77, 0x42, 0x29, 0x9b, 0x64, 0x2d, 0x6f, 0x74, 0xa3, 0x6e, 0x9b, 0xf2, 0x3f,
+	0x04, 0x20, 0xee, 0x84, 0xd6, 0x16, 0x74, 0x0d, 0x54, 0x3f, 0x07, 0x5b, 0xfb, 0xd8, 0x35, 0x31,
+	0xa6, 0x5d, 0xd5, 0xf5, 0x44, 0x18, 0x5f, 0x4d, 0x0b, 0xa3, 0x41, 0xad, 0xee, 0x8d, 0xe3, 0x67,
+	0x02, 0x58, 0xe9, 0xe7, 0x54, 0x1c, 0x49, 0x0b, 0xe4, 0x03, 0x74, 0xd4, 0x70, 0x4d, 0x64, 0xea,
+	0x71, 0x6a, 0x08, 0x59, 0xa9, 0xb1, 0xc2, 0x19, 0x20, 0x32, 0x77, 0x7a, 0x10, 0x64, 0xed, 0x6a,
+	0xb4, 0x76, 0xc0, 0x72, 0x45, 0xfe, 0xfb, 0x24, 0x9b, 0x49, 0x93, 0x93, 0xe8, 0x8f, 0x6d, 0x7c,
+	0xbc, 0x03, 0x4f, 0xf7, 0x02, 0xdb, 0x40, 0xdb, 0x8e, 0x0f, 0x0d, 0xfc, 0x6d, 0x95, 0xbe, 0xe7,
+	0x9d, 0xfd, 0x29, 0xfd, 0xfe, 0xe4, 0xa8, 0xfd, 0xfe, 0x31, 0x98, 0x27, 0x4d, 0xba, 0x4f, 0xbc,
+	0xd7, 0x6d, 0xea, 0x3e, 0x6f, 0xc5, 0x7f, 0x38, 0x5c, 0x2f, 0xc3, 0x87, 0xf0, 0x24, 0x88, 0xac,
+	0xcd, 0x39, 0xdd, 0x41, 0x35, 0xc0, 0x1c, 0x3a, 0xc5, 0x28, 0x20, 0x94, 0xa3, 0x3b, 0xc5, 0xa9,
+	0xae, 0x2a, 0x95, 0xa1, 0x67, 0x31, 0xca, 0xf5, 0x4e, 0x08, 0x59, 0xbb, 0x12, 0x2d, 0x50, 0x55,
+	0xdd, 0x23, 0xc5, 0xf4, 0x70, 0x23, 0xc5, 0xeb, 0x09, 0x8e, 0xdf, 0xee, 0x3b, 0x97, 0x52, 0x9d,
+	0x0a, 0x77, 0xf0, 0xbf, 0x02, 0x28, 0x0f, 0xc7, 0xa7, 0x17, 0x36, 0x5a, 0xa4, 0x5e, 0xa6, 0x4c,
+	0x3c, 0xe7, 0xcb, 0x94, 0xff, 0x4c, 0x80, 0x7c, 0xef, 0xe0, 0xf2, 0x16, 0x98, 0xa2, 0x04, 0x7b,
+	0x8d, 0x7b, 0x74, 0xbb, 0xd5, 0x94, 0xa4, 0x8e, 0xc6, 0xf9, 0x35, 0xf9, 0xae, 0x89, 0xfc, 0x00,
+	0x19, 0x10, 0x23, 0x93, 0xd4, 0xff, 0x06, 0x92, 0x45, 0x41, 0xe3, 0x42, 0xb1, 0x78, 0x45, 0x9c,
+	0x48, 0x15, 0xaf, 0x0c, 0x12, 0xaf, 0x14, 0x0e, 0xc0, 0x6c, 0x7b, 0xfe, 0xc9, 0x75, 0x85, 0x34,
+	0x83, 0x56, 0xd1, 0x25, 0x47, 0x7b, 0xc6, 0x99, 0xc1, 0x6d, 0x9f, 0xba, 0x6e, 0xeb, 0x78, 0x72,
+	0x0d, 0x7d, 0xb9, 0xf7, 0x0e, 0xe8, 0xce, 0x35, 0xf1, 0xe2, 0x88, 0xc9, 0xb9, 0xf6, 0xab, 0xcb,
+	0x20, 0xb7, 0x13, 0x5a, 0x85, 0x5f, 0x08, 0x20, 0xdf, 0x7b, 0xf7, 0x59, 0x19, 0xf8, 0xf1, 0x4d,
+	0xa3, 0x66, 0xf1, 0xcd, 0x91, 0x45, 0x62, 0xee, 0x7e, 0x2a, 0x80, 0x42, 0xca, 0x95, 0xc7, 0xda,
+	0x88, 0x88, 0xbb, 0x0d, 0x5c, 0xac, 0x8e, 0x2e, 0x13, 0x9b, 0xf1, 0x7b, 0x01, 0x2c, 0x0f, 0xba,
+	0x10, 0x5e, 0xcf, 0xc4, 0xee, 0x2f, 0x5c, 0xdc, 0x3a, 0x87, 0x70, 0x6c, 0xe1, 0x1f, 0x04, 0x70,
+	0x63, 0xe0, 0x2d, 0xd1, 0xc6, 0xd8, 0x5a, 0x48, 0xf0, 0x1e, 0x9e, 0x47, 0x3a, 0x36, 0xf2, 0xb1,
+	0x00, 0x16, 0x52, 0x2f, 0x20, 0xee, 0x67, 0xc2, 0xa7, 0x48, 0x15, 0x37, 0xc6, 0x91, 0x8a, 0x8d,
+	0xf9, 0x93, 0x00, 0xbe, 0x9f, 0x3d, 0xc4, 0x6f, 0x0e, 0xa1, 0x63, 0x30, 0x44, 0x71, 0xfb, 0xdc,
+	0x10, 0xb1, 0xcd, 0xbf, 0x13, 0x80, 0xd8, 0x77, 0x74, 0x7a, 0x30, 0x84, 0x9e, 0x54, 0xc9, 0xe2,
+	0x3b, 0xe3, 0x4a, 0x76, 0x9d, 0x6c, 0xea, 0xcc, 0x93, 0x79, 0xb2, 0x69, 0x52, 0xc5, 0x8d, 0x71,
+	0xa4, 0x62, 0x63, 0x7e, 0x2b, 0x80, 0xc5, 0xf4, 0x9e, 0xf6, 0xf5, 0x2c, 0xdc, 0x54, 0xb1, 0xe2,
+	0x5b, 0x63, 0x89, 0xc5, 0xf6, 0x3c, 0x11, 0xc0, 0xcd, 0x61, 0x1a, 0xc0, 0xad, 0x91, 0xeb, 0x64,
+	0x2f, 0x48, 0xf1, 0xbd, 0xe7, 0x00, 0x12, 0x59, 0x5e, 0xfb, 0xf0, 0xcb, 0xa7, 0x25, 0xe1, 0xab,
+	0xa7, 0x25, 0xe1, 0xdf, 0x4f, 0x4b, 0xc2, 0xe7, 0xcf, 0x4a, 0x17, 0xbe, 0x7a, 0x56, 0xba, 0xf0,
+	0xcf, 0x67, 0xa5, 0x0b, 0x1f, 0xbd, 0xd1, 0x71, 0xef, 0xc0, 0x15, 0x2a, 0x75, 0x78, 0x18, 0x46,
+	0x0f, 0xea, 0xa3, 0x7b, 0x15, 0xf5, 0xb4, 0xab, 0x99, 0xa1, 0x97, 0x11, 0x87, 0x53, 0x74, 0xb2,
+	0xba, 0xf7, 0xbf, 0x01, 0x00, 0xd3, 0xea, 0x9b, 0x94, 0x05, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -933,6 +1418,9 @@ type MsgClient interface {
 	SetDenomPairTakerFee(ctx context.Context, in *MsgSetDenomPairTakerFee, opts ...grpc.CallOption) (*MsgSetDenomPairTakerFeeResponse, error)
 	SetTakerFeeShareAgreementForDenom(ctx context.Context, in *MsgSetTakerFeeShareAgreementForDenom, opts ...grpc.CallOption) (*MsgSetTakerFeeShareAgreementForDenomResponse, error)
 	SetRegisteredAlloyedPool(ctx context.Context, in *MsgSetRegisteredAlloyedPool, opts ...grpc.CallOption) (*MsgSetRegisteredAlloyedPoolResponse, error)
+	PlaceConditionalSwap(ctx context.Context, in *MsgPlaceConditionalSwap, opts ...grpc.CallOption) (*MsgPlaceConditionalSwapResponse, error)
+	CancelConditionalSwap(ctx context.Context, in *MsgCancelConditionalSwap, opts ...grpc.CallOption) (*MsgCancelConditionalSwapResponse, error)
+	SwapExactAmountInWithMaxPriceImpact(ctx context.Context, in *MsgSwapExactAmountInWithMaxPriceImpact, opts ...grpc.CallOption) (*MsgSwapExactAmountInWithMaxPriceImpactResponse, error)
 }
 
 type msgClient struct {
@@ -1006,16 +1494,46 @@ func (c *msgClient) SetRegisteredAlloyedPool(ctx context.Context, in *MsgSetRegi
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
-	SwapExactAmountOut(context.Context, *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error)
-	SplitRouteSwapExactAmountIn(context.Context, *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error)
-	SplitRouteSwapExactAmountOut(context.Context, *MsgSplitRouteSwapExactAmountOut) (*MsgSplitRouteSwapExactAmountOutResponse, error)
-	SetDenomPairTakerFee(context.Context, *MsgSetDenomPairTakerFee) (*MsgSetDenomPairTakerFeeResponse, error)
-	SetTakerFeeShareAgreementForDenom(context.Context, *MsgSetTakerFeeShareAgreementForDenom) (*MsgSetTakerFeeShareAgreementForDenomResponse, error)
-	SetRegisteredAlloyedPool(context.Context, *MsgSetRegisteredAlloyedPool) (*MsgSetRegisteredAlloyedPoolResponse, error)
-}
+func (c *msgClient) PlaceConditionalSwap(ctx context.Context, in *MsgPlaceConditionalSwap, opts ...grpc.CallOption) (*MsgPlaceConditionalSwapResponse, error) {
+	out := new(MsgPlaceConditionalSwapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/PlaceConditionalSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelConditionalSwap(ctx context.Context, in *MsgCancelConditionalSwap, opts ...grpc.CallOption) (*MsgCancelConditionalSwapResponse, error) {
+	out := new(MsgCancelConditionalSwapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/CancelConditionalSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SwapExactAmountInWithMaxPriceImpact(ctx context.Context, in *MsgSwapExactAmountInWithMaxPriceImpact, opts ...grpc.CallOption) (*MsgSwapExactAmountInWithMaxPriceImpactResponse, error) {
+	out := new(MsgSwapExactAmountInWithMaxPriceImpactResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SwapExactAmountInWithMaxPriceImpact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
+	SwapExactAmountOut(context.Context, *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error)
+	SplitRouteSwapExactAmountIn(context.Context, *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error)
+	SplitRouteSwapExactAmountOut(context.Context, *MsgSplitRouteSwapExactAmountOut) (*MsgSplitRouteSwapExactAmountOutResponse, error)
+	SetDenomPairTakerFee(context.Context, *MsgSetDenomPairTakerFee) (*MsgSetDenomPairTakerFeeResponse, error)
+	SetTakerFeeShareAgreementForDenom(context.Context, *MsgSetTakerFeeShareAgreementForDenom) (*MsgSetTakerFeeShareAgreementForDenomResponse, error)
+	SetRegisteredAlloyedPool(context.Context, *MsgSetRegisteredAlloyedPool) (*MsgSetRegisteredAlloyedPoolResponse, error)
+	PlaceConditionalSwap(context.Context, *MsgPlaceConditionalSwap) (*MsgPlaceConditionalSwapResponse, error)
+	CancelConditionalSwap(context.Context, *MsgCancelConditionalSwap) (*MsgCancelConditionalSwapResponse, error)
+	SwapExactAmountInWithMaxPriceImpact(context.Context, *MsgSwapExactAmountInWithMaxPriceImpact) (*MsgSwapExactAmountInWithMaxPriceImpactResponse, error)
+}
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
@@ -1042,6 +1560,15 @@ func (*UnimplementedMsgServer) SetTakerFeeShareAgreementForDenom(ctx context.Con
 func (*UnimplementedMsgServer) SetRegisteredAlloyedPool(ctx context.Context, req *MsgSetRegisteredAlloyedPool) (*MsgSetRegisteredAlloyedPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetRegisteredAlloyedPool not implemented")
 }
+func (*UnimplementedMsgServer) PlaceConditionalSwap(ctx context.Context, req *MsgPlaceConditionalSwap) (*MsgPlaceConditionalSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceConditionalSwap not implemented")
+}
+func (*UnimplementedMsgServer) CancelConditionalSwap(ctx context.Context, req *MsgCancelConditionalSwap) (*MsgCancelConditionalSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelConditionalSwap not implemented")
+}
+func (*UnimplementedMsgServer) SwapExactAmountInWithMaxPriceImpact(ctx context.Context, req *MsgSwapExactAmountInWithMaxPriceImpact) (*MsgSwapExactAmountInWithMaxPriceImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapExactAmountInWithMaxPriceImpact not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1173,6 +1700,61 @@ func _Msg_SetRegisteredAlloyedPool_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PlaceConditionalSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceConditionalSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceConditionalSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/PlaceConditionalSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceConditionalSwap(ctx, req.(*MsgPlaceConditionalSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelConditionalSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelConditionalSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelConditionalSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/CancelConditionalSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelConditionalSwap(ctx, req.(*MsgCancelConditionalSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapExactAmountInWithMaxPriceImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapExactAmountInWithMaxPriceImpact)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapExactAmountInWithMaxPriceImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/SwapExactAmountInWithMaxPriceImpact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapExactAmountInWithMaxPriceImpact(ctx, req.(*MsgSwapExactAmountInWithMaxPriceImpact))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolmanager.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1205,6 +1787,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetRegisteredAlloyedPool",
 			Handler:    _Msg_SetRegisteredAlloyedPool_Handler,
 		},
+		{
+			MethodName: "PlaceConditionalSwap",
+			Handler:    _Msg_PlaceConditionalSwap_Handler,
+		},
+		{
+			MethodName: "CancelConditionalSwap",
+			Handler:    _Msg_CancelConditionalSwap_Handler,
+		},
+		{
+			MethodName: "SwapExactAmountInWithMaxPriceImpact",
+			Handler:    _Msg_SwapExactAmountInWithMaxPriceImpact_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/poolmanager/v1beta1/tx.proto",
@@ -1230,6 +1824,13 @@ func (m *MsgSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Affiliate) > 0 {
+		i -= len(m.Affiliate)
+		copy(dAtA[i:], m.Affiliate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Affiliate)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenOutMinAmount.Size()
 		i -= size
@@ -1327,6 +1928,13 @@ func (m *MsgSplitRouteSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Affiliate) > 0 {
+		i -= len(m.Affiliate)
+		copy(dAtA[i:], m.Affiliate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Affiliate)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenOutMinAmount.Size()
 		i -= size
@@ -1421,6 +2029,13 @@ func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Affiliate) > 0 {
+		i -= len(m.Affiliate)
+		copy(dAtA[i:], m.Affiliate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Affiliate)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1518,6 +2133,13 @@ func (m *MsgSplitRouteSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Affiliate) > 0 {
+		i -= len(m.Affiliate)
+		copy(dAtA[i:], m.Affiliate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Affiliate)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenInMaxAmount.Size()
 		i -= size
@@ -1689,6 +2311,54 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if len(m.SkimCap) > 0 {
+		for iNdEx := len(m.SkimCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SkimCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SkimPercentSchedule) > 0 {
+		for iNdEx := len(m.SkimPercentSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SkimPercentSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.EndTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StartTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.SkimAddress) > 0 {
 		i -= len(m.SkimAddress)
 		copy(dAtA[i:], m.SkimAddress)
@@ -1804,7 +2474,7 @@ func (m *MsgSetRegisteredAlloyedPoolResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+func (m *MsgPlaceConditionalSwap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1814,139 +2484,369 @@ func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgPlaceConditionalSwap) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgPlaceConditionalSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenOutDenom) > 0 {
-		i -= len(m.TokenOutDenom)
-		copy(dAtA[i:], m.TokenOutDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
-		i--
-		dAtA[i] = 0x2a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err5 != nil {
+		return 0, err5
 	}
-	if len(m.TokenInDenom) > 0 {
-		i -= len(m.TokenInDenom)
-		copy(dAtA[i:], m.TokenInDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
-		i--
-		dAtA[i] = 0x22
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x2a
 	{
-		size := m.TakerFee.Size()
+		size := m.TokenOutMinAmount.Size()
 		i -= size
-		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	if len(m.Denom1) > 0 {
-		i -= len(m.Denom1)
-		copy(dAtA[i:], m.Denom1)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom1)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Denom0) > 0 {
-		i -= len(m.Denom0)
-		copy(dAtA[i:], m.Denom0)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom0)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPlaceConditionalSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgPlaceConditionalSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceConditionalSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.ConditionalSwapId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ConditionalSwapId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.TokenIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenOutMinAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapExactAmountInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelConditionalSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSplitRouteSwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelConditionalSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelConditionalSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ConditionalSwapId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ConditionalSwapId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.TokenInDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelConditionalSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.TokenOutMinAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSplitRouteSwapExactAmountInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelConditionalSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelConditionalSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RefundedTokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Affiliate) > 0 {
+		i -= len(m.Affiliate)
+		copy(dAtA[i:], m.Affiliate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Affiliate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.ExternalPrice.Size()
+		i -= size
+		if _, err := m.ExternalPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxPriceImpact.Size()
+		i -= size
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapExactAmountOut) Size() (n int) {
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSwapExactAmountIn) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1962,25 +2862,29 @@ func (m *MsgSwapExactAmountOut) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = m.TokenInMaxAmount.Size()
+	l = m.TokenIn.Size()
 	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenOut.Size()
+	l = m.TokenOutMinAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Affiliate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
-func (m *MsgSwapExactAmountOutResponse) Size() (n int) {
+func (m *MsgSwapExactAmountInResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TokenInAmount.Size()
+	l = m.TokenOutAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgSplitRouteSwapExactAmountOut) Size() (n int) {
+func (m *MsgSplitRouteSwapExactAmountIn) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1996,27 +2900,31 @@ func (m *MsgSplitRouteSwapExactAmountOut) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.TokenOutDenom)
+	l = len(m.TokenInDenom)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.TokenInMaxAmount.Size()
+	l = m.TokenOutMinAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Affiliate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
-func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
+func (m *MsgSplitRouteSwapExactAmountInResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TokenInAmount.Size()
+	l = m.TokenOutAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgSetDenomPairTakerFee) Size() (n int) {
+func (m *MsgSwapExactAmountOut) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2026,18 +2934,96 @@ func (m *MsgSetDenomPairTakerFee) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.DenomPairTakerFee) > 0 {
-		for _, e := range m.DenomPairTakerFee {
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *MsgSetDenomPairTakerFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	l = m.TokenInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Affiliate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Affiliate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDenomPairTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomPairTakerFee) > 0 {
+		for _, e := range m.DenomPairTakerFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetDenomPairTakerFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -2067,6 +3053,26 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SkimPercentSchedule) > 0 {
+		for _, e := range m.SkimPercentSchedule {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SkimCap) > 0 {
+		for _, e := range m.SkimCap {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2104,6 +3110,115 @@ func (m *MsgSetRegisteredAlloyedPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPlaceConditionalSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Trigger.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceConditionalSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConditionalSwapId != 0 {
+		n += 1 + sovTx(uint64(m.ConditionalSwapId))
+	}
+	return n
+}
+
+func (m *MsgCancelConditionalSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ConditionalSwapId != 0 {
+		n += 1 + sovTx(uint64(m.ConditionalSwapId))
+	}
+	return n
+}
+
+func (m *MsgCancelConditionalSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RefundedTokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxPriceImpact.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExternalPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Affiliate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func (m *DenomPairTakerFee) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2128,16 +3243,912 @@ func (m *DenomPairTakerFee) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Affiliate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Affiliate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Affiliate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2160,10 +4171,10 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2227,16 +4238,248 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Affiliate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomPairTakerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomPairTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2246,30 +4489,29 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairTakerFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2279,23 +4521,23 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomPairTakerFee = append(m.DenomPairTakerFee, DenomPairTakerFee{})
+			if err := m.DenomPairTakerFee[len(m.DenomPairTakerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2320,7 +4562,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2343,17 +4585,17 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomPairTakerFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2363,26 +4605,12 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2404,7 +4632,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2427,10 +4655,10 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2467,9 +4695,9 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2479,29 +4707,27 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkimPercent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2529,11 +4755,13 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.SkimPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkimAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2561,7 +4789,145 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SkimAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkimPercentSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkimPercentSchedule = append(m.SkimPercentSchedule, SkimPercentStep{})
+			if err := m.SkimPercentSchedule[len(m.SkimPercentSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkimCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkimCap = append(m.SkimCap, types.Coin{})
+			if err := m.SkimCap[len(m.SkimCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2586,7 +4952,7 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2605,50 +4971,16 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2670,7 +5002,7 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2693,10 +5025,10 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2732,78 +5064,10 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Routes = append(m.Routes, SwapAmountOutRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2813,25 +5077,11 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2853,7 +5103,7 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRegisteredAlloyedPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2876,46 +5126,12 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2937,7 +5153,7 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceConditionalSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2960,10 +5176,10 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceConditionalSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceConditionalSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3027,16 +5243,16 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3046,27 +5262,28 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3094,7 +5311,73 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3119,7 +5402,7 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceConditionalSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3142,17 +5425,17 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceConditionalSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceConditionalSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalSwapId", wireType)
 			}
-			var stringLen uint64
+			m.ConditionalSwapId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3162,26 +5445,11 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				m.ConditionalSwapId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3203,7 +5471,7 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelConditionalSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3226,10 +5494,10 @@ func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomPairTakerFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelConditionalSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomPairTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelConditionalSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3265,10 +5533,10 @@ func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairTakerFee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalSwapId", wireType)
 			}
-			var msglen int
+			m.ConditionalSwapId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3278,26 +5546,11 @@ func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ConditionalSwapId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DenomPairTakerFee = append(m.DenomPairTakerFee, DenomPairTakerFee{})
-			if err := m.DenomPairTakerFee[len(m.DenomPairTakerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3319,7 +5572,7 @@ func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelConditionalSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3342,17 +5595,17 @@ func (m *MsgSetDenomPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomPairTakerFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelConditionalSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelConditionalSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedTokenIn", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3362,12 +5615,25 @@ func (m *MsgSetDenomPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundedTokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3389,7 +5655,7 @@ func (m *MsgSetDenomPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3412,10 +5678,10 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountInWithMaxPriceImpact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountInWithMaxPriceImpact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3451,8 +5717,60 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3480,11 +5798,11 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkimPercent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3512,13 +5830,13 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SkimPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkimAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3546,58 +5864,42 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SkimAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenomResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Affiliate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3619,7 +5921,7 @@ func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3642,15 +5944,15 @@ func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountInWithMaxPriceImpactResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountInWithMaxPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3678,13 +5980,15 @@ func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3694,61 +5998,26 @@ func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetRegisteredAlloyedPoolResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
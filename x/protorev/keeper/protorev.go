@@ -136,6 +136,20 @@ func (k Keeper) GetAllBaseDenoms(ctx sdk.Context) ([]types.BaseDenom, error) {
 	return baseDenoms.BaseDenoms, nil
 }
 
+// GetAllBaseDenomNames returns the denoms of all of the base denoms (sorted by priority in descending order)
+func (k Keeper) GetAllBaseDenomNames(ctx sdk.Context) ([]string, error) {
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := make([]string, len(baseDenoms))
+	for i, baseDenom := range baseDenoms {
+		denoms[i] = baseDenom.Denom
+	}
+	return denoms, nil
+}
+
 // SetBaseDenoms sets all of the base denoms used to build cyclic arbitrage routes. The base denoms priority
 // order is going to match the order of the base denoms in the slice.
 func (k Keeper) SetBaseDenoms(ctx sdk.Context, baseDenoms []types.BaseDenom) error {
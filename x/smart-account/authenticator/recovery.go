@@ -0,0 +1,154 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+)
+
+var _ Authenticator = &Recovery{}
+
+const (
+	// RecoveryType represents a set of guardians that can propose to replace the authenticators of an account.
+	// The replacement is executed after a delay, during which the account can cancel it.
+	RecoveryType = "Recovery"
+)
+
+// RecoveryConfig is the configuration stored for a Recovery authenticator.
+type RecoveryConfig struct {
+	// Guardians are the addresses that can propose a recovery.
+	Guardians []string `json:"guardians"`
+	// DelaySeconds is the time between a recovery being proposed and executed.
+	DelaySeconds uint64 `json:"delay_seconds,string"`
+}
+
+// Delay returns the time between a recovery being proposed and executed.
+func (c RecoveryConfig) Delay() time.Duration {
+	return time.Duration(c.DelaySeconds) * time.Second
+}
+
+// IsGuardian returns true if the address is a guardian of the recovery.
+func (c RecoveryConfig) IsGuardian(address sdk.AccAddress) bool {
+	return slices.Contains(c.Guardians, address.String())
+}
+
+// Recovery does not authenticate messages. Its guardians propose recoveries with MsgProposeRecovery, and the
+// smart account keeper replaces the authenticators of the account at the end of the block once the delay has
+// passed, unless the account cancelled the recovery with MsgCancelRecovery.
+type Recovery struct {
+	storeKey storetypes.StoreKey
+
+	config RecoveryConfig
+}
+
+// NewRecovery creates a new Recovery authenticator.
+func NewRecovery(storeKey storetypes.StoreKey) Recovery {
+	return Recovery{
+		storeKey: storeKey,
+	}
+}
+
+func (r Recovery) Type() string {
+	return RecoveryType
+}
+
+func (r Recovery) StaticGas() uint64 {
+	return 0
+}
+
+// Initialize parses and validates the recovery configuration.
+func (r Recovery) Initialize(config []byte) (Authenticator, error) {
+	recoveryConfig, err := ParseRecoveryConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	r.config = recoveryConfig
+	return r, nil
+}
+
+// Authenticate always fails, guardians can't sign messages on behalf of the account.
+func (r Recovery) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "recovery authenticator can't authenticate messages, guardians must propose a recovery")
+}
+
+func (r Recovery) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+func (r Recovery) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+// OnAuthenticatorAdded validates the recovery configuration. Recoveries refer to the authenticator by its id,
+// so it can't be a sub-authenticator of a composite authenticator.
+func (r Recovery) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	if strings.Contains(authenticatorId, ".") {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "recovery authenticator can't be a sub-authenticator")
+	}
+
+	recoveryConfig, err := ParseRecoveryConfig(config)
+	if err != nil {
+		return err
+	}
+	if recoveryConfig.IsGuardian(account) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "account can't be its own guardian")
+	}
+	return nil
+}
+
+// OnAuthenticatorRemoved deletes the pending recovery proposed by the guardians of the authenticator. Its queued
+// execution is dropped by the keeper once the pending recovery is gone.
+func (r Recovery) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	store := ctx.KVStore(r.storeKey)
+	key := types.KeyPendingRecovery(account)
+
+	var pendingRecovery types.PendingRecovery
+	found, err := osmoutils.Get(store, key, &pendingRecovery)
+	if err != nil {
+		return err
+	}
+	if found && strconv.FormatUint(pendingRecovery.AuthenticatorId, 10) == authenticatorId {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// ParseRecoveryConfig parses the configuration of a Recovery authenticator and checks that it is well-formed.
+func ParseRecoveryConfig(config []byte) (RecoveryConfig, error) {
+	var recoveryConfig RecoveryConfig
+	if err := json.Unmarshal(config, &recoveryConfig); err != nil {
+		return RecoveryConfig{}, errorsmod.Wrap(err, "failed to parse recovery config")
+	}
+
+	if len(recoveryConfig.Guardians) == 0 {
+		return RecoveryConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one guardian must be provided")
+	}
+	for i, guardian := range recoveryConfig.Guardians {
+		if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+			return RecoveryConfig{}, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid guardian address %s: %s", guardian, err)
+		}
+		if slices.Contains(recoveryConfig.Guardians[:i], guardian) {
+			return RecoveryConfig{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate guardian %s", guardian)
+		}
+	}
+	if recoveryConfig.DelaySeconds == 0 {
+		return RecoveryConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "recovery delay must be positive")
+	}
+	if recoveryConfig.DelaySeconds > math.MaxInt64/uint64(time.Second) {
+		return RecoveryConfig{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "recovery delay %d seconds is too long", recoveryConfig.DelaySeconds)
+	}
+
+	return recoveryConfig, nil
+}
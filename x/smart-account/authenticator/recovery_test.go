@@ -0,0 +1,100 @@
+package authenticator_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/authenticator"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+)
+
+type RecoveryTest struct {
+	BaseAuthenticatorSuite
+
+	Recovery authenticator.Recovery
+}
+
+func TestRecoveryTest(t *testing.T) {
+	suite.Run(t, new(RecoveryTest))
+}
+
+func (s *RecoveryTest) SetupTest() {
+	s.SetupKeys()
+	s.Recovery = authenticator.NewRecovery(s.OsmosisApp.GetKey(smartaccounttypes.StoreKey))
+}
+
+func (s *RecoveryTest) TearDownTest() {
+	os.RemoveAll(s.HomeDir)
+}
+
+func (s *RecoveryTest) TestOnAuthenticatorAdded() {
+	account := s.TestAccAddress[0]
+	guardian := s.TestAccAddress[1].String()
+
+	tests := map[string]struct {
+		config          authenticator.RecoveryConfig
+		authenticatorId string
+		expectedErr     bool
+	}{
+		"valid": {
+			config: authenticator.RecoveryConfig{Guardians: []string{guardian, s.TestAccAddress[2].String()}, DelaySeconds: 86400},
+		},
+		"no guardians": {
+			config:      authenticator.RecoveryConfig{DelaySeconds: 86400},
+			expectedErr: true,
+		},
+		"invalid guardian": {
+			config:      authenticator.RecoveryConfig{Guardians: []string{"invalid"}, DelaySeconds: 86400},
+			expectedErr: true,
+		},
+		"duplicate guardian": {
+			config:      authenticator.RecoveryConfig{Guardians: []string{guardian, guardian}, DelaySeconds: 86400},
+			expectedErr: true,
+		},
+		"account is a guardian": {
+			config:      authenticator.RecoveryConfig{Guardians: []string{account.String()}, DelaySeconds: 86400},
+			expectedErr: true,
+		},
+		"zero delay": {
+			config:      authenticator.RecoveryConfig{Guardians: []string{guardian}},
+			expectedErr: true,
+		},
+		"sub-authenticator": {
+			config:          authenticator.RecoveryConfig{Guardians: []string{guardian}, DelaySeconds: 86400},
+			authenticatorId: "1.0",
+			expectedErr:     true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			bz, err := json.Marshal(tc.config)
+			s.Require().NoError(err)
+
+			authenticatorId := tc.authenticatorId
+			if authenticatorId == "" {
+				authenticatorId = "1"
+			}
+			err = s.Recovery.OnAuthenticatorAdded(s.Ctx, account, bz, authenticatorId)
+			if tc.expectedErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *RecoveryTest) TestAuthenticate() {
+	bz, err := json.Marshal(authenticator.RecoveryConfig{Guardians: []string{s.TestAccAddress[1].String()}, DelaySeconds: 86400})
+	s.Require().NoError(err)
+	recovery, err := s.Recovery.Initialize(bz)
+	s.Require().NoError(err)
+
+	// guardians can't sign for the account
+	err = recovery.Authenticate(s.Ctx, authenticator.AuthenticationRequest{Account: s.TestAccAddress[0]})
+	s.Require().ErrorContains(err, "can't authenticate messages")
+}
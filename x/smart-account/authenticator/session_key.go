@@ -0,0 +1,200 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+)
+
+var _ Authenticator = &SessionKey{}
+
+const (
+	// SessionKeyType represents a secp256k1 signature verification that is only valid within
+	// a time window, for a limited number of uses and for a limited set of message types.
+	SessionKeyType = "SessionKey"
+)
+
+// SessionKeyConfig is the configuration stored for a SessionKey authenticator.
+type SessionKeyConfig struct {
+	// PubKey is the secp256k1 public key of the session.
+	PubKey []byte `json:"pub_key"`
+	// NotBefore is the block time from which the session can be used. If unset, it can be used immediately.
+	NotBefore time.Time `json:"not_before"`
+	// NotAfter is the block time at which the session expires.
+	NotAfter time.Time `json:"not_after"`
+	// MaxUses is the maximum number of messages the session can authenticate. Zero means unlimited.
+	MaxUses uint64 `json:"max_uses,omitempty,string"`
+	// AllowedMsgTypes is the list of message type urls the session can authenticate. Empty allows every message.
+	AllowedMsgTypes []string `json:"allowed_msg_types,omitempty"`
+}
+
+// SessionKey verifies signatures of a short-lived key. Once a session expires or runs out of uses,
+// it is queued for removal and the smart account keeper removes it at the end of the block.
+type SessionKey struct {
+	storeKey storetypes.StoreKey
+	ak       authante.AccountKeeper
+
+	config SessionKeyConfig
+}
+
+// NewSessionKey creates a new SessionKey authenticator.
+func NewSessionKey(storeKey storetypes.StoreKey, ak authante.AccountKeeper) SessionKey {
+	return SessionKey{
+		storeKey: storeKey,
+		ak:       ak,
+	}
+}
+
+func (sk SessionKey) Type() string {
+	return SessionKeyType
+}
+
+func (sk SessionKey) StaticGas() uint64 {
+	// the gas is consumed by the signature verification in Authenticate()
+	return 0
+}
+
+// Initialize parses and validates the session configuration.
+func (sk SessionKey) Initialize(config []byte) (Authenticator, error) {
+	sessionKeyConfig, err := ParseSessionKeyConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	sk.config = sessionKeyConfig
+	return sk, nil
+}
+
+// Authenticate checks that the session is live and allows the message, then verifies the signature.
+func (sk SessionKey) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	blockTime := ctx.BlockTime()
+	if blockTime.Before(sk.config.NotBefore) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session key is not valid before %s", sk.config.NotBefore)
+	}
+	if !blockTime.Before(sk.config.NotAfter) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session key expired at %s", sk.config.NotAfter)
+	}
+
+	if sk.config.MaxUses > 0 {
+		uses := sk.GetUses(ctx, request.Account, request.AuthenticatorId)
+		if uses >= sk.config.MaxUses {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session key has been used %d times out of %d", uses, sk.config.MaxUses)
+		}
+	}
+
+	if len(sk.config.AllowedMsgTypes) > 0 && !slices.Contains(sk.config.AllowedMsgTypes, request.Msg.TypeURL) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "message type %s is not allowed for session key", request.Msg.TypeURL)
+	}
+
+	signatureVerification := SignatureVerification{
+		ak:     sk.ak,
+		PubKey: &secp256k1.PubKey{Key: sk.config.PubKey},
+	}
+	return signatureVerification.Authenticate(ctx, request)
+}
+
+// Track increments the number of uses of the session. Once the session runs out of uses, it is queued for removal.
+//
+// Every message of a tx is authenticated before any of them is tracked, so the limit is enforced here
+// as well. Otherwise a tx with several messages could use the session past MaxUses.
+func (sk SessionKey) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	if sk.config.MaxUses == 0 {
+		return nil
+	}
+
+	uses := sk.GetUses(ctx, request.Account, request.AuthenticatorId) + 1
+	if uses > sk.config.MaxUses {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session key has been used %d times out of %d", uses-1, sk.config.MaxUses)
+	}
+	osmoutils.MustSet(ctx.KVStore(sk.storeKey),
+		types.KeySessionKeyUses(request.Account, request.AuthenticatorId),
+		&gogotypes.UInt64Value{Value: uses})
+
+	if uses >= sk.config.MaxUses {
+		ctx.KVStore(sk.storeKey).Set(types.KeySessionKeyExpiration(ctx.BlockTime(), request.Account, request.AuthenticatorId), []byte{})
+	}
+	return nil
+}
+
+func (sk SessionKey) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+// OnAuthenticatorAdded validates the session configuration and queues the session for removal once it expires.
+func (sk SessionKey) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	sessionKeyConfig, err := ParseSessionKeyConfig(config)
+	if err != nil {
+		return err
+	}
+	if !sessionKeyConfig.NotAfter.After(ctx.BlockTime()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "session key expiration %s must be after the current block time", sessionKeyConfig.NotAfter)
+	}
+
+	ctx.KVStore(sk.storeKey).Set(types.KeySessionKeyExpiration(sessionKeyConfig.NotAfter, account, authenticatorId), []byte{})
+	return nil
+}
+
+// OnAuthenticatorRemoved deletes the usage and the queued removals of the session.
+func (sk SessionKey) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	store := ctx.KVStore(sk.storeKey)
+	store.Delete(types.KeySessionKeyUses(account, authenticatorId))
+
+	// A removal queued when the session ran out of uses is dropped by the keeper once the authenticator is gone
+	sessionKeyConfig, err := ParseSessionKeyConfig(config)
+	if err == nil {
+		store.Delete(types.KeySessionKeyExpiration(sessionKeyConfig.NotAfter, account, authenticatorId))
+	}
+	return nil
+}
+
+// GetUses returns the number of messages authenticated by the session.
+func (sk SessionKey) GetUses(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) uint64 {
+	return GetSessionKeyUses(ctx.KVStore(sk.storeKey), account, authenticatorId)
+}
+
+// GetSessionKeyUses returns the number of messages authenticated by a session from the smart account store.
+func GetSessionKeyUses(store storetypes.KVStore, account sdk.AccAddress, authenticatorId string) uint64 {
+	uses := gogotypes.UInt64Value{}
+	found, err := osmoutils.Get(store, types.KeySessionKeyUses(account, authenticatorId), &uses)
+	if err != nil || !found {
+		return 0
+	}
+	return uses.Value
+}
+
+// ParseSessionKeyConfig parses the configuration of a SessionKey and checks that it is well-formed.
+func ParseSessionKeyConfig(config []byte) (SessionKeyConfig, error) {
+	var sessionKeyConfig SessionKeyConfig
+	if err := json.Unmarshal(config, &sessionKeyConfig); err != nil {
+		return SessionKeyConfig{}, errorsmod.Wrap(err, "failed to parse session key config")
+	}
+
+	if len(sessionKeyConfig.PubKey) != secp256k1.PubKeySize {
+		return SessionKeyConfig{}, fmt.Errorf("invalid secp256k1 public key size, expected %d, got %d", secp256k1.PubKeySize, len(sessionKeyConfig.PubKey))
+	}
+	if sessionKeyConfig.NotAfter.IsZero() {
+		return SessionKeyConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "session key expiration must be set")
+	}
+	if !sessionKeyConfig.NotAfter.After(sessionKeyConfig.NotBefore) {
+		return SessionKeyConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "session key expiration must be after its start")
+	}
+	for _, msgType := range sessionKeyConfig.AllowedMsgTypes {
+		if msgType == "" {
+			return SessionKeyConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "allowed message types must not be empty")
+		}
+	}
+
+	return sessionKeyConfig, nil
+}
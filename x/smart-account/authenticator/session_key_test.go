@@ -0,0 +1,300 @@
+package authenticator_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	appparams "github.com/osmosis-labs/osmosis/v31/app/params"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/ante"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/post"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+	txfeeskeeper "github.com/osmosis-labs/osmosis/v31/x/txfees/keeper"
+)
+
+type SessionKeyTest struct {
+	BaseAuthenticatorSuite
+
+	SessionKey                 authenticator.SessionKey
+	AuthenticatorAnteDecorator ante.AuthenticatorDecorator
+	AuthenticatorPostDecorator post.AuthenticatorPostDecorator
+}
+
+func TestSessionKeyTest(t *testing.T) {
+	suite.Run(t, new(SessionKeyTest))
+}
+
+func (s *SessionKeyTest) SetupTest() {
+	s.SetupKeys()
+
+	s.Ctx = s.Ctx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
+	s.Ctx = s.Ctx.WithBlockTime(time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC))
+	s.Ctx = s.Ctx.WithBlockHeight(1)
+
+	s.SessionKey = authenticator.NewSessionKey(
+		s.OsmosisApp.GetKey(smartaccounttypes.StoreKey),
+		s.OsmosisApp.AccountKeeper,
+	)
+
+	deductFeeDecorator := txfeeskeeper.NewDeductFeeDecorator(*s.OsmosisApp.TxFeesKeeper, s.OsmosisApp.AccountKeeper, s.OsmosisApp.BankKeeper, nil)
+	s.AuthenticatorAnteDecorator = ante.NewAuthenticatorDecorator(
+		s.OsmosisApp.AppCodec(),
+		s.OsmosisApp.SmartAccountKeeper,
+		s.OsmosisApp.AccountKeeper,
+		s.EncodingConfig.TxConfig.SignModeHandler(),
+		deductFeeDecorator,
+	)
+
+	s.AuthenticatorPostDecorator = post.NewAuthenticatorPostDecorator(
+		s.OsmosisApp.AppCodec(),
+		s.OsmosisApp.SmartAccountKeeper,
+		s.OsmosisApp.AccountKeeper,
+		s.EncodingConfig.TxConfig.SignModeHandler(),
+		sdk.ChainPostDecorators(sdk.Terminator{}), //nolint
+	)
+}
+
+func (s *SessionKeyTest) TearDownTest() {
+	os.RemoveAll(s.HomeDir)
+}
+
+func (s *SessionKeyTest) TestOnAuthenticatorAdded() {
+	pubKey := s.TestPrivKeys[0].PubKey().Bytes()
+	blockTime := s.Ctx.BlockTime()
+
+	tests := map[string]struct {
+		config      authenticator.SessionKeyConfig
+		expectedErr bool
+	}{
+		"valid session": {
+			config: authenticator.SessionKeyConfig{PubKey: pubKey, NotAfter: blockTime.Add(time.Hour)},
+		},
+		"valid session with all restrictions": {
+			config: authenticator.SessionKeyConfig{
+				PubKey:          pubKey,
+				NotBefore:       blockTime.Add(time.Hour),
+				NotAfter:        blockTime.Add(2 * time.Hour),
+				MaxUses:         10,
+				AllowedMsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			},
+		},
+		"missing expiration": {
+			config:      authenticator.SessionKeyConfig{PubKey: pubKey},
+			expectedErr: true,
+		},
+		"already expired": {
+			config:      authenticator.SessionKeyConfig{PubKey: pubKey, NotAfter: blockTime.Add(-time.Hour)},
+			expectedErr: true,
+		},
+		"expiration before start": {
+			config: authenticator.SessionKeyConfig{
+				PubKey:    pubKey,
+				NotBefore: blockTime.Add(2 * time.Hour),
+				NotAfter:  blockTime.Add(time.Hour),
+			},
+			expectedErr: true,
+		},
+		"invalid public key": {
+			config:      authenticator.SessionKeyConfig{PubKey: []byte("invalid"), NotAfter: blockTime.Add(time.Hour)},
+			expectedErr: true,
+		},
+		"empty message type": {
+			config:      authenticator.SessionKeyConfig{PubKey: pubKey, NotAfter: blockTime.Add(time.Hour), AllowedMsgTypes: []string{""}},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			bz, err := json.Marshal(tc.config)
+			s.Require().NoError(err)
+
+			err = s.SessionKey.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], bz, "1")
+			if tc.expectedErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *SessionKeyTest) TestSessionKeyMaxUses() {
+	blockTime := s.Ctx.BlockTime()
+	authAcc, authAccPriv, sessionKeyId := s.addSessionKey(authenticator.SessionKeyConfig{
+		PubKey:   s.TestPrivKeys[1].PubKey().Bytes(),
+		NotAfter: blockTime.Add(time.Hour),
+		MaxUses:  2,
+	})
+
+	for i := 0; i < 2; i++ {
+		err := s.sendWithSessionKey(authAcc, authAccPriv, sessionKeyId)
+		s.Require().NoError(err)
+	}
+
+	err := s.sendWithSessionKey(authAcc, authAccPriv, sessionKeyId)
+	s.Require().ErrorContains(err, "session key has been used 2 times out of 2")
+
+	// the exhausted session is removed at the end of the block
+	s.OsmosisApp.SmartAccountKeeper.RemoveExpiredSessionKeys(s.Ctx)
+	_, err = s.OsmosisApp.SmartAccountKeeper.GetSelectedAuthenticatorData(s.Ctx, authAcc, int(sessionKeyId))
+	s.Require().Error(err)
+	s.Require().Equal(uint64(0), s.SessionKey.GetUses(s.Ctx, authAcc, fmt.Sprint(sessionKeyId)))
+}
+
+func (s *SessionKeyTest) TestSessionKeyMaxUsesMultipleMsgs() {
+	authAcc, authAccPriv, sessionKeyId := s.addSessionKey(authenticator.SessionKeyConfig{
+		PubKey:   s.TestPrivKeys[1].PubKey().Bytes(),
+		NotAfter: s.Ctx.BlockTime().Add(time.Hour),
+		MaxUses:  2,
+	})
+
+	err := s.sendWithSessionKeyMsgs(authAcc, authAccPriv, sessionKeyId, 1)
+	s.Require().NoError(err)
+
+	// every message authenticates while one use is left, but the tx uses the session twice
+	err = s.sendWithSessionKeyMsgs(authAcc, authAccPriv, sessionKeyId, 2)
+	s.Require().ErrorContains(err, "session key has been used 2 times out of 2")
+	s.Require().Equal(uint64(1), s.SessionKey.GetUses(s.Ctx, authAcc, fmt.Sprint(sessionKeyId)))
+
+	err = s.sendWithSessionKeyMsgs(authAcc, authAccPriv, sessionKeyId, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), s.SessionKey.GetUses(s.Ctx, authAcc, fmt.Sprint(sessionKeyId)))
+}
+
+func (s *SessionKeyTest) TestSessionKeyTimeWindow() {
+	blockTime := s.Ctx.BlockTime()
+	authAcc, authAccPriv, sessionKeyId := s.addSessionKey(authenticator.SessionKeyConfig{
+		PubKey:    s.TestPrivKeys[1].PubKey().Bytes(),
+		NotBefore: blockTime.Add(time.Hour),
+		NotAfter:  blockTime.Add(2 * time.Hour),
+	})
+
+	err := s.sendWithSessionKey(authAcc, authAccPriv, sessionKeyId)
+	s.Require().ErrorContains(err, "session key is not valid before")
+
+	s.Ctx = s.Ctx.WithBlockTime(blockTime.Add(90 * time.Minute))
+	err = s.sendWithSessionKey(authAcc, authAccPriv, sessionKeyId)
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockTime(blockTime.Add(3 * time.Hour))
+	err = s.sendWithSessionKey(authAcc, authAccPriv, sessionKeyId)
+	s.Require().ErrorContains(err, "session key expired")
+}
+
+func (s *SessionKeyTest) TestSessionKeyAllowedMsgTypes() {
+	authAcc, authAccPriv, sessionKeyId := s.addSessionKey(authenticator.SessionKeyConfig{
+		PubKey:          s.TestPrivKeys[1].PubKey().Bytes(),
+		NotAfter:        s.Ctx.BlockTime().Add(time.Hour),
+		AllowedMsgTypes: []string{"/cosmos.bank.v1beta1.MsgMultiSend"},
+	})
+
+	err := s.sendWithSessionKey(authAcc, authAccPriv, sessionKeyId)
+	s.Require().ErrorContains(err, "message type /cosmos.bank.v1beta1.MsgSend is not allowed")
+}
+
+func (s *SessionKeyTest) TestSessionKeyWrongSigner() {
+	authAcc, authAccPriv, sessionKeyId := s.addSessionKey(authenticator.SessionKeyConfig{
+		PubKey:   s.TestPrivKeys[2].PubKey().Bytes(),
+		NotAfter: s.Ctx.BlockTime().Add(time.Hour),
+	})
+
+	err := s.sendWithSessionKey(authAcc, authAccPriv, sessionKeyId)
+	s.Require().Error(err)
+}
+
+// addSessionKey adds a session key to a funded account. The account key signs the transactions, so the
+// session is only usable when it is configured with the account public key.
+func (s *SessionKeyTest) addSessionKey(config authenticator.SessionKeyConfig) (sdk.AccAddress, cryptotypes.PrivKey, uint64) {
+	authAcc := s.TestAccAddress[1]
+	authAccPriv := s.TestPrivKeys[1]
+
+	bz, err := json.Marshal(config)
+	s.Require().NoError(err)
+
+	sessionKeyId, err := s.OsmosisApp.SmartAccountKeeper.AddAuthenticator(s.Ctx, authAcc, authenticator.SessionKeyType, bz)
+	s.Require().NoError(err)
+
+	s.FundAcc(authAcc, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(200000000000))))
+
+	return authAcc, authAccPriv, sessionKeyId
+}
+
+// sendWithSessionKey runs the ante handler and the post handler for a bank send authenticated by the session key.
+func (s *SessionKeyTest) sendWithSessionKey(authAcc sdk.AccAddress, authAccPriv cryptotypes.PrivKey, sessionKeyId uint64) error {
+	return s.sendWithSessionKeyMsgs(authAcc, authAccPriv, sessionKeyId, 1)
+}
+
+// sendWithSessionKeyMsgs runs the ante handler and the post handler for a tx of numMsgs bank sends,
+// all authenticated by the session key.
+func (s *SessionKeyTest) sendWithSessionKeyMsgs(authAcc sdk.AccAddress, authAccPriv cryptotypes.PrivKey, sessionKeyId uint64, numMsgs int) error {
+	anteHandler := sdk.ChainAnteDecorators(s.AuthenticatorAnteDecorator)
+	postHandler := sdk.ChainPostDecorators(s.AuthenticatorPostDecorator)
+
+	msgs := make([]sdk.Msg, numMsgs)
+	selectedAuthenticators := make([]uint64, numMsgs)
+	for i := 0; i < numMsgs; i++ {
+		msgs[i] = &banktypes.MsgSend{
+			FromAddress: authAcc.String(),
+			ToAddress:   s.TestAccAddress[2].String(),
+			Amount:      sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(1000))),
+		}
+		selectedAuthenticators[i] = sessionKeyId
+	}
+
+	tx, err := s.GenSimpleTxWithSelectedAuthenticators(msgs, []cryptotypes.PrivKey{authAccPriv}, selectedAuthenticators)
+	s.Require().NoError(err)
+	tx = s.resign(tx, authAcc, authAccPriv)
+
+	cacheCtx, write := s.Ctx.CacheContext()
+	cacheCtx, err = anteHandler(cacheCtx, tx, false)
+	if err != nil {
+		return err
+	}
+
+	_, err = postHandler(cacheCtx, tx, false, true)
+	if err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
+
+// resign signs the tx again, since the selected authenticators are set after the tx is signed.
+func (s *SessionKeyTest) resign(tx sdk.Tx, signer sdk.AccAddress, signerPriv cryptotypes.PrivKey) sdk.Tx {
+	txConfig := s.EncodingConfig.TxConfig
+	txBuilder, err := txConfig.WrapTxBuilder(tx)
+	s.Require().NoError(err)
+
+	account := s.OsmosisApp.AccountKeeper.GetAccount(s.Ctx, signer)
+	signerData := authsigning.SignerData{
+		AccountNumber: account.GetAccountNumber(),
+		Sequence:      account.GetSequence(),
+	}
+	signBytes, err := authsigning.GetSignBytesAdapter(s.Ctx, txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_DIRECT, signerData, txBuilder.GetTx())
+	s.Require().NoError(err)
+	sig, err := signerPriv.Sign(signBytes)
+	s.Require().NoError(err)
+
+	err = txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   signerPriv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+		Sequence: account.GetSequence(),
+	})
+	s.Require().NoError(err)
+	return txBuilder.GetTx()
+}
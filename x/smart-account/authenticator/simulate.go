@@ -0,0 +1,67 @@
+package authenticator
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AuthenticationResult is the result of an authenticator for a request. For composite authenticators, it includes
+// the result of each sub-authenticator, so that the reason a composite authenticator rejected a request can be found.
+type AuthenticationResult struct {
+	AuthenticatorId   string
+	Type              string
+	Error             error
+	SubAuthenticators []AuthenticationResult
+}
+
+// SimulateAuthenticate calls Authenticate on the authenticator and on each of its sub-authenticators. All calls
+// run on cache contexts that are never written.
+//
+// The sub-authenticators of a composite authenticator are all called, even if the composite authenticator would
+// have stopped at the first one that rejected or accepted the request.
+func SimulateAuthenticate(ctx sdk.Context, auth Authenticator, request AuthenticationRequest) AuthenticationResult {
+	cacheCtx, _ := ctx.CacheContext()
+	result := AuthenticationResult{
+		AuthenticatorId: request.AuthenticatorId,
+		Type:            auth.Type(),
+		Error:           auth.Authenticate(cacheCtx, request),
+	}
+
+	var subAuthenticators []Authenticator
+	signatureAssignment := Single
+	skipEmptySignatures := false
+	switch a := auth.(type) {
+	case AllOf:
+		subAuthenticators, signatureAssignment = a.SubAuthenticators, a.signatureAssignment
+	case AnyOf:
+		subAuthenticators, signatureAssignment = a.SubAuthenticators, a.signatureAssignment
+	case Threshold:
+		subAuthenticators, signatureAssignment, skipEmptySignatures = a.SubAuthenticators, Partitioned, true
+	default:
+		return result
+	}
+
+	var signatures [][]byte
+	if signatureAssignment == Partitioned {
+		var err error
+		signatures, err = splitSignatures(request.Signature, len(subAuthenticators))
+		if err != nil {
+			// the composite authenticator fails with the same error, there is nothing to add
+			return result
+		}
+	}
+
+	baseId := request.AuthenticatorId
+	for i, subAuth := range subAuthenticators {
+		subRequest := request
+		subRequest.AuthenticatorId = compositeId(baseId, i)
+		if signatureAssignment == Partitioned {
+			subRequest.Signature = signatures[i]
+		}
+		if skipEmptySignatures && len(subRequest.Signature) == 0 {
+			continue
+		}
+		result.SubAuthenticators = append(result.SubAuthenticators, SimulateAuthenticate(ctx, subAuth, subRequest))
+	}
+
+	return result
+}
@@ -0,0 +1,368 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+)
+
+var _ Authenticator = &SpendLimit{}
+
+const (
+	// SpendLimitType represents a native authenticator that limits the value an account
+	// can send out of its balance within a period.
+	SpendLimitType = "SpendLimit"
+)
+
+// SpendLimitPeriod is the period after which the tracked spending of a SpendLimit is reset.
+type SpendLimitPeriod string
+
+const (
+	SpendLimitPeriodDay   SpendLimitPeriod = "day"
+	SpendLimitPeriodWeek  SpendLimitPeriod = "week"
+	SpendLimitPeriodMonth SpendLimitPeriod = "month"
+	SpendLimitPeriodYear  SpendLimitPeriod = "year"
+
+	// SpendLimitPeriodRolling tracks spending over a sliding window ending at the current block time
+	// instead of over calendar periods.
+	SpendLimitPeriodRolling SpendLimitPeriod = "rolling"
+)
+
+// SpendLimitPriceHop is a single step used to convert a denom into the quote denom.
+type SpendLimitPriceHop struct {
+	PoolId        uint64 `json:"pool_id,string"`
+	TokenOutDenom string `json:"token_out_denom"`
+}
+
+// SpendLimitPriceRoute describes the pools whose twap is used to price a denom in the quote denom.
+type SpendLimitPriceRoute struct {
+	Denom string               `json:"denom"`
+	Route []SpendLimitPriceHop `json:"route"`
+}
+
+// SpendLimitConfig is the configuration stored for a SpendLimit authenticator.
+type SpendLimitConfig struct {
+	// QuoteDenom is the denom in which the limit and all outflows are valued.
+	QuoteDenom string `json:"quote_denom"`
+	// Limit is the maximum value, in QuoteDenom, that can be spent within a period.
+	Limit osmomath.Int `json:"limit"`
+	// ResetPeriod is either a calendar period (day, week, month, year) in UTC or "rolling".
+	ResetPeriod SpendLimitPeriod `json:"reset_period"`
+	// RollingWindow is the length of the window when ResetPeriod is "rolling", e.g. "24h".
+	RollingWindow string `json:"rolling_window,omitempty"`
+	// TwapDuration is the length of the arithmetic twap used to price outflows, e.g. "1h".
+	TwapDuration string `json:"twap_duration"`
+	// PriceRoutes defines how each denom is priced. Outflows of denoms without a route are rejected.
+	PriceRoutes []SpendLimitPriceRoute `json:"price_routes"`
+}
+
+// SpendLimitEntry is a single spending record kept for rolling windows.
+type SpendLimitEntry struct {
+	Time   time.Time    `json:"time"`
+	Amount osmomath.Int `json:"amount"`
+}
+
+// SpendLimitState is the spending tracked for an account-authenticator pair.
+type SpendLimitState struct {
+	PeriodStart time.Time         `json:"period_start"`
+	Spent       osmomath.Int      `json:"spent"`
+	Entries     []SpendLimitEntry `json:"entries,omitempty"`
+}
+
+// SpendLimit tracks the value of the outflows of an account and rejects transactions in
+// ConfirmExecution once the configured limit is exceeded for the current period.
+//
+// The balances of the account are snapshotted in Track and compared with the balances after
+// execution in ConfirmExecution. Every decrease is valued in the quote denom using x/twap prices.
+// Fees are deducted before Track and are therefore not counted.
+//
+// SpendLimit does not verify signatures, so it is meant to be composed with a SignatureVerification
+// in an AllOf authenticator.
+type SpendLimit struct {
+	storeKey   storetypes.StoreKey
+	bankKeeper types.BankKeeper
+	twapKeeper types.TwapKeeper
+
+	config        SpendLimitConfig
+	rollingWindow time.Duration
+	twapDuration  time.Duration
+}
+
+// NewSpendLimit creates a new SpendLimit authenticator.
+func NewSpendLimit(storeKey storetypes.StoreKey, bankKeeper types.BankKeeper, twapKeeper types.TwapKeeper) SpendLimit {
+	return SpendLimit{
+		storeKey:   storeKey,
+		bankKeeper: bankKeeper,
+		twapKeeper: twapKeeper,
+	}
+}
+
+func (sl SpendLimit) Type() string {
+	return SpendLimitType
+}
+
+func (sl SpendLimit) StaticGas() uint64 {
+	return 0
+}
+
+// Initialize parses and validates the spend limit configuration.
+func (sl SpendLimit) Initialize(config []byte) (Authenticator, error) {
+	spendLimitConfig, rollingWindow, twapDuration, err := parseSpendLimitConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	sl.config = spendLimitConfig
+	sl.rollingWindow = rollingWindow
+	sl.twapDuration = twapDuration
+	return sl, nil
+}
+
+// Authenticate is a no-op. The limit can only be enforced once the effects of the transaction are known.
+func (sl SpendLimit) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+// Track stores a snapshot of the account balances before the messages are executed.
+func (sl SpendLimit) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	balances := sl.bankKeeper.GetAllBalances(ctx, request.Account)
+	bz, err := json.Marshal(balances)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to marshal pre-execution balances")
+	}
+	ctx.KVStore(sl.storeKey).Set(types.KeySpendLimitPreExecBalances(request.Account, request.AuthenticatorId), bz)
+	return nil
+}
+
+// ConfirmExecution values the outflows of the account since Track and fails if the spending for the
+// current period exceeds the limit.
+func (sl SpendLimit) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	store := ctx.KVStore(sl.storeKey)
+	preExecKey := types.KeySpendLimitPreExecBalances(request.Account, request.AuthenticatorId)
+	bz := store.Get(preExecKey)
+	if bz == nil {
+		// The outflows of the transaction have already been accounted for by a previous message
+		return nil
+	}
+	store.Delete(preExecKey)
+
+	var preExecBalances sdk.Coins
+	if err := json.Unmarshal(bz, &preExecBalances); err != nil {
+		return errorsmod.Wrap(err, "failed to unmarshal pre-execution balances")
+	}
+
+	postExecBalances := sl.bankKeeper.GetAllBalances(ctx, request.Account)
+	spent := osmomath.ZeroInt()
+	for _, preExecCoin := range preExecBalances {
+		postExecAmount := postExecBalances.AmountOf(preExecCoin.Denom)
+		if postExecAmount.GTE(preExecCoin.Amount) {
+			continue
+		}
+
+		value, err := sl.valueInQuoteDenom(ctx, sdk.NewCoin(preExecCoin.Denom, preExecCoin.Amount.Sub(postExecAmount)))
+		if err != nil {
+			return err
+		}
+		spent = spent.Add(value)
+	}
+
+	if spent.IsZero() {
+		return nil
+	}
+
+	state := sl.getState(ctx, request.Account, request.AuthenticatorId)
+	totalSpent := state.Spent.Add(spent)
+	if totalSpent.GT(sl.config.Limit) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"spend limit exceeded: %s%s already spent in the current period, %s%s requested, limit is %s%s",
+			state.Spent, sl.config.QuoteDenom, spent, sl.config.QuoteDenom, sl.config.Limit, sl.config.QuoteDenom,
+		)
+	}
+
+	state.Spent = totalSpent
+	if sl.config.ResetPeriod == SpendLimitPeriodRolling {
+		state.Entries = append(state.Entries, SpendLimitEntry{Time: ctx.BlockTime(), Amount: spent})
+	}
+	return sl.setState(ctx, request.Account, request.AuthenticatorId, state)
+}
+
+// OnAuthenticatorAdded validates the spend limit configuration.
+func (sl SpendLimit) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	_, _, _, err := parseSpendLimitConfig(config)
+	return err
+}
+
+// OnAuthenticatorRemoved deletes the spending tracked for the account-authenticator pair.
+func (sl SpendLimit) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	store := ctx.KVStore(sl.storeKey)
+	store.Delete(types.KeySpendLimitState(account, authenticatorId))
+	store.Delete(types.KeySpendLimitPreExecBalances(account, authenticatorId))
+	return nil
+}
+
+// GetSpent returns the value, in the quote denom, spent by the account in the current period.
+func (sl SpendLimit) GetSpent(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) osmomath.Int {
+	return sl.getState(ctx, account, authenticatorId).Spent
+}
+
+// valueInQuoteDenom converts a coin into the quote denom by multiplying the twap of every hop of its price route.
+// Coins that have no price route can't be valued and are rejected, so that they can't be spent past the limit.
+func (sl SpendLimit) valueInQuoteDenom(ctx sdk.Context, coin sdk.Coin) (osmomath.Int, error) {
+	if coin.Denom == sl.config.QuoteDenom {
+		return coin.Amount, nil
+	}
+
+	for _, priceRoute := range sl.config.PriceRoutes {
+		if priceRoute.Denom != coin.Denom {
+			continue
+		}
+
+		price := osmomath.OneDec()
+		baseDenom := coin.Denom
+		startTime := ctx.BlockTime().Add(-sl.twapDuration)
+		for _, hop := range priceRoute.Route {
+			twap, err := sl.twapKeeper.GetArithmeticTwapToNow(ctx, hop.PoolId, baseDenom, hop.TokenOutDenom, startTime)
+			if err != nil {
+				return osmomath.Int{}, errorsmod.Wrapf(err, "failed to price %s in %s (pool id = %d)", baseDenom, hop.TokenOutDenom, hop.PoolId)
+			}
+			price = price.Mul(twap)
+			baseDenom = hop.TokenOutDenom
+		}
+		return price.MulInt(coin.Amount).TruncateInt(), nil
+	}
+
+	return osmomath.Int{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "spend limit has no price route for denom %s", coin.Denom)
+}
+
+// getState returns the spending tracked for the current period. Spending from previous periods,
+// or outside the rolling window, is discarded.
+func (sl SpendLimit) getState(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) SpendLimitState {
+	now := ctx.BlockTime()
+	state := SpendLimitState{Spent: osmomath.ZeroInt()}
+
+	bz := ctx.KVStore(sl.storeKey).Get(types.KeySpendLimitState(account, authenticatorId))
+	if bz != nil {
+		// if we can't unmarshal, we start tracking from scratch
+		if err := json.Unmarshal(bz, &state); err != nil {
+			state = SpendLimitState{Spent: osmomath.ZeroInt()}
+		}
+	}
+
+	if sl.config.ResetPeriod == SpendLimitPeriodRolling {
+		windowStart := now.Add(-sl.rollingWindow)
+		state.PeriodStart = windowStart
+		state.Spent = osmomath.ZeroInt()
+
+		var entries []SpendLimitEntry
+		for _, entry := range state.Entries {
+			if entry.Time.After(windowStart) {
+				entries = append(entries, entry)
+				state.Spent = state.Spent.Add(entry.Amount)
+			}
+		}
+		state.Entries = entries
+		return state
+	}
+
+	periodStart := spendLimitPeriodStart(now, sl.config.ResetPeriod)
+	if !state.PeriodStart.Equal(periodStart) {
+		state = SpendLimitState{PeriodStart: periodStart, Spent: osmomath.ZeroInt()}
+	}
+	return state
+}
+
+func (sl SpendLimit) setState(ctx sdk.Context, account sdk.AccAddress, authenticatorId string, state SpendLimitState) error {
+	bz, err := json.Marshal(state)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to marshal spend limit state")
+	}
+	ctx.KVStore(sl.storeKey).Set(types.KeySpendLimitState(account, authenticatorId), bz)
+	return nil
+}
+
+// spendLimitPeriodStart returns the start, in UTC, of the calendar period containing t.
+// Weeks start on Monday.
+func spendLimitPeriodStart(t time.Time, period SpendLimitPeriod) time.Time {
+	t = t.UTC()
+	switch period {
+	case SpendLimitPeriodWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	case SpendLimitPeriodMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case SpendLimitPeriodYear:
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// parseSpendLimitConfig parses the configuration and checks that it is well-formed.
+func parseSpendLimitConfig(config []byte) (SpendLimitConfig, time.Duration, time.Duration, error) {
+	var spendLimitConfig SpendLimitConfig
+	if err := json.Unmarshal(config, &spendLimitConfig); err != nil {
+		return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(err, "failed to parse spend limit config")
+	}
+
+	if err := sdk.ValidateDenom(spendLimitConfig.QuoteDenom); err != nil {
+		return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(err, "invalid quote denom")
+	}
+
+	if spendLimitConfig.Limit.IsNil() || !spendLimitConfig.Limit.IsPositive() {
+		return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "spend limit must be positive")
+	}
+
+	var rollingWindow time.Duration
+	switch spendLimitConfig.ResetPeriod {
+	case SpendLimitPeriodDay, SpendLimitPeriodWeek, SpendLimitPeriodMonth, SpendLimitPeriodYear:
+	case SpendLimitPeriodRolling:
+		var err error
+		rollingWindow, err = time.ParseDuration(spendLimitConfig.RollingWindow)
+		if err != nil {
+			return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(err, "invalid rolling window")
+		}
+		if rollingWindow <= 0 {
+			return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "rolling window must be positive")
+		}
+	default:
+		return SpendLimitConfig{}, 0, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid reset period %s", spendLimitConfig.ResetPeriod)
+	}
+
+	twapDuration, err := time.ParseDuration(spendLimitConfig.TwapDuration)
+	if err != nil {
+		return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(err, "invalid twap duration")
+	}
+	if twapDuration <= 0 {
+		return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "twap duration must be positive")
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, priceRoute := range spendLimitConfig.PriceRoutes {
+		if priceRoute.Denom == spendLimitConfig.QuoteDenom {
+			return SpendLimitConfig{}, 0, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "quote denom %s must not have a price route", priceRoute.Denom)
+		}
+		if seenDenoms[priceRoute.Denom] {
+			return SpendLimitConfig{}, 0, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate price route for denom %s", priceRoute.Denom)
+		}
+		seenDenoms[priceRoute.Denom] = true
+
+		if len(priceRoute.Route) == 0 {
+			return SpendLimitConfig{}, 0, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "empty price route for denom %s", priceRoute.Denom)
+		}
+		lastHop := priceRoute.Route[len(priceRoute.Route)-1]
+		if lastHop.TokenOutDenom != spendLimitConfig.QuoteDenom {
+			return SpendLimitConfig{}, 0, 0, fmt.Errorf("price route for denom %s must end in quote denom %s, got %s", priceRoute.Denom, spendLimitConfig.QuoteDenom, lastHop.TokenOutDenom)
+		}
+	}
+
+	return spendLimitConfig, rollingWindow, twapDuration, nil
+}
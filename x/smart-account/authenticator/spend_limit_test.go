@@ -0,0 +1,331 @@
+package authenticator_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	appparams "github.com/osmosis-labs/osmosis/v31/app/params"
+	"github.com/osmosis-labs/osmosis/v31/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/ante"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/post"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/testutils"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+	txfeeskeeper "github.com/osmosis-labs/osmosis/v31/x/txfees/keeper"
+)
+
+type SpendLimitTest struct {
+	BaseAuthenticatorSuite
+
+	SpendLimit                 authenticator.SpendLimit
+	AlwaysPassAuth             testutils.TestingAuthenticator
+	AuthenticatorAnteDecorator ante.AuthenticatorDecorator
+	AuthenticatorPostDecorator post.AuthenticatorPostDecorator
+}
+
+func TestSpendLimitTest(t *testing.T) {
+	suite.Run(t, new(SpendLimitTest))
+}
+
+func (s *SpendLimitTest) SetupTest() {
+	s.SetupKeys()
+
+	s.Ctx = s.Ctx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
+	s.Ctx = s.Ctx.WithBlockTime(time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC))
+
+	s.SpendLimit = authenticator.NewSpendLimit(
+		s.OsmosisApp.GetKey(smartaccounttypes.StoreKey),
+		s.OsmosisApp.BankKeeper,
+		s.OsmosisApp.TwapKeeper,
+	)
+
+	s.AlwaysPassAuth = testutils.TestingAuthenticator{Approve: testutils.Always, Confirm: testutils.Always, GasConsumption: 0}
+	s.OsmosisApp.SmartAccountKeeper.AuthenticatorManager.RegisterAuthenticator(s.AlwaysPassAuth)
+
+	deductFeeDecorator := txfeeskeeper.NewDeductFeeDecorator(*s.OsmosisApp.TxFeesKeeper, s.OsmosisApp.AccountKeeper, s.OsmosisApp.BankKeeper, nil)
+	s.AuthenticatorAnteDecorator = ante.NewAuthenticatorDecorator(
+		s.OsmosisApp.AppCodec(),
+		s.OsmosisApp.SmartAccountKeeper,
+		s.OsmosisApp.AccountKeeper,
+		s.EncodingConfig.TxConfig.SignModeHandler(),
+		deductFeeDecorator,
+	)
+
+	s.AuthenticatorPostDecorator = post.NewAuthenticatorPostDecorator(
+		s.OsmosisApp.AppCodec(),
+		s.OsmosisApp.SmartAccountKeeper,
+		s.OsmosisApp.AccountKeeper,
+		s.EncodingConfig.TxConfig.SignModeHandler(),
+		sdk.ChainPostDecorators(sdk.Terminator{}), //nolint
+	)
+}
+
+func (s *SpendLimitTest) TearDownTest() {
+	os.RemoveAll(s.HomeDir)
+}
+
+func (s *SpendLimitTest) TestOnAuthenticatorAdded() {
+	tests := map[string]struct {
+		config      string
+		expectedErr string
+	}{
+		"valid calendar period": {
+			config: `{"quote_denom":"uusdc","limit":"100","reset_period":"day","twap_duration":"1h"}`,
+		},
+		"valid rolling period": {
+			config: `{"quote_denom":"uusdc","limit":"100","reset_period":"rolling","rolling_window":"24h","twap_duration":"1h"}`,
+		},
+		"valid price route": {
+			config: `{"quote_denom":"uusdc","limit":"100","reset_period":"week","twap_duration":"1h","price_routes":[{"denom":"uosmo","route":[{"pool_id":"1","token_out_denom":"uusdc"}]}]}`,
+		},
+		"invalid json": {
+			config:      `{"quote_denom":`,
+			expectedErr: "failed to parse spend limit config",
+		},
+		"zero limit": {
+			config:      `{"quote_denom":"uusdc","limit":"0","reset_period":"day","twap_duration":"1h"}`,
+			expectedErr: "spend limit must be positive",
+		},
+		"invalid reset period": {
+			config:      `{"quote_denom":"uusdc","limit":"100","reset_period":"fortnight","twap_duration":"1h"}`,
+			expectedErr: "invalid reset period fortnight",
+		},
+		"rolling period without window": {
+			config:      `{"quote_denom":"uusdc","limit":"100","reset_period":"rolling","twap_duration":"1h"}`,
+			expectedErr: "invalid rolling window",
+		},
+		"price route not ending in quote denom": {
+			config:      `{"quote_denom":"uusdc","limit":"100","reset_period":"day","twap_duration":"1h","price_routes":[{"denom":"uosmo","route":[{"pool_id":"1","token_out_denom":"uatom"}]}]}`,
+			expectedErr: "must end in quote denom uusdc",
+		},
+		"duplicate price route": {
+			config:      `{"quote_denom":"uusdc","limit":"100","reset_period":"day","twap_duration":"1h","price_routes":[{"denom":"uosmo","route":[{"pool_id":"1","token_out_denom":"uusdc"}]},{"denom":"uosmo","route":[{"pool_id":"2","token_out_denom":"uusdc"}]}]}`,
+			expectedErr: "duplicate price route for denom uosmo",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			err := s.SpendLimit.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], []byte(tc.config), "1")
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *SpendLimitTest) TestSpendLimit() {
+	usdcOsmoPoolId := s.preparePool(
+		[]balancer.PoolAsset{
+			{
+				Weight: osmomath.NewInt(100000),
+				Token:  sdk.NewCoin(UUSDC, osmomath.NewInt(1500000000)),
+			},
+			{
+				Weight: osmomath.NewInt(100000),
+				Token:  sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(1000000000)),
+			},
+		},
+	)
+
+	// increase time by 1hr to ensure twap price is available
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+
+	config := authenticator.SpendLimitConfig{
+		QuoteDenom:   UUSDC,
+		Limit:        osmomath.NewInt(3000),
+		ResetPeriod:  authenticator.SpendLimitPeriodDay,
+		TwapDuration: "1h",
+		PriceRoutes: []authenticator.SpendLimitPriceRoute{
+			{
+				Denom: appparams.BaseCoinUnit,
+				Route: []authenticator.SpendLimitPriceHop{{PoolId: usdcOsmoPoolId, TokenOutDenom: UUSDC}},
+			},
+		},
+	}
+	authAcc, authAccPriv, spendLimitId := s.addSpendLimit(config)
+
+	// 1000 uosmo is worth 1500 uusdc
+	_, err := s.sendWithSpendLimit(authAcc, authAccPriv, spendLimitId, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(1000))))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(1500), s.getSpent(config, authAcc, spendLimitId))
+
+	// 1500 uusdc reaches the limit exactly
+	_, err = s.sendWithSpendLimit(authAcc, authAccPriv, spendLimitId, sdk.NewCoins(sdk.NewCoin(UUSDC, osmomath.NewInt(1500))))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(3000), s.getSpent(config, authAcc, spendLimitId))
+
+	// any further outflow exceeds the limit
+	_, err = s.sendWithSpendLimit(authAcc, authAccPriv, spendLimitId, sdk.NewCoins(sdk.NewCoin(UUSDC, osmomath.NewInt(1))))
+	s.Require().ErrorContains(err, "spend limit exceeded")
+
+	// denoms without a price route can't be valued and are rejected
+	_, err = s.sendWithSpendLimit(authAcc, authAccPriv, spendLimitId, sdk.NewCoins(sdk.NewCoin("uion", osmomath.NewInt(1000))))
+	s.Require().ErrorContains(err, "spend limit has no price route for denom uion")
+
+	// the spending is reset in the next day
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour * 24))
+	s.Require().Equal(osmomath.ZeroInt(), s.getSpent(config, authAcc, spendLimitId))
+	_, err = s.sendWithSpendLimit(authAcc, authAccPriv, spendLimitId, sdk.NewCoins(sdk.NewCoin(UUSDC, osmomath.NewInt(1))))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(1), s.getSpent(config, authAcc, spendLimitId))
+}
+
+func (s *SpendLimitTest) TestSpendLimitRollingWindow() {
+	config := authenticator.SpendLimitConfig{
+		QuoteDenom:    UUSDC,
+		Limit:         osmomath.NewInt(1000),
+		ResetPeriod:   authenticator.SpendLimitPeriodRolling,
+		RollingWindow: "2h",
+		TwapDuration:  "1h",
+	}
+	authAcc, authAccPriv, spendLimitId := s.addSpendLimit(config)
+
+	_, err := s.sendWithSpendLimit(authAcc, authAccPriv, spendLimitId, sdk.NewCoins(sdk.NewCoin(UUSDC, osmomath.NewInt(600))))
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	_, err = s.sendWithSpendLimit(authAcc, authAccPriv, spendLimitId, sdk.NewCoins(sdk.NewCoin(UUSDC, osmomath.NewInt(400))))
+	s.Require().NoError(err)
+
+	// both outflows are still within the window
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute * 30))
+	_, err = s.sendWithSpendLimit(authAcc, authAccPriv, spendLimitId, sdk.NewCoins(sdk.NewCoin(UUSDC, osmomath.NewInt(1))))
+	s.Require().ErrorContains(err, "spend limit exceeded")
+
+	// the first outflow leaves the window
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute * 30))
+	s.Require().Equal(osmomath.NewInt(400), s.getSpent(config, authAcc, spendLimitId))
+	_, err = s.sendWithSpendLimit(authAcc, authAccPriv, spendLimitId, sdk.NewCoins(sdk.NewCoin(UUSDC, osmomath.NewInt(600))))
+	s.Require().NoError(err)
+}
+
+func (s *SpendLimitTest) TestSpendLimitRemoved() {
+	config := authenticator.SpendLimitConfig{
+		QuoteDenom:   UUSDC,
+		Limit:        osmomath.NewInt(1000),
+		ResetPeriod:  authenticator.SpendLimitPeriodMonth,
+		TwapDuration: "1h",
+	}
+	authAcc, authAccPriv, spendLimitId := s.addSpendLimit(config)
+
+	_, err := s.sendWithSpendLimit(authAcc, authAccPriv, spendLimitId, sdk.NewCoins(sdk.NewCoin(UUSDC, osmomath.NewInt(600))))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(600), s.getSpent(config, authAcc, spendLimitId))
+
+	err = s.OsmosisApp.SmartAccountKeeper.RemoveAuthenticator(s.Ctx, authAcc, spendLimitId)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.ZeroInt(), s.getSpent(config, authAcc, spendLimitId))
+}
+
+// addSpendLimit adds an always passing authenticator for the fee payer and a spend limit to a funded account.
+func (s *SpendLimitTest) addSpendLimit(config authenticator.SpendLimitConfig) (sdk.AccAddress, cryptotypes.PrivKey, uint64) {
+	sak := s.OsmosisApp.SmartAccountKeeper
+
+	authAcc := s.TestAccAddress[1]
+	authAccPriv := s.TestPrivKeys[1]
+
+	bz, err := json.Marshal(config)
+	s.Require().NoError(err)
+
+	_, err = sak.AddAuthenticator(s.Ctx, authAcc, s.AlwaysPassAuth.Type(), []byte{})
+	s.Require().NoError(err)
+
+	spendLimitId, err := sak.AddAuthenticator(s.Ctx, authAcc, authenticator.SpendLimitType, bz)
+	s.Require().NoError(err)
+
+	s.FundAcc(authAcc, sdk.NewCoins(
+		sdk.NewCoin(UUSDC, osmomath.NewInt(200000000000)),
+		sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(200000000000)),
+		sdk.NewCoin("uion", osmomath.NewInt(200000000000)),
+	))
+
+	return authAcc, authAccPriv, spendLimitId
+}
+
+// sendWithSpendLimit runs the ante handler, a bank send and the post handler for a tx whose send
+// message is authenticated by the spend limit.
+func (s *SpendLimitTest) sendWithSpendLimit(authAcc sdk.AccAddress, authAccPriv cryptotypes.PrivKey, spendLimitId uint64, amount sdk.Coins) (sdk.Context, error) {
+	anteHandler := sdk.ChainAnteDecorators(s.AuthenticatorAnteDecorator)
+	postHandler := sdk.ChainPostDecorators(s.AuthenticatorPostDecorator)
+
+	// a hack for setting fee payer
+	selfSend := banktypes.MsgSend{
+		FromAddress: authAcc.String(),
+		ToAddress:   authAcc.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(UUSDC, osmomath.NewInt(1))),
+	}
+	send := banktypes.MsgSend{
+		FromAddress: authAcc.String(),
+		ToAddress:   s.TestAccAddress[2].String(),
+		Amount:      amount,
+	}
+
+	tx, err := s.GenSimpleTxWithSelectedAuthenticators([]sdk.Msg{&selfSend, &send}, []cryptotypes.PrivKey{authAccPriv}, []uint64{1, spendLimitId})
+	s.Require().NoError(err)
+
+	cacheCtx, write := s.Ctx.CacheContext()
+	cacheCtx, err = anteHandler(cacheCtx, tx, false)
+	if err != nil {
+		return cacheCtx, err
+	}
+
+	_, err = s.OsmosisApp.MsgServiceRouter().Handler(&send)(cacheCtx, &send)
+	s.Require().NoError(err)
+
+	cacheCtx, err = postHandler(cacheCtx, tx, false, true)
+	if err != nil {
+		return cacheCtx, err
+	}
+
+	write()
+	return cacheCtx, nil
+}
+
+func (s *SpendLimitTest) getSpent(config authenticator.SpendLimitConfig, account sdk.AccAddress, spendLimitId uint64) osmomath.Int {
+	bz, err := json.Marshal(config)
+	s.Require().NoError(err)
+
+	initialized, err := s.SpendLimit.Initialize(bz)
+	s.Require().NoError(err)
+
+	spendLimit, ok := initialized.(authenticator.SpendLimit)
+	s.Require().True(ok)
+
+	return spendLimit.GetSpent(s.Ctx, account, fmt.Sprint(spendLimitId))
+}
+
+func (s *SpendLimitTest) preparePool(poolAssets []balancer.PoolAsset) uint64 {
+	poolCreator := s.TestAccAddress[0]
+
+	s.FundAcc(poolCreator, s.OsmosisApp.PoolManagerKeeper.GetParams(s.Ctx).PoolCreationFee)
+	for _, asset := range poolAssets {
+		s.FundAcc(poolCreator, sdk.NewCoins(asset.Token))
+	}
+
+	poolParams := balancer.PoolParams{
+		SwapFee: osmomath.ZeroDec(),
+		ExitFee: osmomath.ZeroDec(),
+	}
+
+	poolId, err := s.OsmosisApp.PoolManagerKeeper.CreatePool(
+		s.Ctx,
+		balancer.NewMsgCreateBalancerPool(poolCreator, poolParams, poolAssets, ""),
+	)
+	s.Require().NoError(err)
+
+	return poolId
+}
@@ -0,0 +1,218 @@
+package authenticator
+
+import (
+	"bytes"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Authenticator = &Threshold{}
+
+const (
+	// ThresholdType represents a weighted M-of-N multisig, where each key is a SignatureVerification
+	// sub-authenticator and a message is authenticated once the weights of the valid signatures reach the threshold.
+	ThresholdType = "Threshold"
+)
+
+// ThresholdConfig is the configuration stored for a Threshold authenticator.
+type ThresholdConfig struct {
+	// Threshold is the total weight of the signatures required to authenticate a message.
+	Threshold uint64 `json:"threshold,string"`
+	// SubAuthenticators are the keys of the multisig. They must be of type SignatureVerification.
+	SubAuthenticators []WeightedSubAuthenticatorInitData `json:"sub_authenticators"`
+}
+
+// WeightedSubAuthenticatorInitData is the initialization data of a sub-authenticator along with its weight.
+// The weight defaults to 1 if unset.
+type WeightedSubAuthenticatorInitData struct {
+	SubAuthenticatorInitData
+	Weight uint64 `json:"weight,omitempty,string"`
+}
+
+// Threshold authenticates a message when the signatures provided by its keys add up to the threshold.
+//
+// The signature of the transaction is a json encoded list with one signature per sub-authenticator,
+// like the signature of a partitioned composite authenticator. Keys that did not sign are left empty.
+type Threshold struct {
+	SubAuthenticators []Authenticator
+	Weights           []uint64
+	Threshold         uint64
+	am                *AuthenticatorManager
+}
+
+// NewThreshold creates a new Threshold authenticator.
+func NewThreshold(am *AuthenticatorManager) Threshold {
+	return Threshold{
+		am:                am,
+		SubAuthenticators: []Authenticator{},
+	}
+}
+
+func (t Threshold) Type() string {
+	return ThresholdType
+}
+
+func (t Threshold) StaticGas() uint64 {
+	var totalGas uint64
+	for _, auth := range t.SubAuthenticators {
+		totalGas += auth.StaticGas()
+	}
+	return totalGas
+}
+
+func (t Threshold) Initialize(config []byte) (Authenticator, error) {
+	thresholdConfig, err := parseThresholdConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	t.SubAuthenticators = []Authenticator{}
+	t.Weights = []uint64{}
+	for _, initData := range thresholdConfig.SubAuthenticators {
+		authenticatorCode := t.am.GetAuthenticatorByType(initData.Type)
+		if authenticatorCode == nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered in manager", initData.Type)
+		}
+		instance, err := authenticatorCode.Initialize(initData.Config)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to initialize sub-authenticator (type = %s)", initData.Type)
+		}
+		t.SubAuthenticators = append(t.SubAuthenticators, instance)
+		t.Weights = append(t.Weights, initData.Weight)
+	}
+	t.Threshold = thresholdConfig.Threshold
+
+	return t, nil
+}
+
+// Authenticate verifies every signature provided and checks that the weights of the signers reach the threshold.
+// An invalid signature fails the authentication, even if the other signatures reach the threshold.
+func (t Threshold) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	if len(t.SubAuthenticators) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no sub-authenticators provided")
+	}
+
+	signatures, err := splitSignatures(request.Signature, len(t.SubAuthenticators))
+	if err != nil {
+		return err
+	}
+
+	var totalWeight uint64
+	baseId := request.AuthenticatorId
+	for i, auth := range t.SubAuthenticators {
+		if len(signatures[i]) == 0 {
+			continue
+		}
+
+		// update the request to include the sub-authenticator id and signature
+		request.AuthenticatorId = compositeId(baseId, i)
+		request.Signature = signatures[i]
+		if err := auth.Authenticate(ctx, request); err != nil {
+			return errorsmod.Wrapf(err, "sub-authenticator failed to authenticate (sub-authenticator id = %s)", request.AuthenticatorId)
+		}
+		totalWeight += t.Weights[i]
+	}
+
+	if totalWeight < t.Threshold {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signatures weight %d is below the threshold %d", totalWeight, t.Threshold)
+	}
+	return nil
+}
+
+func (t Threshold) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	return subTrack(ctx, request, t.SubAuthenticators)
+}
+
+func (t Threshold) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	baseId := request.AuthenticatorId
+	for i, auth := range t.SubAuthenticators {
+		// update the authenticator id to include the sub-authenticator id
+		request.AuthenticatorId = compositeId(baseId, i)
+		if err := auth.ConfirmExecution(ctx, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t Threshold) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	thresholdConfig, err := parseThresholdConfig(config)
+	if err != nil {
+		return err
+	}
+
+	for id, initData := range thresholdConfig.SubAuthenticators {
+		authenticatorCode := t.am.GetAuthenticatorByType(initData.Type)
+		if authenticatorCode == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered in manager", initData.Type)
+		}
+		subId := compositeId(authenticatorId, id)
+		if err := authenticatorCode.OnAuthenticatorAdded(ctx, account, initData.Config, subId); err != nil {
+			return errorsmod.Wrapf(err, "sub-authenticator `OnAuthenticatorAdded` failed (sub-authenticator id = %s)", subId)
+		}
+	}
+	return nil
+}
+
+func (t Threshold) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	var thresholdConfig ThresholdConfig
+	if err := json.Unmarshal(config, &thresholdConfig); err != nil {
+		return err
+	}
+
+	for id, initData := range thresholdConfig.SubAuthenticators {
+		authenticatorCode := t.am.GetAuthenticatorByType(initData.Type)
+		if authenticatorCode == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered in manager", initData.Type)
+		}
+		subId := compositeId(authenticatorId, id)
+		if err := authenticatorCode.OnAuthenticatorRemoved(ctx, account, initData.Config, subId); err != nil {
+			return errorsmod.Wrapf(err, "sub-authenticator `OnAuthenticatorRemoved` failed (sub-authenticator id = %s)", subId)
+		}
+	}
+	return nil
+}
+
+// parseThresholdConfig parses the configuration of a Threshold authenticator, sets the default weights
+// and checks that the threshold can be reached.
+func parseThresholdConfig(config []byte) (ThresholdConfig, error) {
+	var thresholdConfig ThresholdConfig
+	if err := json.Unmarshal(config, &thresholdConfig); err != nil {
+		return ThresholdConfig{}, errorsmod.Wrap(err, "failed to parse threshold config")
+	}
+
+	if len(thresholdConfig.SubAuthenticators) <= 1 {
+		return ThresholdConfig{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "at least 2 sub-authenticators must be provided, but got %d", len(thresholdConfig.SubAuthenticators))
+	}
+	if thresholdConfig.Threshold == 0 {
+		return ThresholdConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "threshold must be positive")
+	}
+
+	var totalWeight uint64
+	for i, initData := range thresholdConfig.SubAuthenticators {
+		if initData.Type != SignatureVerificationType {
+			return ThresholdConfig{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticators must be of type %s, got %s", SignatureVerificationType, initData.Type)
+		}
+		for _, other := range thresholdConfig.SubAuthenticators[:i] {
+			if bytes.Equal(initData.Config, other.Config) {
+				return ThresholdConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "sub-authenticators must have distinct keys")
+			}
+		}
+		if initData.Weight == 0 {
+			thresholdConfig.SubAuthenticators[i].Weight = 1
+		}
+		totalWeight += thresholdConfig.SubAuthenticators[i].Weight
+		if totalWeight < thresholdConfig.SubAuthenticators[i].Weight {
+			return ThresholdConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "total weight overflows")
+		}
+	}
+	if thresholdConfig.Threshold > totalWeight {
+		return ThresholdConfig{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "threshold %d is above the total weight %d", thresholdConfig.Threshold, totalWeight)
+	}
+
+	return thresholdConfig, nil
+}
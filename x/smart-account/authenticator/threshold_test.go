@@ -0,0 +1,205 @@
+package authenticator_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/authenticator"
+)
+
+type ThresholdTest struct {
+	BaseAuthenticatorSuite
+
+	Threshold authenticator.Threshold
+}
+
+func TestThresholdTest(t *testing.T) {
+	suite.Run(t, new(ThresholdTest))
+}
+
+func (s *ThresholdTest) SetupTest() {
+	s.SetupKeys()
+	am := authenticator.NewAuthenticatorManager()
+
+	s.Threshold = authenticator.NewThreshold(am)
+	am.RegisterAuthenticator(s.Threshold)
+	am.RegisterAuthenticator(authenticator.NewSignatureVerification(s.OsmosisApp.AccountKeeper))
+	am.RegisterAuthenticator(authenticator.NewMessageFilter(s.EncodingConfig))
+}
+
+func (s *ThresholdTest) TearDownTest() {
+	os.RemoveAll(s.HomeDir)
+}
+
+func (s *ThresholdTest) TestOnAuthenticatorAdded() {
+	key := func(i int) authenticator.WeightedSubAuthenticatorInitData {
+		return authenticator.WeightedSubAuthenticatorInitData{
+			SubAuthenticatorInitData: authenticator.SubAuthenticatorInitData{
+				Type:   authenticator.SignatureVerificationType,
+				Config: s.TestPrivKeys[i].PubKey().Bytes(),
+			},
+		}
+	}
+	weighted := func(i int, weight uint64) authenticator.WeightedSubAuthenticatorInitData {
+		sub := key(i)
+		sub.Weight = weight
+		return sub
+	}
+
+	tests := map[string]struct {
+		config      authenticator.ThresholdConfig
+		expectedErr bool
+	}{
+		"2 of 3": {
+			config: authenticator.ThresholdConfig{Threshold: 2, SubAuthenticators: []authenticator.WeightedSubAuthenticatorInitData{key(0), key(1), key(2)}},
+		},
+		"weighted": {
+			config: authenticator.ThresholdConfig{Threshold: 3, SubAuthenticators: []authenticator.WeightedSubAuthenticatorInitData{weighted(0, 2), key(1)}},
+		},
+		"zero threshold": {
+			config:      authenticator.ThresholdConfig{Threshold: 0, SubAuthenticators: []authenticator.WeightedSubAuthenticatorInitData{key(0), key(1)}},
+			expectedErr: true,
+		},
+		"threshold above total weight": {
+			config:      authenticator.ThresholdConfig{Threshold: 4, SubAuthenticators: []authenticator.WeightedSubAuthenticatorInitData{weighted(0, 2), key(1)}},
+			expectedErr: true,
+		},
+		"single key": {
+			config:      authenticator.ThresholdConfig{Threshold: 1, SubAuthenticators: []authenticator.WeightedSubAuthenticatorInitData{key(0)}},
+			expectedErr: true,
+		},
+		"duplicate keys": {
+			config:      authenticator.ThresholdConfig{Threshold: 2, SubAuthenticators: []authenticator.WeightedSubAuthenticatorInitData{key(0), key(0)}},
+			expectedErr: true,
+		},
+		"not a signature verification": {
+			config: authenticator.ThresholdConfig{Threshold: 1, SubAuthenticators: []authenticator.WeightedSubAuthenticatorInitData{key(0), {
+				SubAuthenticatorInitData: authenticator.SubAuthenticatorInitData{
+					Type:   "MessageFilter",
+					Config: []byte(`{"@type":"/cosmos.bank.v1beta1.MsgSend"}`),
+				},
+			}}},
+			expectedErr: true,
+		},
+		"invalid key": {
+			config: authenticator.ThresholdConfig{Threshold: 1, SubAuthenticators: []authenticator.WeightedSubAuthenticatorInitData{key(0), {
+				SubAuthenticatorInitData: authenticator.SubAuthenticatorInitData{
+					Type:   authenticator.SignatureVerificationType,
+					Config: []byte("invalid"),
+				},
+			}}},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			bz, err := json.Marshal(tc.config)
+			s.Require().NoError(err)
+
+			err = s.Threshold.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], bz, "1")
+			if tc.expectedErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *ThresholdTest) TestAuthenticate() {
+	// keys 0, 1 and 2 have weights 2, 1 and 1, so key 0 alone or keys 1 and 2 together reach the threshold
+	config := authenticator.ThresholdConfig{Threshold: 2}
+	for i, weight := range []uint64{2, 1, 1} {
+		config.SubAuthenticators = append(config.SubAuthenticators, authenticator.WeightedSubAuthenticatorInitData{
+			SubAuthenticatorInitData: authenticator.SubAuthenticatorInitData{
+				Type:   authenticator.SignatureVerificationType,
+				Config: s.TestPrivKeys[i].PubKey().Bytes(),
+			},
+			Weight: weight,
+		})
+	}
+	bz, err := json.Marshal(config)
+	s.Require().NoError(err)
+	threshold, err := s.Threshold.Initialize(bz)
+	s.Require().NoError(err)
+
+	tests := map[string]struct {
+		signers     []int
+		badSigners  []int
+		expectedErr bool
+	}{
+		"heaviest key alone": {
+			signers: []int{0},
+		},
+		"all keys": {
+			signers: []int{0, 1, 2},
+		},
+		"two light keys": {
+			signers: []int{1, 2},
+		},
+		"light key alone": {
+			signers:     []int{1},
+			expectedErr: true,
+		},
+		"no signatures": {
+			expectedErr: true,
+		},
+		"invalid signature with enough valid signatures": {
+			signers:     []int{0},
+			badSigners:  []int{1},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			request := s.generateRequest()
+
+			signatures := make([][]byte, len(config.SubAuthenticators))
+			for _, i := range tc.signers {
+				signatures[i], err = s.TestPrivKeys[i].Sign(request.SignModeTxData.Direct)
+				s.Require().NoError(err)
+			}
+			for _, i := range tc.badSigners {
+				// sign with a key that does not belong to the sub-authenticator
+				signatures[i], err = s.TestPrivKeys[(i+1)%len(s.TestPrivKeys)].Sign(request.SignModeTxData.Direct)
+				s.Require().NoError(err)
+			}
+			request.Signature, err = json.Marshal(signatures)
+			s.Require().NoError(err)
+
+			err = threshold.Authenticate(s.Ctx, request)
+			if tc.expectedErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	// the number of signatures must match the number of keys
+	request := s.generateRequest()
+	sig, err := s.TestPrivKeys[0].Sign(request.SignModeTxData.Direct)
+	s.Require().NoError(err)
+	request.Signature, err = json.Marshal([][]byte{sig})
+	s.Require().NoError(err)
+	err = threshold.Authenticate(s.Ctx, request)
+	s.Require().ErrorContains(err, "invalid number of signatures")
+}
+
+func (s *ThresholdTest) generateRequest() authenticator.AuthenticationRequest {
+	msg := &bank.MsgSend{FromAddress: s.TestAccAddress[0].String(), ToAddress: s.TestAccAddress[1].String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("foo", 1))}
+	tx, err := s.GenSimpleTx([]sdk.Msg{msg}, []cryptotypes.PrivKey{s.TestPrivKeys[0]})
+	s.Require().NoError(err)
+
+	request, err := authenticator.GenerateAuthenticationRequest(s.Ctx, s.OsmosisApp.AppCodec(), s.OsmosisApp.AccountKeeper, s.EncodingConfig.TxConfig.SignModeHandler(), s.TestAccAddress[0], s.TestAccAddress[0], nil, sdk.NewCoins(), msg, tx, 0, false, authenticator.SequenceMatch)
+	s.Require().NoError(err)
+	return request
+}
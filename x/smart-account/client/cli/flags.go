@@ -0,0 +1,15 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagSelectedAuthenticators = "selected-authenticators"
+)
+
+func FlagSetSelectedAuthenticators() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagSelectedAuthenticators, "", "Comma separated authenticator ids to use for each message of the tx")
+	return fs
+}
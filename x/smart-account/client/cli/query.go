@@ -1,7 +1,12 @@
 package cli
 
 import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
@@ -12,6 +17,9 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAuthenticators)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAuthenticator)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdParams)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdSessionKeys)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPendingRecovery)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdSimulateAuthentication)
 
 	return cmd
 }
@@ -42,3 +50,60 @@ func GetCmdParams() (*osmocli.QueryDescriptor, *types.QueryParamsRequest) {
 {{.CommandPrefix}} params`,
 	}, &types.QueryParamsRequest{}
 }
+
+func GetCmdSessionKeys() (*osmocli.QueryDescriptor, *types.GetSessionKeysRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "session-keys",
+		Short: "Query the live session keys of an account",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`,
+	}, &types.GetSessionKeysRequest{}
+}
+
+func GetCmdPendingRecovery() (*osmocli.QueryDescriptor, *types.GetPendingRecoveryRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pending-recovery",
+		Short: "Query the pending recovery of an account",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`,
+	}, &types.GetPendingRecoveryRequest{}
+}
+
+func GetCmdSimulateAuthentication() (*osmocli.QueryDescriptor, *types.SimulateAuthenticationRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "simulate-authentication",
+		Short: "Query which authenticators accept or reject each message of a tx",
+		Long: `{{.Short}}
+The tx is base64 encoded, as returned by the tx encode command. If it is not signed, signatures are not verified.
+The selected authenticators of the tx extension are used unless --selected-authenticators is set.{{.ExampleHeader}}
+{{.CommandPrefix}} $(osmosisd tx encode tx.json) --selected-authenticators 1,2`,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*pflag.FlagSet{FlagSetSelectedAuthenticators()}},
+		CustomFlagOverrides: map[string]string{"SelectedAuthenticators": FlagSelectedAuthenticators},
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Tx":                     parseTxBytes,
+			"SelectedAuthenticators": parseSelectedAuthenticators,
+		},
+	}, &types.SimulateAuthenticationRequest{}
+}
+
+func parseTxBytes(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	txBytes, err := base64.StdEncoding.DecodeString(arg)
+	return txBytes, osmocli.UsedArg, err
+}
+
+func parseSelectedAuthenticators(_ string, flags *pflag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	flagValue, err := flags.GetString(FlagSelectedAuthenticators)
+	if err != nil || flagValue == "" {
+		return []uint64{}, osmocli.UsedFlag, err
+	}
+
+	var selectedAuthenticators []uint64
+	for _, id := range strings.Split(flagValue, ",") {
+		selectedAuthenticator, err := strconv.ParseUint(strings.TrimSpace(id), 10, 64)
+		if err != nil {
+			return nil, osmocli.UsedFlag, err
+		}
+		selectedAuthenticators = append(selectedAuthenticators, selectedAuthenticator)
+	}
+	return selectedAuthenticators, osmocli.UsedFlag, nil
+}
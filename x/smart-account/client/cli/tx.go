@@ -2,6 +2,8 @@ package cli
 
 import (
 	"encoding/base64"
+	"encoding/json"
+	"strconv"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -17,6 +19,8 @@ func NewTxCmd() *cobra.Command {
 	txCmd := osmocli.TxIndexCmd(types.ModuleName)
 	osmocli.AddTxCmd(txCmd, NewAddAuthentiactorCmd)
 	osmocli.AddTxCmd(txCmd, NewRemoveAuthentiactorCmd)
+	osmocli.AddTxCmd(txCmd, NewProposeRecoveryCmd)
+	osmocli.AddTxCmd(txCmd, NewCancelRecoveryCmd)
 	return txCmd
 }
 
@@ -47,6 +51,59 @@ func NewRemoveAuthentiactorCmd() (*osmocli.TxCliDesc, *types.MsgRemoveAuthentica
 	}, &types.MsgRemoveAuthenticator{}
 }
 
+func NewProposeRecoveryCmd() (*osmocli.TxCliDesc, *types.MsgProposeRecovery) {
+	return &osmocli.TxCliDesc{
+		Use:   "propose-recovery",
+		Short: "propose to replace the authenticators of an account as one of its guardians",
+		Long: `The new authenticators are a json list of authenticator types and base64 encoded configs.
+They replace every authenticator of the account except the Recovery authenticator once its delay has passed.`,
+		Example: `
+			osmosisd tx smartaccount propose-recovery osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj 3 \
+			'[{"type":"SignatureVerification","config":"<base64 pubkey>"}]' --from guardian \
+			--chain-id osmosis-1 -b sync --keyring-backend test \
+			--fees 1000uosmo
+		`,
+		NumArgs:          3,
+		ParseAndBuildMsg: BuildProposeRecoveryMsg,
+	}, &types.MsgProposeRecovery{}
+}
+
+func NewCancelRecoveryCmd() (*osmocli.TxCliDesc, *types.MsgCancelRecovery) {
+	return &osmocli.TxCliDesc{
+		Use:   "cancel-recovery",
+		Short: "cancel the pending recovery of an account",
+		Long:  "",
+		Example: `
+			osmosisd tx smartaccount cancel-recovery --from val \
+			--chain-id osmosis-1 -b sync --keyring-backend test \
+			--fees 1000uosmo
+		`,
+	}, &types.MsgCancelRecovery{}
+}
+
+func BuildProposeRecoveryMsg(
+	clientCtx client.Context,
+	args []string,
+	flags *pflag.FlagSet,
+) (sdk.Msg, error) {
+	authenticatorId, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var newAuthenticators []types.AuthenticatorInitData
+	if err := json.Unmarshal([]byte(args[2]), &newAuthenticators); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeRecovery{
+		Sender:            clientCtx.GetFromAddress().String(),
+		Account:           args[0],
+		AuthenticatorId:   authenticatorId,
+		NewAuthenticators: newAuthenticators,
+	}, nil
+}
+
 func BuildAddAuthenticatorMsg(
 	clientCtx client.Context,
 	args []string,
@@ -38,6 +38,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			}
 		}
 	}
+
+	for _, pendingRecovery := range genState.PendingRecoveries {
+		k.SetPendingRecovery(ctx, pendingRecovery)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -52,5 +56,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	genesis.AuthenticatorData = allAuthenticators
 
+	pendingRecoveries, err := k.GetAllPendingRecoveries(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.PendingRecoveries = pendingRecoveries
+
 	return genesis
 }
@@ -6,10 +6,12 @@ import (
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	gogotypes "github.com/cosmos/gogoproto/types"
 
@@ -28,21 +30,25 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 type Keeper struct {
 	storeKey                storetypes.StoreKey
-	cdc                     codec.BinaryCodec
+	cdc                     codec.Codec
 	paramSpace              paramtypes.Subspace
 	CircuitBreakerGovernor  sdk.AccAddress
 	isSmartAccountActiveBz  []byte
 	isSmartAccountActiveVal bool
+	accountKeeper           authante.AccountKeeper
+	txConfig                client.TxConfig
 
 	AuthenticatorManager *authenticator.AuthenticatorManager
 }
 
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	StoreKey storetypes.StoreKey,
 	govModuleAddr sdk.AccAddress,
 	ps paramtypes.Subspace,
 	authenticatorManager *authenticator.AuthenticatorManager,
+	accountKeeper authante.AccountKeeper,
+	txConfig client.TxConfig,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -55,6 +61,8 @@ func NewKeeper(
 		CircuitBreakerGovernor: govModuleAddr,
 		paramSpace:             ps,
 		AuthenticatorManager:   authenticatorManager,
+		accountKeeper:          accountKeeper,
+		txConfig:               txConfig,
 	}
 }
 
@@ -130,3 +130,63 @@ func (m msgServer) SetActiveState(goCtx context.Context, msg *types.MsgSetActive
 
 	return &types.MsgSetActiveStateResponse{}, nil
 }
+
+// ProposeRecovery proposes to replace the authenticators of an account. The sender must be a guardian of the
+// Recovery authenticator, and the recovery is executed once its delay has passed.
+func (m msgServer) ProposeRecovery(goCtx context.Context, msg *types.MsgProposeRecovery) (*types.MsgProposeRecoveryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	isSmartAccountActive := m.GetIsSmartAccountActive(ctx)
+	if !isSmartAccountActive {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "smartaccount module is not active")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+	account, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid account address")
+	}
+
+	pendingRecovery, err := m.Keeper.ProposeRecovery(ctx, sender, account, msg.AuthenticatorId, msg.NewAuthenticators)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtRecoveryProposed,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Account),
+			sdk.NewAttribute(types.AttributeKeyGuardian, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorId, strconv.FormatUint(msg.AuthenticatorId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecoveryTime, pendingRecovery.ExecuteAfter.String()),
+		),
+	})
+
+	return &types.MsgProposeRecoveryResponse{}, nil
+}
+
+// CancelRecovery cancels the pending recovery of the sender's account.
+func (m msgServer) CancelRecovery(goCtx context.Context, msg *types.MsgCancelRecovery) (*types.MsgCancelRecoveryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := m.Keeper.CancelRecovery(ctx, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtRecoveryCancelled,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Sender),
+		),
+	})
+
+	return &types.MsgCancelRecoveryResponse{}, nil
+}
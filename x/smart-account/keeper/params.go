@@ -20,6 +20,11 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
+// SetParam sets a specific smart account module's parameter with the provided parameter.
+func (k Keeper) SetParam(ctx sdk.Context, key []byte, value interface{}) {
+	k.paramSpace.Set(ctx, key, value)
+}
+
 // GetIsSmartAccountActive returns the value of the isSmartAccountActive parameter.
 // If the value has not been set, it will return false.
 // If there is an error unmarshalling the value, it will return false.
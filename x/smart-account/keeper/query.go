@@ -55,3 +55,69 @@ func (k Keeper) GetAuthenticator(
 
 	return &types.GetAuthenticatorResponse{AccountAuthenticator: authenticator}, nil
 }
+
+func (k Keeper) GetSessionKeys(
+	ctx context.Context,
+	request *types.GetSessionKeysRequest,
+) (*types.GetSessionKeysResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	acc, err := sdk.AccAddressFromBech32(request.Account)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sessionKeys, err := k.GetLiveSessionKeys(sdkCtx, acc)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.GetSessionKeysResponse{SessionKeys: sessionKeys}, nil
+}
+
+func (k Keeper) GetPendingRecovery(
+	ctx context.Context,
+	request *types.GetPendingRecoveryRequest,
+) (*types.GetPendingRecoveryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	acc, err := sdk.AccAddressFromBech32(request.Account)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pendingRecovery, found := k.GetAccountPendingRecovery(sdkCtx, acc)
+	if !found {
+		return &types.GetPendingRecoveryResponse{}, nil
+	}
+
+	return &types.GetPendingRecoveryResponse{PendingRecovery: &pendingRecovery}, nil
+}
+
+func (k Keeper) SimulateAuthentication(
+	ctx context.Context,
+	request *types.SimulateAuthenticationRequest,
+) (*types.SimulateAuthenticationResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	tx, err := k.txConfig.TxDecoder()(request.Tx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response, err := k.SimulateTxAuthentication(sdkCtx, tx, request.SelectedAuthenticators)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return response, nil
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+)
+
+// ProposeRecovery stores a recovery of the account proposed by a guardian of its Recovery authenticator.
+// The recovery is executed at the end of the first block after the delay of the authenticator, unless the
+// account cancels it. An account can only have one pending recovery.
+func (k Keeper) ProposeRecovery(
+	ctx sdk.Context,
+	guardian sdk.AccAddress,
+	account sdk.AccAddress,
+	authenticatorId uint64,
+	newAuthenticators []types.AuthenticatorInitData,
+) (types.PendingRecovery, error) {
+	accountAuthenticator, err := k.GetSelectedAuthenticatorData(ctx, account, int(authenticatorId))
+	if err != nil {
+		return types.PendingRecovery{}, err
+	}
+	if accountAuthenticator.Type != authenticator.RecoveryType {
+		return types.PendingRecovery{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "authenticator %d is not a %s authenticator", authenticatorId, authenticator.RecoveryType)
+	}
+	config, err := authenticator.ParseRecoveryConfig(accountAuthenticator.Config)
+	if err != nil {
+		return types.PendingRecovery{}, err
+	}
+	if !config.IsGuardian(guardian) {
+		return types.PendingRecovery{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a guardian of authenticator %d", guardian, authenticatorId)
+	}
+	if _, found := k.GetAccountPendingRecovery(ctx, account); found {
+		return types.PendingRecovery{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already has a pending recovery", account)
+	}
+
+	// make sure the new authenticators can be added, the account owner has the delay to check them anyway
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.replaceAuthenticators(cacheCtx, account, authenticatorId, newAuthenticators); err != nil {
+		return types.PendingRecovery{}, err
+	}
+
+	pendingRecovery := types.PendingRecovery{
+		Account:           account.String(),
+		AuthenticatorId:   authenticatorId,
+		Guardian:          guardian.String(),
+		NewAuthenticators: newAuthenticators,
+		ExecuteAfter:      ctx.BlockTime().Add(config.Delay()),
+	}
+	k.SetPendingRecovery(ctx, pendingRecovery)
+	return pendingRecovery, nil
+}
+
+// CancelRecovery deletes the pending recovery of the account.
+func (k Keeper) CancelRecovery(ctx sdk.Context, account sdk.AccAddress) error {
+	if _, found := k.GetAccountPendingRecovery(ctx, account); !found {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "account %s has no pending recovery", account)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.KeyPendingRecovery(account))
+	return nil
+}
+
+// ExecuteRecoveries replaces the authenticators of the accounts whose pending recovery reached its execution time.
+// A recovery that fails to execute is dropped, and the guardians can propose a new one.
+// At most MaxRecoveriesPerBlock queued recoveries are handled per block, the earliest first. The others stay in
+// the recovery queue, so the next blocks resume with them.
+func (k Keeper) ExecuteRecoveries(ctx sdk.Context) {
+	var maxRecoveriesPerBlock uint64
+	k.paramSpace.Get(ctx, types.KeyMaxRecoveriesPerBlock, &maxRecoveriesPerBlock)
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyRecoveryQueuePrefixId()
+	end := types.BuildKey(types.KeyRecoveryQueuePrefix, sdk.FormatTimeString(ctx.BlockTime()), "~")
+
+	var queuedKeys [][]byte
+	iterator := store.Iterator(prefix, end)
+	for ; iterator.Valid() && uint64(len(queuedKeys)) < maxRecoveriesPerBlock; iterator.Next() {
+		queuedKeys = append(queuedKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range queuedKeys {
+		store.Delete(key)
+
+		// key format: 8|<execute after>|<account>|
+		elements := strings.Split(string(key), types.KeySeparator)
+		if len(elements) < 3 {
+			continue
+		}
+		account, err := sdk.AccAddressFromBech32(elements[2])
+		if err != nil {
+			continue
+		}
+
+		// the recovery may have been cancelled, or cancelled and proposed again with a later execution time
+		pendingRecovery, found := k.GetAccountPendingRecovery(ctx, account)
+		if !found || sdk.FormatTimeString(pendingRecovery.ExecuteAfter) != elements[1] {
+			continue
+		}
+		store.Delete(types.KeyPendingRecovery(account))
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.replaceAuthenticators(cacheCtx, account, pendingRecovery.AuthenticatorId, pendingRecovery.NewAuthenticators); err != nil {
+			k.Logger(ctx).Error("failed to execute recovery", "account", account, "authenticatorId", pendingRecovery.AuthenticatorId, "error", err)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtRecoveryExecuted,
+			sdk.NewAttribute(types.AttributeKeyAccount, pendingRecovery.Account),
+			sdk.NewAttribute(types.AttributeKeyGuardian, pendingRecovery.Guardian),
+		))
+	}
+}
+
+// replaceAuthenticators removes every authenticator of the account except the Recovery authenticator, then adds
+// the new authenticators.
+func (k Keeper) replaceAuthenticators(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	recoveryAuthenticatorId uint64,
+	newAuthenticators []types.AuthenticatorInitData,
+) error {
+	accountAuthenticators, err := k.GetAuthenticatorDataForAccount(ctx, account)
+	if err != nil {
+		return err
+	}
+	for _, accountAuthenticator := range accountAuthenticators {
+		if accountAuthenticator.Id == recoveryAuthenticatorId {
+			continue
+		}
+		if err := k.RemoveAuthenticator(ctx, account, accountAuthenticator.Id); err != nil {
+			return err
+		}
+	}
+
+	for _, newAuthenticator := range newAuthenticators {
+		if _, err := k.AddAuthenticator(ctx, account, newAuthenticator.Type, newAuthenticator.Config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAccountPendingRecovery returns the pending recovery of the account, if any.
+func (k Keeper) GetAccountPendingRecovery(ctx sdk.Context, account sdk.AccAddress) (types.PendingRecovery, bool) {
+	var pendingRecovery types.PendingRecovery
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPendingRecovery(account), &pendingRecovery)
+	if err != nil {
+		panic(err)
+	}
+	return pendingRecovery, found
+}
+
+// SetPendingRecovery stores the pending recovery of an account and queues its execution.
+func (k Keeper) SetPendingRecovery(ctx sdk.Context, pendingRecovery types.PendingRecovery) {
+	account := sdk.MustAccAddressFromBech32(pendingRecovery.Account)
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyPendingRecovery(account), &pendingRecovery)
+	store.Set(types.KeyRecoveryQueue(pendingRecovery.ExecuteAfter, account), []byte{})
+}
+
+// GetAllPendingRecoveries is used in genesis export to export the pending recoveries of all accounts.
+func (k Keeper) GetAllPendingRecoveries(ctx sdk.Context) ([]types.PendingRecovery, error) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPendingRecoveryPrefixId())
+	defer iterator.Close()
+
+	pendingRecoveries := []types.PendingRecovery{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingRecovery types.PendingRecovery
+		if err := k.cdc.Unmarshal(iterator.Value(), &pendingRecovery); err != nil {
+			return nil, err
+		}
+		pendingRecoveries = append(pendingRecoveries, pendingRecovery)
+	}
+	return pendingRecoveries, nil
+}
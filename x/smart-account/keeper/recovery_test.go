@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v31/app/apptesting"
+	smartaccount "github.com/osmosis-labs/osmosis/v31/x/smart-account"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+)
+
+func (s *KeeperTestSuite) addRecovery(account sdk.AccAddress, config authenticator.RecoveryConfig) uint64 {
+	bz, err := json.Marshal(config)
+	s.Require().NoError(err)
+
+	id, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, account, authenticator.RecoveryType, bz)
+	s.Require().NoError(err)
+	return id
+}
+
+func (s *KeeperTestSuite) TestKeeper_ProposeRecovery() {
+	account, guardian, stranger := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+	sak := s.App.SmartAccountKeeper
+
+	oldKeyId, err := sak.AddAuthenticator(s.Ctx, account, authenticator.SignatureVerificationType, secp256k1.GenPrivKey().PubKey().Bytes())
+	s.Require().NoError(err)
+	recoveryId := s.addRecovery(account, authenticator.RecoveryConfig{Guardians: []string{guardian.String()}, DelaySeconds: 3600})
+
+	newAuthenticators := []types.AuthenticatorInitData{{
+		Type:   authenticator.SignatureVerificationType,
+		Config: secp256k1.GenPrivKey().PubKey().Bytes(),
+	}}
+
+	// the cases run in order, so the valid proposal is pending for the last one
+	tests := []struct {
+		name              string
+		guardian          sdk.AccAddress
+		authenticatorId   uint64
+		newAuthenticators []types.AuthenticatorInitData
+		expectedErr       string
+	}{
+		{
+			name:              "not a guardian",
+			guardian:          stranger,
+			authenticatorId:   recoveryId,
+			newAuthenticators: newAuthenticators,
+			expectedErr:       "is not a guardian",
+		},
+		{
+			name:              "not a recovery authenticator",
+			guardian:          guardian,
+			authenticatorId:   oldKeyId,
+			newAuthenticators: newAuthenticators,
+			expectedErr:       "is not a Recovery authenticator",
+		},
+		{
+			name:              "invalid new authenticator",
+			guardian:          guardian,
+			authenticatorId:   recoveryId,
+			newAuthenticators: []types.AuthenticatorInitData{{Type: authenticator.SignatureVerificationType, Config: []byte("invalid")}},
+			expectedErr:       "invalid secp256k1 public key size",
+		},
+		{
+			name:              "valid proposal",
+			guardian:          guardian,
+			authenticatorId:   recoveryId,
+			newAuthenticators: newAuthenticators,
+		},
+		{
+			name:              "recovery already pending",
+			guardian:          guardian,
+			authenticatorId:   recoveryId,
+			newAuthenticators: newAuthenticators,
+			expectedErr:       "already has a pending recovery",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			_, err := sak.ProposeRecovery(s.Ctx, tc.guardian, account, tc.authenticatorId, tc.newAuthenticators)
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	// the authenticators are untouched until the recovery is executed
+	authenticators, err := sak.GetAuthenticatorDataForAccount(s.Ctx, account)
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 2)
+
+	res, err := sak.GetPendingRecovery(s.Ctx, &types.GetPendingRecoveryRequest{Account: account.String()})
+	s.Require().NoError(err)
+	s.Require().NotNil(res.PendingRecovery)
+	s.Require().Equal(guardian.String(), res.PendingRecovery.Guardian)
+	s.Require().Equal(recoveryId, res.PendingRecovery.AuthenticatorId)
+	s.Require().Equal(s.Ctx.BlockTime().Add(time.Hour), res.PendingRecovery.ExecuteAfter)
+}
+
+func (s *KeeperTestSuite) TestKeeper_ExecuteRecoveries() {
+	account, guardian := s.TestAccs[0], s.TestAccs[1]
+	sak := s.App.SmartAccountKeeper
+	blockTime := s.Ctx.BlockTime()
+
+	_, err := sak.AddAuthenticator(s.Ctx, account, authenticator.SignatureVerificationType, secp256k1.GenPrivKey().PubKey().Bytes())
+	s.Require().NoError(err)
+	recoveryId := s.addRecovery(account, authenticator.RecoveryConfig{Guardians: []string{guardian.String()}, DelaySeconds: 3600})
+
+	newKey := secp256k1.GenPrivKey().PubKey().Bytes()
+	newAuthenticators := []types.AuthenticatorInitData{{Type: authenticator.SignatureVerificationType, Config: newKey}}
+	_, err = sak.ProposeRecovery(s.Ctx, guardian, account, recoveryId, newAuthenticators)
+	s.Require().NoError(err)
+
+	// the owner cancels the recovery, which is not executed once the delay has passed
+	s.Require().NoError(sak.CancelRecovery(s.Ctx, account))
+	s.Require().Error(sak.CancelRecovery(s.Ctx, account))
+
+	s.Ctx = s.Ctx.WithBlockTime(blockTime.Add(30 * time.Minute))
+	_, err = sak.ProposeRecovery(s.Ctx, guardian, account, recoveryId, newAuthenticators)
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockTime(blockTime.Add(time.Hour))
+	sak.ExecuteRecoveries(s.Ctx)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtRecoveryExecuted, 0)
+	authenticators, err := sak.GetAuthenticatorDataForAccount(s.Ctx, account)
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 2)
+
+	// the second proposal is executed after its own delay, and only the recovery authenticator is kept
+	s.Ctx = s.Ctx.WithBlockTime(blockTime.Add(90 * time.Minute))
+	sak.ExecuteRecoveries(s.Ctx)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtRecoveryExecuted, 1)
+	authenticators, err = sak.GetAuthenticatorDataForAccount(s.Ctx, account)
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 2)
+	s.Require().Equal(recoveryId, authenticators[0].Id)
+	s.Require().Equal(authenticator.SignatureVerificationType, authenticators[1].Type)
+	s.Require().Equal(newKey, authenticators[1].Config)
+
+	_, found := sak.GetAccountPendingRecovery(s.Ctx, account)
+	s.Require().False(found)
+
+	// removing the recovery authenticator drops its pending recovery
+	_, err = sak.ProposeRecovery(s.Ctx, guardian, account, recoveryId, newAuthenticators)
+	s.Require().NoError(err)
+	s.Require().NoError(sak.RemoveAuthenticator(s.Ctx, account, recoveryId))
+	_, found = sak.GetAccountPendingRecovery(s.Ctx, account)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestKeeper_ExecuteRecoveries_PerBlockLimit() {
+	guardian := s.TestAccs[0]
+	accounts := apptesting.CreateRandomAccounts(3)
+	sak := s.App.SmartAccountKeeper
+	blockTime := s.Ctx.BlockTime()
+	sak.SetParam(s.Ctx, types.KeyMaxRecoveriesPerBlock, uint64(2))
+
+	newAuthenticators := []types.AuthenticatorInitData{{Type: authenticator.SignatureVerificationType, Config: secp256k1.GenPrivKey().PubKey().Bytes()}}
+	for _, account := range accounts {
+		recoveryId := s.addRecovery(account, authenticator.RecoveryConfig{Guardians: []string{guardian.String()}, DelaySeconds: 3600})
+		_, err := sak.ProposeRecovery(s.Ctx, guardian, account, recoveryId, newAuthenticators)
+		s.Require().NoError(err)
+	}
+
+	// only two of the recoveries sharing the same execution time are executed in the first block
+	s.Ctx = s.Ctx.WithBlockTime(blockTime.Add(time.Hour))
+	sak.ExecuteRecoveries(s.Ctx)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtRecoveryExecuted, 2)
+	pendingRecoveries := 0
+	for _, account := range accounts {
+		if _, found := sak.GetAccountPendingRecovery(s.Ctx, account); found {
+			pendingRecoveries++
+		}
+	}
+	s.Require().Equal(1, pendingRecoveries)
+
+	// the next block resumes with the remaining one
+	s.Ctx = s.Ctx.WithBlockTime(blockTime.Add(time.Hour + time.Second)).WithEventManager(sdk.NewEventManager())
+	sak.ExecuteRecoveries(s.Ctx)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtRecoveryExecuted, 1)
+	for _, account := range accounts {
+		_, found := sak.GetAccountPendingRecovery(s.Ctx, account)
+		s.Require().False(found)
+	}
+}
+
+func (s *KeeperTestSuite) TestKeeper_PendingRecoveryGenesis() {
+	account, guardian := s.TestAccs[0], s.TestAccs[1]
+	sak := s.App.SmartAccountKeeper
+
+	recoveryId := s.addRecovery(account, authenticator.RecoveryConfig{Guardians: []string{guardian.String()}, DelaySeconds: 3600})
+	pendingRecovery, err := sak.ProposeRecovery(s.Ctx, guardian, account, recoveryId, []types.AuthenticatorInitData{{
+		Type:   authenticator.SignatureVerificationType,
+		Config: secp256k1.GenPrivKey().PubKey().Bytes(),
+	}})
+	s.Require().NoError(err)
+
+	genesis := smartaccount.ExportGenesis(s.Ctx, *sak)
+	s.Require().Len(genesis.PendingRecoveries, 1)
+	s.Require().NoError(genesis.Validate())
+
+	s.Reset()
+	sak = s.App.SmartAccountKeeper
+	smartaccount.InitGenesis(s.Ctx, *sak, *genesis)
+
+	imported, found := sak.GetAccountPendingRecovery(s.Ctx, account)
+	s.Require().True(found)
+	s.Require().Equal(pendingRecovery, imported)
+
+	// the imported recovery is queued for execution
+	s.Ctx = s.Ctx.WithBlockTime(pendingRecovery.ExecuteAfter)
+	sak.ExecuteRecoveries(s.Ctx)
+	_, found = sak.GetAccountPendingRecovery(s.Ctx, account)
+	s.Require().False(found)
+	authenticators, err := sak.GetAuthenticatorDataForAccount(s.Ctx, account)
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 2)
+}
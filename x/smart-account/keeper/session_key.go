@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+)
+
+// RemoveExpiredSessionKeys removes the SessionKey authenticators that expired or ran out of uses
+// up to the current block time. Removal goes through RemoveAuthenticator so OnAuthenticatorRemoved
+// is called as if the owner removed them.
+//
+// At most MaxExpiredSessionKeysPerBlock sessions are removed per block, the earliest expired first.
+// The others stay in the expiration queue, so the next blocks resume with them.
+//
+// Sessions that are sub-authenticators of a composite authenticator are not removed, since that
+// would remove the whole composite. They simply stop authenticating.
+func (k Keeper) RemoveExpiredSessionKeys(ctx sdk.Context) {
+	var maxExpiredSessionKeysPerBlock uint64
+	k.paramSpace.Get(ctx, types.KeyMaxExpiredSessionKeysPerBlock, &maxExpiredSessionKeysPerBlock)
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeySessionKeyExpirationPrefixId()
+	end := types.BuildKey(types.KeySessionKeyExpirationPrefix, sdk.FormatTimeString(ctx.BlockTime()), "~")
+
+	var expiredKeys [][]byte
+	iterator := store.Iterator(prefix, end)
+	for ; iterator.Valid() && uint64(len(expiredKeys)) < maxExpiredSessionKeysPerBlock; iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range expiredKeys {
+		store.Delete(key)
+
+		// key format: 6|<expiration>|<account>|<authenticator id>|
+		elements := strings.Split(string(key), types.KeySeparator)
+		if len(elements) < 4 {
+			continue
+		}
+		account, err := sdk.AccAddressFromBech32(elements[2])
+		if err != nil {
+			continue
+		}
+		authenticatorId, err := strconv.ParseUint(elements[3], 10, 64)
+		if err != nil {
+			// composite ids such as 1.0 are not top-level authenticators
+			continue
+		}
+		if !store.Has(types.KeyAccountId(account, authenticatorId)) {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.RemoveAuthenticator(cacheCtx, account, authenticatorId); err != nil {
+			k.Logger(ctx).Error("failed to remove expired session key", "account", account, "authenticatorId", authenticatorId, "error", err)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtSessionKeyRemoved,
+			sdk.NewAttribute(types.AttributeKeyAccount, account.String()),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorId, elements[3]),
+		))
+	}
+}
+
+// GetLiveSessionKeys returns the top-level SessionKey authenticators of an account that can
+// currently authenticate messages.
+func (k Keeper) GetLiveSessionKeys(ctx sdk.Context, account sdk.AccAddress) ([]types.SessionKeyInfo, error) {
+	accountAuthenticators, err := k.GetAuthenticatorDataForAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockTime()
+	sessionKeys := []types.SessionKeyInfo{}
+	for _, accountAuthenticator := range accountAuthenticators {
+		if accountAuthenticator.Type != authenticator.SessionKeyType {
+			continue
+		}
+
+		config, err := authenticator.ParseSessionKeyConfig(accountAuthenticator.Config)
+		if err != nil {
+			return nil, err
+		}
+		if blockTime.Before(config.NotBefore) || !blockTime.Before(config.NotAfter) {
+			continue
+		}
+
+		uses := authenticator.GetSessionKeyUses(store, account, strconv.FormatUint(accountAuthenticator.Id, 10))
+		if config.MaxUses > 0 && uses >= config.MaxUses {
+			continue
+		}
+
+		sessionKeys = append(sessionKeys, types.SessionKeyInfo{
+			AuthenticatorId: accountAuthenticator.Id,
+			PubKey:          config.PubKey,
+			NotBefore:       config.NotBefore,
+			NotAfter:        config.NotAfter,
+			MaxUses:         config.MaxUses,
+			Uses:            uses,
+			AllowedMsgTypes: config.AllowedMsgTypes,
+		})
+	}
+
+	return sessionKeys, nil
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+)
+
+func (s *KeeperTestSuite) addSessionKey(account sdk.AccAddress, config authenticator.SessionKeyConfig) uint64 {
+	bz, err := json.Marshal(config)
+	s.Require().NoError(err)
+
+	id, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, account, authenticator.SessionKeyType, bz)
+	s.Require().NoError(err)
+	return id
+}
+
+func (s *KeeperTestSuite) TestKeeper_RemoveExpiredSessionKeys() {
+	account := s.TestAccs[0]
+	pubKey := secp256k1.GenPrivKey().PubKey().Bytes()
+	blockTime := s.Ctx.BlockTime()
+
+	shortSessionId := s.addSessionKey(account, authenticator.SessionKeyConfig{PubKey: pubKey, NotAfter: blockTime.Add(time.Hour)})
+	longSessionId := s.addSessionKey(account, authenticator.SessionKeyConfig{PubKey: pubKey, NotAfter: blockTime.Add(2 * time.Hour)})
+
+	// nothing has expired yet
+	s.App.SmartAccountKeeper.RemoveExpiredSessionKeys(s.Ctx)
+	authenticators, err := s.App.SmartAccountKeeper.GetAuthenticatorDataForAccount(s.Ctx, account)
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 2)
+
+	s.Ctx = s.Ctx.WithBlockTime(blockTime.Add(time.Hour))
+	s.App.SmartAccountKeeper.RemoveExpiredSessionKeys(s.Ctx)
+	authenticators, err = s.App.SmartAccountKeeper.GetAuthenticatorDataForAccount(s.Ctx, account)
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 1)
+	s.Require().Equal(longSessionId, authenticators[0].Id)
+	s.Require().NotEqual(shortSessionId, authenticators[0].Id)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtSessionKeyRemoved, 1)
+
+	// removing the session before it expires also drops it from the expiration queue
+	err = s.App.SmartAccountKeeper.RemoveAuthenticator(s.Ctx, account, longSessionId)
+	s.Require().NoError(err)
+	iterator := storetypes.KVStorePrefixIterator(s.Ctx.KVStore(s.App.GetKey(types.StoreKey)), types.KeySessionKeyExpirationPrefixId())
+	defer iterator.Close()
+	s.Require().False(iterator.Valid())
+}
+
+func (s *KeeperTestSuite) TestKeeper_RemoveExpiredSessionKeys_PerBlockLimit() {
+	account := s.TestAccs[0]
+	pubKey := secp256k1.GenPrivKey().PubKey().Bytes()
+	blockTime := s.Ctx.BlockTime()
+	s.App.SmartAccountKeeper.SetParam(s.Ctx, types.KeyMaxExpiredSessionKeysPerBlock, uint64(2))
+
+	for i := 0; i < 3; i++ {
+		s.addSessionKey(account, authenticator.SessionKeyConfig{PubKey: pubKey, NotAfter: blockTime.Add(time.Hour)})
+	}
+
+	// only two of the sessions sharing the same expiration are removed in the first block
+	s.Ctx = s.Ctx.WithBlockTime(blockTime.Add(time.Hour))
+	s.App.SmartAccountKeeper.RemoveExpiredSessionKeys(s.Ctx)
+	authenticators, err := s.App.SmartAccountKeeper.GetAuthenticatorDataForAccount(s.Ctx, account)
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 1)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtSessionKeyRemoved, 2)
+
+	// the next block resumes with the remaining one
+	s.Ctx = s.Ctx.WithBlockTime(blockTime.Add(time.Hour + time.Second)).WithEventManager(sdk.NewEventManager())
+	s.App.SmartAccountKeeper.RemoveExpiredSessionKeys(s.Ctx)
+	authenticators, err = s.App.SmartAccountKeeper.GetAuthenticatorDataForAccount(s.Ctx, account)
+	s.Require().NoError(err)
+	s.Require().Empty(authenticators)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtSessionKeyRemoved, 1)
+}
+
+func (s *KeeperTestSuite) TestKeeper_GetSessionKeys() {
+	account := s.TestAccs[0]
+	pubKey := secp256k1.GenPrivKey().PubKey().Bytes()
+	blockTime := s.Ctx.BlockTime()
+
+	liveId := s.addSessionKey(account, authenticator.SessionKeyConfig{
+		PubKey:          pubKey,
+		NotAfter:        blockTime.Add(time.Hour),
+		MaxUses:         5,
+		AllowedMsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+	})
+	s.addSessionKey(account, authenticator.SessionKeyConfig{
+		PubKey:    pubKey,
+		NotBefore: blockTime.Add(time.Hour),
+		NotAfter:  blockTime.Add(2 * time.Hour),
+	})
+	_, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, account, authenticator.SignatureVerificationType, pubKey)
+	s.Require().NoError(err)
+
+	res, err := s.App.SmartAccountKeeper.GetSessionKeys(s.Ctx, &types.GetSessionKeysRequest{Account: account.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.SessionKeys, 1)
+	s.Require().Equal(liveId, res.SessionKeys[0].AuthenticatorId)
+	s.Require().Equal(uint64(5), res.SessionKeys[0].MaxUses)
+	s.Require().Equal(uint64(0), res.SessionKeys[0].Uses)
+	s.Require().Equal([]string{"/cosmos.bank.v1beta1.MsgSend"}, res.SessionKeys[0].AllowedMsgTypes)
+
+	_, err = s.App.SmartAccountKeeper.GetSessionKeys(s.Ctx, &types.GetSessionKeysRequest{Account: "invalid"})
+	s.Require().Error(err)
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+)
+
+// SimulateTxAuthentication runs the Authenticate path of the ante handler for every message of the tx and
+// returns the result of each authenticator, without calling Track or deducting fees. Unlike the ante handler,
+// it does not stop at the first message that fails to authenticate.
+//
+// If selectedAuthenticators is empty, the authenticators selected in the tx extension are used. If the tx is not
+// signed, it is authenticated as a simulated tx, so signatures are not verified.
+func (k Keeper) SimulateTxAuthentication(ctx sdk.Context, tx sdk.Tx, selectedAuthenticators []uint64) (*types.SimulateAuthenticationResponse, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no messages in transaction")
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	if len(selectedAuthenticators) == 0 {
+		extTx, ok := tx.(authante.HasExtensionOptionsTx)
+		if !ok {
+			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a HasExtensionOptionsTx to use Authenticators")
+		}
+		txOptions := k.GetAuthenticatorExtension(extTx.GetNonCriticalExtensionOptions())
+		if txOptions == nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no selected authenticators provided and none in the tx")
+		}
+		selectedAuthenticators = txOptions.GetSelectedAuthenticators()
+	}
+	if len(selectedAuthenticators) != len(msgs) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"Mismatch between the number of selected authenticators and messages, msg count %d, got %d selected authenticators", len(msgs), len(selectedAuthenticators))
+	}
+
+	signatures, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	signaturesSkipped := len(signatures) == 0
+	replayProtection := authenticator.ReplayProtection(authenticator.SequenceMatch)
+	if signaturesSkipped {
+		tx, err = k.withEmptySignatures(sigTx)
+		if err != nil {
+			return nil, err
+		}
+		replayProtection = authenticator.NoReplayProtection
+	}
+
+	// The fee payer is the first signer of the transaction
+	feePayerSigners, _, err := k.cdc.GetMsgV1Signers(msgs[0])
+	if err != nil || len(feePayerSigners) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "failed to get signers")
+	}
+	feePayer := sdk.AccAddress(feePayerSigners[0])
+
+	response := &types.SimulateAuthenticationResponse{
+		Authenticated:     true,
+		SignaturesSkipped: signaturesSkipped,
+	}
+	for msgIndex, msg := range msgs {
+		msgResult := types.MsgAuthenticationResult{
+			MsgIndex:   uint64(msgIndex),
+			MsgTypeUrl: sdk.MsgTypeURL(msg),
+			Authenticator: types.AuthenticatorResult{
+				AuthenticatorId: strconv.FormatUint(selectedAuthenticators[msgIndex], 10),
+			},
+		}
+
+		result, err := k.simulateMsgAuthentication(ctx, tx, msg, msgIndex, feePayer, feeTx, selectedAuthenticators[msgIndex], signaturesSkipped, replayProtection, &msgResult)
+		if err != nil {
+			msgResult.Authenticator.Error = err.Error()
+		} else {
+			msgResult.Authenticator = toAuthenticatorResult(result)
+		}
+
+		response.Authenticated = response.Authenticated && msgResult.Authenticator.Authenticated
+		response.MsgResults = append(response.MsgResults, msgResult)
+	}
+
+	return response, nil
+}
+
+// simulateMsgAuthentication authenticates a message with its selected authenticator. It returns an error
+// if the authentication request can't be built.
+func (k Keeper) simulateMsgAuthentication(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	msg sdk.Msg,
+	msgIndex int,
+	feePayer sdk.AccAddress,
+	feeTx sdk.FeeTx,
+	selectedAuthenticatorId uint64,
+	simulate bool,
+	replayProtection authenticator.ReplayProtection,
+	msgResult *types.MsgAuthenticationResult,
+) (authenticator.AuthenticationResult, error) {
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return authenticator.AuthenticationResult{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "failed to get signers")
+	}
+	if len(signers) != 1 {
+		return authenticator.AuthenticationResult{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "messages must have exactly one signer")
+	}
+	account := sdk.AccAddress(signers[0])
+	msgResult.Account = account.String()
+
+	selectedAuthenticator, err := k.GetInitializedAuthenticatorForAccount(ctx, account, int(selectedAuthenticatorId))
+	if err != nil {
+		return authenticator.AuthenticationResult{}, errorsmod.Wrap(err, "failed to get initialized authenticator")
+	}
+	msgResult.Authenticator.Type = selectedAuthenticator.Authenticator.Type()
+
+	request, err := authenticator.GenerateAuthenticationRequest(
+		ctx,
+		k.cdc,
+		k.accountKeeper,
+		k.txConfig.SignModeHandler(),
+		account,
+		feePayer,
+		feeTx.FeeGranter(),
+		feeTx.GetFee(),
+		msg,
+		tx,
+		msgIndex,
+		simulate,
+		replayProtection,
+	)
+	if err != nil {
+		return authenticator.AuthenticationResult{}, errorsmod.Wrap(err, "failed to generate authentication data")
+	}
+	request.AuthenticatorId = strconv.FormatUint(selectedAuthenticator.Id, 10)
+
+	return authenticator.SimulateAuthenticate(ctx, selectedAuthenticator.Authenticator, request), nil
+}
+
+// withEmptySignatures returns the tx with an empty signature for each signer, so that authentication requests
+// can be built for an unsigned tx. The signer infos require a public key, so an empty one is used.
+func (k Keeper) withEmptySignatures(tx authsigning.Tx) (sdk.Tx, error) {
+	signers, err := tx.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder, err := k.txConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, err
+	}
+	signatures := make([]signing.SignatureV2, len(signers))
+	for i := range signers {
+		signatures[i] = signing.SignatureV2{
+			PubKey: &secp256k1.PubKey{},
+			Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		}
+	}
+	if err := txBuilder.SetSignatures(signatures...); err != nil {
+		return nil, err
+	}
+	return txBuilder.GetTx(), nil
+}
+
+func toAuthenticatorResult(result authenticator.AuthenticationResult) types.AuthenticatorResult {
+	authenticatorResult := types.AuthenticatorResult{
+		AuthenticatorId: result.AuthenticatorId,
+		Type:            result.Type,
+		Authenticated:   result.Error == nil,
+	}
+	if result.Error != nil {
+		authenticatorResult.Error = result.Error.Error()
+	}
+	for _, subResult := range result.SubAuthenticators {
+		authenticatorResult.SubAuthenticators = append(authenticatorResult.SubAuthenticators, toAuthenticatorResult(subResult))
+	}
+	return authenticatorResult
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/v31/app"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+)
+
+func (s *KeeperTestSuite) TestKeeper_SimulateAuthentication() {
+	priv := secp256k1.GenPrivKey()
+	otherPriv := secp256k1.GenPrivKey()
+	account := sdk.AccAddress(priv.PubKey().Address())
+	s.FundAcc(account, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000_000)))
+
+	sak := s.App.SmartAccountKeeper
+	sigVerificationId, err := sak.AddAuthenticator(s.Ctx, account, authenticator.SignatureVerificationType, priv.PubKey().Bytes())
+	s.Require().NoError(err)
+	anyOfConfig, err := json.Marshal([]authenticator.SubAuthenticatorInitData{
+		{Type: authenticator.SignatureVerificationType, Config: otherPriv.PubKey().Bytes()},
+		{Type: authenticator.SignatureVerificationType, Config: priv.PubKey().Bytes()},
+	})
+	s.Require().NoError(err)
+	anyOfId, err := sak.AddAuthenticator(s.Ctx, account, "AnyOf", anyOfConfig)
+	s.Require().NoError(err)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: account.String(),
+		ToAddress:   s.TestAccs[1].String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1)),
+	}
+
+	tests := map[string]struct {
+		signer                 *secp256k1.PrivKey
+		txAuthenticators       []uint64
+		selectedAuthenticators []uint64
+
+		expectedErr           bool
+		expectedAuthenticated bool
+		expectedSkipped       bool
+		expectedResult        types.AuthenticatorResult
+	}{
+		"signed tx": {
+			signer:                 priv,
+			selectedAuthenticators: []uint64{sigVerificationId},
+			expectedAuthenticated:  true,
+			expectedResult:         types.AuthenticatorResult{AuthenticatorId: "1", Type: authenticator.SignatureVerificationType, Authenticated: true},
+		},
+		"authenticators selected in the tx": {
+			signer:                priv,
+			txAuthenticators:      []uint64{sigVerificationId},
+			expectedAuthenticated: true,
+			expectedResult:        types.AuthenticatorResult{AuthenticatorId: "1", Type: authenticator.SignatureVerificationType, Authenticated: true},
+		},
+		"unsigned tx": {
+			selectedAuthenticators: []uint64{sigVerificationId},
+			expectedAuthenticated:  true,
+			expectedSkipped:        true,
+			expectedResult:         types.AuthenticatorResult{AuthenticatorId: "1", Type: authenticator.SignatureVerificationType, Authenticated: true},
+		},
+		"wrong signer": {
+			signer:                 otherPriv,
+			selectedAuthenticators: []uint64{sigVerificationId},
+			expectedResult:         types.AuthenticatorResult{AuthenticatorId: "1", Type: authenticator.SignatureVerificationType},
+		},
+		"composite authenticator": {
+			signer:                 priv,
+			selectedAuthenticators: []uint64{anyOfId},
+			expectedAuthenticated:  true,
+			expectedResult: types.AuthenticatorResult{AuthenticatorId: "2", Type: "AnyOf", Authenticated: true, SubAuthenticators: []types.AuthenticatorResult{
+				{AuthenticatorId: "2.0", Type: authenticator.SignatureVerificationType},
+				{AuthenticatorId: "2.1", Type: authenticator.SignatureVerificationType, Authenticated: true},
+			}},
+		},
+		"unknown authenticator": {
+			signer:                 priv,
+			selectedAuthenticators: []uint64{42},
+			expectedResult:         types.AuthenticatorResult{AuthenticatorId: "42"},
+		},
+		"no selected authenticators": {
+			signer:      priv,
+			expectedErr: true,
+		},
+		"too many selected authenticators": {
+			signer:                 priv,
+			selectedAuthenticators: []uint64{sigVerificationId, sigVerificationId},
+			expectedErr:            true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			txBytes := s.buildTx(msg, account, tc.signer, tc.txAuthenticators)
+
+			res, err := sak.SimulateAuthentication(s.Ctx, &types.SimulateAuthenticationRequest{
+				Tx:                     txBytes,
+				SelectedAuthenticators: tc.selectedAuthenticators,
+			})
+			if tc.expectedErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.expectedAuthenticated, res.Authenticated)
+			s.Require().Equal(tc.expectedSkipped, res.SignaturesSkipped)
+			s.Require().Len(res.MsgResults, 1)
+			s.Require().Equal(account.String(), res.MsgResults[0].Account)
+			s.Require().Equal(sdk.MsgTypeURL(msg), res.MsgResults[0].MsgTypeUrl)
+
+			result := res.MsgResults[0].Authenticator
+			s.Require().Equal(tc.expectedResult.AuthenticatorId, result.AuthenticatorId)
+			s.Require().Equal(tc.expectedResult.Type, result.Type)
+			s.Require().Equal(tc.expectedResult.Authenticated, result.Authenticated)
+			s.Require().Equal(tc.expectedResult.Authenticated, result.Error == "")
+			s.Require().Len(result.SubAuthenticators, len(tc.expectedResult.SubAuthenticators))
+			for i, expectedSubResult := range tc.expectedResult.SubAuthenticators {
+				s.Require().Equal(expectedSubResult.AuthenticatorId, result.SubAuthenticators[i].AuthenticatorId)
+				s.Require().Equal(expectedSubResult.Authenticated, result.SubAuthenticators[i].Authenticated)
+			}
+		})
+	}
+
+	// the simulation does not track nor deduct fees
+	s.Require().Equal(int64(1_000_000), s.App.BankKeeper.GetBalance(s.Ctx, account, "uosmo").Amount.Int64())
+}
+
+// buildTx encodes a tx with the message, signed by the signer if it is set.
+func (s *KeeperTestSuite) buildTx(msg sdk.Msg, account sdk.AccAddress, signer *secp256k1.PrivKey, selectedAuthenticators []uint64) []byte {
+	txConfig := app.MakeEncodingConfig().TxConfig
+	baseTxBuilder := txConfig.NewTxBuilder()
+	s.Require().NoError(baseTxBuilder.SetMsgs(msg))
+	baseTxBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 2500)))
+	baseTxBuilder.SetGasLimit(300000)
+
+	if len(selectedAuthenticators) > 0 {
+		txBuilder, ok := baseTxBuilder.(authtx.ExtensionOptionsTxBuilder)
+		s.Require().True(ok)
+		value, err := codectypes.NewAnyWithValue(&types.TxExtension{SelectedAuthenticators: selectedAuthenticators})
+		s.Require().NoError(err)
+		txBuilder.SetNonCriticalExtensionOptions(value)
+	}
+
+	if signer != nil {
+		acc := s.App.AccountKeeper.GetAccount(s.Ctx, account)
+		signature := signing.SignatureV2{
+			PubKey:   signer.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			Sequence: acc.GetSequence(),
+		}
+		s.Require().NoError(baseTxBuilder.SetSignatures(signature))
+
+		signerData := authsigning.SignerData{
+			ChainID:       s.Ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+		}
+		signBytes, err := authsigning.GetSignBytesAdapter(s.Ctx, txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_DIRECT, signerData, baseTxBuilder.GetTx())
+		s.Require().NoError(err)
+		sig, err := signer.Sign(signBytes)
+		s.Require().NoError(err)
+		signature.Data = &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig}
+		s.Require().NoError(baseTxBuilder.SetSignatures(signature))
+	}
+
+	txBytes, err := txConfig.TxEncoder()(baseTxBuilder.GetTx())
+	s.Require().NoError(err)
+	return txBytes
+}
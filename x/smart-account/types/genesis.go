@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 0
 
@@ -9,11 +15,29 @@ func DefaultGenesis() *GenesisState {
 		Params:              DefaultParams(),
 		NextAuthenticatorId: DefaultIndex,
 		AuthenticatorData:   []AuthenticatorData{},
+		PendingRecoveries:   []PendingRecovery{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	accounts := make(map[string]bool, len(gs.PendingRecoveries))
+	for _, pendingRecovery := range gs.PendingRecoveries {
+		if _, err := sdk.AccAddressFromBech32(pendingRecovery.Account); err != nil {
+			return fmt.Errorf("invalid pending recovery account (%s)", err)
+		}
+		if _, err := sdk.AccAddressFromBech32(pendingRecovery.Guardian); err != nil {
+			return fmt.Errorf("invalid pending recovery guardian (%s)", err)
+		}
+		if accounts[pendingRecovery.Account] {
+			return fmt.Errorf("duplicate pending recovery for account %s", pendingRecovery.Account)
+		}
+		accounts[pendingRecovery.Account] = true
+	}
+	return nil
 }
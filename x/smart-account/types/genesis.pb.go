@@ -90,6 +90,9 @@ type GenesisState struct {
 	// authenticator_data contains the data for multiple accounts, each with their
 	// authenticators.
 	AuthenticatorData []AuthenticatorData `protobuf:"bytes,3,rep,name=authenticator_data,json=authenticatorData,proto3" json:"authenticator_data"`
+	// pending_recoveries are the recoveries proposed by guardians that have not
+	// been executed yet.
+	PendingRecoveries []PendingRecovery `protobuf:"bytes,4,rep,name=pending_recoveries,json=pendingRecoveries,proto3" json:"pending_recoveries"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -146,6 +149,13 @@ func (m *GenesisState) GetAuthenticatorData() []AuthenticatorData {
 	return nil
 }
 
+func (m *GenesisState) GetPendingRecoveries() []PendingRecovery {
+	if m != nil {
+		return m.PendingRecoveries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AuthenticatorData)(nil), "osmosis.smartaccount.v1beta1.AuthenticatorData")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.smartaccount.v1beta1.GenesisState")
@@ -156,29 +166,31 @@ func init() {
 }
 
 var fileDescriptor_678d63c22c684b43 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4b, 0x02, 0x41,
-	0x14, 0xc7, 0x77, 0x54, 0x8c, 0xc6, 0x08, 0xdc, 0x0a, 0x16, 0x89, 0x4d, 0xa4, 0x83, 0x05, 0xee,
-	0xe0, 0x7a, 0xea, 0xa8, 0x04, 0xd1, 0x2d, 0xf4, 0xd6, 0xc5, 0xde, 0xee, 0x0c, 0xeb, 0x82, 0xbb,
-	0x23, 0x3b, 0x4f, 0xb1, 0x4f, 0x51, 0x1f, 0xcb, 0xa3, 0xc7, 0x4e, 0x11, 0x7a, 0xed, 0x43, 0x84,
-	0xbb, 0x23, 0xb8, 0x06, 0xd6, 0x6d, 0x1e, 0xf3, 0x7b, 0xef, 0xff, 0xfe, 0xef, 0x4f, 0x6f, 0xa5,
-	0x8a, 0xa4, 0x0a, 0x15, 0x53, 0x11, 0x24, 0x08, 0xbe, 0x2f, 0xa7, 0x31, 0xb2, 0x59, 0xdb, 0x13,
-	0x08, 0x6d, 0x16, 0x88, 0x58, 0xa8, 0x50, 0x39, 0x93, 0x44, 0xa2, 0x34, 0x2f, 0x35, 0xeb, 0xec,
-	0xb2, 0x8e, 0x66, 0x6b, 0xe7, 0x81, 0x0c, 0x64, 0x0a, 0xb2, 0xcd, 0x2b, 0xeb, 0xa9, 0xdd, 0x1c,
-	0x9c, 0x3f, 0x81, 0x04, 0x22, 0xf5, 0x2f, 0x34, 0x92, 0x5c, 0x8c, 0x35, 0xda, 0x78, 0x23, 0xb4,
-	0xda, 0x9d, 0xe2, 0x48, 0xc4, 0x18, 0xfa, 0x80, 0x32, 0xb9, 0x07, 0x04, 0xd3, 0xa2, 0x47, 0xc0,
-	0x79, 0x22, 0x94, 0xb2, 0x48, 0x9d, 0x34, 0x8f, 0xfb, 0xdb, 0xd2, 0x7c, 0xa1, 0xa7, 0xb0, 0x8b,
-	0x2b, 0xab, 0x50, 0x2f, 0x36, 0x2b, 0xae, 0xeb, 0x1c, 0xb2, 0xe4, 0x74, 0xb3, 0x3a, 0xa7, 0xd4,
-	0x2b, 0x2d, 0x3e, 0xaf, 0x8c, 0xfe, 0xde, 0xbc, 0xc6, 0x37, 0xa1, 0x27, 0x0f, 0xd9, 0xb5, 0x06,
-	0x08, 0x28, 0xcc, 0x1e, 0x2d, 0x67, 0xee, 0xd2, 0x5d, 0x2a, 0xee, 0xf5, 0x61, 0xa9, 0xa7, 0x94,
-	0xd5, 0xc3, 0x75, 0xa7, 0xe9, 0xd2, 0x8b, 0x58, 0xcc, 0x71, 0x98, 0xd3, 0x1a, 0x86, 0xdc, 0x2a,
-	0xd4, 0x49, 0xb3, 0xd4, 0x3f, 0xdb, 0x7c, 0xe6, 0x96, 0x7b, 0xe4, 0x26, 0xa7, 0x66, 0x1e, 0xe7,
-	0x80, 0x60, 0x15, 0x53, 0xbb, 0xec, 0x0f, 0xbb, 0xfb, 0x17, 0xd5, 0xeb, 0x54, 0xe1, 0xd7, 0xc7,
-	0x60, 0xb1, 0xb2, 0xc9, 0x72, 0x65, 0x93, 0xaf, 0x95, 0x4d, 0xde, 0xd7, 0xb6, 0xb1, 0x5c, 0xdb,
-	0xc6, 0xc7, 0xda, 0x36, 0x9e, 0xef, 0x82, 0x10, 0x47, 0x53, 0xcf, 0xf1, 0x65, 0xc4, 0xb4, 0x5a,
-	0x6b, 0x0c, 0x9e, 0xda, 0x16, 0x6c, 0xd6, 0x69, 0xb3, 0x79, 0x96, 0x71, 0x6b, 0x1b, 0x32, 0xbe,
-	0x4e, 0x84, 0xf2, 0xca, 0x69, 0xb8, 0x9d, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x64, 0x29, 0xb7,
-	0x2e, 0x94, 0x02, 0x00, 0x00,
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6a, 0xea, 0x40,
+	0x14, 0x86, 0x13, 0x15, 0x2f, 0x77, 0xbc, 0x5c, 0x70, 0xee, 0x2d, 0x04, 0x29, 0xa9, 0x48, 0x17,
+	0xb6, 0x60, 0x06, 0xe3, 0xaa, 0x4b, 0xa5, 0x50, 0xba, 0x2b, 0x71, 0xd7, 0x8d, 0x3d, 0xc9, 0x0c,
+	0x71, 0xc0, 0x64, 0x42, 0x66, 0x14, 0x7d, 0x8a, 0xf6, 0xb1, 0x5c, 0xba, 0xec, 0xaa, 0x14, 0x7d,
+	0x91, 0x62, 0x32, 0x01, 0x63, 0x41, 0xbb, 0xcb, 0xc9, 0x7c, 0xff, 0xf9, 0xcf, 0x7f, 0x38, 0xe8,
+	0x56, 0xc8, 0x48, 0x48, 0x2e, 0x89, 0x8c, 0x20, 0x55, 0x10, 0x04, 0x62, 0x1e, 0x2b, 0xb2, 0xe8,
+	0xfb, 0x4c, 0x41, 0x9f, 0x84, 0x2c, 0x66, 0x92, 0x4b, 0x27, 0x49, 0x85, 0x12, 0xf8, 0x52, 0xb3,
+	0xce, 0x21, 0xeb, 0x68, 0xb6, 0xf5, 0x3f, 0x14, 0xa1, 0xc8, 0x40, 0xb2, 0xff, 0xca, 0x35, 0xad,
+	0x9b, 0x93, 0xfd, 0x13, 0x48, 0x21, 0x92, 0x3f, 0x42, 0x23, 0x41, 0xd9, 0x4c, 0xa3, 0x9d, 0x57,
+	0x13, 0x35, 0x87, 0x73, 0x35, 0x65, 0xb1, 0xe2, 0x01, 0x28, 0x91, 0xde, 0x83, 0x02, 0x6c, 0xa1,
+	0x5f, 0x40, 0x69, 0xca, 0xa4, 0xb4, 0xcc, 0xb6, 0xd9, 0xfd, 0xed, 0x15, 0x25, 0x7e, 0x41, 0x7f,
+	0xe1, 0x10, 0x97, 0x56, 0xa5, 0x5d, 0xed, 0x36, 0x5c, 0xd7, 0x39, 0x15, 0xc9, 0x19, 0xe6, 0x75,
+	0xc9, 0x69, 0x54, 0x5b, 0x7f, 0x5c, 0x19, 0xde, 0x51, 0xbf, 0xce, 0xa6, 0x82, 0xfe, 0x3c, 0xe4,
+	0xdb, 0x1a, 0x2b, 0x50, 0x0c, 0x8f, 0x50, 0x3d, 0x4f, 0x97, 0xcd, 0xd2, 0x70, 0xaf, 0x4f, 0x5b,
+	0x3d, 0x65, 0xac, 0x6e, 0xae, 0x95, 0xd8, 0x45, 0x17, 0x31, 0x5b, 0xaa, 0x49, 0xc9, 0x6b, 0xc2,
+	0xa9, 0x55, 0x69, 0x9b, 0xdd, 0x9a, 0xf7, 0x6f, 0xff, 0x58, 0x1a, 0xee, 0x91, 0x62, 0x8a, 0x70,
+	0x19, 0xa7, 0xa0, 0xc0, 0xaa, 0x66, 0x71, 0xc9, 0x99, 0xb8, 0xc7, 0x1b, 0xd5, 0xe3, 0x34, 0xe1,
+	0xdb, 0xaa, 0x7d, 0x84, 0x13, 0x16, 0x53, 0x1e, 0x87, 0x93, 0x94, 0x05, 0x62, 0xc1, 0x52, 0xce,
+	0xa4, 0x55, 0xcb, 0x5c, 0x7a, 0x67, 0x92, 0xe6, 0x3a, 0x2f, 0x97, 0xad, 0x0a, 0x8f, 0xa4, 0xf4,
+	0x9b, 0x33, 0x39, 0x1a, 0xaf, 0xb7, 0xb6, 0xb9, 0xd9, 0xda, 0xe6, 0xe7, 0xd6, 0x36, 0xdf, 0x76,
+	0xb6, 0xb1, 0xd9, 0xd9, 0xc6, 0xfb, 0xce, 0x36, 0x9e, 0xef, 0x42, 0xae, 0xa6, 0x73, 0xdf, 0x09,
+	0x44, 0x44, 0xb4, 0x57, 0x6f, 0x06, 0xbe, 0x2c, 0x0a, 0xb2, 0x18, 0xf4, 0xc9, 0x32, 0xbf, 0xa3,
+	0x5e, 0x71, 0x48, 0x6a, 0x95, 0x30, 0xe9, 0xd7, 0xb3, 0x03, 0x1a, 0x7c, 0x05, 0x00, 0x00, 0xff,
+	0xff, 0x09, 0xff, 0x2d, 0xa3, 0xf8, 0x02, 0x00, 0x00,
 }
 
 func (m *AuthenticatorData) Marshal() (dAtA []byte, err error) {
@@ -245,6 +257,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingRecoveries) > 0 {
+		for iNdEx := len(m.PendingRecoveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRecoveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.AuthenticatorData) > 0 {
 		for iNdEx := len(m.AuthenticatorData) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -324,6 +350,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingRecoveries) > 0 {
+		for _, e := range m.PendingRecoveries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -564,6 +596,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRecoveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRecoveries = append(m.PendingRecoveries, PendingRecovery{})
+			if err := m.PendingRecoveries[len(m.PendingRecoveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,12 +3,16 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	account := sdk.AccAddress([]byte("account_____________")).String()
+	guardian := sdk.AccAddress([]byte("guardian____________")).String()
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -24,6 +28,28 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc: "valid pending recovery",
+			genState: &types.GenesisState{PendingRecoveries: []types.PendingRecovery{
+				{Account: account, AuthenticatorId: 1, Guardian: guardian},
+			}},
+			valid: true,
+		},
+		{
+			desc: "invalid pending recovery guardian",
+			genState: &types.GenesisState{PendingRecoveries: []types.PendingRecovery{
+				{Account: account, AuthenticatorId: 1, Guardian: "invalid"},
+			}},
+			valid: false,
+		},
+		{
+			desc: "duplicate pending recovery",
+			genState: &types.GenesisState{PendingRecoveries: []types.PendingRecovery{
+				{Account: account, AuthenticatorId: 1, Guardian: guardian},
+				{Account: account, AuthenticatorId: 2, Guardian: guardian},
+			}},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -3,6 +3,7 @@ package types
 import (
 	fmt "fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -27,17 +28,34 @@ const (
 
 	AttributeKeyAccountSequenceAuthenticator = "authenticator_acc_seq"
 	AttributeKeySignatureAuthenticator       = "authenticator_signature"
+
+	TypeEvtSessionKeyRemoved = "session_key_removed"
+	TypeEvtRecoveryProposed  = "recovery_proposed"
+	TypeEvtRecoveryCancelled = "recovery_cancelled"
+	TypeEvtRecoveryExecuted  = "recovery_executed"
+	AttributeKeyAccount      = "account"
+	AttributeKeyGuardian     = "guardian"
+	AttributeKeyRecoveryTime = "execute_after"
 )
 
 var (
 	// Store prefix keys
 	KeyNextAccountAuthenticatorIdPrefix = []byte{0x01}
 	KeyAccountAuthenticatorsPrefix      = []byte{0x02}
+	KeySpendLimitStatePrefix            = []byte{0x03}
+	KeySpendLimitPreExecBalancesPrefix  = []byte{0x04}
+	KeySessionKeyUsesPrefix             = []byte{0x05}
+	KeySessionKeyExpirationPrefix       = []byte{0x06}
+	KeyPendingRecoveryPrefix            = []byte{0x07}
+	KeyRecoveryQueuePrefix              = []byte{0x08}
 
 	// Parameter keys
 	KeyMaximumUnauthenticatedGas = []byte("MaximumUnauthenticatedGas")
 	KeyIsSmartAccountActive      = []byte("IsSmartAccountActive")
 	KeyCircuitBreakerControllers = []byte("CircuitBreakerControllers")
+
+	KeyMaxExpiredSessionKeysPerBlock = []byte("MaxExpiredSessionKeysPerBlock")
+	KeyMaxRecoveriesPerBlock         = []byte("MaxRecoveriesPerBlock")
 )
 
 func KeyAccount(account sdk.AccAddress) []byte {
@@ -56,6 +74,54 @@ func KeyAccountAuthenticatorsPrefixId() []byte {
 	return BuildKey(KeyAccountAuthenticatorsPrefix)
 }
 
+// KeySpendLimitState returns the key for the spending tracked by a SpendLimit authenticator.
+func KeySpendLimitState(account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeySpendLimitStatePrefix, account.String(), authenticatorId)
+}
+
+// KeySpendLimitPreExecBalances returns the key for the balances snapshot taken by a SpendLimit authenticator
+// before the messages of a transaction are executed.
+func KeySpendLimitPreExecBalances(account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeySpendLimitPreExecBalancesPrefix, account.String(), authenticatorId)
+}
+
+// KeySessionKeyUses returns the key for the number of messages authenticated by a SessionKey authenticator.
+func KeySessionKeyUses(account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeySessionKeyUsesPrefix, account.String(), authenticatorId)
+}
+
+// KeySessionKeyExpiration returns the key used to queue a SessionKey authenticator for removal at the given time.
+// The time is formatted so that the keys are sorted by expiration.
+func KeySessionKeyExpiration(expiration time.Time, account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeySessionKeyExpirationPrefix, sdk.FormatTimeString(expiration), account.String(), authenticatorId)
+}
+
+// KeySessionKeyExpirationPrefixId returns the prefix of all queued SessionKey expirations.
+func KeySessionKeyExpirationPrefixId() []byte {
+	return BuildKey(KeySessionKeyExpirationPrefix)
+}
+
+// KeyPendingRecovery returns the key for the pending recovery of an account.
+func KeyPendingRecovery(account sdk.AccAddress) []byte {
+	return BuildKey(KeyPendingRecoveryPrefix, account.String())
+}
+
+// KeyPendingRecoveryPrefixId returns the prefix of all pending recoveries.
+func KeyPendingRecoveryPrefixId() []byte {
+	return BuildKey(KeyPendingRecoveryPrefix)
+}
+
+// KeyRecoveryQueue returns the key used to queue the pending recovery of an account for execution at the given time.
+// The time is formatted so that the keys are sorted by execution time.
+func KeyRecoveryQueue(executeAfter time.Time, account sdk.AccAddress) []byte {
+	return BuildKey(KeyRecoveryQueuePrefix, sdk.FormatTimeString(executeAfter), account.String())
+}
+
+// KeyRecoveryQueuePrefixId returns the prefix of all queued recoveries.
+func KeyRecoveryQueuePrefixId() []byte {
+	return BuildKey(KeyRecoveryQueuePrefix)
+}
+
 // BuildKey creates a key by concatenating the provided elements with the key separator.
 func BuildKey(elements ...interface{}) []byte {
 	strElements := make([]string, len(elements))
@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -93,8 +98,254 @@ func (m *AccountAuthenticator) GetConfig() []byte {
 	return nil
 }
 
+// SessionKeyInfo describes a SessionKey authenticator of an account and its
+// current usage.
+type SessionKeyInfo struct {
+	// AuthenticatorId is the id of the SessionKey authenticator.
+	AuthenticatorId uint64 `protobuf:"varint,1,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+	// PubKey is the secp256k1 public key of the session.
+	PubKey []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// NotBefore is the block time from which the session can be used.
+	NotBefore time.Time `protobuf:"bytes,3,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before"`
+	// NotAfter is the block time after which the session expires.
+	NotAfter time.Time `protobuf:"bytes,4,opt,name=not_after,json=notAfter,proto3,stdtime" json:"not_after"`
+	// MaxUses is the maximum number of messages the session can authenticate.
+	// Zero means unlimited.
+	MaxUses uint64 `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// Uses is the number of messages the session has authenticated so far.
+	Uses uint64 `protobuf:"varint,6,opt,name=uses,proto3" json:"uses,omitempty"`
+	// AllowedMsgTypes is the list of message type urls the session can
+	// authenticate. An empty list allows every message type.
+	AllowedMsgTypes []string `protobuf:"bytes,7,rep,name=allowed_msg_types,json=allowedMsgTypes,proto3" json:"allowed_msg_types,omitempty"`
+}
+
+func (m *SessionKeyInfo) Reset()         { *m = SessionKeyInfo{} }
+func (m *SessionKeyInfo) String() string { return proto.CompactTextString(m) }
+func (*SessionKeyInfo) ProtoMessage()    {}
+func (*SessionKeyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6c4440607a75fe8, []int{1}
+}
+func (m *SessionKeyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionKeyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionKeyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionKeyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionKeyInfo.Merge(m, src)
+}
+func (m *SessionKeyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionKeyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionKeyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionKeyInfo proto.InternalMessageInfo
+
+func (m *SessionKeyInfo) GetAuthenticatorId() uint64 {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return 0
+}
+
+func (m *SessionKeyInfo) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *SessionKeyInfo) GetNotBefore() time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return time.Time{}
+}
+
+func (m *SessionKeyInfo) GetNotAfter() time.Time {
+	if m != nil {
+		return m.NotAfter
+	}
+	return time.Time{}
+}
+
+func (m *SessionKeyInfo) GetMaxUses() uint64 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *SessionKeyInfo) GetUses() uint64 {
+	if m != nil {
+		return m.Uses
+	}
+	return 0
+}
+
+func (m *SessionKeyInfo) GetAllowedMsgTypes() []string {
+	if m != nil {
+		return m.AllowedMsgTypes
+	}
+	return nil
+}
+
+// AuthenticatorInitData is the type and configuration of an authenticator to
+// add to an account.
+type AuthenticatorInitData struct {
+	Type   string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Config []byte `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (m *AuthenticatorInitData) Reset()         { *m = AuthenticatorInitData{} }
+func (m *AuthenticatorInitData) String() string { return proto.CompactTextString(m) }
+func (*AuthenticatorInitData) ProtoMessage()    {}
+func (*AuthenticatorInitData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6c4440607a75fe8, []int{2}
+}
+func (m *AuthenticatorInitData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthenticatorInitData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthenticatorInitData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthenticatorInitData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticatorInitData.Merge(m, src)
+}
+func (m *AuthenticatorInitData) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthenticatorInitData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticatorInitData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticatorInitData proto.InternalMessageInfo
+
+func (m *AuthenticatorInitData) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AuthenticatorInitData) GetConfig() []byte {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// PendingRecovery is a replacement of the authenticators of an account
+// proposed by a guardian of its Recovery authenticator. It is executed once
+// the delay of the Recovery authenticator has passed, unless the account
+// cancels it.
+type PendingRecovery struct {
+	// account is the account whose authenticators are replaced.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// authenticator_id is the id of the Recovery authenticator of the account.
+	AuthenticatorId uint64 `protobuf:"varint,2,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+	// guardian is the guardian that proposed the recovery.
+	Guardian string `protobuf:"bytes,3,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// new_authenticators replace every authenticator of the account except the
+	// Recovery authenticator.
+	NewAuthenticators []AuthenticatorInitData `protobuf:"bytes,4,rep,name=new_authenticators,json=newAuthenticators,proto3" json:"new_authenticators"`
+	// execute_after is the block time from which the recovery is executed.
+	ExecuteAfter time.Time `protobuf:"bytes,5,opt,name=execute_after,json=executeAfter,proto3,stdtime" json:"execute_after"`
+}
+
+func (m *PendingRecovery) Reset()         { *m = PendingRecovery{} }
+func (m *PendingRecovery) String() string { return proto.CompactTextString(m) }
+func (*PendingRecovery) ProtoMessage()    {}
+func (*PendingRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6c4440607a75fe8, []int{3}
+}
+func (m *PendingRecovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRecovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRecovery.Merge(m, src)
+}
+func (m *PendingRecovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRecovery proto.InternalMessageInfo
+
+func (m *PendingRecovery) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *PendingRecovery) GetAuthenticatorId() uint64 {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return 0
+}
+
+func (m *PendingRecovery) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *PendingRecovery) GetNewAuthenticators() []AuthenticatorInitData {
+	if m != nil {
+		return m.NewAuthenticators
+	}
+	return nil
+}
+
+func (m *PendingRecovery) GetExecuteAfter() time.Time {
+	if m != nil {
+		return m.ExecuteAfter
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*AccountAuthenticator)(nil), "osmosis.smartaccount.v1beta1.AccountAuthenticator")
+	proto.RegisterType((*SessionKeyInfo)(nil), "osmosis.smartaccount.v1beta1.SessionKeyInfo")
+	proto.RegisterType((*AuthenticatorInitData)(nil), "osmosis.smartaccount.v1beta1.AuthenticatorInitData")
+	proto.RegisterType((*PendingRecovery)(nil), "osmosis.smartaccount.v1beta1.PendingRecovery")
 }
 
 func init() {
@@ -102,21 +353,42 @@ func init() {
 }
 
 var fileDescriptor_e6c4440607a75fe8 = []byte{
-	// 218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xce, 0x4d, 0x2c, 0x2a, 0x49, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b,
-	0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0xcf, 0xcd, 0x4f, 0x49, 0xcd, 0x29, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0x2a, 0xd5, 0x43, 0x56, 0xaa, 0x07, 0x55, 0xaa,
-	0x14, 0xc4, 0x25, 0xe2, 0x08, 0x11, 0x72, 0x2c, 0x2d, 0xc9, 0x48, 0xcd, 0x2b, 0xc9, 0x4c, 0x4e,
-	0x2c, 0xc9, 0x2f, 0x12, 0xe2, 0xe3, 0x62, 0xca, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09,
-	0x62, 0xca, 0x4c, 0x11, 0x12, 0xe2, 0x62, 0x29, 0xa9, 0x2c, 0x48, 0x95, 0x60, 0x52, 0x60, 0xd4,
-	0xe0, 0x0c, 0x02, 0xb3, 0x85, 0xc4, 0xb8, 0xd8, 0x92, 0xf3, 0xf3, 0xd2, 0x32, 0xd3, 0x25, 0x98,
-	0x15, 0x18, 0x35, 0x78, 0x82, 0xa0, 0x3c, 0xa7, 0xe0, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0x88, 0xb2, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87,
-	0x3a, 0x4b, 0x37, 0x27, 0x31, 0xa9, 0x18, 0xc6, 0xd1, 0x2f, 0x33, 0x36, 0xd4, 0xaf, 0x80, 0x78,
-	0x4a, 0x17, 0xe6, 0x2b, 0x90, 0x5d, 0xc5, 0x49, 0x6c, 0x60, 0xdf, 0x18, 0x03, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xd5, 0xa5, 0x26, 0x1d, 0xfa, 0x00, 0x00, 0x00,
+	// 546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xda, 0x4c,
+	0x14, 0xc5, 0x86, 0xf0, 0x33, 0xe1, 0x0b, 0x5f, 0x46, 0x69, 0xeb, 0xa2, 0xca, 0x20, 0x56, 0xa4,
+	0x52, 0x6c, 0x11, 0x56, 0x5d, 0x42, 0xba, 0x41, 0x51, 0xa5, 0xca, 0x49, 0x37, 0xdd, 0x58, 0x63,
+	0xfb, 0x62, 0xac, 0xe2, 0xb9, 0xc8, 0x33, 0xe6, 0xe7, 0x2d, 0xf2, 0x16, 0x7d, 0x84, 0xbe, 0x42,
+	0x96, 0x59, 0x76, 0xd5, 0x56, 0xf0, 0x22, 0x95, 0x07, 0x83, 0x82, 0x84, 0x2a, 0x65, 0x37, 0xe7,
+	0xce, 0xbd, 0x67, 0xe6, 0x9c, 0x33, 0x43, 0x2e, 0x51, 0xc4, 0x28, 0x22, 0x61, 0x8b, 0x98, 0x25,
+	0x92, 0xf9, 0x3e, 0xa6, 0x5c, 0xda, 0xf3, 0x9e, 0x07, 0x92, 0xf5, 0xec, 0x18, 0x03, 0x98, 0x0a,
+	0x6b, 0x96, 0xa0, 0x44, 0xfa, 0x2e, 0x6f, 0xb5, 0x9e, 0xb7, 0x5a, 0x79, 0x6b, 0xf3, 0x22, 0xc4,
+	0x10, 0x55, 0xa3, 0x9d, 0xad, 0xb6, 0x33, 0xcd, 0x56, 0x88, 0x18, 0x4e, 0xc1, 0x56, 0xc8, 0x4b,
+	0xc7, 0xb6, 0x8c, 0x62, 0x10, 0x92, 0xc5, 0xb3, 0x6d, 0x43, 0xc7, 0x21, 0x17, 0x83, 0x2d, 0xd3,
+	0x20, 0x95, 0x13, 0xe0, 0x32, 0xf2, 0x99, 0xc4, 0x84, 0x9e, 0x11, 0x3d, 0x0a, 0x0c, 0xad, 0xad,
+	0x75, 0x4b, 0x8e, 0x1e, 0x05, 0x94, 0x92, 0x92, 0x5c, 0xcd, 0xc0, 0xd0, 0xdb, 0x5a, 0xb7, 0xe6,
+	0xa8, 0x35, 0x7d, 0x4d, 0xca, 0x3e, 0xf2, 0x71, 0x14, 0x1a, 0xc5, 0xb6, 0xd6, 0xad, 0x3b, 0x39,
+	0xea, 0xfc, 0xd0, 0xc9, 0xd9, 0x1d, 0x08, 0x11, 0x21, 0xbf, 0x85, 0xd5, 0x88, 0x8f, 0x91, 0x5e,
+	0x92, 0xff, 0xd9, 0x73, 0x7e, 0x77, 0x4f, 0xde, 0x38, 0xa8, 0x8f, 0x02, 0xfa, 0x86, 0x54, 0x66,
+	0xa9, 0xe7, 0x7e, 0x83, 0x95, 0x3a, 0xac, 0xee, 0x94, 0x67, 0xa9, 0x77, 0x0b, 0x2b, 0x7a, 0x43,
+	0x08, 0x47, 0xe9, 0x7a, 0x30, 0xc6, 0x04, 0xd4, 0x91, 0xa7, 0xd7, 0x4d, 0x6b, 0x2b, 0xd0, 0xda,
+	0x09, 0xb4, 0xee, 0x77, 0x02, 0x87, 0xd5, 0xc7, 0x5f, 0xad, 0xc2, 0xc3, 0xef, 0x96, 0xe6, 0xd4,
+	0x38, 0xca, 0xa1, 0x1a, 0xa3, 0x03, 0x92, 0x01, 0x97, 0x8d, 0x25, 0x24, 0x46, 0xe9, 0x05, 0x1c,
+	0x55, 0x8e, 0x72, 0x90, 0x4d, 0xd1, 0xb7, 0xa4, 0x1a, 0xb3, 0xa5, 0x9b, 0x0a, 0x10, 0xc6, 0x89,
+	0xd2, 0x50, 0x89, 0xd9, 0xf2, 0x8b, 0x00, 0x91, 0xb9, 0xa4, 0xca, 0x65, 0x55, 0x56, 0x6b, 0xfa,
+	0x9e, 0x9c, 0xb3, 0xe9, 0x14, 0x17, 0x10, 0xb8, 0xb1, 0x08, 0xdd, 0xcc, 0x39, 0x61, 0x54, 0xda,
+	0xc5, 0x6e, 0xcd, 0x69, 0xe4, 0x1b, 0x9f, 0x44, 0x78, 0x9f, 0x95, 0x3b, 0x37, 0xe4, 0xd5, 0x41,
+	0x0c, 0x23, 0x1e, 0xc9, 0x8f, 0x4c, 0xb2, 0xbd, 0xfd, 0xda, 0x51, 0xfb, 0xf5, 0x03, 0xfb, 0xbf,
+	0xeb, 0xa4, 0xf1, 0x19, 0x78, 0x10, 0xf1, 0xd0, 0x01, 0x1f, 0xe7, 0x90, 0xac, 0xa8, 0x41, 0x2a,
+	0xf9, 0x83, 0xc9, 0x29, 0x76, 0xf0, 0x68, 0x32, 0xfa, 0xf1, 0x64, 0x9a, 0xa4, 0x1a, 0xa6, 0x2c,
+	0x09, 0x22, 0xc6, 0x95, 0xfd, 0x35, 0x67, 0x8f, 0xe9, 0x84, 0x50, 0x0e, 0x0b, 0xf7, 0x60, 0x44,
+	0x18, 0xa5, 0x76, 0xb1, 0x7b, 0x7a, 0xdd, 0xb7, 0xfe, 0xf5, 0x72, 0xad, 0xa3, 0x8a, 0x87, 0xa5,
+	0xcc, 0x79, 0xe7, 0x9c, 0xc3, 0xe2, 0x60, 0x5f, 0xd0, 0x11, 0xf9, 0x0f, 0x96, 0xe0, 0xa7, 0x12,
+	0xf2, 0x14, 0x4f, 0x5e, 0x90, 0x62, 0x3d, 0x1f, 0x55, 0x49, 0x0e, 0xef, 0x1e, 0xd7, 0xa6, 0xf6,
+	0xb4, 0x36, 0xb5, 0x3f, 0x6b, 0x53, 0x7b, 0xd8, 0x98, 0x85, 0xa7, 0x8d, 0x59, 0xf8, 0xb9, 0x31,
+	0x0b, 0x5f, 0x3f, 0x84, 0x91, 0x9c, 0xa4, 0x9e, 0xe5, 0x63, 0x6c, 0xe7, 0x97, 0xbf, 0x9a, 0x32,
+	0x4f, 0xec, 0x80, 0x3d, 0xef, 0xf7, 0xec, 0xe5, 0xf6, 0xd3, 0x5e, 0xed, 0x7e, 0xad, 0x8a, 0xd6,
+	0x2b, 0xab, 0x0b, 0xf4, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x44, 0xfa, 0xbd, 0xda, 0x03,
+	0x00, 0x00,
 }
 
 func (m *AccountAuthenticator) Marshal() (dAtA []byte, err error) {
@@ -158,47 +430,785 @@ func (m *AccountAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
-}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionKeyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionKeyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionKeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedMsgTypes) > 0 {
+		for iNdEx := len(m.AllowedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypes[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.AllowedMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Uses != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Uses))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxUses != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NotAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NotAfter):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintModels(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NotBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NotBefore):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintModels(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuthenticatorId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.AuthenticatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticatorInitData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticatorInitData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticatorInitData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingRecovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRecovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRecovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecuteAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecuteAfter):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintModels(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.NewAuthenticators) > 0 {
+		for iNdEx := len(m.NewAuthenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewAuthenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AuthenticatorId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.AuthenticatorId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModels(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionKeyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuthenticatorId != 0 {
+		n += 1 + sovModels(uint64(m.AuthenticatorId))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NotBefore)
+	n += 1 + l + sovModels(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NotAfter)
+	n += 1 + l + sovModels(uint64(l))
+	if m.MaxUses != 0 {
+		n += 1 + sovModels(uint64(m.MaxUses))
+	}
+	if m.Uses != 0 {
+		n += 1 + sovModels(uint64(m.Uses))
+	}
+	if len(m.AllowedMsgTypes) > 0 {
+		for _, s := range m.AllowedMsgTypes {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AuthenticatorInitData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingRecovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.AuthenticatorId != 0 {
+		n += 1 + sovModels(uint64(m.AuthenticatorId))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.NewAuthenticators) > 0 {
+		for _, e := range m.NewAuthenticators {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecuteAfter)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func sovModels(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModels(x uint64) (n int) {
+	return sovModels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccountAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionKeyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionKeyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionKeyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			m.AuthenticatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NotAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+			}
+			m.Uses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypes = append(m.AllowedMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
-	offset -= sovModels(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *AccountAuthenticator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovModels(uint64(m.Id))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	l = len(m.Config)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+func (m *AuthenticatorInitData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthenticatorInitData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthenticatorInitData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovModels(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozModels(x uint64) (n int) {
-	return sovModels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AccountAuthenticator) Unmarshal(dAtA []byte) error {
+func (m *PendingRecovery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -221,17 +1231,17 @@ func (m *AccountAuthenticator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountAuthenticator: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingRecovery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingRecovery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -241,14 +1251,46 @@ func (m *AccountAuthenticator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			m.AuthenticatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -276,13 +1318,13 @@ func (m *AccountAuthenticator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthenticators", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -292,24 +1334,57 @@ func (m *AccountAuthenticator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
-			if m.Config == nil {
-				m.Config = []byte{}
+			m.NewAuthenticators = append(m.NewAuthenticators, AuthenticatorInitData{})
+			if err := m.NewAuthenticators[len(m.NewAuthenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecuteAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -75,3 +75,39 @@ func (msg *MsgSetActiveState) ValidateBasic() error {
 func (msg *MsgSetActiveState) GetSigners() []sdk.AccAddress {
 	return getSender(msg.Sender)
 }
+
+// MsgProposeRecovery
+var _ sdk.Msg = &MsgProposeRecovery{}
+
+func (msg *MsgProposeRecovery) ValidateBasic() error {
+	if err := validateSender(msg.Sender); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return fmt.Errorf("invalid account address (%s)", err)
+	}
+	if len(msg.NewAuthenticators) == 0 {
+		return fmt.Errorf("at least one new authenticator must be provided")
+	}
+	for _, newAuthenticator := range msg.NewAuthenticators {
+		if newAuthenticator.Type == "" {
+			return fmt.Errorf("new authenticator type must not be empty")
+		}
+	}
+	return nil
+}
+
+func (msg *MsgProposeRecovery) GetSigners() []sdk.AccAddress {
+	return getSender(msg.Sender)
+}
+
+// MsgCancelRecovery
+var _ sdk.Msg = &MsgCancelRecovery{}
+
+func (msg *MsgCancelRecovery) ValidateBasic() error {
+	return validateSender(msg.Sender)
+}
+
+func (msg *MsgCancelRecovery) GetSigners() []sdk.AccAddress {
+	return getSender(msg.Sender)
+}
@@ -9,6 +9,13 @@ import (
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+const (
+	// DefaultMaxExpiredSessionKeysPerBlock is the default maximum number of expired session keys removed at the end of each block.
+	DefaultMaxExpiredSessionKeysPerBlock uint64 = 100
+	// DefaultMaxRecoveriesPerBlock is the default maximum number of pending recoveries executed at the end of each block.
+	DefaultMaxRecoveriesPerBlock uint64 = 100
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -17,9 +24,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams() Params {
 	return Params{
-		MaximumUnauthenticatedGas: 120_000,
-		IsSmartAccountActive:      true,
-		CircuitBreakerControllers: []string{},
+		MaximumUnauthenticatedGas:     120_000,
+		IsSmartAccountActive:          true,
+		CircuitBreakerControllers:     []string{},
+		MaxExpiredSessionKeysPerBlock: DefaultMaxExpiredSessionKeysPerBlock,
+		MaxRecoveriesPerBlock:         DefaultMaxRecoveriesPerBlock,
 	}
 }
 
@@ -34,6 +43,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaximumUnauthenticatedGas, &p.MaximumUnauthenticatedGas, validateMaximumUnauthenticatedGas),
 		paramtypes.NewParamSetPair(KeyIsSmartAccountActive, &p.IsSmartAccountActive, validateIsSmartAccountActive),
 		paramtypes.NewParamSetPair(KeyCircuitBreakerControllers, &p.CircuitBreakerControllers, validateCircuitBreakerControllers),
+		paramtypes.NewParamSetPair(KeyMaxExpiredSessionKeysPerBlock, &p.MaxExpiredSessionKeysPerBlock, validateMaxExpiredSessionKeysPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxRecoveriesPerBlock, &p.MaxRecoveriesPerBlock, validateMaxRecoveriesPerBlock),
 	}
 }
 
@@ -54,6 +65,16 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	err = validateMaxExpiredSessionKeysPerBlock(p.MaxExpiredSessionKeysPerBlock)
+	if err != nil {
+		return err
+	}
+
+	err = validateMaxRecoveriesPerBlock(p.MaxRecoveriesPerBlock)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -95,3 +116,23 @@ func validateCircuitBreakerControllers(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxExpiredSessionKeysPerBlock(i interface{}) error {
+	// Convert the given parameter to a uint64.
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxRecoveriesPerBlock(i interface{}) error {
+	// Convert the given parameter to a uint64.
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -36,6 +36,14 @@ type Params struct {
 	// CircuitBreakerControllers defines list of addresses that are allowed to
 	// set is_smart_account_active without going through governance.
 	CircuitBreakerControllers []string `protobuf:"bytes,3,rep,name=circuit_breaker_controllers,json=circuitBreakerControllers,proto3" json:"circuit_breaker_controllers,omitempty" yaml:"circuit_breaker_controllers"`
+	// MaxExpiredSessionKeysPerBlock defines the maximum number of expired
+	// session keys removed at the end of each block. The others stay queued and
+	// are removed in the next blocks. Zero pauses the removal.
+	MaxExpiredSessionKeysPerBlock uint64 `protobuf:"varint,4,opt,name=max_expired_session_keys_per_block,json=maxExpiredSessionKeysPerBlock,proto3" json:"max_expired_session_keys_per_block,omitempty" yaml:"max_expired_session_keys_per_block"`
+	// MaxRecoveriesPerBlock defines the maximum number of pending recoveries
+	// executed at the end of each block. The others stay queued and are executed
+	// in the next blocks. Zero pauses the execution of recoveries.
+	MaxRecoveriesPerBlock uint64 `protobuf:"varint,5,opt,name=max_recoveries_per_block,json=maxRecoveriesPerBlock,proto3" json:"max_recoveries_per_block,omitempty" yaml:"max_recoveries_per_block"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -92,6 +100,20 @@ func (m *Params) GetCircuitBreakerControllers() []string {
 	return nil
 }
 
+func (m *Params) GetMaxExpiredSessionKeysPerBlock() uint64 {
+	if m != nil {
+		return m.MaxExpiredSessionKeysPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRecoveriesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRecoveriesPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.smartaccount.v1beta1.Params")
 }
@@ -101,28 +123,34 @@ func init() {
 }
 
 var fileDescriptor_f2a36e3b8e84dacf = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4e, 0x32, 0x31,
-	0x14, 0xc7, 0x19, 0xf8, 0x42, 0x3e, 0x67, 0x49, 0x48, 0x04, 0x35, 0x85, 0x74, 0x61, 0x70, 0xc1,
-	0x34, 0x84, 0x95, 0xee, 0x18, 0x17, 0x6e, 0x0d, 0xc4, 0x85, 0x6e, 0x9a, 0x37, 0xb5, 0x0e, 0x8d,
-	0xd3, 0x29, 0x69, 0x3b, 0x04, 0x6e, 0xe1, 0x5d, 0xbc, 0x84, 0x4b, 0x96, 0xae, 0x88, 0x81, 0x1b,
-	0x70, 0x02, 0x33, 0x33, 0x25, 0xe2, 0x42, 0x76, 0xed, 0xfb, 0xfd, 0xda, 0xf7, 0x5e, 0xfe, 0xfe,
-	0x95, 0x32, 0x52, 0x19, 0x61, 0x88, 0x91, 0xa0, 0x2d, 0x30, 0xa6, 0xb2, 0xd4, 0x92, 0xf9, 0x20,
-	0xe2, 0x16, 0x06, 0x64, 0x06, 0x1a, 0xa4, 0x09, 0x66, 0x5a, 0x59, 0xd5, 0xb8, 0x70, 0x6a, 0x70,
-	0xa8, 0x06, 0x4e, 0x3d, 0x6b, 0xc6, 0x2a, 0x56, 0x85, 0x48, 0xf2, 0x53, 0xf9, 0x06, 0xbf, 0x57,
-	0xfd, 0xfa, 0x7d, 0xf1, 0x49, 0xe3, 0xc5, 0x3f, 0x97, 0xb0, 0x10, 0x32, 0x93, 0x34, 0x4b, 0x21,
-	0xb3, 0x53, 0x9e, 0x5a, 0xc1, 0xc0, 0xf2, 0x67, 0x1a, 0x83, 0x69, 0x79, 0x5d, 0xaf, 0xf7, 0x2f,
-	0xbc, 0xdc, 0xad, 0x3b, 0x78, 0x09, 0x32, 0xb9, 0xc1, 0x47, 0x64, 0x3c, 0x6e, 0x3b, 0xfa, 0xf0,
-	0x1b, 0xde, 0x81, 0x69, 0x3c, 0xfa, 0xa7, 0xc2, 0xd0, 0x62, 0x46, 0xea, 0x86, 0xa4, 0xc0, 0xac,
-	0x98, 0xf3, 0x56, 0xb5, 0xeb, 0xf5, 0xfe, 0x87, 0x78, 0xb7, 0xee, 0xa0, 0xb2, 0xc7, 0x1f, 0x22,
-	0x1e, 0x37, 0x85, 0x99, 0xe4, 0x60, 0x54, 0xd6, 0x47, 0x45, 0x39, 0x5f, 0x81, 0x09, 0xcd, 0x32,
-	0x61, 0x69, 0xa4, 0x39, 0xbc, 0x72, 0x4d, 0x99, 0x4a, 0xad, 0x56, 0x49, 0xc2, 0xb5, 0x69, 0xd5,
-	0xba, 0xb5, 0xde, 0xc9, 0xe1, 0x0a, 0x47, 0x64, 0x3c, 0x6e, 0x3b, 0x1a, 0x96, 0xf0, 0xf6, 0x87,
-	0x85, 0x93, 0x8f, 0x0d, 0xf2, 0x56, 0x1b, 0xe4, 0x7d, 0x6d, 0x90, 0xf7, 0xb6, 0x45, 0x95, 0xd5,
-	0x16, 0x55, 0x3e, 0xb7, 0xa8, 0xf2, 0x74, 0x1d, 0x0b, 0x3b, 0xcd, 0xa2, 0x80, 0x29, 0x49, 0x5c,
-	0x1c, 0xfd, 0x04, 0x22, 0xb3, 0xbf, 0x90, 0xf9, 0x70, 0x40, 0x16, 0x65, 0x98, 0xfd, 0x7d, 0x9a,
-	0x76, 0x39, 0xe3, 0x26, 0xaa, 0x17, 0x89, 0x0c, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x78, 0x3d,
-	0x32, 0x4c, 0xf2, 0x01, 0x00, 0x00,
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0x63, 0x1a, 0x2a, 0xf0, 0xd1, 0x2a, 0xc2, 0xe5, 0xc3, 0x8e, 0x16, 0x09, 0xa5, 0x87,
+	0xc4, 0x8a, 0x7a, 0x82, 0x5b, 0x8d, 0x10, 0x07, 0x2e, 0x95, 0x23, 0x0e, 0x20, 0xa4, 0xd5, 0x78,
+	0x3b, 0xa4, 0xab, 0x78, 0xbd, 0xd6, 0xce, 0x3a, 0x72, 0x9e, 0x81, 0x0b, 0x8f, 0xc5, 0xb1, 0x47,
+	0x4e, 0x11, 0x4a, 0xde, 0x20, 0x4f, 0x80, 0xfc, 0x91, 0x36, 0x48, 0x90, 0xde, 0xec, 0xfd, 0xfd,
+	0x76, 0x66, 0x67, 0xf4, 0x77, 0xcf, 0x34, 0x29, 0x4d, 0x92, 0x22, 0x52, 0x60, 0x2c, 0x08, 0xa1,
+	0xcb, 0xdc, 0x46, 0x8b, 0x49, 0x8a, 0x16, 0x26, 0x51, 0x01, 0x06, 0x14, 0x8d, 0x0b, 0xa3, 0xad,
+	0xf6, 0x5e, 0x74, 0xea, 0x78, 0x5f, 0x1d, 0x77, 0xea, 0xb3, 0x93, 0x99, 0x9e, 0xe9, 0x46, 0x8c,
+	0xea, 0xaf, 0xf6, 0x0e, 0xfb, 0xde, 0x77, 0x8f, 0x2f, 0x9b, 0x22, 0xde, 0x37, 0xf7, 0xb9, 0x82,
+	0x4a, 0xaa, 0x52, 0xf1, 0x32, 0x87, 0xd2, 0x5e, 0x63, 0x6e, 0xa5, 0x00, 0x8b, 0x57, 0x7c, 0x06,
+	0xe4, 0x3b, 0x03, 0x67, 0xd8, 0x8f, 0x5f, 0x6f, 0x57, 0x21, 0x5b, 0x82, 0xca, 0xde, 0xb2, 0x03,
+	0x32, 0x4b, 0x4e, 0x3b, 0xfa, 0xe9, 0x6f, 0xf8, 0x01, 0xc8, 0xfb, 0xec, 0x3e, 0x95, 0xc4, 0x9b,
+	0x37, 0xf2, 0xee, 0x91, 0x1c, 0x84, 0x95, 0x0b, 0xf4, 0x1f, 0x0c, 0x9c, 0xe1, 0xa3, 0x98, 0x6d,
+	0x57, 0x61, 0xd0, 0xf6, 0xf8, 0x8f, 0xc8, 0x92, 0x13, 0x49, 0xd3, 0x1a, 0x5c, 0xb4, 0xe7, 0x17,
+	0xcd, 0x71, 0x3d, 0x82, 0x90, 0x46, 0x94, 0xd2, 0xf2, 0xd4, 0x20, 0xcc, 0xd1, 0x70, 0xa1, 0x73,
+	0x6b, 0x74, 0x96, 0xa1, 0x21, 0xff, 0x68, 0x70, 0x34, 0x7c, 0xbc, 0x3f, 0xc2, 0x01, 0x99, 0x25,
+	0xa7, 0x1d, 0x8d, 0x5b, 0xf8, 0xee, 0x8e, 0x79, 0x95, 0x5b, 0x4f, 0xcf, 0xb1, 0x2a, 0xa4, 0xc1,
+	0x2b, 0x4e, 0x48, 0x24, 0x75, 0xce, 0xe7, 0xb8, 0x24, 0x5e, 0xa0, 0xe1, 0x69, 0xa6, 0xc5, 0xdc,
+	0xef, 0x37, 0x1b, 0x1b, 0x6d, 0x57, 0xe1, 0xd9, 0xed, 0xc6, 0xee, 0xb9, 0xc3, 0x92, 0x97, 0x0a,
+	0xaa, 0xf7, 0xad, 0x33, 0x6d, 0x95, 0x8f, 0xb8, 0xa4, 0x4b, 0x34, 0x71, 0xcd, 0xbd, 0xaf, 0xae,
+	0x5f, 0x57, 0x31, 0x28, 0xf4, 0x02, 0x8d, 0xc4, 0xfd, 0x7e, 0x0f, 0x9b, 0x7e, 0xaf, 0xb6, 0xab,
+	0x30, 0xbc, 0xeb, 0xf7, 0x2f, 0x93, 0x25, 0x4f, 0x14, 0x54, 0xc9, 0x2d, 0xd9, 0x55, 0x8f, 0xa7,
+	0x3f, 0xd7, 0x81, 0x73, 0xb3, 0x0e, 0x9c, 0xdf, 0xeb, 0xc0, 0xf9, 0xb1, 0x09, 0x7a, 0x37, 0x9b,
+	0xa0, 0xf7, 0x6b, 0x13, 0xf4, 0xbe, 0xbc, 0x99, 0x49, 0x7b, 0x5d, 0xa6, 0x63, 0xa1, 0x55, 0xd4,
+	0xc5, 0x6c, 0x94, 0x41, 0x4a, 0xbb, 0x9f, 0x68, 0x71, 0x3e, 0x89, 0xaa, 0x36, 0xa4, 0xa3, 0x5d,
+	0x4a, 0xed, 0xb2, 0x40, 0x4a, 0x8f, 0x9b, 0xa4, 0x9d, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x48,
+	0xdc, 0x03, 0x98, 0xca, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -145,6 +173,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRecoveriesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRecoveriesPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxExpiredSessionKeysPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxExpiredSessionKeysPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.CircuitBreakerControllers) > 0 {
 		for iNdEx := len(m.CircuitBreakerControllers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.CircuitBreakerControllers[iNdEx])
@@ -201,6 +239,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.MaxExpiredSessionKeysPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxExpiredSessionKeysPerBlock))
+	}
+	if m.MaxRecoveriesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxRecoveriesPerBlock))
+	}
 	return n
 }
 
@@ -310,6 +354,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.CircuitBreakerControllers = append(m.CircuitBreakerControllers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpiredSessionKeysPerBlock", wireType)
+			}
+			m.MaxExpiredSessionKeysPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpiredSessionKeysPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecoveriesPerBlock", wireType)
+			}
+			m.MaxRecoveriesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecoveriesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -301,498 +301,2100 @@ func (m *GetAuthenticatorResponse) GetAccountAuthenticator() *AccountAuthenticat
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.smartaccount.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.smartaccount.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*GetAuthenticatorsRequest)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorsRequest")
-	proto.RegisterType((*GetAuthenticatorsResponse)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorsResponse")
-	proto.RegisterType((*GetAuthenticatorRequest)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorRequest")
-	proto.RegisterType((*GetAuthenticatorResponse)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorResponse")
+// GetSessionKeysRequest defines the Query/GetSessionKeys request type.
+type GetSessionKeysRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("osmosis/smartaccount/v1beta1/query.proto", fileDescriptor_aab2e1fc442f3cc3)
+func (m *GetSessionKeysRequest) Reset()         { *m = GetSessionKeysRequest{} }
+func (m *GetSessionKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSessionKeysRequest) ProtoMessage()    {}
+func (*GetSessionKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{6}
 }
-
-var fileDescriptor_aab2e1fc442f3cc3 = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xae, 0x47, 0x29, 0xc2, 0x3b, 0x30, 0x4c, 0x07, 0xa1, 0xaa, 0x42, 0x15, 0xed, 0xd0, 0x21,
-	0x2d, 0x5e, 0x3b, 0x18, 0xe2, 0xc6, 0x7a, 0x01, 0x6e, 0x10, 0x4e, 0x70, 0x60, 0x72, 0x52, 0x2b,
-	0xb3, 0xd4, 0xc4, 0x59, 0xec, 0x4c, 0x4c, 0xd3, 0x2e, 0x20, 0x71, 0x46, 0xda, 0xbf, 0xe0, 0x37,
-	0xf0, 0x03, 0x26, 0x71, 0x99, 0xc4, 0x85, 0x13, 0x42, 0x2d, 0x3f, 0x04, 0xcd, 0x76, 0x0b, 0x69,
-	0x43, 0x58, 0x76, 0xab, 0x5f, 0xbf, 0xef, 0x7d, 0xdf, 0xf7, 0xde, 0x53, 0x60, 0x97, 0x8b, 0x88,
-	0x0b, 0x26, 0xb0, 0x88, 0x48, 0x2a, 0x49, 0x10, 0xf0, 0x2c, 0x96, 0xf8, 0xa0, 0xe7, 0x53, 0x49,
-	0x7a, 0x78, 0x3f, 0xa3, 0xe9, 0xa1, 0x9b, 0xa4, 0x5c, 0x72, 0xd4, 0x36, 0x48, 0xf7, 0x6f, 0xa4,
-	0x6b, 0x90, 0xad, 0x66, 0xc8, 0x43, 0xae, 0x80, 0xf8, 0xfc, 0x97, 0xe6, 0xb4, 0xda, 0x21, 0xe7,
-	0xe1, 0x88, 0x62, 0x92, 0x30, 0x4c, 0xe2, 0x98, 0x4b, 0x22, 0x19, 0x8f, 0x85, 0xf9, 0xf7, 0x7e,
-	0xa0, 0x5a, 0x62, 0x9f, 0x08, 0xaa, 0xa5, 0x66, 0xc2, 0x09, 0x09, 0x59, 0xac, 0xc0, 0x06, 0xbb,
-	0x5e, 0xea, 0x33, 0x21, 0x29, 0x89, 0xc4, 0x85, 0xa0, 0x11, 0x1f, 0xd2, 0x91, 0x81, 0x3a, 0x4d,
-	0x88, 0x5e, 0x9e, 0xeb, 0xbe, 0x50, 0x7c, 0x8f, 0xee, 0x67, 0x54, 0x48, 0xe7, 0x35, 0xbc, 0x95,
-	0xab, 0x8a, 0x84, 0xc7, 0x82, 0xa2, 0x01, 0x6c, 0x68, 0x1d, 0x0b, 0x74, 0x40, 0x77, 0xb9, 0xbf,
-	0xe6, 0x96, 0x4d, 0xc4, 0xd5, 0xec, 0x41, 0xfd, 0xf4, 0xc7, 0xbd, 0x9a, 0x67, 0x98, 0xce, 0x03,
-	0x68, 0x3d, 0xa5, 0x72, 0x27, 0x93, 0x7b, 0x34, 0x96, 0x2c, 0x20, 0x92, 0xa7, 0x53, 0x59, 0x64,
-	0xc1, 0x6b, 0xa6, 0x87, 0x12, 0xb8, 0xee, 0x4d, 0x9f, 0xce, 0x47, 0x00, 0xef, 0x16, 0xd0, 0x8c,
-	0x2f, 0x06, 0x6f, 0x1b, 0xe0, 0x2e, 0xc9, 0x21, 0x2c, 0xd0, 0xb9, 0xd2, 0x5d, 0xee, 0xf7, 0xcb,
-	0x7d, 0xee, 0xe8, 0x77, 0xae, 0xb9, 0xb7, 0x4a, 0x0a, 0xaa, 0xc2, 0x79, 0x0b, 0xef, 0xcc, 0xfb,
-	0xf8, 0xaf, 0x7b, 0xb4, 0x0e, 0x57, 0x72, 0xbe, 0x76, 0xd9, 0xd0, 0x5a, 0xea, 0x80, 0x6e, 0xdd,
-	0xbb, 0x91, 0xab, 0x3f, 0x1f, 0x3a, 0x1f, 0xc0, 0xe2, 0x7c, 0x66, 0x39, 0x43, 0xb8, 0x5a, 0x98,
-	0xd3, 0xac, 0xe3, 0x32, 0x31, 0x9b, 0x45, 0x31, 0xfb, 0x9f, 0xeb, 0xf0, 0xaa, 0x3a, 0x00, 0x74,
-	0x02, 0x60, 0x43, 0xef, 0x11, 0x6d, 0x96, 0xb7, 0x5f, 0x3c, 0xa3, 0x56, 0xaf, 0x02, 0x43, 0x47,
-	0x74, 0xd6, 0xde, 0x7f, 0xfb, 0x75, 0xb2, 0x64, 0xa3, 0x36, 0x2e, 0xbc, 0x61, 0x7d, 0x44, 0xe8,
-	0x2b, 0x80, 0x2b, 0xf3, 0x53, 0x42, 0x0f, 0xcb, 0xd5, 0xfe, 0xb1, 0xb6, 0xd6, 0x76, 0x55, 0x9a,
-	0x71, 0xfa, 0x4c, 0x39, 0x1d, 0xa0, 0x27, 0xc5, 0x4e, 0x73, 0x0b, 0xc2, 0x47, 0xa6, 0x7c, 0x8c,
-	0x8f, 0xe6, 0x2f, 0xe1, 0x18, 0x7d, 0x01, 0xf0, 0xe6, 0xc2, 0x71, 0xa3, 0x8a, 0xbe, 0x66, 0x43,
-	0x7f, 0x54, 0x99, 0x67, 0x02, 0x6d, 0xab, 0x40, 0x9b, 0xc8, 0xbd, 0x40, 0x20, 0xf1, 0x27, 0xd1,
-	0xe0, 0xd5, 0xe9, 0xd8, 0x06, 0x67, 0x63, 0x1b, 0xfc, 0x1c, 0xdb, 0xe0, 0xd3, 0xc4, 0xae, 0x9d,
-	0x4d, 0xec, 0xda, 0xf7, 0x89, 0x5d, 0x7b, 0xf3, 0x38, 0x64, 0x72, 0x2f, 0xf3, 0xdd, 0x80, 0x47,
-	0xd3, 0x9e, 0x1b, 0x23, 0xe2, 0x8b, 0x99, 0xc0, 0xc1, 0x56, 0x0f, 0xbf, 0xd3, 0x32, 0x1b, 0x53,
-	0x1d, 0x79, 0x98, 0x50, 0xe1, 0x37, 0xd4, 0xe7, 0x69, 0xeb, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xa5, 0xda, 0xd0, 0x3e, 0x9e, 0x05, 0x00, 0x00,
+func (m *GetSessionKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	GetAuthenticator(ctx context.Context, in *GetAuthenticatorRequest, opts ...grpc.CallOption) (*GetAuthenticatorResponse, error)
-	GetAuthenticators(ctx context.Context, in *GetAuthenticatorsRequest, opts ...grpc.CallOption) (*GetAuthenticatorsResponse, error)
+func (m *GetSessionKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSessionKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *GetSessionKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSessionKeysRequest.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *GetSessionKeysRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetSessionKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSessionKeysRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetAuthenticator(ctx context.Context, in *GetAuthenticatorRequest, opts ...grpc.CallOption) (*GetAuthenticatorResponse, error) {
-	out := new(GetAuthenticatorResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Query/GetAuthenticator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_GetSessionKeysRequest proto.InternalMessageInfo
 
-func (c *queryClient) GetAuthenticators(ctx context.Context, in *GetAuthenticatorsRequest, opts ...grpc.CallOption) (*GetAuthenticatorsResponse, error) {
-	out := new(GetAuthenticatorsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Query/GetAuthenticators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetSessionKeysRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	GetAuthenticator(context.Context, *GetAuthenticatorRequest) (*GetAuthenticatorResponse, error)
-	GetAuthenticators(context.Context, *GetAuthenticatorsRequest) (*GetAuthenticatorsResponse, error)
+// GetSessionKeysResponse defines the Query/GetSessionKeys response type.
+type GetSessionKeysResponse struct {
+	SessionKeys []SessionKeyInfo `protobuf:"bytes,1,rep,name=session_keys,json=sessionKeys,proto3" json:"session_keys"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *GetSessionKeysResponse) Reset()         { *m = GetSessionKeysResponse{} }
+func (m *GetSessionKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSessionKeysResponse) ProtoMessage()    {}
+func (*GetSessionKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{7}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *GetSessionKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetAuthenticator(ctx context.Context, req *GetAuthenticatorRequest) (*GetAuthenticatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuthenticator not implemented")
+func (m *GetSessionKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSessionKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetAuthenticators(ctx context.Context, req *GetAuthenticatorsRequest) (*GetAuthenticatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuthenticators not implemented")
+func (m *GetSessionKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSessionKeysResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *GetSessionKeysResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.smartaccount.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *GetSessionKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSessionKeysResponse.DiscardUnknown(m)
 }
 
-func _Query_GetAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAuthenticatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAuthenticator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.smartaccount.v1beta1.Query/GetAuthenticator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAuthenticator(ctx, req.(*GetAuthenticatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_GetSessionKeysResponse proto.InternalMessageInfo
 
-func _Query_GetAuthenticators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAuthenticatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAuthenticators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.smartaccount.v1beta1.Query/GetAuthenticators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAuthenticators(ctx, req.(*GetAuthenticatorsRequest))
+func (m *GetSessionKeysResponse) GetSessionKeys() []SessionKeyInfo {
+	if m != nil {
+		return m.SessionKeys
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.smartaccount.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetAuthenticator",
-			Handler:    _Query_GetAuthenticator_Handler,
-		},
-		{
-			MethodName: "GetAuthenticators",
-			Handler:    _Query_GetAuthenticators_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/smartaccount/v1beta1/query.proto",
+// GetPendingRecoveryRequest defines the Query/GetPendingRecovery request type.
+type GetPendingRecoveryRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetPendingRecoveryRequest) Reset()         { *m = GetPendingRecoveryRequest{} }
+func (m *GetPendingRecoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPendingRecoveryRequest) ProtoMessage()    {}
+func (*GetPendingRecoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{8}
+}
+func (m *GetPendingRecoveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPendingRecoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPendingRecoveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetPendingRecoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingRecoveryRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *GetPendingRecoveryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPendingRecoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingRecoveryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetPendingRecoveryRequest proto.InternalMessageInfo
+
+func (m *GetPendingRecoveryRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GetPendingRecoveryResponse defines the Query/GetPendingRecovery response
+// type. pending_recovery is empty if the account has no pending recovery.
+type GetPendingRecoveryResponse struct {
+	PendingRecovery *PendingRecovery `protobuf:"bytes,1,opt,name=pending_recovery,json=pendingRecovery,proto3" json:"pending_recovery,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *GetPendingRecoveryResponse) Reset()         { *m = GetPendingRecoveryResponse{} }
+func (m *GetPendingRecoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPendingRecoveryResponse) ProtoMessage()    {}
+func (*GetPendingRecoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{9}
+}
+func (m *GetPendingRecoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPendingRecoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPendingRecoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *GetPendingRecoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingRecoveryResponse.Merge(m, src)
+}
+func (m *GetPendingRecoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPendingRecoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingRecoveryResponse.DiscardUnknown(m)
 }
 
-func (m *GetAuthenticatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetPendingRecoveryResponse proto.InternalMessageInfo
+
+func (m *GetPendingRecoveryResponse) GetPendingRecovery() *PendingRecovery {
+	if m != nil {
+		return m.PendingRecovery
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetAuthenticatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SimulateAuthenticationRequest defines the Query/SimulateAuthentication
+// request type.
+type SimulateAuthenticationRequest struct {
+	// tx is the encoded tx to authenticate. If it is not signed, signatures are
+	// not verified, as in a simulated tx.
+	Tx []byte `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// selected_authenticators are the authenticators to use for each message. If
+	// empty, the authenticators selected in the tx extension are used.
+	SelectedAuthenticators []uint64 `protobuf:"varint,2,rep,packed,name=selected_authenticators,json=selectedAuthenticators,proto3" json:"selected_authenticators,omitempty"`
 }
 
-func (m *GetAuthenticatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+func (m *SimulateAuthenticationRequest) Reset()         { *m = SimulateAuthenticationRequest{} }
+func (m *SimulateAuthenticationRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateAuthenticationRequest) ProtoMessage()    {}
+func (*SimulateAuthenticationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{10}
+}
+func (m *SimulateAuthenticationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateAuthenticationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateAuthenticationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SimulateAuthenticationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateAuthenticationRequest.Merge(m, src)
+}
+func (m *SimulateAuthenticationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateAuthenticationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateAuthenticationRequest.DiscardUnknown(m)
 }
 
-func (m *GetAuthenticatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SimulateAuthenticationRequest proto.InternalMessageInfo
+
+func (m *SimulateAuthenticationRequest) GetTx() []byte {
+	if m != nil {
+		return m.Tx
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetAuthenticatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SimulateAuthenticationRequest) GetSelectedAuthenticators() []uint64 {
+	if m != nil {
+		return m.SelectedAuthenticators
+	}
+	return nil
 }
 
-func (m *GetAuthenticatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AccountAuthenticators) > 0 {
-		for iNdEx := len(m.AccountAuthenticators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccountAuthenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// SimulateAuthenticationResponse defines the Query/SimulateAuthentication
+// response type.
+type SimulateAuthenticationResponse struct {
+	// authenticated is true if every message of the tx is authenticated.
+	Authenticated bool `protobuf:"varint,1,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
+	// signatures_skipped is true if the tx is not signed and signatures were not
+	// verified.
+	SignaturesSkipped bool                      `protobuf:"varint,2,opt,name=signatures_skipped,json=signaturesSkipped,proto3" json:"signatures_skipped,omitempty"`
+	MsgResults        []MsgAuthenticationResult `protobuf:"bytes,3,rep,name=msg_results,json=msgResults,proto3" json:"msg_results"`
 }
 
-func (m *GetAuthenticatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SimulateAuthenticationResponse) Reset()         { *m = SimulateAuthenticationResponse{} }
+func (m *SimulateAuthenticationResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateAuthenticationResponse) ProtoMessage()    {}
+func (*SimulateAuthenticationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{11}
+}
+func (m *SimulateAuthenticationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateAuthenticationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateAuthenticationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetAuthenticatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SimulateAuthenticationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateAuthenticationResponse.Merge(m, src)
+}
+func (m *SimulateAuthenticationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateAuthenticationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateAuthenticationResponse.DiscardUnknown(m)
 }
 
-func (m *GetAuthenticatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AuthenticatorId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuthenticatorId))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_SimulateAuthenticationResponse proto.InternalMessageInfo
+
+func (m *SimulateAuthenticationResponse) GetAuthenticated() bool {
+	if m != nil {
+		return m.Authenticated
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *SimulateAuthenticationResponse) GetSignaturesSkipped() bool {
+	if m != nil {
+		return m.SignaturesSkipped
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *GetAuthenticatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SimulateAuthenticationResponse) GetMsgResults() []MsgAuthenticationResult {
+	if m != nil {
+		return m.MsgResults
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetAuthenticatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAuthenticationResult is the result of the authentication of a message.
+type MsgAuthenticationResult struct {
+	MsgIndex      uint64              `protobuf:"varint,1,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	MsgTypeUrl    string              `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Account       string              `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Authenticator AuthenticatorResult `protobuf:"bytes,4,opt,name=authenticator,proto3" json:"authenticator"`
 }
 
-func (m *GetAuthenticatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AccountAuthenticator != nil {
-		{
-			size, err := m.AccountAuthenticator.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *MsgAuthenticationResult) Reset()         { *m = MsgAuthenticationResult{} }
+func (m *MsgAuthenticationResult) String() string { return proto.CompactTextString(m) }
+func (*MsgAuthenticationResult) ProtoMessage()    {}
+func (*MsgAuthenticationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{12}
+}
+func (m *MsgAuthenticationResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAuthenticationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAuthenticationResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAuthenticationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthenticationResult.Merge(m, src)
+}
+func (m *MsgAuthenticationResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAuthenticationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthenticationResult.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgAuthenticationResult proto.InternalMessageInfo
+
+func (m *MsgAuthenticationResult) GetMsgIndex() uint64 {
+	if m != nil {
+		return m.MsgIndex
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgAuthenticationResult) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAuthenticationResult) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *GetAuthenticatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *MsgAuthenticationResult) GetAuthenticator() AuthenticatorResult {
+	if m != nil {
+		return m.Authenticator
 	}
-	return n
+	return AuthenticatorResult{}
 }
 
-func (m *GetAuthenticatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AccountAuthenticators) > 0 {
-		for _, e := range m.AccountAuthenticators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// AuthenticatorResult is the result of an authenticator for a message. For
+// composite authenticators, it includes the result of each sub-authenticator.
+type AuthenticatorResult struct {
+	// authenticator_id is the id of the authenticator, or the composite id of a
+	// sub-authenticator, such as 1.0.
+	AuthenticatorId string `protobuf:"bytes,1,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+	Type            string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Authenticated   bool   `protobuf:"varint,3,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
+	// error is the reason the authenticator rejected the message.
+	Error             string                `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	SubAuthenticators []AuthenticatorResult `protobuf:"bytes,5,rep,name=sub_authenticators,json=subAuthenticators,proto3" json:"sub_authenticators"`
+}
+
+func (m *AuthenticatorResult) Reset()         { *m = AuthenticatorResult{} }
+func (m *AuthenticatorResult) String() string { return proto.CompactTextString(m) }
+func (*AuthenticatorResult) ProtoMessage()    {}
+func (*AuthenticatorResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{13}
+}
+func (m *AuthenticatorResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthenticatorResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthenticatorResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *AuthenticatorResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticatorResult.Merge(m, src)
+}
+func (m *AuthenticatorResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthenticatorResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticatorResult.DiscardUnknown(m)
 }
 
-func (m *GetAuthenticatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AuthenticatorId != 0 {
-		n += 1 + sovQuery(uint64(m.AuthenticatorId))
+var xxx_messageInfo_AuthenticatorResult proto.InternalMessageInfo
+
+func (m *AuthenticatorResult) GetAuthenticatorId() string {
+	if m != nil {
+		return m.AuthenticatorId
 	}
-	return n
+	return ""
 }
 
-func (m *GetAuthenticatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AuthenticatorResult) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	var l int
-	_ = l
-	if m.AccountAuthenticator != nil {
-		l = m.AccountAuthenticator.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *AuthenticatorResult) GetAuthenticated() bool {
+	if m != nil {
+		return m.Authenticated
 	}
-	return n
+	return false
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *AuthenticatorResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AuthenticatorResult) GetSubAuthenticators() []AuthenticatorResult {
+	if m != nil {
+		return m.SubAuthenticators
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.smartaccount.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.smartaccount.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*GetAuthenticatorsRequest)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorsRequest")
+	proto.RegisterType((*GetAuthenticatorsResponse)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorsResponse")
+	proto.RegisterType((*GetAuthenticatorRequest)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorRequest")
+	proto.RegisterType((*GetAuthenticatorResponse)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorResponse")
+	proto.RegisterType((*GetSessionKeysRequest)(nil), "osmosis.smartaccount.v1beta1.GetSessionKeysRequest")
+	proto.RegisterType((*GetSessionKeysResponse)(nil), "osmosis.smartaccount.v1beta1.GetSessionKeysResponse")
+	proto.RegisterType((*GetPendingRecoveryRequest)(nil), "osmosis.smartaccount.v1beta1.GetPendingRecoveryRequest")
+	proto.RegisterType((*GetPendingRecoveryResponse)(nil), "osmosis.smartaccount.v1beta1.GetPendingRecoveryResponse")
+	proto.RegisterType((*SimulateAuthenticationRequest)(nil), "osmosis.smartaccount.v1beta1.SimulateAuthenticationRequest")
+	proto.RegisterType((*SimulateAuthenticationResponse)(nil), "osmosis.smartaccount.v1beta1.SimulateAuthenticationResponse")
+	proto.RegisterType((*MsgAuthenticationResult)(nil), "osmosis.smartaccount.v1beta1.MsgAuthenticationResult")
+	proto.RegisterType((*AuthenticatorResult)(nil), "osmosis.smartaccount.v1beta1.AuthenticatorResult")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/smartaccount/v1beta1/query.proto", fileDescriptor_aab2e1fc442f3cc3)
+}
+
+var fileDescriptor_aab2e1fc442f3cc3 = []byte{
+	// 976 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xd8, 0x4e, 0x48, 0x5e, 0x42, 0x9b, 0x4c, 0xf3, 0x61, 0x4c, 0x30, 0xd6, 0x2a, 0x07,
+	0xb7, 0xaa, 0xbd, 0xb1, 0x93, 0x7e, 0xf0, 0x71, 0xa0, 0xb9, 0x94, 0x08, 0x21, 0x95, 0x0d, 0x95,
+	0x00, 0x01, 0xd6, 0xda, 0x9e, 0x6e, 0x46, 0xdd, 0xdd, 0xd9, 0xee, 0xcc, 0x46, 0xb1, 0xaa, 0x5e,
+	0x40, 0xe2, 0x8c, 0xd4, 0xbf, 0x84, 0x3b, 0x17, 0x6e, 0x95, 0x10, 0x52, 0x51, 0x2e, 0x9c, 0x10,
+	0x4a, 0xf8, 0x0b, 0x38, 0x71, 0xac, 0x3c, 0x33, 0xfe, 0xd8, 0xf5, 0xda, 0x8e, 0x73, 0xf3, 0xbc,
+	0x79, 0x1f, 0xbf, 0xdf, 0x7b, 0x6f, 0xe7, 0x67, 0x28, 0x33, 0xee, 0x31, 0x4e, 0xb9, 0xc9, 0x3d,
+	0x3b, 0x14, 0x76, 0xab, 0xc5, 0x22, 0x5f, 0x98, 0x27, 0xb5, 0x26, 0x11, 0x76, 0xcd, 0x7c, 0x16,
+	0x91, 0xb0, 0x53, 0x0d, 0x42, 0x26, 0x18, 0xde, 0xd6, 0x9e, 0xd5, 0x61, 0xcf, 0xaa, 0xf6, 0x2c,
+	0xac, 0x3b, 0xcc, 0x61, 0xd2, 0xd1, 0xec, 0xfe, 0x52, 0x31, 0x85, 0x6d, 0x87, 0x31, 0xc7, 0x25,
+	0xa6, 0x1d, 0x50, 0xd3, 0xf6, 0x7d, 0x26, 0x6c, 0x41, 0x99, 0xcf, 0xf5, 0xed, 0xad, 0x96, 0x4c,
+	0x69, 0x36, 0x6d, 0x4e, 0x54, 0xa9, 0x7e, 0xe1, 0xc0, 0x76, 0xa8, 0x2f, 0x9d, 0xb5, 0xef, 0xcd,
+	0x89, 0x38, 0x03, 0x3b, 0xb4, 0x3d, 0x7e, 0x29, 0x57, 0x8f, 0xb5, 0x89, 0xab, 0x5d, 0x8d, 0x75,
+	0xc0, 0x5f, 0x74, 0xeb, 0x3e, 0x92, 0xf1, 0x16, 0x79, 0x16, 0x11, 0x2e, 0x8c, 0xaf, 0xe1, 0x46,
+	0xcc, 0xca, 0x03, 0xe6, 0x73, 0x82, 0x0f, 0x60, 0x41, 0xd5, 0xc9, 0xa3, 0x12, 0x2a, 0x2f, 0xd7,
+	0x77, 0xaa, 0x93, 0x3a, 0x52, 0x55, 0xd1, 0x07, 0xb9, 0x57, 0x7f, 0xbf, 0x3f, 0x67, 0xe9, 0x48,
+	0x63, 0x1f, 0xf2, 0x0f, 0x89, 0x78, 0x10, 0x89, 0x63, 0xe2, 0x0b, 0xda, 0xb2, 0x05, 0x0b, 0x7b,
+	0x65, 0x71, 0x1e, 0xde, 0xd2, 0x39, 0x64, 0x81, 0x25, 0xab, 0x77, 0x34, 0x7e, 0x42, 0xf0, 0x4e,
+	0x4a, 0x98, 0xc6, 0x45, 0x61, 0x53, 0x3b, 0x36, 0xec, 0x98, 0x47, 0x1e, 0x95, 0xb2, 0xe5, 0xe5,
+	0x7a, 0x7d, 0x32, 0xce, 0x07, 0xea, 0x1c, 0x4b, 0x6e, 0x6d, 0xd8, 0x29, 0x56, 0x6e, 0x7c, 0x0f,
+	0x5b, 0x49, 0x1c, 0x53, 0xd1, 0xe3, 0x9b, 0xb0, 0x1a, 0xc3, 0xd5, 0xa0, 0xed, 0x7c, 0xa6, 0x84,
+	0xca, 0x39, 0xeb, 0x7a, 0xcc, 0x7e, 0xd8, 0x36, 0x7e, 0x44, 0xa3, 0xfd, 0xe9, 0xf3, 0x74, 0x60,
+	0x23, 0x95, 0xa7, 0x1e, 0xc7, 0x55, 0x68, 0xae, 0xa7, 0xd1, 0x34, 0x6a, 0xb0, 0xf1, 0x90, 0x88,
+	0x23, 0xc2, 0x39, 0x65, 0xfe, 0x67, 0xa4, 0x73, 0x89, 0x09, 0x31, 0xd8, 0x4c, 0x86, 0x68, 0xd4,
+	0x8f, 0x61, 0x85, 0x2b, 0x73, 0xe3, 0x29, 0xe9, 0xf4, 0x66, 0x72, 0x7b, 0x32, 0xd8, 0x41, 0xa2,
+	0x43, 0xff, 0x09, 0xd3, 0x3b, 0xb4, 0xcc, 0x07, 0xe9, 0x8d, 0x3b, 0x72, 0x23, 0x1e, 0x11, 0xbf,
+	0x4d, 0x7d, 0xc7, 0x22, 0x2d, 0x76, 0x42, 0xc2, 0xce, 0x74, 0x9c, 0x27, 0x50, 0x48, 0x0b, 0xd3,
+	0x58, 0xbf, 0x82, 0xd5, 0x40, 0x5d, 0x35, 0x42, 0x7d, 0xa7, 0x9b, 0x5b, 0x99, 0xb2, 0xeb, 0x89,
+	0x84, 0xd7, 0x83, 0xb8, 0xc1, 0x38, 0x86, 0xf7, 0x8e, 0xa8, 0x17, 0xb9, 0xb6, 0x20, 0x43, 0xbd,
+	0xa6, 0xcc, 0xef, 0x41, 0xbe, 0x06, 0x19, 0x71, 0x2a, 0x8b, 0xad, 0x58, 0x19, 0x71, 0x8a, 0xef,
+	0xc1, 0x16, 0x27, 0x2e, 0x69, 0x09, 0xd2, 0x4e, 0x6e, 0x75, 0xa6, 0x94, 0x2d, 0xe7, 0xac, 0xcd,
+	0xde, 0x75, 0x62, 0x45, 0xcf, 0x10, 0x14, 0xc7, 0x95, 0xd2, 0x34, 0x77, 0xe0, 0xed, 0xa1, 0x94,
+	0xa4, 0x2d, 0xcb, 0x2e, 0x5a, 0x71, 0x23, 0xae, 0x00, 0xe6, 0xd4, 0xf1, 0x6d, 0x11, 0x85, 0x84,
+	0x37, 0xf8, 0x53, 0x1a, 0x04, 0x44, 0x2d, 0xee, 0xa2, 0xb5, 0x36, 0xb8, 0x39, 0x52, 0x17, 0xf8,
+	0x5b, 0x58, 0xf6, 0x78, 0xb7, 0x6f, 0x3c, 0x72, 0x05, 0xcf, 0x67, 0xe5, 0x98, 0xef, 0x4c, 0x6e,
+	0xdb, 0xe7, 0xdc, 0x19, 0x81, 0x18, 0xb9, 0x42, 0xcf, 0x1b, 0x3c, 0xee, 0x28, 0x03, 0x37, 0xfe,
+	0x44, 0xb0, 0x35, 0xc6, 0x1b, 0xbf, 0x0b, 0x4b, 0xdd, 0xca, 0xd4, 0x6f, 0x13, 0xd5, 0xc1, 0x9c,
+	0xb5, 0xe8, 0x71, 0xe7, 0xb0, 0x7b, 0xc6, 0x25, 0x58, 0xe9, 0x5e, 0x8a, 0x4e, 0x40, 0x1a, 0x51,
+	0xe8, 0x4a, 0xfc, 0x4b, 0x32, 0xf5, 0x97, 0x9d, 0x80, 0x3c, 0x0e, 0xdd, 0xe1, 0x65, 0xc9, 0xc6,
+	0x3f, 0xdc, 0xef, 0x62, 0x7d, 0x62, 0x61, 0x3e, 0x27, 0x77, 0xa1, 0x36, 0xe5, 0x43, 0x4b, 0x7c,
+	0xbc, 0x03, 0x42, 0xf1, 0x6c, 0xc6, 0xff, 0x08, 0x6e, 0xa4, 0x38, 0xa7, 0xbe, 0x17, 0x6a, 0x8d,
+	0x93, 0xef, 0x05, 0xc6, 0x90, 0xeb, 0x32, 0xd3, 0xac, 0xe4, 0xef, 0xd1, 0xe9, 0x66, 0xd3, 0xa6,
+	0xbb, 0x0e, 0xf3, 0x24, 0x0c, 0x35, 0xa7, 0x25, 0x4b, 0x1d, 0xf0, 0x13, 0xc0, 0x3c, 0x6a, 0x26,
+	0x17, 0x6e, 0x5e, 0xce, 0xf2, 0xca, 0xb4, 0xd7, 0x78, 0xd4, 0x8c, 0x2f, 0x69, 0xfd, 0xbf, 0x45,
+	0x98, 0x97, 0x12, 0x83, 0x5f, 0x22, 0x58, 0x50, 0x4a, 0x81, 0x77, 0x27, 0x17, 0x18, 0x15, 0xaa,
+	0x42, 0x6d, 0x86, 0x08, 0xb5, 0xfb, 0xc6, 0xce, 0x0f, 0x67, 0xff, 0xbe, 0xcc, 0x14, 0xf1, 0xb6,
+	0x99, 0xaa, 0x92, 0x4a, 0xa6, 0xf0, 0xef, 0x08, 0x56, 0x93, 0xef, 0x30, 0x9e, 0xb2, 0xcc, 0x63,
+	0x84, 0xa1, 0x70, 0x77, 0xd6, 0x30, 0x8d, 0xf4, 0x53, 0x89, 0xf4, 0x00, 0x7f, 0x92, 0x8e, 0x34,
+	0x36, 0x23, 0xf3, 0xb9, 0x36, 0xbf, 0x30, 0x9f, 0x27, 0x77, 0xe7, 0x05, 0xfe, 0x15, 0xc1, 0xda,
+	0x88, 0x7c, 0xe2, 0x19, 0x71, 0xf5, 0x9b, 0x7e, 0x6f, 0xe6, 0x38, 0x4d, 0xe8, 0xae, 0x24, 0xb4,
+	0x8b, 0xab, 0x97, 0x20, 0xc4, 0x07, 0x8c, 0xf0, 0x2f, 0x08, 0xae, 0xc5, 0xc5, 0x05, 0xef, 0x4d,
+	0xc5, 0x30, 0xaa, 0x5e, 0x85, 0xfd, 0xd9, 0x82, 0x34, 0xea, 0x7d, 0x89, 0xba, 0x8a, 0x6f, 0xa7,
+	0xa3, 0x1e, 0xd6, 0xb6, 0x21, 0xcc, 0xbf, 0x21, 0xc0, 0xa3, 0x42, 0x83, 0xa7, 0xf7, 0x2e, 0x5d,
+	0xd1, 0x0a, 0xf7, 0x67, 0x0f, 0xd4, 0xf8, 0xef, 0x4b, 0xfc, 0x75, 0xbc, 0x3b, 0x66, 0xe1, 0x13,
+	0x7a, 0x37, 0xc4, 0xe1, 0x0f, 0x04, 0x9b, 0xe9, 0x4a, 0x82, 0x3f, 0x9a, 0x22, 0xdf, 0x93, 0xa4,
+	0xae, 0xf0, 0xf1, 0xd5, 0x82, 0xe3, 0x7c, 0x3e, 0x44, 0xb7, 0x8c, 0xca, 0x98, 0x91, 0xe8, 0x04,
+	0xc3, 0xcf, 0x18, 0x65, 0xfe, 0xc1, 0xd1, 0xab, 0xf3, 0x22, 0x7a, 0x7d, 0x5e, 0x44, 0xff, 0x9c,
+	0x17, 0xd1, 0xcf, 0x17, 0xc5, 0xb9, 0xd7, 0x17, 0xc5, 0xb9, 0xbf, 0x2e, 0x8a, 0x73, 0xdf, 0x7c,
+	0xe0, 0x50, 0x71, 0x1c, 0x35, 0xab, 0x2d, 0xe6, 0xf5, 0x52, 0x56, 0x5c, 0xbb, 0xc9, 0xfb, 0xf9,
+	0x4f, 0xf6, 0x6a, 0xe6, 0xa9, 0xaa, 0x52, 0xe9, 0x95, 0xe9, 0x3e, 0xb6, 0xbc, 0xb9, 0x20, 0xff,
+	0x48, 0xef, 0xbd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x1e, 0xe9, 0x05, 0xe2, 0x48, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	GetAuthenticator(ctx context.Context, in *GetAuthenticatorRequest, opts ...grpc.CallOption) (*GetAuthenticatorResponse, error)
+	GetAuthenticators(ctx context.Context, in *GetAuthenticatorsRequest, opts ...grpc.CallOption) (*GetAuthenticatorsResponse, error)
+	// GetSessionKeys returns the SessionKey authenticators of an account that
+	// can currently be used.
+	GetSessionKeys(ctx context.Context, in *GetSessionKeysRequest, opts ...grpc.CallOption) (*GetSessionKeysResponse, error)
+	// GetPendingRecovery returns the recovery proposed by a guardian of the
+	// account that has not been executed yet.
+	GetPendingRecovery(ctx context.Context, in *GetPendingRecoveryRequest, opts ...grpc.CallOption) (*GetPendingRecoveryResponse, error)
+	// SimulateAuthentication runs the authenticators selected for each message
+	// of a tx and returns which authenticators, and composite sub-authenticators,
+	// accepted or rejected it. No state is written.
+	SimulateAuthentication(ctx context.Context, in *SimulateAuthenticationRequest, opts ...grpc.CallOption) (*SimulateAuthenticationResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAuthenticator(ctx context.Context, in *GetAuthenticatorRequest, opts ...grpc.CallOption) (*GetAuthenticatorResponse, error) {
+	out := new(GetAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Query/GetAuthenticator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAuthenticators(ctx context.Context, in *GetAuthenticatorsRequest, opts ...grpc.CallOption) (*GetAuthenticatorsResponse, error) {
+	out := new(GetAuthenticatorsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Query/GetAuthenticators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSessionKeys(ctx context.Context, in *GetSessionKeysRequest, opts ...grpc.CallOption) (*GetSessionKeysResponse, error) {
+	out := new(GetSessionKeysResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Query/GetSessionKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPendingRecovery(ctx context.Context, in *GetPendingRecoveryRequest, opts ...grpc.CallOption) (*GetPendingRecoveryResponse, error) {
+	out := new(GetPendingRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Query/GetPendingRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateAuthentication(ctx context.Context, in *SimulateAuthenticationRequest, opts ...grpc.CallOption) (*SimulateAuthenticationResponse, error) {
+	out := new(SimulateAuthenticationResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Query/SimulateAuthentication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	GetAuthenticator(context.Context, *GetAuthenticatorRequest) (*GetAuthenticatorResponse, error)
+	GetAuthenticators(context.Context, *GetAuthenticatorsRequest) (*GetAuthenticatorsResponse, error)
+	// GetSessionKeys returns the SessionKey authenticators of an account that
+	// can currently be used.
+	GetSessionKeys(context.Context, *GetSessionKeysRequest) (*GetSessionKeysResponse, error)
+	// GetPendingRecovery returns the recovery proposed by a guardian of the
+	// account that has not been executed yet.
+	GetPendingRecovery(context.Context, *GetPendingRecoveryRequest) (*GetPendingRecoveryResponse, error)
+	// SimulateAuthentication runs the authenticators selected for each message
+	// of a tx and returns which authenticators, and composite sub-authenticators,
+	// accepted or rejected it. No state is written.
+	SimulateAuthentication(context.Context, *SimulateAuthenticationRequest) (*SimulateAuthenticationResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetAuthenticator(ctx context.Context, req *GetAuthenticatorRequest) (*GetAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthenticator not implemented")
+}
+func (*UnimplementedQueryServer) GetAuthenticators(ctx context.Context, req *GetAuthenticatorsRequest) (*GetAuthenticatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthenticators not implemented")
+}
+func (*UnimplementedQueryServer) GetSessionKeys(ctx context.Context, req *GetSessionKeysRequest) (*GetSessionKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionKeys not implemented")
+}
+func (*UnimplementedQueryServer) GetPendingRecovery(ctx context.Context, req *GetPendingRecoveryRequest) (*GetPendingRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingRecovery not implemented")
+}
+func (*UnimplementedQueryServer) SimulateAuthentication(ctx context.Context, req *SimulateAuthenticationRequest) (*SimulateAuthenticationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAuthentication not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.smartaccount.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthenticatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.smartaccount.v1beta1.Query/GetAuthenticator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAuthenticator(ctx, req.(*GetAuthenticatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAuthenticators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthenticatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAuthenticators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.smartaccount.v1beta1.Query/GetAuthenticators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAuthenticators(ctx, req.(*GetAuthenticatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSessionKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSessionKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.smartaccount.v1beta1.Query/GetSessionKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSessionKeys(ctx, req.(*GetSessionKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPendingRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPendingRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.smartaccount.v1beta1.Query/GetPendingRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPendingRecovery(ctx, req.(*GetPendingRecoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateAuthentication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateAuthenticationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateAuthentication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.smartaccount.v1beta1.Query/SimulateAuthentication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateAuthentication(ctx, req.(*SimulateAuthenticationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.smartaccount.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetAuthenticator",
+			Handler:    _Query_GetAuthenticator_Handler,
+		},
+		{
+			MethodName: "GetAuthenticators",
+			Handler:    _Query_GetAuthenticators_Handler,
+		},
+		{
+			MethodName: "GetSessionKeys",
+			Handler:    _Query_GetSessionKeys_Handler,
+		},
+		{
+			MethodName: "GetPendingRecovery",
+			Handler:    _Query_GetPendingRecovery_Handler,
+		},
+		{
+			MethodName: "SimulateAuthentication",
+			Handler:    _Query_SimulateAuthentication_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/smartaccount/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAuthenticatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAuthenticatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAuthenticatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAuthenticatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAuthenticatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAuthenticatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountAuthenticators) > 0 {
+		for iNdEx := len(m.AccountAuthenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountAuthenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAuthenticatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAuthenticatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAuthenticatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuthenticatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuthenticatorId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAuthenticatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAuthenticatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAuthenticatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccountAuthenticator != nil {
+		{
+			size, err := m.AccountAuthenticator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSessionKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSessionKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSessionKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSessionKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSessionKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSessionKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionKeys) > 0 {
+		for iNdEx := len(m.SessionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPendingRecoveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPendingRecoveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPendingRecoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPendingRecoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPendingRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPendingRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingRecovery != nil {
+		{
+			size, err := m.PendingRecovery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateAuthenticationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateAuthenticationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateAuthenticationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SelectedAuthenticators) > 0 {
+		dAtA5 := make([]byte, len(m.SelectedAuthenticators)*10)
+		var j4 int
+		for _, num := range m.SelectedAuthenticators {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintQuery(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateAuthenticationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateAuthenticationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateAuthenticationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.SignaturesSkipped {
+		i--
+		if m.SignaturesSkipped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Authenticated {
+		i--
+		if m.Authenticated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAuthenticationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthenticationResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthenticationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Authenticator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticatorResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticatorResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticatorResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubAuthenticators) > 0 {
+		for iNdEx := len(m.SubAuthenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubAuthenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Authenticated {
+		i--
+		if m.Authenticated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuthenticatorId) > 0 {
+		i -= len(m.AuthenticatorId)
+		copy(dAtA[i:], m.AuthenticatorId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AuthenticatorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetAuthenticatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAuthenticatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccountAuthenticators) > 0 {
+		for _, e := range m.AccountAuthenticators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetAuthenticatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AuthenticatorId != 0 {
+		n += 1 + sovQuery(uint64(m.AuthenticatorId))
+	}
+	return n
+}
+
+func (m *GetAuthenticatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountAuthenticator != nil {
+		l = m.AccountAuthenticator.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSessionKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSessionKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SessionKeys) > 0 {
+		for _, e := range m.SessionKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetPendingRecoveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetPendingRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingRecovery != nil {
+		l = m.PendingRecovery.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateAuthenticationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SelectedAuthenticators) > 0 {
+		l = 0
+		for _, e := range m.SelectedAuthenticators {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *SimulateAuthenticationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authenticated {
+		n += 2
+	}
+	if m.SignaturesSkipped {
+		n += 2
+	}
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAuthenticationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.MsgIndex))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Authenticator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AuthenticatorResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuthenticatorId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Authenticated {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SubAuthenticators) > 0 {
+		for _, e := range m.SubAuthenticators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAuthenticatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAuthenticatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAuthenticatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAuthenticatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAuthenticatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAuthenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAuthenticators = append(m.AccountAuthenticators, &AccountAuthenticator{})
+			if err := m.AccountAuthenticators[len(m.AccountAuthenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAuthenticatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAuthenticatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAuthenticatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			m.AuthenticatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAuthenticatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAuthenticatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAuthenticatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAuthenticator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccountAuthenticator == nil {
+				m.AccountAuthenticator = &AccountAuthenticator{}
+			}
+			if err := m.AccountAuthenticator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSessionKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -815,12 +2417,210 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSessionKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSessionKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSessionKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSessionKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSessionKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeys = append(m.SessionKeys, SessionKeyInfo{})
+			if err := m.SessionKeys[len(m.SessionKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPendingRecoveryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPendingRecoveryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPendingRecoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -842,7 +2642,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetPendingRecoveryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -865,15 +2665,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPendingRecoveryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPendingRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRecovery", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -900,7 +2700,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PendingRecovery == nil {
+				m.PendingRecovery = &PendingRecovery{}
+			}
+			if err := m.PendingRecovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -925,7 +2728,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAuthenticatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *SimulateAuthenticationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -948,17 +2751,17 @@ func (m *GetAuthenticatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAuthenticatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateAuthenticationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAuthenticatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateAuthenticationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -968,24 +2771,102 @@ func (m *GetAuthenticatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SelectedAuthenticators = append(m.SelectedAuthenticators, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SelectedAuthenticators) == 0 {
+					m.SelectedAuthenticators = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SelectedAuthenticators = append(m.SelectedAuthenticators, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedAuthenticators", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1007,7 +2888,7 @@ func (m *GetAuthenticatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *SimulateAuthenticationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1030,15 +2911,55 @@ func (m *GetAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAuthenticatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateAuthenticationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAuthenticatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateAuthenticationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Authenticated = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignaturesSkipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SignaturesSkipped = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAuthenticators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1065,8 +2986,8 @@ func (m *GetAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAuthenticators = append(m.AccountAuthenticators, &AccountAuthenticator{})
-			if err := m.AccountAuthenticators[len(m.AccountAuthenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MsgResults = append(m.MsgResults, MsgAuthenticationResult{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1091,7 +3012,7 @@ func (m *GetAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAuthenticatorRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAuthenticationResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1114,13 +3035,64 @@ func (m *GetAuthenticatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAuthenticatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAuthenticationResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAuthenticatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAuthenticationResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -1152,11 +3124,11 @@ func (m *GetAuthenticatorRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticator", wireType)
 			}
-			m.AuthenticatorId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1166,11 +3138,25 @@ func (m *GetAuthenticatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuthenticatorId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authenticator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1192,7 +3178,7 @@ func (m *GetAuthenticatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAuthenticatorResponse) Unmarshal(dAtA []byte) error {
+func (m *AuthenticatorResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1215,15 +3201,131 @@ func (m *GetAuthenticatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAuthenticatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthenticatorResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAuthenticatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthenticatorResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAuthenticator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Authenticated = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubAuthenticators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1250,10 +3352,8 @@ func (m *GetAuthenticatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccountAuthenticator == nil {
-				m.AccountAuthenticator = &AccountAuthenticator{}
-			}
-			if err := m.AccountAuthenticator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SubAuthenticators = append(m.SubAuthenticators, AuthenticatorResult{})
+			if err := m.SubAuthenticators[len(m.SubAuthenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -323,6 +324,199 @@ func (m *MsgSetActiveStateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetActiveStateResponse proto.InternalMessageInfo
 
+// MsgProposeRecovery defines the Msg/ProposeRecovery request type.
+type MsgProposeRecovery struct {
+	// sender is a guardian of the Recovery authenticator.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// account is the account to recover.
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// authenticator_id is the id of the Recovery authenticator of the account.
+	AuthenticatorId uint64 `protobuf:"varint,3,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+	// new_authenticators replace every authenticator of the account except the
+	// Recovery authenticator.
+	NewAuthenticators []AuthenticatorInitData `protobuf:"bytes,4,rep,name=new_authenticators,json=newAuthenticators,proto3" json:"new_authenticators"`
+}
+
+func (m *MsgProposeRecovery) Reset()         { *m = MsgProposeRecovery{} }
+func (m *MsgProposeRecovery) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeRecovery) ProtoMessage()    {}
+func (*MsgProposeRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e696d15b139ba7e5, []int{6}
+}
+func (m *MsgProposeRecovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeRecovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeRecovery.Merge(m, src)
+}
+func (m *MsgProposeRecovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeRecovery proto.InternalMessageInfo
+
+func (m *MsgProposeRecovery) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgProposeRecovery) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MsgProposeRecovery) GetAuthenticatorId() uint64 {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return 0
+}
+
+func (m *MsgProposeRecovery) GetNewAuthenticators() []AuthenticatorInitData {
+	if m != nil {
+		return m.NewAuthenticators
+	}
+	return nil
+}
+
+// MsgProposeRecoveryResponse defines the Msg/ProposeRecovery response type.
+type MsgProposeRecoveryResponse struct {
+}
+
+func (m *MsgProposeRecoveryResponse) Reset()         { *m = MsgProposeRecoveryResponse{} }
+func (m *MsgProposeRecoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeRecoveryResponse) ProtoMessage()    {}
+func (*MsgProposeRecoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e696d15b139ba7e5, []int{7}
+}
+func (m *MsgProposeRecoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeRecoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeRecoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeRecoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeRecoveryResponse.Merge(m, src)
+}
+func (m *MsgProposeRecoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeRecoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeRecoveryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeRecoveryResponse proto.InternalMessageInfo
+
+// MsgCancelRecovery defines the Msg/CancelRecovery request type.
+type MsgCancelRecovery struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgCancelRecovery) Reset()         { *m = MsgCancelRecovery{} }
+func (m *MsgCancelRecovery) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecovery) ProtoMessage()    {}
+func (*MsgCancelRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e696d15b139ba7e5, []int{8}
+}
+func (m *MsgCancelRecovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecovery.Merge(m, src)
+}
+func (m *MsgCancelRecovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRecovery proto.InternalMessageInfo
+
+func (m *MsgCancelRecovery) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgCancelRecoveryResponse defines the Msg/CancelRecovery response type.
+type MsgCancelRecoveryResponse struct {
+}
+
+func (m *MsgCancelRecoveryResponse) Reset()         { *m = MsgCancelRecoveryResponse{} }
+func (m *MsgCancelRecoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecoveryResponse) ProtoMessage()    {}
+func (*MsgCancelRecoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e696d15b139ba7e5, []int{9}
+}
+func (m *MsgCancelRecoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRecoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecoveryResponse.Merge(m, src)
+}
+func (m *MsgCancelRecoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecoveryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRecoveryResponse proto.InternalMessageInfo
+
 // TxExtension allows for additional authenticator-specific data in
 // transactions.
 type TxExtension struct {
@@ -335,7 +529,7 @@ func (m *TxExtension) Reset()         { *m = TxExtension{} }
 func (m *TxExtension) String() string { return proto.CompactTextString(m) }
 func (*TxExtension) ProtoMessage()    {}
 func (*TxExtension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e696d15b139ba7e5, []int{6}
+	return fileDescriptor_e696d15b139ba7e5, []int{10}
 }
 func (m *TxExtension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,6 +572,10 @@ func init() {
 	proto.RegisterType((*MsgRemoveAuthenticatorResponse)(nil), "osmosis.smartaccount.v1beta1.MsgRemoveAuthenticatorResponse")
 	proto.RegisterType((*MsgSetActiveState)(nil), "osmosis.smartaccount.v1beta1.MsgSetActiveState")
 	proto.RegisterType((*MsgSetActiveStateResponse)(nil), "osmosis.smartaccount.v1beta1.MsgSetActiveStateResponse")
+	proto.RegisterType((*MsgProposeRecovery)(nil), "osmosis.smartaccount.v1beta1.MsgProposeRecovery")
+	proto.RegisterType((*MsgProposeRecoveryResponse)(nil), "osmosis.smartaccount.v1beta1.MsgProposeRecoveryResponse")
+	proto.RegisterType((*MsgCancelRecovery)(nil), "osmosis.smartaccount.v1beta1.MsgCancelRecovery")
+	proto.RegisterType((*MsgCancelRecoveryResponse)(nil), "osmosis.smartaccount.v1beta1.MsgCancelRecoveryResponse")
 	proto.RegisterType((*TxExtension)(nil), "osmosis.smartaccount.v1beta1.TxExtension")
 }
 
@@ -386,39 +584,51 @@ func init() {
 }
 
 var fileDescriptor_e696d15b139ba7e5 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x31, 0x6f, 0xd3, 0x50,
-	0x10, 0xc7, 0xe3, 0x24, 0x0a, 0xe5, 0x40, 0x15, 0x71, 0xa5, 0x34, 0xa4, 0xc8, 0xaa, 0x22, 0x81,
-	0x42, 0x25, 0xfb, 0xc9, 0x14, 0x88, 0x12, 0xb1, 0x04, 0x09, 0xb6, 0x2c, 0x4e, 0x27, 0x96, 0xea,
-	0xc5, 0x3e, 0xb9, 0x96, 0x6a, 0xbf, 0xc8, 0xf7, 0x62, 0xa5, 0x65, 0x41, 0x6c, 0x30, 0xf5, 0x63,
-	0x30, 0xf6, 0x63, 0x30, 0x76, 0x64, 0x44, 0xc9, 0xd0, 0xaf, 0x81, 0xf2, 0x62, 0x57, 0x75, 0x6a,
-	0x68, 0xcb, 0x92, 0xf8, 0x7c, 0xf7, 0xbf, 0xfb, 0x9d, 0xdf, 0x5f, 0x0f, 0x9e, 0x0b, 0x0a, 0x05,
-	0x05, 0xc4, 0x28, 0xe4, 0xb1, 0xe4, 0xae, 0x2b, 0xa6, 0x91, 0x64, 0x89, 0x3d, 0x46, 0xc9, 0x6d,
-	0x26, 0x67, 0xd6, 0x24, 0x16, 0x52, 0xe8, 0xcf, 0xd2, 0x32, 0xeb, 0x7a, 0x99, 0x95, 0x96, 0xb5,
-	0xb6, 0x5d, 0x95, 0x66, 0x21, 0xf9, 0x2c, 0xb1, 0x97, 0x7f, 0x2b, 0x59, 0xab, 0xce, 0xc3, 0x20,
-	0x12, 0x4c, 0xfd, 0xae, 0x5e, 0xb5, 0x7f, 0x68, 0xb0, 0x35, 0x24, 0x7f, 0xe0, 0x79, 0x83, 0xa9,
-	0x3c, 0xc2, 0x48, 0x06, 0x2e, 0x97, 0x22, 0xd6, 0x1b, 0x50, 0x23, 0x8c, 0x3c, 0x8c, 0x9b, 0xda,
-	0xae, 0xd6, 0x79, 0xe8, 0xa4, 0x91, 0x6e, 0x82, 0xce, 0xaf, 0x17, 0x1e, 0xca, 0x93, 0x09, 0x36,
-	0xcb, 0xaa, 0xa6, 0x9e, 0xcb, 0x1c, 0x9c, 0x4c, 0x50, 0xd7, 0xa1, 0xea, 0x71, 0xc9, 0x9b, 0x95,
-	0x5d, 0xad, 0xf3, 0xd8, 0x51, 0xcf, 0xfd, 0xb7, 0x5f, 0x2f, 0xcf, 0xf7, 0xd2, 0x7e, 0xdf, 0x2f,
-	0xcf, 0xf7, 0x5e, 0x14, 0xee, 0xcc, 0x3d, 0xcf, 0xcc, 0xf5, 0x6b, 0x77, 0x61, 0xa7, 0x80, 0xd4,
-	0x41, 0x9a, 0x88, 0x88, 0x50, 0x6f, 0xc2, 0x03, 0x9a, 0xba, 0x2e, 0x12, 0x29, 0xe4, 0x0d, 0x27,
-	0x0b, 0xdb, 0x9f, 0xa1, 0x31, 0x24, 0xdf, 0xc1, 0x50, 0x24, 0x78, 0xb7, 0x2d, 0x37, 0xa1, 0x1c,
-	0x78, 0x6a, 0xab, 0xaa, 0x53, 0x0e, 0xbc, 0x7e, 0x6f, 0x0d, 0xf9, 0x65, 0x21, 0x72, 0xac, 0x26,
-	0xac, 0x51, 0xf7, 0xc1, 0x28, 0x1e, 0x7e, 0x07, 0xf0, 0x53, 0xa8, 0x0f, 0xc9, 0x1f, 0xa1, 0x1c,
-	0xb8, 0x32, 0x48, 0x70, 0x24, 0xb9, 0xc4, 0xbf, 0x32, 0x37, 0xa0, 0xc6, 0x55, 0x99, 0xe2, 0xde,
-	0x70, 0xd2, 0xa8, 0xff, 0x66, 0x8d, 0xbd, 0xd8, 0x62, 0x84, 0xd2, 0x5c, 0x09, 0x4c, 0x5a, 0x8e,
-	0x69, 0xef, 0xc0, 0xd3, 0x1b, 0xb3, 0x33, 0xe4, 0xf6, 0x47, 0x78, 0x74, 0x30, 0xfb, 0x30, 0x93,
-	0x18, 0x51, 0x20, 0x22, 0xbd, 0x0b, 0xdb, 0x84, 0xc7, 0xe8, 0x4a, 0xf4, 0x0e, 0x73, 0xdb, 0x2f,
-	0x37, 0xaa, 0x74, 0xaa, 0x4e, 0x23, 0x4b, 0xe7, 0xbe, 0x00, 0xbd, 0x3a, 0xab, 0x40, 0x65, 0x48,
-	0xbe, 0xfe, 0x45, 0x83, 0x27, 0x37, 0x2c, 0x68, 0x5b, 0xff, 0x72, 0xb9, 0x55, 0xe0, 0x85, 0x56,
-	0xef, 0xde, 0x92, 0xab, 0x53, 0xf8, 0xa6, 0xc1, 0x56, 0x91, 0x45, 0x5e, 0xdf, 0xda, 0xb2, 0x40,
-	0xd5, 0x7a, 0xf7, 0x3f, 0xaa, 0x2b, 0x96, 0x53, 0xd8, 0x5c, 0x3b, 0x74, 0x76, 0x6b, 0xbf, 0xbc,
-	0xa0, 0xd5, 0xbd, 0xa7, 0x20, 0x9b, 0xfd, 0x7e, 0xf4, 0x73, 0x6e, 0x68, 0x17, 0x73, 0x43, 0xfb,
-	0x3d, 0x37, 0xb4, 0xb3, 0x85, 0x51, 0xba, 0x58, 0x18, 0xa5, 0x5f, 0x0b, 0xa3, 0xf4, 0xa9, 0xe7,
-	0x07, 0xf2, 0x68, 0x3a, 0xb6, 0x5c, 0x11, 0xb2, 0xb4, 0xb9, 0x79, 0xcc, 0xc7, 0x94, 0x05, 0x2c,
-	0xd9, 0xb7, 0xd9, 0x6c, 0x65, 0x2b, 0x33, 0xf3, 0xd5, 0xf2, 0x7a, 0xa0, 0x71, 0x4d, 0x5d, 0x36,
-	0xfb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x92, 0xb5, 0xde, 0x7a, 0xdf, 0x04, 0x00, 0x00,
+	// 694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0xdb, 0x4a,
+	0x10, 0x8f, 0x93, 0x3c, 0xe0, 0x2d, 0x4f, 0x40, 0x96, 0xa7, 0x90, 0x1a, 0xe4, 0x46, 0x51, 0x5b,
+	0x05, 0x24, 0xc7, 0x0d, 0xd0, 0x52, 0xa2, 0x5e, 0xa0, 0x7f, 0x24, 0x0e, 0x91, 0x2a, 0xc3, 0xa9,
+	0x17, 0xb4, 0xb1, 0x47, 0xc6, 0x52, 0xec, 0x8d, 0x3c, 0x9b, 0x10, 0xa8, 0x2a, 0x55, 0xbd, 0xb5,
+	0xbd, 0x54, 0xfd, 0x14, 0x3d, 0xf2, 0x31, 0x38, 0x72, 0xec, 0xa9, 0xaa, 0xe0, 0xc0, 0xd7, 0xa8,
+	0xb2, 0x71, 0x52, 0x6c, 0x0c, 0x09, 0xbd, 0x24, 0x3b, 0xbb, 0xf3, 0x9b, 0xf9, 0xcd, 0xec, 0xfc,
+	0xbc, 0xe4, 0x21, 0x47, 0x8f, 0xa3, 0x8b, 0x06, 0x7a, 0x2c, 0x10, 0xcc, 0xb2, 0x78, 0xdb, 0x17,
+	0x46, 0xa7, 0xda, 0x00, 0xc1, 0xaa, 0x86, 0xe8, 0x56, 0x5a, 0x01, 0x17, 0x9c, 0x2e, 0x85, 0x6e,
+	0x95, 0xab, 0x6e, 0x95, 0xd0, 0x4d, 0x5d, 0xb0, 0xe4, 0xb1, 0xe1, 0xa1, 0x63, 0x74, 0xaa, 0xbd,
+	0xbf, 0x3e, 0x4c, 0xcd, 0x31, 0xcf, 0xf5, 0xb9, 0x21, 0x7f, 0xc3, 0xad, 0xff, 0x1d, 0xee, 0x70,
+	0xb9, 0x34, 0x7a, 0xab, 0x70, 0x77, 0xf9, 0x56, 0x1a, 0x1e, 0xb7, 0xa1, 0x89, 0x7d, 0xd7, 0xd2,
+	0x77, 0x85, 0xcc, 0xd7, 0xd1, 0xd9, 0xb2, 0xed, 0xad, 0xb6, 0x38, 0x00, 0x5f, 0xb8, 0x16, 0x13,
+	0x3c, 0xa0, 0x79, 0x32, 0x81, 0xe0, 0xdb, 0x10, 0x14, 0x94, 0xa2, 0x52, 0xfe, 0xd7, 0x0c, 0x2d,
+	0xaa, 0x13, 0xca, 0xae, 0x3a, 0xee, 0x8b, 0xa3, 0x16, 0x14, 0xd2, 0xd2, 0x27, 0x17, 0x39, 0xd9,
+	0x3b, 0x6a, 0x01, 0xa5, 0x24, 0x6b, 0x33, 0xc1, 0x0a, 0x99, 0xa2, 0x52, 0xfe, 0xcf, 0x94, 0xeb,
+	0xda, 0xd3, 0x8f, 0x97, 0x27, 0x2b, 0x61, 0xbc, 0xcf, 0x97, 0x27, 0x2b, 0x8f, 0x12, 0xd9, 0x32,
+	0xdb, 0xd6, 0x23, 0xf1, 0x4a, 0x1b, 0x64, 0x31, 0x81, 0xa9, 0x09, 0xd8, 0xe2, 0x3e, 0x02, 0x2d,
+	0x90, 0x49, 0x6c, 0x5b, 0x16, 0x20, 0x4a, 0xca, 0x53, 0xe6, 0xc0, 0x2c, 0xbd, 0x23, 0xf9, 0x3a,
+	0x3a, 0x26, 0x78, 0xbc, 0x03, 0xe3, 0x55, 0x39, 0x43, 0xd2, 0xae, 0x2d, 0xab, 0xca, 0x9a, 0x69,
+	0xd7, 0xae, 0x6d, 0xc6, 0x28, 0x27, 0x37, 0x38, 0x90, 0x19, 0x62, 0xac, 0x6b, 0x44, 0x4b, 0x4e,
+	0x3e, 0x06, 0xf1, 0x63, 0x92, 0xab, 0xa3, 0xb3, 0x0b, 0x62, 0xcb, 0x12, 0x6e, 0x07, 0x76, 0x05,
+	0x13, 0x70, 0x23, 0xe7, 0x3c, 0x99, 0x60, 0xd2, 0x4d, 0xf2, 0x9e, 0x32, 0x43, 0xab, 0xf6, 0x24,
+	0xc6, 0x3d, 0x79, 0x46, 0x11, 0x84, 0xde, 0x07, 0xe8, 0xd8, 0x4b, 0x53, 0x5a, 0x24, 0xf7, 0xae,
+	0xe5, 0x1e, 0x50, 0x2e, 0x7d, 0x4b, 0x13, 0x5a, 0x47, 0xe7, 0x4d, 0xc0, 0x5b, 0x1c, 0xc1, 0x04,
+	0x8b, 0x77, 0x20, 0x38, 0xba, 0x91, 0x5a, 0x81, 0x4c, 0x86, 0x79, 0xc2, 0x49, 0x19, 0x98, 0x74,
+	0x99, 0xcc, 0x45, 0xc7, 0xc9, 0xb5, 0xe5, 0xac, 0x64, 0xcd, 0xd9, 0xc8, 0xfe, 0x8e, 0x4d, 0x0f,
+	0x08, 0xf5, 0xe1, 0x70, 0x3f, 0xb2, 0x8d, 0x85, 0x6c, 0x31, 0x53, 0x9e, 0x5e, 0x5d, 0xab, 0xdc,
+	0xa6, 0xa8, 0x4a, 0xa4, 0xef, 0x3b, 0xbe, 0x2b, 0x5e, 0x32, 0xc1, 0xb6, 0xb3, 0xa7, 0x3f, 0xef,
+	0xa7, 0xcc, 0x9c, 0x0f, 0x87, 0x91, 0x73, 0x1c, 0xb3, 0x63, 0xad, 0x7e, 0xf1, 0x7a, 0x10, 0x56,
+	0x5f, 0x5a, 0x22, 0xea, 0xf5, 0x9e, 0x0c, 0x5b, 0xc6, 0xe4, 0x5d, 0xbe, 0x60, 0xbe, 0x05, 0xcd,
+	0x51, 0x0d, 0xab, 0xad, 0xc7, 0x18, 0x3c, 0x48, 0x64, 0x60, 0xc9, 0x60, 0x7f, 0x08, 0xf4, 0xaf,
+	0x2c, 0x9a, 0x62, 0x98, 0xff, 0x35, 0x99, 0xde, 0xeb, 0xbe, 0xea, 0x0a, 0xf0, 0xd1, 0xe5, 0x3e,
+	0xdd, 0x20, 0x0b, 0x08, 0x4d, 0xb0, 0x04, 0xd8, 0xf1, 0x96, 0x2a, 0xc5, 0x4c, 0x39, 0x6b, 0xe6,
+	0x07, 0xc7, 0xd1, 0xe6, 0xac, 0x7e, 0xf9, 0x87, 0x64, 0xea, 0xe8, 0xd0, 0x0f, 0x0a, 0x99, 0xbb,
+	0xf6, 0xd5, 0xa8, 0xde, 0x7e, 0x0f, 0x09, 0xf2, 0x55, 0x37, 0xef, 0x0c, 0x19, 0x0a, 0xe7, 0x93,
+	0x42, 0xe6, 0x93, 0x54, 0xbd, 0x3e, 0x32, 0x64, 0x02, 0x4a, 0x7d, 0xfe, 0x37, 0xa8, 0x21, 0x97,
+	0x63, 0x32, 0x13, 0xd3, 0xa9, 0x31, 0x32, 0x5e, 0x14, 0xa0, 0x6e, 0xdc, 0x11, 0x30, 0xcc, 0xfd,
+	0x9e, 0xcc, 0xc6, 0x95, 0xf8, 0x78, 0x64, 0xac, 0x18, 0x42, 0x7d, 0x76, 0x57, 0xc4, 0xd5, 0xd2,
+	0x63, 0x63, 0x3d, 0xba, 0xf4, 0x28, 0x60, 0x8c, 0xd2, 0x93, 0xa7, 0x7a, 0x7b, 0xf7, 0xf4, 0x5c,
+	0x53, 0xce, 0xce, 0x35, 0xe5, 0xd7, 0xb9, 0xa6, 0x7c, 0xbd, 0xd0, 0x52, 0x67, 0x17, 0x5a, 0xea,
+	0xc7, 0x85, 0x96, 0x7a, 0xbb, 0xe9, 0xb8, 0xe2, 0xa0, 0xdd, 0xa8, 0x58, 0xdc, 0x33, 0xc2, 0xe0,
+	0x7a, 0x93, 0x35, 0x70, 0x60, 0x18, 0x9d, 0xb5, 0xaa, 0xd1, 0xed, 0x0b, 0x4a, 0x1f, 0x28, 0xaa,
+	0xf7, 0x98, 0x61, 0x63, 0x42, 0x3e, 0x8d, 0x6b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x45, 0xd0,
+	0x0c, 0xdd, 0xce, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -438,6 +648,11 @@ type MsgClient interface {
 	// SetActiveState sets the active state of the authenticator.
 	// Primarily used for circuit breaking.
 	SetActiveState(ctx context.Context, in *MsgSetActiveState, opts ...grpc.CallOption) (*MsgSetActiveStateResponse, error)
+	// ProposeRecovery proposes to replace the authenticators of an account. It
+	// must be sent by a guardian of a Recovery authenticator of the account.
+	ProposeRecovery(ctx context.Context, in *MsgProposeRecovery, opts ...grpc.CallOption) (*MsgProposeRecoveryResponse, error)
+	// CancelRecovery cancels the pending recovery of the sender's account.
+	CancelRecovery(ctx context.Context, in *MsgCancelRecovery, opts ...grpc.CallOption) (*MsgCancelRecoveryResponse, error)
 }
 
 type msgClient struct {
@@ -475,6 +690,24 @@ func (c *msgClient) SetActiveState(ctx context.Context, in *MsgSetActiveState, o
 	return out, nil
 }
 
+func (c *msgClient) ProposeRecovery(ctx context.Context, in *MsgProposeRecovery, opts ...grpc.CallOption) (*MsgProposeRecoveryResponse, error) {
+	out := new(MsgProposeRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Msg/ProposeRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRecovery(ctx context.Context, in *MsgCancelRecovery, opts ...grpc.CallOption) (*MsgCancelRecoveryResponse, error) {
+	out := new(MsgCancelRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Msg/CancelRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AddAuthenticator(context.Context, *MsgAddAuthenticator) (*MsgAddAuthenticatorResponse, error)
@@ -482,6 +715,11 @@ type MsgServer interface {
 	// SetActiveState sets the active state of the authenticator.
 	// Primarily used for circuit breaking.
 	SetActiveState(context.Context, *MsgSetActiveState) (*MsgSetActiveStateResponse, error)
+	// ProposeRecovery proposes to replace the authenticators of an account. It
+	// must be sent by a guardian of a Recovery authenticator of the account.
+	ProposeRecovery(context.Context, *MsgProposeRecovery) (*MsgProposeRecoveryResponse, error)
+	// CancelRecovery cancels the pending recovery of the sender's account.
+	CancelRecovery(context.Context, *MsgCancelRecovery) (*MsgCancelRecoveryResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -497,6 +735,12 @@ func (*UnimplementedMsgServer) RemoveAuthenticator(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) SetActiveState(ctx context.Context, req *MsgSetActiveState) (*MsgSetActiveStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetActiveState not implemented")
 }
+func (*UnimplementedMsgServer) ProposeRecovery(ctx context.Context, req *MsgProposeRecovery) (*MsgProposeRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeRecovery not implemented")
+}
+func (*UnimplementedMsgServer) CancelRecovery(ctx context.Context, req *MsgCancelRecovery) (*MsgCancelRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecovery not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -556,6 +800,42 @@ func _Msg_SetActiveState_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ProposeRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeRecovery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.smartaccount.v1beta1.Msg/ProposeRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeRecovery(ctx, req.(*MsgProposeRecovery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRecovery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.smartaccount.v1beta1.Msg/CancelRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRecovery(ctx, req.(*MsgCancelRecovery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.smartaccount.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -572,6 +852,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetActiveState",
 			Handler:    _Msg_SetActiveState_Handler,
 		},
+		{
+			MethodName: "ProposeRecovery",
+			Handler:    _Msg_ProposeRecovery_Handler,
+		},
+		{
+			MethodName: "CancelRecovery",
+			Handler:    _Msg_CancelRecovery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/smartaccount/v1beta1/tx.proto",
@@ -785,7 +1073,7 @@ func (m *MsgSetActiveStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *TxExtension) Marshal() (dAtA []byte, err error) {
+func (m *MsgProposeRecovery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -795,86 +1083,218 @@ func (m *TxExtension) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxExtension) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgProposeRecovery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgProposeRecovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SelectedAuthenticators) > 0 {
-		dAtA2 := make([]byte, len(m.SelectedAuthenticators)*10)
-		var j1 int
-		for _, num := range m.SelectedAuthenticators {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
+	if len(m.NewAuthenticators) > 0 {
+		for iNdEx := len(m.NewAuthenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewAuthenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTx(dAtA, i, uint64(j1))
+	}
+	if m.AuthenticatorId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuthenticatorId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgProposeRecoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddAuthenticator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgProposeRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AuthenticatorType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddAuthenticatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelRecovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveAuthenticator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *MsgCancelRecoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *TxExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SelectedAuthenticators) > 0 {
+		dAtA2 := make([]byte, len(m.SelectedAuthenticators)*10)
+		var j1 int
+		for _, num := range m.SelectedAuthenticators {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuthenticatorType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAuthenticatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRemoveAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Sender)
 	if l > 0 {
@@ -923,6 +1343,63 @@ func (m *MsgSetActiveStateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgProposeRecovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuthenticatorId != 0 {
+		n += 1 + sovTx(uint64(m.AuthenticatorId))
+	}
+	if len(m.NewAuthenticators) > 0 {
+		for _, e := range m.NewAuthenticators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposeRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRecovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *TxExtension) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1486,6 +1963,355 @@ func (m *MsgSetActiveStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgProposeRecovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeRecovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeRecovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			m.AuthenticatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAuthenticators = append(m.NewAuthenticators, AuthenticatorInitData{})
+			if err := m.NewAuthenticators[len(m.NewAuthenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeRecoveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeRecoveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRecovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRecovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRecovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRecoveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRecoveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TxExtension) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
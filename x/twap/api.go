@@ -79,6 +79,75 @@ func (k Keeper) GetGeometricTwapToNow(
 	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetGeometricStrategy())
 }
 
+// GetRealizedVolatility returns the realized volatility of the spot price of the base asset, in units of the
+// quote asset, from startTime until endTime, as determined by prices from AMM pool `poolId`.
+//
+// The realized volatility is the standard deviation of the natural log returns between consecutive historical
+// records, starting from the record at or before startTime. It is not annualized, and it is zero if the spot
+// price did not change within the time range.
+//
+// This function has the same time range constraints, and errors, as GetArithmeticTwap.
+// It also errors if a spot price within the time range is zero.
+func (k Keeper) GetRealizedVolatility(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	records, err := k.getRecordsForTimeRange(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	return computeRealizedVolatility(records, startTime, quoteAssetDenom)
+}
+
+// GetTimeWeightedMedian returns the time weighted median of the spot price of the base asset, in units of the
+// quote asset, from startTime until endTime, as determined by prices from AMM pool `poolId`.
+//
+// Each historical record's spot price is weighted by the time it was in effect within the time range,
+// and the median is the lowest spot price that was in effect, along with the lower prices, for at least
+// half of the time range. Unlike the arithmetic twap, a short-lived price spike does not move the median.
+//
+// This function has the same time range constraints, and errors, as GetArithmeticTwap.
+func (k Keeper) GetTimeWeightedMedian(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	records, err := k.getRecordsForTimeRange(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	return computeTimeWeightedMedian(records, startTime, endTime, quoteAssetDenom)
+}
+
+// getRecordsForTimeRange validates the time range and returns the historical records describing the spot
+// price of the pool from startTime until endTime.
+func (k Keeper) getRecordsForTimeRange(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) ([]types.TwapRecord, error) {
+	if startTime.After(endTime) {
+		return nil, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return nil, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+
+	return k.getRecordsInTimeRange(ctx, poolId, startTime, endTime, baseAssetDenom, quoteAssetDenom)
+}
+
 // getTwap computes and returns twap from the start time until the end time. The type
 // of twap returned depends on the strategy given and can be either arithmetic or geometric.
 func (k Keeper) getTwap(
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
 	sdkrand "github.com/osmosis-labs/osmosis/v31/simulation/simtypes/random"
 	"github.com/osmosis-labs/osmosis/v31/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v31/x/twap"
@@ -909,3 +910,144 @@ func (s *TestSuite) TestGeometricTwapToNow_BalancerPool_Randomized() {
 		})
 	}
 }
+
+func (s *TestSuite) TestGetRealizedVolatility() {
+	tPlusTen, tPlusEleven := baseTime.Add(10*time.Second), baseTime.Add(11*time.Second)
+	// the price doubles, and then halves
+	priceRecords := []types.TwapRecord{
+		newRecord(basePoolId, baseTime, osmomath.NewDec(10), zeroDec, zeroDec, zeroDec),
+		newRecord(basePoolId, tPlusTen, osmomath.NewDec(20), zeroDec, zeroDec, zeroDec),
+		newRecord(basePoolId, tPlusEleven, osmomath.NewDec(10), zeroDec, zeroDec, zeroDec),
+	}
+	ln2 := osmomath.MustNewDecFromStr("0.693147180559945309")
+
+	tests := map[string]struct {
+		startTime     time.Time
+		endTime       time.Time
+		blockTime     time.Time
+		expVolatility osmomath.Dec
+		expErr        error
+	}{
+		"no price change": {
+			startTime:     baseTime,
+			endTime:       baseTime.Add(5 * time.Second),
+			blockTime:     tPlusOneMin,
+			expVolatility: zeroDec,
+		},
+		"one log return": {
+			startTime:     baseTime,
+			endTime:       tPlusTen,
+			blockTime:     tPlusOneMin,
+			expVolatility: zeroDec,
+		},
+		"log returns of ln(2) and -ln(2)": {
+			startTime:     baseTime,
+			endTime:       tPlusOneMin,
+			blockTime:     tPlusOneMin,
+			expVolatility: ln2,
+		},
+		"start time between records uses the record before it": {
+			startTime:     baseTime.Add(5 * time.Second),
+			endTime:       tPlusOneMin,
+			blockTime:     tPlusOneMin,
+			expVolatility: ln2,
+		},
+		"start time after end time": {
+			startTime: tPlusTen,
+			endTime:   baseTime,
+			blockTime: tPlusOneMin,
+			expErr:    types.StartTimeAfterEndTimeError{StartTime: tPlusTen, EndTime: baseTime},
+		},
+		"end time in the future": {
+			startTime: baseTime,
+			endTime:   tPlusOneMin,
+			blockTime: tPlusTen,
+			expErr:    types.EndTimeInFutureError{EndTime: tPlusOneMin, BlockTime: tPlusTen},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(priceRecords)
+			s.Ctx = s.Ctx.WithBlockTime(test.blockTime)
+
+			volatility, err := s.twapkeeper.GetRealizedVolatility(s.Ctx, basePoolId, denom1, denom0, test.startTime, test.endTime)
+			if test.expErr != nil {
+				s.Require().ErrorIs(err, test.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			osmoassert.DecApproxEq(s.T(), test.expVolatility, volatility, osmomath.NewDecWithPrec(1, 15))
+		})
+	}
+}
+
+func (s *TestSuite) TestGetTimeWeightedMedian() {
+	tPlusTen, tPlusEleven := baseTime.Add(10*time.Second), baseTime.Add(11*time.Second)
+	// the price spikes for one second, and then settles above the starting price
+	priceRecords := []types.TwapRecord{
+		newRecord(basePoolId, baseTime, osmomath.NewDec(10), zeroDec, zeroDec, zeroDec),
+		newRecord(basePoolId, tPlusTen, osmomath.NewDec(1000), zeroDec, zeroDec, zeroDec),
+		newRecord(basePoolId, tPlusEleven, osmomath.NewDec(12), zeroDec, zeroDec, zeroDec),
+	}
+
+	tests := map[string]struct {
+		startTime  time.Time
+		endTime    time.Time
+		quoteDenom string
+		baseDenom  string
+		expMedian  osmomath.Dec
+		expErr     error
+	}{
+		"spike does not move the median": {
+			startTime:  baseTime,
+			endTime:    baseTime.Add(20 * time.Second),
+			quoteDenom: denom0,
+			baseDenom:  denom1,
+			expMedian:  osmomath.NewDec(10),
+		},
+		"price in effect for most of the time range": {
+			startTime:  baseTime,
+			endTime:    tPlusOneMin,
+			quoteDenom: denom0,
+			baseDenom:  denom1,
+			expMedian:  osmomath.NewDec(12),
+		},
+		"inverse price": {
+			startTime:  baseTime,
+			endTime:    tPlusOneMin,
+			quoteDenom: denom1,
+			baseDenom:  denom0,
+			expMedian:  osmomath.OneDec().QuoInt64(12),
+		},
+		"start time equals end time": {
+			startTime:  tPlusTen,
+			endTime:    tPlusTen,
+			quoteDenom: denom0,
+			baseDenom:  denom1,
+			expMedian:  osmomath.NewDec(1000),
+		},
+		"start time before the first record": {
+			startTime:  tMinOne,
+			endTime:    tPlusOneMin,
+			quoteDenom: denom0,
+			baseDenom:  denom1,
+			expErr:     twap.TimeTooOldError{Time: tMinOne},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(priceRecords)
+			s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+
+			median, err := s.twapkeeper.GetTimeWeightedMedian(s.Ctx, basePoolId, test.baseDenom, test.quoteDenom, test.startTime, test.endTime)
+			if test.expErr != nil {
+				s.Require().ErrorIs(err, test.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expMedian, median)
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package twap
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/twap/types"
+)
+
+// updateCandles updates the candles of every candle interval for the denom pair of a swap in the pool,
+// with the spot price after the swap and the swapped amounts.
+// Candles are stored with the lexicographically larger denom as the base asset, so the spot price is
+// computed the same way as the p0 spot price of twap records.
+//
+// Swaps with more than one token in or out are not tracked, and neither are swaps for which the
+// spot price can't be computed, the candles are best effort and must not make swaps fail.
+func (k Keeper) updateCandles(ctx sdk.Context, poolId uint64, tokensIn sdk.Coins, tokensOut sdk.Coins) {
+	candleIntervals := k.GetParams(ctx).CandleIntervals
+	if len(candleIntervals) == 0 || len(tokensIn) != 1 || len(tokensOut) != 1 {
+		return
+	}
+
+	quoteDenom, baseDenom, err := types.LexicographicalOrderDenoms(tokensIn[0].Denom, tokensOut[0].Denom)
+	if err != nil {
+		return
+	}
+	spotPrice, err := k.poolmanagerKeeper.RouteCalculateSpotPrice(ctx, poolId, quoteDenom, baseDenom)
+	if err != nil {
+		ctx.Logger().Debug(fmt.Sprintf("twap: failed to update candles of pool %d: %s", poolId, err))
+		return
+	}
+	if spotPrice.GT(types.MaxSpotPriceBigDec) {
+		spotPrice = types.MaxSpotPriceBigDec
+	}
+	price := spotPrice.Dec()
+
+	swapped := tokensIn.Add(tokensOut...)
+	baseVolume, quoteVolume := swapped.AmountOf(baseDenom), swapped.AmountOf(quoteDenom)
+
+	for _, candleInterval := range candleIntervals {
+		k.updateCandle(ctx, poolId, baseDenom, quoteDenom, candleInterval, price, baseVolume, quoteVolume)
+	}
+}
+
+// updateCandle updates the candle of the interval containing the block time with the swap.
+// When the candle is opened, the candles of the same interval that are older than its retention are pruned.
+func (k Keeper) updateCandle(
+	ctx sdk.Context,
+	poolId uint64,
+	baseDenom string,
+	quoteDenom string,
+	candleInterval types.CandleInterval,
+	price osmomath.Dec,
+	baseVolume osmomath.Int,
+	quoteVolume osmomath.Int,
+) {
+	store := ctx.KVStore(k.storeKey)
+	startTime := ctx.BlockTime().Truncate(candleInterval.Interval)
+	key := types.FormatCandleKey(poolId, quoteDenom, baseDenom, candleInterval.Interval, startTime)
+
+	var candle types.Candle
+	found, err := osmoutils.Get(store, key, &candle)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		candle = types.NewCandle(poolId, baseDenom, quoteDenom, candleInterval.Interval, startTime, price)
+		k.pruneCandlesBeforeTime(store, poolId, quoteDenom, baseDenom, candleInterval.Interval, ctx.BlockTime().Add(-candleInterval.Retention))
+	}
+	candle.Update(price, baseVolume, quoteVolume)
+
+	osmoutils.MustSet(store, key, &candle)
+}
+
+// pruneCandlesBeforeTime deletes the candles of the interval for the (pool id, denom1, denom2) triplet
+// that started before the given time.
+func (k Keeper) pruneCandlesBeforeTime(store storetypes.KVStore, poolId uint64, denom1, denom2 string, interval time.Duration, t time.Time) {
+	iter := store.Iterator(
+		types.FormatCandleIntervalPrefix(poolId, denom1, denom2, interval),
+		types.FormatCandleKey(poolId, denom1, denom2, interval, t))
+	defer iter.Close()
+
+	var keysToDelete [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keysToDelete = append(keysToDelete, iter.Key())
+	}
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+}
+
+// NumCandlesToPrunePerBlock is the number of candles checked for pruning per block.
+var NumCandlesToPrunePerBlock uint16 = 200
+
+// pruneExpiredCandles deletes the candles that started before their interval's retention, checking
+// candles from startKey on. Candles of intervals that are no longer in the params are deleted as well.
+//
+// If we reach the per block limit, we store the key of the next candle to check, so that we can continue
+// pruning from where we left off in the next block. Once all candles have been checked, the pruning key is deleted.
+func (k Keeper) pruneExpiredCandles(ctx sdk.Context, startKey []byte) error {
+	store := ctx.KVStore(k.storeKey)
+
+	retentions := make(map[time.Duration]time.Duration)
+	for _, candleInterval := range k.GetParams(ctx).CandleIntervals {
+		retentions[candleInterval.Interval] = candleInterval.Retention
+	}
+
+	iter := store.Iterator(startKey, storetypes.PrefixEndBytes([]byte(types.CandlesPrefix)))
+	defer iter.Close()
+
+	var numChecked uint16
+	var keysToDelete [][]byte
+	var nextKey []byte
+	for ; iter.Valid(); iter.Next() {
+		if numChecked >= NumCandlesToPrunePerBlock {
+			nextKey = iter.Key()
+			break
+		}
+		numChecked++
+
+		candle, err := types.ParseCandleFromBz(iter.Value())
+		if err != nil {
+			return err
+		}
+		retention, found := retentions[candle.Interval]
+		if !found || candle.StartTime.Before(ctx.BlockTime().Add(-retention)) {
+			keysToDelete = append(keysToDelete, iter.Key())
+		}
+	}
+
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+	if nextKey != nil {
+		k.setCandlePruningKey(ctx, nextKey)
+	} else {
+		store.Delete(types.CandlePruningKey)
+	}
+	return nil
+}
+
+// getCandlePruningKey returns the key of the next candle to check for pruning, or nil if candles are not being pruned.
+func (k Keeper) getCandlePruningKey(ctx sdk.Context) []byte {
+	return ctx.KVStore(k.storeKey).Get(types.CandlePruningKey)
+}
+
+func (k Keeper) setCandlePruningKey(ctx sdk.Context, key []byte) {
+	ctx.KVStore(k.storeKey).Set(types.CandlePruningKey, key)
+}
+
+// GetCandles returns the candles of the given interval for the base and quote assets of pool `poolId`,
+// that started from startTime until endTime, in time order. The prices of the candles are of the base
+// asset in units of the quote asset.
+//
+// Candles are only kept for the candle intervals of the params, and for their retention. Returns an error if:
+// * interval is not a candle interval
+// * startTime is after endTime
+// * baseAssetDenom and quoteAssetDenom are the same
+func (k Keeper) GetCandles(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	interval time.Duration,
+	startTime time.Time,
+	endTime time.Time,
+	pagination *query.PageRequest,
+) ([]types.Candle, *query.PageResponse, error) {
+	if !k.GetParams(ctx).HasCandleInterval(interval) {
+		return nil, nil, fmt.Errorf("candles are not kept for interval %s", interval)
+	}
+	if startTime.After(endTime) {
+		return nil, nil, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	candleStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FormatCandleIntervalPrefix(poolId, asset0Denom, asset1Denom, interval))
+	candles := []types.Candle{}
+	pageRes, err := query.FilteredPaginate(candleStore, pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		candle, err := types.ParseCandleFromBz(value)
+		if err != nil {
+			return false, err
+		}
+		if candle.StartTime.Before(startTime) || candle.StartTime.After(endTime) {
+			return false, nil
+		}
+
+		if accumulate {
+			// candles are stored with the lexicographically smaller denom as the quote asset.
+			if quoteAssetDenom != asset0Denom {
+				candle = candle.Inverted()
+			}
+			candles = append(candles, candle)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return candles, pageRes, nil
+}
+
+// setCandle stores the candle, it is used in genesis import.
+func (k Keeper) setCandle(ctx sdk.Context, candle types.Candle) {
+	key := types.FormatCandleKey(candle.PoolId, candle.QuoteDenom, candle.BaseDenom, candle.Interval, candle.StartTime)
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), key, &candle)
+}
+
+// getAllCandles returns the candles of every pool, it is used in genesis export.
+func (k Keeper) getAllCandles(ctx sdk.Context) ([]types.Candle, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.CandlesPrefix), types.ParseCandleFromBz)
+}
@@ -0,0 +1,140 @@
+package twap_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/twap/types"
+)
+
+var oneMinCandles = types.CandleInterval{Interval: time.Minute, Retention: 3 * time.Minute}
+
+// swapAndGetSpotPrice runs a basic swap of denom0 for denom1 in the pool, and returns the spot price
+// of denom1 in units of denom0 after the swap.
+func (s *TestSuite) swapAndGetSpotPrice(poolId uint64) osmomath.Dec {
+	s.RunBasicSwap(poolId)
+	spotPrice, err := s.App.PoolManagerKeeper.RouteCalculateSpotPrice(s.Ctx, poolId, denom0, denom1)
+	s.Require().NoError(err)
+	return spotPrice.Dec()
+}
+
+func (s *TestSuite) TestCandles() {
+	poolId, _, _ := s.setupDefaultPool()
+	params := s.twapkeeper.GetParams(s.Ctx)
+	params.CandleIntervals = []types.CandleInterval{oneMinCandles}
+	s.twapkeeper.SetParams(s.Ctx, params)
+
+	// swapping denom0 in increases the price of denom1, so the candles open at their low and close at their high.
+	firstPrice := s.swapAndGetSpotPrice(poolId)
+	s.swapAndGetSpotPrice(poolId)
+	s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(30 * time.Second))
+	thirdPrice := s.swapAndGetSpotPrice(poolId)
+	s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+	fourthPrice := s.swapAndGetSpotPrice(poolId)
+
+	candles, _, err := s.twapkeeper.GetCandles(s.Ctx, poolId, denom1, denom0, time.Minute, baseTime, tPlusOneMin, nil)
+	s.Require().NoError(err)
+	s.Require().Len(candles, 2)
+
+	candle := candles[0]
+	s.Require().Equal(denom1, candle.BaseDenom)
+	s.Require().Equal(denom0, candle.QuoteDenom)
+	s.Require().Equal(baseTime, candle.StartTime)
+	s.Require().Equal(firstPrice, candle.Open)
+	s.Require().Equal(firstPrice, candle.Low)
+	s.Require().Equal(thirdPrice, candle.High)
+	s.Require().Equal(thirdPrice, candle.Close)
+	s.Require().Equal(osmomath.NewInt(3000), candle.QuoteVolume)
+	s.Require().True(candle.BaseVolume.IsPositive())
+
+	s.Require().Equal(tPlusOneMin, candles[1].StartTime)
+	s.Require().Equal(fourthPrice, candles[1].Open)
+	s.Require().Equal(fourthPrice, candles[1].Close)
+
+	// the inverse candles have the prices of denom0 in units of denom1
+	invertedCandles, _, err := s.twapkeeper.GetCandles(s.Ctx, poolId, denom0, denom1, time.Minute, baseTime, tPlusOneMin, nil)
+	s.Require().NoError(err)
+	s.Require().Len(invertedCandles, 2)
+	s.Require().Equal(denom0, invertedCandles[0].BaseDenom)
+	s.Require().Equal(osmomath.OneDec().Quo(thirdPrice), invertedCandles[0].Low)
+	s.Require().Equal(osmomath.OneDec().Quo(firstPrice), invertedCandles[0].High)
+	s.Require().Equal(candle.BaseVolume, invertedCandles[0].QuoteVolume)
+
+	// the time range and pagination limit the candles
+	candles, _, err = s.twapkeeper.GetCandles(s.Ctx, poolId, denom1, denom0, time.Minute, baseTime.Add(time.Second), tPlusOneMin, nil)
+	s.Require().NoError(err)
+	s.Require().Len(candles, 1)
+	s.Require().Equal(tPlusOneMin, candles[0].StartTime)
+
+	candles, pageRes, err := s.twapkeeper.GetCandles(s.Ctx, poolId, denom1, denom0, time.Minute, baseTime, tPlusOneMin, &query.PageRequest{Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(candles, 1)
+	s.Require().Equal(baseTime, candles[0].StartTime)
+	s.Require().NotNil(pageRes.NextKey)
+
+	// opening a candle prunes the candles older than the retention
+	s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(5 * time.Minute))
+	s.swapAndGetSpotPrice(poolId)
+	candles, _, err = s.twapkeeper.GetCandles(s.Ctx, poolId, denom1, denom0, time.Minute, baseTime, s.Ctx.BlockTime(), nil)
+	s.Require().NoError(err)
+	s.Require().Len(candles, 1)
+	s.Require().Equal(baseTime.Add(5*time.Minute), candles[0].StartTime)
+
+	// candles are only kept for the candle intervals
+	_, _, err = s.twapkeeper.GetCandles(s.Ctx, poolId, denom1, denom0, time.Hour, baseTime, s.Ctx.BlockTime(), nil)
+	s.Require().ErrorContains(err, "candles are not kept for interval")
+
+	_, _, err = s.twapkeeper.GetCandles(s.Ctx, poolId, denom1, denom0, time.Minute, s.Ctx.BlockTime(), baseTime, nil)
+	s.Require().ErrorIs(err, types.StartTimeAfterEndTimeError{StartTime: s.Ctx.BlockTime(), EndTime: baseTime})
+}
+
+func (s *TestSuite) TestCandlesGenesis() {
+	poolId, _, _ := s.setupDefaultPool()
+	params := s.twapkeeper.GetParams(s.Ctx)
+	params.CandleIntervals = []types.CandleInterval{oneMinCandles, {Interval: time.Hour, Retention: time.Hour}}
+	s.twapkeeper.SetParams(s.Ctx, params)
+
+	s.swapAndGetSpotPrice(poolId)
+	s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+	s.swapAndGetSpotPrice(poolId)
+
+	genesis := s.twapkeeper.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.Candles, 3)
+	s.Require().NoError(genesis.Validate())
+
+	s.SetupTest()
+	s.twapkeeper.InitGenesis(s.Ctx, genesis)
+	s.Require().Equal(genesis.Candles, s.twapkeeper.ExportGenesis(s.Ctx).Candles)
+}
+
+func (s *TestSuite) TestPruneExpiredCandles() {
+	poolId, _, _ := s.setupDefaultPool()
+	params := s.twapkeeper.GetParams(s.Ctx)
+	params.CandleIntervals = []types.CandleInterval{oneMinCandles}
+	s.twapkeeper.SetParams(s.Ctx, params)
+
+	s.swapAndGetSpotPrice(poolId)
+	s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+	s.swapAndGetSpotPrice(poolId)
+
+	// the pool stops trading, so no candle is opened that would prune the old ones
+	s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(3*time.Minute + 30*time.Second))
+	s.twapkeeper.EndBlock(s.Ctx)
+	candles, _, err := s.twapkeeper.GetCandles(s.Ctx, poolId, denom1, denom0, time.Minute, baseTime, s.Ctx.BlockTime(), nil)
+	s.Require().NoError(err)
+	s.Require().Len(candles, 2)
+
+	// the prune epoch prunes the candles older than the retention in the following end block
+	err = s.App.TwapKeeper.EpochHooks().AfterEpochEnd(s.Ctx, s.twapkeeper.PruneEpochIdentifier(s.Ctx), int64(1))
+	s.Require().NoError(err)
+	s.Require().NotNil(s.twapkeeper.GetCandlePruningKey(s.Ctx))
+
+	s.twapkeeper.EndBlock(s.Ctx)
+	s.Require().Nil(s.twapkeeper.GetCandlePruningKey(s.Ctx))
+	candles, _, err = s.twapkeeper.GetCandles(s.Ctx, poolId, denom1, denom0, time.Minute, baseTime, s.Ctx.BlockTime(), nil)
+	s.Require().NoError(err)
+	s.Require().Len(candles, 1)
+	s.Require().Equal(tPlusOneMin, candles[0].StartTime)
+}
@@ -30,6 +30,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	cmd.AddCommand(GetQueryArithmeticCommand())
 	cmd.AddCommand(GetQueryGeometricCommand())
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdCandles)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdRecordHistoryKeepPeriod)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -138,6 +140,26 @@ Example:
 	return cmd
 }
 
+// GetCmdCandles returns a query command for the candles of a pool.
+func GetCmdCandles() (*osmocli.QueryDescriptor, *queryproto.CandlesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "candles",
+		Short: "Query the candles of a pool for an interval. Start and end time must be unix time.",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} candles 1 uosmo uion 1h 1667088000 1667174400`,
+	}, &queryproto.CandlesRequest{}
+}
+
+// GetCmdRecordHistoryKeepPeriod returns a query command for the record history keep period of a pool.
+func GetCmdRecordHistoryKeepPeriod() (*osmocli.QueryDescriptor, *queryproto.RecordHistoryKeepPeriodRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "record-history-keep-period",
+		Short: "Query the time twap records of a pool are kept for, taking its override into account",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} record-history-keep-period 1`,
+	}, &queryproto.RecordHistoryKeepPeriodRequest{}
+}
+
 // getQuoteDenomFromLiquidity gets the quote liquidity denom from the pool. In addition, validates that base denom
 // exists in the pool. Fails if not.
 func getQuoteDenomFromLiquidity(ctx context.Context, clientCtx client.Context, poolId uint64, baseDenom string) (string, error) {
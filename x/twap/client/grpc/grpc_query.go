@@ -21,6 +21,36 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) TimeWeightedMedian(grpcCtx context.Context,
+	req *queryproto.TimeWeightedMedianRequest,
+) (*queryproto.TimeWeightedMedianResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TimeWeightedMedian(ctx, *req)
+}
+
+func (q Querier) RecordHistoryKeepPeriod(grpcCtx context.Context,
+	req *queryproto.RecordHistoryKeepPeriodRequest,
+) (*queryproto.RecordHistoryKeepPeriodResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RecordHistoryKeepPeriod(ctx, *req)
+}
+
+func (q Querier) RealizedVolatility(grpcCtx context.Context,
+	req *queryproto.RealizedVolatilityRequest,
+) (*queryproto.RealizedVolatilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RealizedVolatility(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -51,6 +81,16 @@ func (q Querier) GeometricTwap(grpcCtx context.Context,
 	return q.Q.GeometricTwap(ctx, *req)
 }
 
+func (q Querier) Candles(grpcCtx context.Context,
+	req *queryproto.CandlesRequest,
+) (*queryproto.CandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Candles(ctx, *req)
+}
+
 func (q Querier) ArithmeticTwapToNow(grpcCtx context.Context,
 	req *queryproto.ArithmeticTwapToNowRequest,
 ) (*queryproto.ArithmeticTwapToNowResponse, error) {
@@ -61,6 +61,54 @@ func (q Querier) GeometricTwapToNow(ctx sdk.Context,
 	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap}, err
 }
 
+func (q Querier) RealizedVolatility(ctx sdk.Context,
+	req queryproto.RealizedVolatilityRequest,
+) (*queryproto.RealizedVolatilityResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	volatility, err := q.K.GetRealizedVolatility(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.RealizedVolatilityResponse{RealizedVolatility: volatility}, err
+}
+
+func (q Querier) TimeWeightedMedian(ctx sdk.Context,
+	req queryproto.TimeWeightedMedianRequest,
+) (*queryproto.TimeWeightedMedianResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	median, err := q.K.GetTimeWeightedMedian(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.TimeWeightedMedianResponse{TimeWeightedMedian: median}, err
+}
+
+func (q Querier) Candles(ctx sdk.Context,
+	req queryproto.CandlesRequest,
+) (*queryproto.CandlesResponse, error) {
+	candles, pageRes, err := q.K.GetCandles(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.Interval, req.StartTime, req.EndTime, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.CandlesResponse{Candles: candles, Pagination: pageRes}, nil
+}
+
+func (q Querier) RecordHistoryKeepPeriod(ctx sdk.Context,
+	req queryproto.RecordHistoryKeepPeriodRequest,
+) (*queryproto.RecordHistoryKeepPeriodResponse, error) {
+	keepPeriod := q.K.PoolRecordHistoryKeepPeriod(ctx, req.PoolId)
+	return &queryproto.RecordHistoryKeepPeriodResponse{RecordHistoryKeepPeriod: keepPeriod}, nil
+}
+
 func (q Querier) Params(ctx sdk.Context,
 	req queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
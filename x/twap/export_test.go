@@ -44,6 +44,10 @@ func (k Keeper) PruneRecordsBeforeTimeButNewest(ctx sdk.Context, state types.Pru
 	return k.pruneRecordsBeforeTimeButNewest(ctx, state)
 }
 
+func (k Keeper) GetCandlePruningKey(ctx sdk.Context) []byte {
+	return k.getCandlePruningKey(ctx)
+}
+
 func (k Keeper) GetInterpolatedRecord(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string, t time.Time) (types.TwapRecord, error) {
 	return k.getInterpolatedRecord(ctx, poolId, t, asset0Denom, asset1Denom)
 }
@@ -52,6 +56,14 @@ func ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quote
 	return computeTwap(startRecord, endRecord, quoteAsset, strategy)
 }
 
+func ComputeRealizedVolatility(records []types.TwapRecord, startTime time.Time, quoteAsset string) (osmomath.Dec, error) {
+	return computeRealizedVolatility(records, startTime, quoteAsset)
+}
+
+func ComputeTimeWeightedMedian(records []types.TwapRecord, startTime time.Time, endTime time.Time, quoteAsset string) (osmomath.Dec, error) {
+	return computeTimeWeightedMedian(records, startTime, endTime, quoteAsset)
+}
+
 func (s arithmetic) ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
 	return s.computeTwap(startRecord, endRecord, quoteAsset)
 }
@@ -56,6 +56,14 @@ func (k *Keeper) RecordHistoryKeepPeriod(ctx sdk.Context) time.Duration {
 	return k.GetParams(ctx).RecordHistoryKeepPeriod
 }
 
+// PoolRecordHistoryKeepPeriod returns the time the twap records of the given pool are kept for.
+// This is the pool's record history keep period override if it has one, and the record history
+// keep period otherwise.
+func (k Keeper) PoolRecordHistoryKeepPeriod(ctx sdk.Context, poolId uint64) time.Duration {
+	params := k.GetParams(ctx)
+	return params.PoolRecordHistoryKeepPeriod(poolId)
+}
+
 // InitGenesis initializes the twap module's state from a provided genesis
 // state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
@@ -75,6 +83,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, twap := range genState.Twaps {
 		k.StoreNewRecord(ctx, twap)
 	}
+
+	for _, candle := range genState.Candles {
+		k.setCandle(ctx, candle)
+	}
 }
 
 // ExportGenesis returns the twap module's exported genesis.
@@ -84,9 +96,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	candles, err := k.getAllCandles(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
-		Twaps:  twapRecords,
+		Params:  k.GetParams(ctx),
+		Twaps:   twapRecords,
+		Candles: candles,
 	}
 }
 
@@ -39,6 +39,9 @@ func (hook *epochhook) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, ep
 				LastSeenPoolId: poolIdToStartFrom,
 			})
 		}
+		// candles of pools that stopped trading are never pruned when a candle is opened,
+		// so every candle is checked against its retention in the following end blocks.
+		hook.k.setCandlePruningKey(ctx, []byte(types.CandlesPrefix))
 	}
 	return nil
 }
@@ -63,6 +66,7 @@ func (hook *gammhook) AfterCFMMPoolCreated(ctx sdk.Context, sender sdk.AccAddres
 // AfterCFMMSwap is called after SwapExactAmountIn and SwapExactAmountOut in x/gamm.
 func (hook *gammhook) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 	hook.k.trackChangedPool(ctx, poolId)
+	hook.k.updateCandles(ctx, poolId, input, output)
 }
 
 func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount osmomath.Int) {
@@ -95,4 +99,5 @@ func (l *concentratedLiquidityListener) AfterLastPoolPositionRemoved(ctx sdk.Con
 
 func (l *concentratedLiquidityListener) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 	l.k.trackChangedPool(ctx, poolId)
+	l.k.updateCandles(ctx, poolId, input, output)
 }
@@ -3,6 +3,7 @@ package twap
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -123,6 +124,13 @@ func (k Keeper) EndBlock(ctx sdk.Context) {
 			ctx.Logger().Error("Error pruning old twaps at the end block", err)
 		}
 	}
+
+	if candlePruningKey := k.getCandlePruningKey(ctx); candlePruningKey != nil {
+		err := k.pruneExpiredCandles(ctx, candlePruningKey)
+		if err != nil {
+			ctx.Logger().Error("Error pruning old candles at the end block", err)
+		}
+	}
 }
 
 // updateRecords updates all records for a given pool id.
@@ -314,3 +322,114 @@ func twapLog(price osmomath.Dec) osmomath.Dec {
 
 	return osmomath.BigDecFromDec(price).LogBase2().Dec()
 }
+
+// computeRealizedVolatility returns the standard deviation of the natural log returns of the spot prices
+// between consecutive records, given the quote asset.
+// precondition: records is non-empty and sorted by time.
+// Like computeTwap, it returns the result along with an error if the spot price errored within the time range.
+func computeRealizedVolatility(records []types.TwapRecord, startTime time.Time, quoteAsset string) (osmomath.Dec, error) {
+	err := spotPriceErrorInRange(records, startTime)
+
+	logReturns := make([]osmomath.BigDec, 0, len(records)-1)
+	var previousLogPrice osmomath.BigDec
+	for i, record := range records {
+		price := recordSpotPrice(record, quoteAsset)
+		if !price.IsPositive() {
+			return osmomath.Dec{}, fmt.Errorf("twap: spot price of record at %s is not positive, cannot compute log returns", record.Time)
+		}
+		logPrice := osmomath.BigDecFromDec(price).Ln()
+		if i > 0 {
+			logReturns = append(logReturns, logPrice.Sub(previousLogPrice))
+		}
+		previousLogPrice = logPrice
+	}
+
+	if len(logReturns) == 0 {
+		return osmomath.ZeroDec(), err
+	}
+
+	n := osmomath.NewBigDec(int64(len(logReturns)))
+	sum := osmomath.ZeroBigDec()
+	for _, logReturn := range logReturns {
+		sum.AddMut(logReturn)
+	}
+	mean := sum.Quo(n)
+
+	sumOfSquares := osmomath.ZeroBigDec()
+	for _, logReturn := range logReturns {
+		deviation := logReturn.Sub(mean)
+		sumOfSquares.AddMut(deviation.Mul(deviation))
+	}
+
+	volatility, sqrtErr := osmomath.MonotonicSqrtBigDec(sumOfSquares.Quo(n))
+	if sqrtErr != nil {
+		return osmomath.Dec{}, sqrtErr
+	}
+	return volatility.Dec(), err
+}
+
+// computeTimeWeightedMedian returns the time weighted median of the spot prices of the records from startTime
+// until endTime, given the quote asset. Each spot price is weighted by the time until the next record, or endTime.
+// precondition: records is non-empty, sorted by time, and the first record is at or before startTime.
+// Like computeTwap, it returns the result along with an error if the spot price errored within the time range.
+func computeTimeWeightedMedian(records []types.TwapRecord, startTime time.Time, endTime time.Time, quoteAsset string) (osmomath.Dec, error) {
+	err := spotPriceErrorInRange(records, startTime)
+
+	type weightedPrice struct {
+		price  osmomath.Dec
+		weight int64
+	}
+
+	weightedPrices := make([]weightedPrice, 0, len(records))
+	totalWeight := int64(0)
+	for i, record := range records {
+		segmentStart := record.Time
+		if i == 0 {
+			segmentStart = startTime
+		}
+		segmentEnd := endTime
+		if i+1 < len(records) {
+			segmentEnd = records[i+1].Time
+		}
+
+		weight := types.CanonicalTimeMs(segmentEnd) - types.CanonicalTimeMs(segmentStart)
+		weightedPrices = append(weightedPrices, weightedPrice{price: recordSpotPrice(record, quoteAsset), weight: weight})
+		totalWeight += weight
+	}
+
+	// if the time range is empty, return the spot price at that time.
+	if totalWeight == 0 {
+		return weightedPrices[len(weightedPrices)-1].price, err
+	}
+
+	sort.SliceStable(weightedPrices, func(i, j int) bool {
+		return weightedPrices[i].price.LT(weightedPrices[j].price)
+	})
+
+	cumulativeWeight := int64(0)
+	for _, weightedPrice := range weightedPrices {
+		cumulativeWeight += weightedPrice.weight
+		if 2*cumulativeWeight >= totalWeight {
+			return weightedPrice.price, err
+		}
+	}
+	return weightedPrices[len(weightedPrices)-1].price, err
+}
+
+// recordSpotPrice returns the last spot price of the record given the quote asset.
+func recordSpotPrice(record types.TwapRecord, quoteAsset string) osmomath.Dec {
+	if quoteAsset == record.Asset0Denom {
+		return record.P0LastSpotPrice
+	}
+	return record.P1LastSpotPrice
+}
+
+// spotPriceErrorInRange returns an error if the spot price of the pool errored from startTime until
+// the last record, following the same rules as computeTwap.
+func spotPriceErrorInRange(records []types.TwapRecord, startTime time.Time) error {
+	startRecord, endRecord := records[0], records[len(records)-1]
+	if !endRecord.LastErrorTime.Before(startTime) || startRecord.LastErrorTime.Equal(startRecord.Time) {
+		return errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
+	}
+	return nil
+}
@@ -1364,6 +1364,57 @@ func (s *TestSuite) TestComputeArithmeticTwapWithSpotPriceError() {
 	}
 }
 
+func (s *TestSuite) TestComputeRealizedVolatilityAndMedianWithSpotPriceError() {
+	newRecordWErrorTime := func(t time.Time, sp0 osmomath.Dec, errTime time.Time) types.TwapRecord {
+		record := newRecord(basePoolId, t, sp0, zeroDec, zeroDec, zeroDec)
+		record.LastErrorTime = errTime
+		return record
+	}
+	tPlusTwo := baseTime.Add(2 * time.Second)
+
+	tests := map[string]struct {
+		records       []types.TwapRecord
+		expVolatility osmomath.Dec
+		expMedian     osmomath.Dec
+		expErr        bool
+	}{
+		"err before StartTime": {
+			records:       []types.TwapRecord{newRecordWErrorTime(baseTime, oneDec, tMinOne), newRecordWErrorTime(tPlusOne, oneDec, tMinOne)},
+			expVolatility: zeroDec,
+			expMedian:     oneDec,
+		},
+		"err at StartTime exactly from start record": {
+			records:       []types.TwapRecord{newRecordWErrorTime(baseTime, oneDec, baseTime), newRecord(basePoolId, tPlusOne, oneDec, zeroDec, zeroDec, zeroDec)},
+			expVolatility: zeroDec,
+			expMedian:     oneDec,
+			expErr:        true,
+		},
+		"err between records": {
+			records:       []types.TwapRecord{newRecord(basePoolId, baseTime, oneDec, zeroDec, zeroDec, zeroDec), newRecordWErrorTime(tPlusOne, oneDec, tPlusOne)},
+			expVolatility: zeroDec,
+			expMedian:     oneDec,
+			expErr:        true,
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			volatility, err := twap.ComputeRealizedVolatility(test.records, baseTime, denom0)
+			s.Require().Equal(test.expVolatility, volatility)
+			osmoassert.ConditionalError(s.T(), test.expErr, err)
+
+			median, err := twap.ComputeTimeWeightedMedian(test.records, baseTime, tPlusTwo, denom0)
+			s.Require().Equal(test.expMedian, median)
+			osmoassert.ConditionalError(s.T(), test.expErr, err)
+		})
+	}
+
+	// the log return of a zero spot price is undefined
+	zeroPriceRecord := newRecord(basePoolId, tPlusOne, oneDec, zeroDec, zeroDec, zeroDec)
+	zeroPriceRecord.P0LastSpotPrice = zeroDec
+	_, err := twap.ComputeRealizedVolatility([]types.TwapRecord{newRecord(basePoolId, baseTime, oneDec, zeroDec, zeroDec, zeroDec), zeroPriceRecord}, baseTime, denom0)
+	s.Require().ErrorContains(err, "is not positive")
+}
+
 // TestTwapLog_CorrectBase tests that the base of 2 is used for the twap log function.
 // log_2{16} = 4
 func (s *TestSuite) TestTwapLog_CorrectBase() {
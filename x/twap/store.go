@@ -89,11 +89,15 @@ func (k Keeper) StoreHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
 // we keep the newest record that is older than the pruning time.
 // This is why we would keep the -50 hour and -1hour twaps despite a 48hr pruning period
 //
+// The pruning state's last kept time is computed with the record history keep period, pools
+// with a record history keep period override have their last kept time shifted accordingly.
+//
 // If we reach the per block pruning limit, we store the last key seen in the pruning state.
 // This is so that we can continue pruning from where we left off in the next block.
 // If we have pruned all records, we set the pruning state to not pruning.
 func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, state types.PruningState) error {
 	store := ctx.KVStore(k.storeKey)
+	params := k.GetParams(ctx)
 
 	var numPruned uint16
 	var lastPoolIdCompleted uint64
@@ -103,6 +107,7 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, state types.Pru
 		if err != nil {
 			return err
 		}
+		lastKeptTime := state.LastKeptTime.Add(params.RecordHistoryKeepPeriod - params.PoolRecordHistoryKeepPeriod(poolId))
 
 		// Notice, if we hit the prune limit in the middle of a pool, we will re-iterate over the completed pruned pool records.
 		// This is acceptable overhead for the simplification this provides.
@@ -113,7 +118,7 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, state types.Pru
 			// lastKeptTime exclusively down to the oldest record.
 			iter := store.ReverseIterator(
 				types.FormatHistoricalPoolIndexDenomPairTWAPKey(poolId, denomPair.Denom0, denomPair.Denom1),
-				types.FormatHistoricalPoolIndexTWAPKey(poolId, denomPair.Denom0, denomPair.Denom1, state.LastKeptTime))
+				types.FormatHistoricalPoolIndexTWAPKey(poolId, denomPair.Denom0, denomPair.Denom1, lastKeptTime))
 			defer iter.Close()
 
 			firstIteration := true
@@ -269,6 +274,30 @@ func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.T
 	return twap, nil
 }
 
+// getRecordsInTimeRange returns the historical records for the asset pair of pool poolId that
+// describe the spot price over (startTime, endTime]. The first record is the record at or immediately
+// before startTime, followed by every record after startTime and at or before endTime, in time order.
+//
+// This returns the same errors as getRecordAtOrBeforeTime for startTime.
+func (k Keeper) getRecordsInTimeRange(ctx sdk.Context, poolId uint64, startTime time.Time, endTime time.Time, asset0Denom string, asset1Denom string) ([]types.TwapRecord, error) {
+	startRecord, err := k.getRecordAtOrBeforeTime(ctx, poolId, startTime, asset0Denom, asset1Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	// the time suffix keys sort right after the records at that time,
+	// so this iterates over the records after startTime and at or before endTime.
+	startKey := types.FormatHistoricalPoolIndexTimeSuffix(poolId, startRecord.Asset0Denom, startRecord.Asset1Denom, startTime)
+	endKey := types.FormatHistoricalPoolIndexTimeSuffix(poolId, startRecord.Asset0Denom, startRecord.Asset1Denom, endTime)
+	records, err := osmoutils.GatherValuesFromStore(store, startKey, endKey, types.ParseTwapFromBz)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]types.TwapRecord{startRecord}, records...), nil
+}
+
 // DeleteHistoricalTimeIndexedTWAPs deletes every historical twap record indexed by time (now deprecated) up till the limit.
 // This is to be used in the upgrade handler, to clear out the now-obsolete historical twap records
 // that were indexed by time.
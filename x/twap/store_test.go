@@ -591,6 +591,46 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 	}
 }
 
+// TestPruneRecordsBeforeTimeButNewest_RecordHistoryKeepPeriodOverride tests that pools with a record
+// history keep period override are pruned with their own last kept time.
+func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest_RecordHistoryKeepPeriodOverride() {
+	s.SetupTest()
+	poolCoins := []sdk.Coins{twoAssetPoolCoins, muliAssetPoolCoins, twoAssetPoolCoins, twoAssetPoolCoins}
+	s.prepPoolsAndRemoveRecords(poolCoins)
+
+	_, _, _, _, pool1BaseSecBaseMs, pool4Plus1SBaseMs := s.createTestRecordsFromTime(baseTime)
+	_, _, _, _, pool1BaseSecMin1Ms, pool4Plus1SMin1Ms := s.createTestRecordsFromTime(baseTime.Add(-time.Millisecond))
+	_, _, _, _, pool1BaseSecMin2Ms, pool4Plus1SMin2Ms := s.createTestRecordsFromTime(baseTime.Add(2 * -time.Millisecond))
+	s.preSetRecords([]types.TwapRecord{
+		pool1BaseSecBaseMs, pool1BaseSecMin1Ms, pool1BaseSecMin2Ms,
+		pool4Plus1SBaseMs, pool4Plus1SMin1Ms, pool4Plus1SMin2Ms,
+	})
+
+	// pool 1 keeps its records 2 seconds longer, so its last kept time is base time - 1ms.
+	params := s.twapkeeper.GetParams(s.Ctx)
+	params.RecordHistoryKeepPeriodOverrides = []types.RecordHistoryKeepPeriodOverride{
+		{PoolId: 1, RecordHistoryKeepPeriod: params.RecordHistoryKeepPeriod + 2*time.Second + time.Millisecond},
+	}
+	s.twapkeeper.SetParams(s.Ctx, params)
+
+	state := types.PruningState{
+		IsPruning:      true,
+		LastKeptTime:   baseTime.Add(2 * time.Second),
+		LastSeenPoolId: s.App.PoolManagerKeeper.GetNextPoolId(s.Ctx) - 1,
+	}
+	err := s.twapkeeper.PruneRecordsBeforeTimeButNewest(s.Ctx, state)
+	s.Require().NoError(err)
+
+	s.validateExpectedRecords([]types.TwapRecord{
+		pool1BaseSecMin2Ms, // newest before pool 1's last kept time
+		pool1BaseSecMin1Ms,
+		pool1BaseSecBaseMs,
+		pool4Plus1SBaseMs, // newest before the last kept time
+	})
+	s.Require().Equal(params.RecordHistoryKeepPeriod+2*time.Second+time.Millisecond, s.twapkeeper.PoolRecordHistoryKeepPeriod(s.Ctx, 1))
+	s.Require().Equal(params.RecordHistoryKeepPeriod, s.twapkeeper.PoolRecordHistoryKeepPeriod(s.Ctx, 4))
+}
+
 // TestPruneRecordsBeforeTimeButNewestPerBlock tests TWAP record pruning logic over multiple blocks.
 func (s *TestSuite) TestPruneRecordsBeforeTimeButNewestPerBlock() {
 	s.SetupTest()
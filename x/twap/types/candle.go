@@ -0,0 +1,103 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// NewCandle returns the candle of the interval starting at startTime, opened at the given price.
+func NewCandle(poolId uint64, baseDenom, quoteDenom string, interval time.Duration, startTime time.Time, price osmomath.Dec) Candle {
+	return Candle{
+		PoolId:      poolId,
+		BaseDenom:   baseDenom,
+		QuoteDenom:  quoteDenom,
+		Interval:    interval,
+		StartTime:   startTime,
+		Open:        price,
+		High:        price,
+		Low:         price,
+		Close:       price,
+		BaseVolume:  osmomath.ZeroInt(),
+		QuoteVolume: osmomath.ZeroInt(),
+	}
+}
+
+// Update updates the candle with a swap of the given volumes, after which the spot price is price.
+func (c *Candle) Update(price osmomath.Dec, baseVolume, quoteVolume osmomath.Int) {
+	if price.GT(c.High) {
+		c.High = price
+	}
+	if price.LT(c.Low) {
+		c.Low = price
+	}
+	c.Close = price
+	c.BaseVolume = c.BaseVolume.Add(baseVolume)
+	c.QuoteVolume = c.QuoteVolume.Add(quoteVolume)
+}
+
+// Inverted returns the candle with the base and quote assets swapped,
+// so its prices are those of the quote asset in units of the base asset.
+func (c Candle) Inverted() Candle {
+	invert := func(price osmomath.Dec) osmomath.Dec {
+		if price.IsZero() {
+			return price
+		}
+		return osmomath.OneDec().Quo(price)
+	}
+
+	return Candle{
+		PoolId:      c.PoolId,
+		BaseDenom:   c.QuoteDenom,
+		QuoteDenom:  c.BaseDenom,
+		Interval:    c.Interval,
+		StartTime:   c.StartTime,
+		Open:        invert(c.Open),
+		High:        invert(c.Low),
+		Low:         invert(c.High),
+		Close:       invert(c.Close),
+		BaseVolume:  c.QuoteVolume,
+		QuoteVolume: c.BaseVolume,
+	}
+}
+
+// validate validates the candle, returns nil on success, error otherwise.
+func (c Candle) validate() error {
+	if c.PoolId == 0 {
+		return errors.New("pool id cannot be 0")
+	}
+
+	if c.BaseDenom == "" || c.QuoteDenom == "" {
+		return fmt.Errorf("candle denoms cannot be empty, were (%s, %s)", c.BaseDenom, c.QuoteDenom)
+	}
+
+	// candles are stored with the lexicographically larger denom as the base asset.
+	if c.BaseDenom <= c.QuoteDenom {
+		return fmt.Errorf("candle base denom must be lexicographically larger than its quote denom, were (%s, %s)", c.BaseDenom, c.QuoteDenom)
+	}
+
+	if c.Interval <= 0 {
+		return fmt.Errorf("candle interval must be positive, was (%s)", c.Interval)
+	}
+
+	if c.StartTime.IsZero() || !c.StartTime.Equal(c.StartTime.Truncate(c.Interval)) {
+		return fmt.Errorf("candle start time must be a multiple of its interval, was (%s)", c.StartTime)
+	}
+
+	for _, price := range []osmomath.Dec{c.Open, c.High, c.Low, c.Close} {
+		if price.IsNil() || price.IsNegative() {
+			return fmt.Errorf("candle prices cannot be negative, were (%s, %s, %s, %s)", c.Open, c.High, c.Low, c.Close)
+		}
+	}
+
+	if c.Low.GT(c.High) {
+		return fmt.Errorf("candle low %s cannot be greater than its high %s", c.Low, c.High)
+	}
+
+	if c.BaseVolume.IsNil() || c.BaseVolume.IsNegative() || c.QuoteVolume.IsNil() || c.QuoteVolume.IsNegative() {
+		return fmt.Errorf("candle volumes cannot be negative, were (%s, %s)", c.BaseVolume, c.QuoteVolume)
+	}
+	return nil
+}
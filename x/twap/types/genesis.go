@@ -29,6 +29,12 @@ func (g *GenesisState) Validate() error {
 			return err
 		}
 	}
+
+	for _, candle := range g.Candles {
+		if err := candle.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
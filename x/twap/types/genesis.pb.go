@@ -33,6 +33,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	PruneEpochIdentifier    string        `protobuf:"bytes,1,opt,name=prune_epoch_identifier,json=pruneEpochIdentifier,proto3" json:"prune_epoch_identifier,omitempty"`
 	RecordHistoryKeepPeriod time.Duration `protobuf:"bytes,2,opt,name=record_history_keep_period,json=recordHistoryKeepPeriod,proto3,stdduration" json:"record_history_keep_period" yaml:"record_history_keep_period"`
+	// record_history_keep_period_overrides replace the record history keep
+	// period for specific pools, e.g. to keep a longer history for pools whose
+	// TWAPs are used over longer time ranges.
+	RecordHistoryKeepPeriodOverrides []RecordHistoryKeepPeriodOverride `protobuf:"bytes,3,rep,name=record_history_keep_period_overrides,json=recordHistoryKeepPeriodOverrides,proto3" json:"record_history_keep_period_overrides" yaml:"record_history_keep_period_overrides"`
+	// candle_intervals are the intervals of the candles kept for each pool and
+	// denom pair on swaps, along with how long the candles are kept for.
+	CandleIntervals []CandleInterval `protobuf:"bytes,4,rep,name=candle_intervals,json=candleIntervals,proto3" json:"candle_intervals" yaml:"candle_intervals"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -82,19 +89,143 @@ func (m *Params) GetRecordHistoryKeepPeriod() time.Duration {
 	return 0
 }
 
+func (m *Params) GetRecordHistoryKeepPeriodOverrides() []RecordHistoryKeepPeriodOverride {
+	if m != nil {
+		return m.RecordHistoryKeepPeriodOverrides
+	}
+	return nil
+}
+
+func (m *Params) GetCandleIntervals() []CandleInterval {
+	if m != nil {
+		return m.CandleIntervals
+	}
+	return nil
+}
+
+// CandleInterval is an interval of the candles kept by the module, and the
+// time the candles are kept for.
+type CandleInterval struct {
+	Interval  time.Duration `protobuf:"bytes,1,opt,name=interval,proto3,stdduration" json:"interval" yaml:"interval"`
+	Retention time.Duration `protobuf:"bytes,2,opt,name=retention,proto3,stdduration" json:"retention" yaml:"retention"`
+}
+
+func (m *CandleInterval) Reset()         { *m = CandleInterval{} }
+func (m *CandleInterval) String() string { return proto.CompactTextString(m) }
+func (*CandleInterval) ProtoMessage()    {}
+func (*CandleInterval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+}
+func (m *CandleInterval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CandleInterval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CandleInterval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CandleInterval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandleInterval.Merge(m, src)
+}
+func (m *CandleInterval) XXX_Size() int {
+	return m.Size()
+}
+func (m *CandleInterval) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandleInterval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandleInterval proto.InternalMessageInfo
+
+func (m *CandleInterval) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *CandleInterval) GetRetention() time.Duration {
+	if m != nil {
+		return m.Retention
+	}
+	return 0
+}
+
+// RecordHistoryKeepPeriodOverride is the record history keep period of a pool,
+// replacing the record history keep period of the params.
+type RecordHistoryKeepPeriodOverride struct {
+	PoolId                  uint64        `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	RecordHistoryKeepPeriod time.Duration `protobuf:"bytes,2,opt,name=record_history_keep_period,json=recordHistoryKeepPeriod,proto3,stdduration" json:"record_history_keep_period" yaml:"record_history_keep_period"`
+}
+
+func (m *RecordHistoryKeepPeriodOverride) Reset()         { *m = RecordHistoryKeepPeriodOverride{} }
+func (m *RecordHistoryKeepPeriodOverride) String() string { return proto.CompactTextString(m) }
+func (*RecordHistoryKeepPeriodOverride) ProtoMessage()    {}
+func (*RecordHistoryKeepPeriodOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f4bdf49b69bd63c, []int{2}
+}
+func (m *RecordHistoryKeepPeriodOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordHistoryKeepPeriodOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordHistoryKeepPeriodOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordHistoryKeepPeriodOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordHistoryKeepPeriodOverride.Merge(m, src)
+}
+func (m *RecordHistoryKeepPeriodOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordHistoryKeepPeriodOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordHistoryKeepPeriodOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordHistoryKeepPeriodOverride proto.InternalMessageInfo
+
+func (m *RecordHistoryKeepPeriodOverride) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *RecordHistoryKeepPeriodOverride) GetRecordHistoryKeepPeriod() time.Duration {
+	if m != nil {
+		return m.RecordHistoryKeepPeriod
+	}
+	return 0
+}
+
 // GenesisState defines the twap module's genesis state.
 type GenesisState struct {
 	// twaps is the collection of all twap records.
 	Twaps []TwapRecord `protobuf:"bytes,1,rep,name=twaps,proto3" json:"twaps"`
 	// params is the container of twap parameters.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// candles is the collection of all candles.
+	Candles []Candle `protobuf:"bytes,3,rep,name=candles,proto3" json:"candles"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+	return fileDescriptor_3f4bdf49b69bd63c, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,8 +268,17 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.twap.v1beta1.Params")
+	proto.RegisterType((*CandleInterval)(nil), "osmosis.twap.v1beta1.CandleInterval")
+	proto.RegisterType((*RecordHistoryKeepPeriodOverride)(nil), "osmosis.twap.v1beta1.RecordHistoryKeepPeriodOverride")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.twap.v1beta1.GenesisState")
 }
 
@@ -147,32 +287,44 @@ func init() {
 }
 
 var fileDescriptor_3f4bdf49b69bd63c = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbf, 0xce, 0xd3, 0x30,
-	0x14, 0xc5, 0x63, 0xfe, 0x54, 0x22, 0x1f, 0x53, 0x54, 0x41, 0xbf, 0x0a, 0xa5, 0x21, 0x03, 0xea,
-	0x52, 0x9b, 0xb6, 0x4c, 0x15, 0x53, 0x05, 0xe2, 0xdf, 0x52, 0x05, 0x26, 0x96, 0xc8, 0x49, 0x6e,
-	0x53, 0x8b, 0x26, 0xb6, 0x6c, 0xa7, 0x25, 0x0f, 0x80, 0xc4, 0xc8, 0xc8, 0x13, 0xa1, 0x8e, 0x1d,
-	0x99, 0x0a, 0x6a, 0xdf, 0x80, 0x27, 0x40, 0x89, 0x5d, 0x06, 0xd4, 0x6f, 0xcb, 0xd5, 0xef, 0x9c,
-	0xa3, 0x93, 0x7b, 0xed, 0x86, 0x5c, 0x15, 0x5c, 0x31, 0x45, 0xf4, 0x96, 0x0a, 0xb2, 0x19, 0x27,
-	0xa0, 0xe9, 0x98, 0xe4, 0x50, 0x82, 0x62, 0x0a, 0x0b, 0xc9, 0x35, 0xf7, 0xba, 0x56, 0x83, 0x1b,
-	0x0d, 0xb6, 0x9a, 0x7e, 0x37, 0xe7, 0x39, 0x6f, 0x05, 0xa4, 0xf9, 0x32, 0xda, 0xfe, 0x93, 0x8b,
-	0x79, 0xcd, 0x10, 0x4b, 0x48, 0xb9, 0xcc, 0xac, 0xee, 0x3a, 0xe7, 0x3c, 0x5f, 0x03, 0x69, 0xa7,
-	0xa4, 0x5a, 0x12, 0x5a, 0xd6, 0x67, 0x94, 0xb6, 0x19, 0xb1, 0xc9, 0x36, 0x83, 0x45, 0xfe, 0xff,
-	0xae, 0xac, 0x92, 0x54, 0x33, 0x5e, 0x1a, 0x1e, 0xfe, 0x40, 0x6e, 0x67, 0x41, 0x25, 0x2d, 0x94,
-	0xf7, 0xcc, 0x7d, 0x20, 0x64, 0x55, 0x42, 0x0c, 0x82, 0xa7, 0xab, 0x98, 0x65, 0x50, 0x6a, 0xb6,
-	0x64, 0x20, 0x7b, 0x28, 0x40, 0xc3, 0x7b, 0x51, 0xb7, 0xa5, 0x2f, 0x1b, 0xf8, 0xe6, 0x1f, 0xf3,
-	0xbe, 0x20, 0xb7, 0x6f, 0x7a, 0xc6, 0x2b, 0xa6, 0x34, 0x97, 0x75, 0xfc, 0x09, 0x40, 0xc4, 0x02,
-	0x24, 0xe3, 0x59, 0xef, 0x56, 0x80, 0x86, 0x57, 0x93, 0x6b, 0x6c, 0x6a, 0xe0, 0x73, 0x0d, 0xfc,
-	0xc2, 0xd6, 0x98, 0x8f, 0x76, 0x87, 0x81, 0xf3, 0xe7, 0x30, 0x78, 0x5c, 0xd3, 0x62, 0x3d, 0x0b,
-	0x6f, 0x8e, 0x0a, 0xbf, 0xff, 0x1a, 0xa0, 0xe8, 0xa1, 0x11, 0xbc, 0x36, 0xfc, 0x1d, 0x80, 0x58,
-	0x18, 0xfa, 0x15, 0xb9, 0xf7, 0x5f, 0x99, 0x23, 0xbc, 0xd7, 0x54, 0x83, 0xf7, 0xdc, 0xbd, 0xdb,
-	0x2c, 0x51, 0xf5, 0x50, 0x70, 0x7b, 0x78, 0x35, 0x09, 0xf0, 0xa5, 0x9b, 0xe0, 0x0f, 0x5b, 0x2a,
-	0xa2, 0x36, 0x72, 0x7e, 0xa7, 0x69, 0x12, 0x19, 0x93, 0x37, 0x73, 0x3b, 0xa2, 0x5d, 0x8b, 0xfd,
-	0x83, 0x47, 0x97, 0xed, 0x66, 0x75, 0xd6, 0x6a, 0x1d, 0xf3, 0xb7, 0xbb, 0xa3, 0x8f, 0xf6, 0x47,
-	0x1f, 0xfd, 0x3e, 0xfa, 0xe8, 0xdb, 0xc9, 0x77, 0xf6, 0x27, 0xdf, 0xf9, 0x79, 0xf2, 0x9d, 0x8f,
-	0x4f, 0x73, 0xa6, 0x57, 0x55, 0x82, 0x53, 0x5e, 0x10, 0x9b, 0x37, 0x5a, 0xd3, 0x44, 0x9d, 0x07,
-	0xb2, 0x99, 0x8e, 0xc9, 0x67, 0xf3, 0x12, 0x74, 0x2d, 0x40, 0x25, 0x9d, 0x76, 0x63, 0xd3, 0xbf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xa0, 0x1f, 0xe1, 0x76, 0x02, 0x00, 0x00,
+	// 591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcf, 0x4e, 0x14, 0x31,
+	0x18, 0xdf, 0x0a, 0x2e, 0x52, 0x0c, 0x90, 0x86, 0xc8, 0x82, 0x64, 0x66, 0x9d, 0x10, 0x43, 0x42,
+	0x98, 0x11, 0xd0, 0x0b, 0xe1, 0x34, 0x6a, 0x14, 0x3d, 0x48, 0x46, 0xbd, 0x78, 0x99, 0x74, 0x67,
+	0xca, 0xd0, 0x38, 0x3b, 0x6d, 0xda, 0xee, 0xe2, 0x3e, 0x80, 0x77, 0x8f, 0x3e, 0x8b, 0xde, 0x0d,
+	0x47, 0x4e, 0xc6, 0x13, 0x1a, 0xf6, 0x0d, 0x48, 0xbc, 0x9b, 0x69, 0x3b, 0x10, 0xc8, 0xb0, 0x7b,
+	0xf5, 0xd6, 0x2f, 0xbf, 0x3f, 0xfd, 0xb5, 0xdf, 0xd7, 0x42, 0x8f, 0xc9, 0x2e, 0x93, 0x54, 0x06,
+	0xea, 0x08, 0xf3, 0xa0, 0xbf, 0xd9, 0x21, 0x0a, 0x6f, 0x06, 0x19, 0x29, 0x88, 0xa4, 0xd2, 0xe7,
+	0x82, 0x29, 0x86, 0x16, 0x2c, 0xc7, 0x2f, 0x39, 0xbe, 0xe5, 0x2c, 0x2f, 0x64, 0x2c, 0x63, 0x9a,
+	0x10, 0x94, 0x2b, 0xc3, 0x5d, 0x7e, 0x58, 0xeb, 0x57, 0x16, 0xb1, 0x20, 0x09, 0x13, 0xa9, 0xe5,
+	0x2d, 0x65, 0x8c, 0x65, 0x39, 0x09, 0x74, 0xd5, 0xe9, 0x1d, 0x04, 0xb8, 0x18, 0x54, 0x50, 0xa2,
+	0x3d, 0x62, 0xe3, 0x6d, 0x0a, 0x0b, 0x39, 0xd7, 0x55, 0x69, 0x4f, 0x60, 0x45, 0x59, 0x61, 0x70,
+	0xef, 0xef, 0x04, 0x6c, 0xee, 0x63, 0x81, 0xbb, 0x12, 0x3d, 0x86, 0xf7, 0xb8, 0xe8, 0x15, 0x24,
+	0x26, 0x9c, 0x25, 0x87, 0x31, 0x4d, 0x49, 0xa1, 0xe8, 0x01, 0x25, 0xa2, 0x05, 0xda, 0x60, 0x6d,
+	0x3a, 0x5a, 0xd0, 0xe8, 0xf3, 0x12, 0xdc, 0xbb, 0xc0, 0xd0, 0x67, 0x00, 0x97, 0x4d, 0xce, 0xf8,
+	0x90, 0x4a, 0xc5, 0xc4, 0x20, 0xfe, 0x48, 0x08, 0x8f, 0x39, 0x11, 0x94, 0xa5, 0xad, 0x5b, 0x6d,
+	0xb0, 0x36, 0xb3, 0xb5, 0xe4, 0x9b, 0x18, 0x7e, 0x15, 0xc3, 0x7f, 0x66, 0x63, 0x84, 0x1b, 0xc7,
+	0xa7, 0x6e, 0xe3, 0xfc, 0xd4, 0x7d, 0x30, 0xc0, 0xdd, 0x7c, 0xc7, 0xbb, 0xd9, 0xca, 0xfb, 0xfa,
+	0xdb, 0x05, 0xd1, 0xa2, 0x21, 0xbc, 0x34, 0xf8, 0x6b, 0x42, 0xf8, 0xbe, 0x46, 0xd1, 0x37, 0x00,
+	0x57, 0x6f, 0x16, 0xc7, 0xac, 0x4f, 0x84, 0xa0, 0x29, 0x91, 0xad, 0x89, 0xf6, 0xc4, 0xda, 0xcc,
+	0xd6, 0x13, 0xbf, 0xae, 0x45, 0x7e, 0x54, 0xef, 0xfe, 0xc6, 0xaa, 0xc3, 0x6d, 0x9b, 0x76, 0x7d,
+	0x5c, 0xda, 0xcb, 0x0d, 0xbd, 0xa8, 0x2d, 0x46, 0xbb, 0x4a, 0xc4, 0xe1, 0x7c, 0x82, 0x8b, 0x34,
+	0x27, 0x31, 0x2d, 0x14, 0x11, 0x7d, 0x9c, 0xcb, 0xd6, 0xa4, 0xce, 0xb9, 0x5a, 0x9f, 0xf3, 0xa9,
+	0x66, 0xef, 0x59, 0x72, 0xe8, 0xda, 0x58, 0x8b, 0x26, 0xd6, 0x75, 0x2f, 0x2f, 0x9a, 0x4b, 0xae,
+	0x08, 0xa4, 0xf7, 0x1d, 0xc0, 0xd9, 0xab, 0x26, 0x28, 0x82, 0x77, 0x2a, 0x85, 0xee, 0xf8, 0xc8,
+	0xb6, 0xdd, 0xb7, 0x3b, 0xce, 0x99, 0x1d, 0x2b, 0xa1, 0x69, 0xd2, 0x85, 0x0f, 0x7a, 0x0f, 0xa7,
+	0x05, 0x51, 0xe5, 0xb0, 0xb0, 0x62, 0xfc, 0x2c, 0xac, 0x58, 0xd3, 0xf9, 0xea, 0x76, 0xad, 0xd2,
+	0xb8, 0x5e, 0x3a, 0x79, 0x3f, 0x01, 0x74, 0xc7, 0xb4, 0x0a, 0xad, 0xc3, 0x29, 0xce, 0x58, 0x1e,
+	0xd3, 0x54, 0x9f, 0x66, 0x32, 0x44, 0xe7, 0xa7, 0xee, 0xac, 0x71, 0xb6, 0x80, 0x17, 0x35, 0xcb,
+	0xd5, 0x5e, 0xfa, 0xbf, 0x4c, 0xb1, 0xf7, 0x03, 0xc0, 0xbb, 0x2f, 0xcc, 0x57, 0xf2, 0x56, 0x61,
+	0x45, 0xd0, 0x2e, 0xbc, 0x5d, 0x36, 0x5e, 0xb6, 0x80, 0x1e, 0x87, 0x76, 0xfd, 0x38, 0xbc, 0x3b,
+	0xc2, 0xdc, 0xdc, 0x47, 0x38, 0x59, 0x26, 0x89, 0x8c, 0x08, 0xed, 0xc0, 0x26, 0xd7, 0x8f, 0xdb,
+	0x9e, 0x60, 0xa5, 0x5e, 0x6e, 0x3e, 0x00, 0x2b, 0xb5, 0x0a, 0xb4, 0x0b, 0xa7, 0xcc, 0xd0, 0x54,
+	0x4f, 0x66, 0x65, 0xd4, 0x28, 0x5a, 0x71, 0x25, 0x09, 0x5f, 0x1d, 0x9f, 0x39, 0xe0, 0xe4, 0xcc,
+	0x01, 0x7f, 0xce, 0x1c, 0xf0, 0x65, 0xe8, 0x34, 0x4e, 0x86, 0x4e, 0xe3, 0xd7, 0xd0, 0x69, 0x7c,
+	0x78, 0x94, 0x51, 0x75, 0xd8, 0xeb, 0xf8, 0x09, 0xeb, 0x06, 0xd6, 0x70, 0x23, 0xc7, 0x1d, 0x59,
+	0x15, 0x41, 0x7f, 0x7b, 0x33, 0xf8, 0x64, 0x7e, 0x43, 0x35, 0xe0, 0x44, 0x76, 0x9a, 0xfa, 0xbe,
+	0xb7, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x26, 0x20, 0xb7, 0xfc, 0x7a, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -195,6 +347,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CandleIntervals) > 0 {
+		for iNdEx := len(m.CandleIntervals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CandleIntervals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RecordHistoryKeepPeriodOverrides) > 0 {
+		for iNdEx := len(m.RecordHistoryKeepPeriodOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordHistoryKeepPeriodOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
 	if err1 != nil {
 		return 0, err1
@@ -213,6 +393,81 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CandleInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CandleInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CandleInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Retention, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Retention):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordHistoryKeepPeriodOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordHistoryKeepPeriodOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordHistoryKeepPeriodOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -233,6 +488,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -283,6 +552,45 @@ func (m *Params) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod)
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RecordHistoryKeepPeriodOverrides) > 0 {
+		for _, e := range m.RecordHistoryKeepPeriodOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CandleIntervals) > 0 {
+		for _, e := range m.CandleIntervals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CandleInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Retention)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RecordHistoryKeepPeriodOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -300,6 +608,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -403,6 +717,292 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordHistoryKeepPeriodOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordHistoryKeepPeriodOverrides = append(m.RecordHistoryKeepPeriodOverrides, RecordHistoryKeepPeriodOverride{})
+			if err := m.RecordHistoryKeepPeriodOverrides[len(m.RecordHistoryKeepPeriodOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleIntervals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandleIntervals = append(m.CandleIntervals, CandleInterval{})
+			if err := m.CandleIntervals[len(m.CandleIntervals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CandleInterval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CandleInterval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CandleInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Retention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordHistoryKeepPeriodOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordHistoryKeepPeriodOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordHistoryKeepPeriodOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordHistoryKeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RecordHistoryKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -520,6 +1120,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -79,6 +79,22 @@ func TestGenesisState_Validate(t *testing.T) {
 		return record
 	}
 
+	withOverrides := func(params Params, overrides ...RecordHistoryKeepPeriodOverride) Params {
+		params.RecordHistoryKeepPeriodOverrides = overrides
+		return params
+	}
+
+	withCandles := func(candles ...Candle) *GenesisState {
+		genesis := NewGenesisState(basicParams, []TwapRecord{baseRecord})
+		genesis.Candles = candles
+		return genesis
+	}
+
+	withCandleIntervals := func(params Params, candleIntervals ...CandleInterval) Params {
+		params.CandleIntervals = candleIntervals
+		return params
+	}
+
 	testCases := map[string]struct {
 		twapGenesis *GenesisState
 
@@ -99,6 +115,43 @@ func TestGenesisState_Validate(t *testing.T) {
 		"valid geometric twap acc is negative": {
 			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withGeometricAcc(baseRecord, osmomath.NewDec(-1))}),
 		},
+		"valid record history keep period override": {
+			twapGenesis: NewGenesisState(withOverrides(basicParams, RecordHistoryKeepPeriodOverride{PoolId: basePoolId, RecordHistoryKeepPeriod: 7 * 24 * time.Hour}), []TwapRecord{baseRecord}),
+		},
+		"invalid record history keep period override with pool id 0": {
+			twapGenesis: NewGenesisState(withOverrides(basicParams, RecordHistoryKeepPeriodOverride{PoolId: 0, RecordHistoryKeepPeriod: time.Hour}), []TwapRecord{baseRecord}),
+			expectedErr: true,
+		},
+		"invalid record history keep period override with non-positive period": {
+			twapGenesis: NewGenesisState(withOverrides(basicParams, RecordHistoryKeepPeriodOverride{PoolId: basePoolId}), []TwapRecord{baseRecord}),
+			expectedErr: true,
+		},
+		"invalid duplicate record history keep period override": {
+			twapGenesis: NewGenesisState(withOverrides(basicParams,
+				RecordHistoryKeepPeriodOverride{PoolId: basePoolId, RecordHistoryKeepPeriod: time.Hour},
+				RecordHistoryKeepPeriodOverride{PoolId: basePoolId, RecordHistoryKeepPeriod: 2 * time.Hour},
+			), []TwapRecord{baseRecord}),
+			expectedErr: true,
+		},
+		"valid candle": {
+			twapGenesis: withCandles(NewCandle(basePoolId, "uosmo", "uatom", time.Minute, tPlusOne.Truncate(time.Minute), osmomath.OneDec())),
+		},
+		"invalid candle with base denom smaller than quote denom": {
+			twapGenesis: withCandles(NewCandle(basePoolId, "uatom", "uosmo", time.Minute, tPlusOne.Truncate(time.Minute), osmomath.OneDec())),
+			expectedErr: true,
+		},
+		"invalid candle start time not a multiple of its interval": {
+			twapGenesis: withCandles(NewCandle(basePoolId, "uosmo", "uatom", time.Minute, tPlusOne.Truncate(time.Minute).Add(time.Second), osmomath.OneDec())),
+			expectedErr: true,
+		},
+		"invalid duplicate candle interval": {
+			twapGenesis: NewGenesisState(withCandleIntervals(basicParams, CandleInterval{Interval: time.Minute, Retention: time.Hour}, CandleInterval{Interval: time.Minute, Retention: 2 * time.Hour}), []TwapRecord{baseRecord}),
+			expectedErr: true,
+		},
+		"invalid candle retention shorter than its interval": {
+			twapGenesis: NewGenesisState(withCandleIntervals(basicParams, CandleInterval{Interval: time.Hour, Retention: time.Minute}), []TwapRecord{baseRecord}),
+			expectedErr: true,
+		},
 		"invalid geometric twap acc is nil": {
 			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withGeometricAcc(baseRecord, osmomath.Dec{})}),
 			expectedErr: true,
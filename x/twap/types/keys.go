@@ -30,8 +30,11 @@ var (
 	PruningStateKey = []byte{0x01}
 	// TODO: Delete in v26
 	DeprecatedHistoricalTWAPsIsPruningKey = []byte{0x02}
-	mostRecentTWAPsNoSeparator            = "recent_twap"
-	historicalTWAPPoolIndexNoSeparator    = "historical_pool_index"
+	// CandlePruningKey stores the key of the next candle to check for pruning, while candles are pruned.
+	CandlePruningKey                   = []byte{0x03}
+	mostRecentTWAPsNoSeparator         = "recent_twap"
+	historicalTWAPPoolIndexNoSeparator = "historical_pool_index"
+	candlesNoSeparator                 = "candles"
 
 	// We do key management to let us easily meet the goals of (AKA minimal iteration):
 	// * Get most recent twap for a (pool id, asset 1, asset 2) with no iteration
@@ -44,6 +47,9 @@ var (
 	// format is pool id | denom1 | denom2 | time
 	// made for efficiently getting records given (pool id, denom1, denom2) and time bounds
 	HistoricalTWAPPoolIndexPrefix = historicalTWAPPoolIndexNoSeparator + KeySeparator
+	// format is pool id | denom1 | denom2 | interval | start time
+	// made for getting the candles of an interval of a (pool id, denom1, denom2) in time order
+	CandlesPrefix = candlesNoSeparator + KeySeparator
 )
 
 // TODO: make utility command to automatically interlace separators
@@ -84,6 +90,19 @@ func FormatHistoricalPoolIndexTimeSuffix(poolId uint64, denom1, denom2 string, a
 	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s.", HistoricalTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
 }
 
+// FormatCandleIntervalPrefix returns the prefix of the candles of the given interval,
+// for the (pool id, denom1, denom2) triplet.
+func FormatCandleIntervalPrefix(poolId uint64, denom1, denom2 string, interval time.Duration) []byte {
+	poolIdS := osmoutils.FormatFixedLengthU64(poolId)
+	intervalS := osmoutils.FormatFixedLengthU64(uint64(interval))
+	return []byte(fmt.Sprintf("%s%s%s%s%s%s%s%s%s", CandlesPrefix, poolIdS, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, intervalS, KeySeparator))
+}
+
+func FormatCandleKey(poolId uint64, denom1, denom2 string, interval time.Duration, startTime time.Time) []byte {
+	timeS := osmoutils.FormatTimeString(startTime)
+	return append(FormatCandleIntervalPrefix(poolId, denom1, denom2, interval), []byte(timeS)...)
+}
+
 // GetAllMostRecentTwapsForPool returns all of the most recent twap records for a pool id.
 // if the pool id doesn't exist, then this returns a blank list.
 func GetAllMostRecentTwapsForPool(store storetypes.KVStore, poolId uint64) ([]TwapRecord, error) {
@@ -100,6 +119,14 @@ func GetMostRecentTwapForPool(store storetypes.KVStore, poolId uint64, denom1, d
 	return ParseTwapFromBz(bz)
 }
 
+func ParseCandleFromBz(bz []byte) (candle Candle, err error) {
+	if len(bz) == 0 {
+		return Candle{}, errors.New("candle not found")
+	}
+	err = proto.Unmarshal(bz, &candle)
+	return candle, err
+}
+
 func ParseTwapFromBz(bz []byte) (twap TwapRecord, err error) {
 	if len(bz) == 0 {
 		return TwapRecord{}, errors.New("twap not found")
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -14,6 +15,9 @@ var (
 	KeyPruneEpochIdentifier    = []byte("PruneEpochIdentifier")
 	KeyRecordHistoryKeepPeriod = []byte("RecordHistoryKeepPeriod")
 
+	KeyRecordHistoryKeepPeriodOverrides = []byte("RecordHistoryKeepPeriodOverrides")
+	KeyCandleIntervals                  = []byte("CandleIntervals")
+
 	_ paramtypes.ParamSet = &Params{}
 )
 
@@ -22,6 +26,14 @@ const (
 	defaultRecordHistoryKeepPeriod = 48 * time.Hour
 )
 
+// DefaultCandleIntervals are the candles kept by default, 1 minute candles for a day,
+// 1 hour candles for a week and 1 day candles for 90 days.
+var DefaultCandleIntervals = []CandleInterval{
+	{Interval: time.Minute, Retention: 24 * time.Hour},
+	{Interval: time.Hour, Retention: 7 * 24 * time.Hour},
+	{Interval: 24 * time.Hour, Retention: 90 * 24 * time.Hour},
+}
+
 // ParamTable for twap module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -39,6 +51,7 @@ func DefaultParams() Params {
 	return Params{
 		PruneEpochIdentifier:    defaultPruneEpochIdentifier,
 		RecordHistoryKeepPeriod: defaultRecordHistoryKeepPeriod,
+		CandleIntervals:         DefaultCandleIntervals,
 	}
 }
 
@@ -52,6 +65,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateRecordHistoryKeepPeriodOverrides(p.RecordHistoryKeepPeriodOverrides); err != nil {
+		return err
+	}
+
+	if err := validateCandleIntervals(p.CandleIntervals); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -60,6 +81,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPruneEpochIdentifier, &p.PruneEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(KeyRecordHistoryKeepPeriod, &p.RecordHistoryKeepPeriod, validatePeriod),
+		paramtypes.NewParamSetPair(KeyRecordHistoryKeepPeriodOverrides, &p.RecordHistoryKeepPeriodOverrides, validateRecordHistoryKeepPeriodOverrides),
+		paramtypes.NewParamSetPair(KeyCandleIntervals, &p.CandleIntervals, validateCandleIntervals),
 	}
 }
 
@@ -75,3 +98,72 @@ func validatePeriod(i interface{}) error {
 
 	return nil
 }
+
+func validateRecordHistoryKeepPeriodOverrides(i interface{}) error {
+	overrides, ok := i.([]RecordHistoryKeepPeriodOverride)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenPoolIds := make(map[uint64]struct{}, len(overrides))
+	for _, override := range overrides {
+		if override.PoolId == 0 {
+			return errors.New("record history keep period override pool id cannot be 0")
+		}
+		if _, ok := seenPoolIds[override.PoolId]; ok {
+			return fmt.Errorf("duplicate record history keep period override for pool %d", override.PoolId)
+		}
+		seenPoolIds[override.PoolId] = struct{}{}
+
+		if err := validatePeriod(override.RecordHistoryKeepPeriod); err != nil {
+			return fmt.Errorf("invalid record history keep period override for pool %d: %w", override.PoolId, err)
+		}
+	}
+
+	return nil
+}
+
+func validateCandleIntervals(i interface{}) error {
+	candleIntervals, ok := i.([]CandleInterval)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenIntervals := make(map[time.Duration]struct{}, len(candleIntervals))
+	for _, candleInterval := range candleIntervals {
+		if err := validatePeriod(candleInterval.Interval); err != nil {
+			return fmt.Errorf("invalid candle interval: %w", err)
+		}
+		if _, ok := seenIntervals[candleInterval.Interval]; ok {
+			return fmt.Errorf("duplicate candle interval %s", candleInterval.Interval)
+		}
+		seenIntervals[candleInterval.Interval] = struct{}{}
+
+		if candleInterval.Retention < candleInterval.Interval {
+			return fmt.Errorf("candle retention %s must be at least the candle interval %s", candleInterval.Retention, candleInterval.Interval)
+		}
+	}
+
+	return nil
+}
+
+// HasCandleInterval returns true if candles are kept for the given interval.
+func (p Params) HasCandleInterval(interval time.Duration) bool {
+	for _, candleInterval := range p.CandleIntervals {
+		if candleInterval.Interval == interval {
+			return true
+		}
+	}
+	return false
+}
+
+// PoolRecordHistoryKeepPeriod returns the record history keep period of the pool,
+// which is its override if it has one.
+func (p Params) PoolRecordHistoryKeepPeriod(poolId uint64) time.Duration {
+	for _, override := range p.RecordHistoryKeepPeriodOverrides {
+		if override.PoolId == poolId {
+			return override.RecordHistoryKeepPeriod
+		}
+	}
+	return p.RecordHistoryKeepPeriod
+}
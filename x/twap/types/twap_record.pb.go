@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -216,9 +217,97 @@ func (m *PruningState) GetLastSeenPoolId() uint64 {
 	return 0
 }
 
+// Candle is the open, high, low and close spot price of the base asset, in
+// units of the quote asset, and the volume swapped of each asset, in a pool
+// over the interval starting at start_time.
+// Candles are stored with the lexicographically larger denom of the pair as
+// the base asset, the same orientation as p0_last_spot_price in TwapRecord.
+type Candle struct {
+	PoolId      uint64                      `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	BaseDenom   string                      `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	QuoteDenom  string                      `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	Interval    time.Duration               `protobuf:"bytes,4,opt,name=interval,proto3,stdduration" json:"interval" yaml:"interval"`
+	StartTime   time.Time                   `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	Open        cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=open,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"open"`
+	High        cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=high,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"high"`
+	Low         cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=low,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"low"`
+	Close       cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=close,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"close"`
+	BaseVolume  cosmossdk_io_math.Int       `protobuf:"bytes,10,opt,name=base_volume,json=baseVolume,proto3,customtype=cosmossdk.io/math.Int" json:"base_volume" yaml:"base_volume"`
+	QuoteVolume cosmossdk_io_math.Int       `protobuf:"bytes,11,opt,name=quote_volume,json=quoteVolume,proto3,customtype=cosmossdk.io/math.Int" json:"quote_volume" yaml:"quote_volume"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{2}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *Candle) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *Candle) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *Candle) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Candle) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*TwapRecord)(nil), "osmosis.twap.v1beta1.TwapRecord")
 	proto.RegisterType((*PruningState)(nil), "osmosis.twap.v1beta1.PruningState")
+	proto.RegisterType((*Candle)(nil), "osmosis.twap.v1beta1.Candle")
 }
 
 func init() {
@@ -226,47 +315,61 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x4f, 0xdb, 0x40,
-	0x14, 0x8e, 0x21, 0x0d, 0x70, 0x09, 0x45, 0x58, 0xb4, 0x58, 0x41, 0xb5, 0x83, 0x2b, 0x55, 0x61,
-	0xa8, 0x1d, 0x97, 0x8d, 0x4e, 0x44, 0x74, 0x68, 0x8b, 0xaa, 0xc8, 0x30, 0x75, 0xb1, 0x2e, 0xf6,
-	0xc3, 0xb1, 0x88, 0x73, 0xa7, 0xbb, 0x0b, 0x34, 0xff, 0x05, 0xff, 0x52, 0x37, 0x46, 0xc6, 0xaa,
-	0x43, 0x5a, 0xc1, 0xd6, 0x91, 0xb1, 0x53, 0x75, 0x77, 0x4e, 0x4a, 0xe8, 0x0f, 0x60, 0xf3, 0x7b,
-	0xf7, 0xbd, 0xef, 0xfb, 0x9e, 0xef, 0xdd, 0x43, 0x2f, 0x08, 0xcf, 0x09, 0xcf, 0xb8, 0x2f, 0x4e,
-	0x31, 0xf5, 0x4f, 0x82, 0x2e, 0x08, 0x1c, 0xa8, 0x20, 0x62, 0x10, 0x13, 0x96, 0x78, 0x94, 0x11,
-	0x41, 0xcc, 0xb5, 0x02, 0xe7, 0xc9, 0x23, 0xaf, 0xc0, 0xd5, 0xd7, 0x52, 0x92, 0x12, 0x05, 0xf0,
-	0xe5, 0x97, 0xc6, 0xd6, 0x9d, 0x94, 0x90, 0xb4, 0x0f, 0xbe, 0x8a, 0xba, 0xc3, 0x23, 0x5f, 0x64,
-	0x39, 0x70, 0x81, 0x73, 0xaa, 0x01, 0xee, 0xe7, 0x0a, 0x42, 0x87, 0xa7, 0x98, 0x86, 0x4a, 0xc1,
-	0x5c, 0x47, 0x0b, 0x94, 0x90, 0x7e, 0x94, 0x25, 0x96, 0xd1, 0x30, 0x9a, 0xe5, 0xb0, 0x22, 0xc3,
-	0xb7, 0x89, 0xb9, 0x89, 0x6a, 0x98, 0x73, 0x10, 0xad, 0x28, 0x81, 0x01, 0xc9, 0xad, 0xb9, 0x86,
-	0xd1, 0x5c, 0x0a, 0xab, 0x3a, 0xb7, 0x27, 0x53, 0x53, 0x48, 0x50, 0x40, 0xe6, 0x6f, 0x40, 0x02,
-	0x0d, 0xd9, 0x45, 0x95, 0x1e, 0x64, 0x69, 0x4f, 0x58, 0xe5, 0x86, 0xd1, 0x9c, 0x6f, 0x6f, 0xfd,
-	0x18, 0x3b, 0xcb, 0xba, 0xb9, 0x48, 0x1f, 0x5c, 0x8f, 0x9d, 0xb5, 0x11, 0xce, 0xfb, 0x3b, 0xee,
-	0x4c, 0xda, 0x0d, 0x8b, 0x42, 0xf3, 0x03, 0x2a, 0xcb, 0x1e, 0xac, 0x47, 0x0d, 0xa3, 0x59, 0x7d,
-	0x55, 0xf7, 0x74, 0x83, 0xde, 0xa4, 0x41, 0xef, 0x70, 0xd2, 0x60, 0xdb, 0x3e, 0x1f, 0x3b, 0xa5,
-	0xeb, 0xb1, 0x63, 0xce, 0xf0, 0xc9, 0x62, 0xf7, 0xec, 0x9b, 0x63, 0x84, 0x8a, 0xc7, 0xec, 0x20,
-	0x93, 0xb6, 0xa2, 0x3e, 0xe6, 0x22, 0xe2, 0x94, 0x88, 0x88, 0xb2, 0x2c, 0x06, 0xab, 0x22, 0xbd,
-	0xb7, 0x9f, 0x4b, 0x86, 0xaf, 0x63, 0x67, 0x23, 0x56, 0xbf, 0x9c, 0x27, 0xc7, 0x5e, 0x46, 0xfc,
-	0x1c, 0x8b, 0x9e, 0xb7, 0x0f, 0x29, 0x8e, 0x47, 0x7b, 0x10, 0x87, 0x2b, 0xb4, 0xb5, 0x8f, 0xb9,
-	0x38, 0xa0, 0x44, 0x74, 0x64, 0xad, 0x62, 0x0c, 0xfe, 0x60, 0x5c, 0x78, 0x08, 0x63, 0x30, 0xcb,
-	0xd8, 0x43, 0x36, 0x6d, 0x45, 0x98, 0x65, 0xa2, 0x97, 0x83, 0xc8, 0xe2, 0x48, 0x0d, 0x05, 0x8e,
-	0xe3, 0x61, 0x3e, 0xec, 0x63, 0x41, 0x98, 0xb5, 0x78, 0x7f, 0xf6, 0x0d, 0xda, 0xda, 0x9d, 0x32,
-	0xc9, 0xab, 0xdf, 0xfd, 0xcd, 0xa3, 0x94, 0x82, 0xff, 0x2a, 0x2d, 0x3d, 0x44, 0x29, 0xf8, 0xb7,
-	0x12, 0x46, 0xf5, 0x14, 0x48, 0x0e, 0x82, 0xfd, 0x4d, 0x05, 0xdd, 0x5f, 0xc5, 0x9a, 0xd2, 0xdc,
-	0x96, 0x38, 0x42, 0x2b, 0xea, 0x16, 0x80, 0x31, 0xc2, 0xd4, 0xc5, 0x5b, 0xd5, 0x3b, 0xa7, 0xc6,
-	0x2d, 0xa6, 0xe6, 0xa9, 0x9e, 0x9a, 0x5b, 0x04, 0x7a, 0x72, 0x96, 0x65, 0xf6, 0x8d, 0x4c, 0xca,
-	0x3a, 0xf7, 0xa7, 0x81, 0x6a, 0x1d, 0x36, 0x1c, 0x64, 0x83, 0xf4, 0x40, 0x60, 0x01, 0xe6, 0x33,
-	0x84, 0x32, 0x1e, 0x51, 0x9d, 0x52, 0x0f, 0x69, 0x31, 0x5c, 0xca, 0x78, 0x81, 0x31, 0x63, 0xf4,
-	0x58, 0xd1, 0x1e, 0x03, 0x15, 0xda, 0xd6, 0xdc, 0x9d, 0xb6, 0x36, 0x0b, 0x5b, 0x4f, 0x6e, 0xd8,
-	0x9a, 0xd6, 0x6b, 0x57, 0x35, 0x99, 0x7c, 0x0f, 0x54, 0xc8, 0x2a, 0xf3, 0x35, 0x5a, 0x2e, 0x40,
-	0xa3, 0x88, 0x03, 0x0c, 0xd4, 0x73, 0xac, 0xb5, 0xd7, 0xaf, 0xc7, 0xce, 0x6a, 0x02, 0x94, 0x41,
-	0x8c, 0x05, 0x24, 0x3b, 0xae, 0x60, 0x43, 0x70, 0x2d, 0x23, 0xac, 0xea, 0xea, 0xd1, 0x01, 0xc0,
-	0xc0, 0xdc, 0x42, 0xab, 0x7a, 0x7e, 0x01, 0x06, 0xd1, 0x64, 0x21, 0x94, 0xd5, 0x42, 0x50, 0xd6,
-	0x25, 0xa8, 0xa3, 0x16, 0x43, 0xfb, 0xdd, 0xf9, 0xa5, 0x6d, 0x5c, 0x5c, 0xda, 0xc6, 0xf7, 0x4b,
-	0xdb, 0x38, 0xbb, 0xb2, 0x4b, 0x17, 0x57, 0x76, 0xe9, 0xcb, 0x95, 0x5d, 0xfa, 0xd8, 0x4a, 0x33,
-	0xd1, 0x1b, 0x76, 0xbd, 0x98, 0xe4, 0x7e, 0xb1, 0xb2, 0x5e, 0xf6, 0x71, 0x97, 0x4f, 0x02, 0xff,
-	0x64, 0x3b, 0xf0, 0x3f, 0xe9, 0x6d, 0x27, 0x46, 0x14, 0x78, 0xb7, 0xa2, 0x1a, 0xdf, 0xfe, 0x15,
-	0x00, 0x00, 0xff, 0xff, 0x8e, 0x49, 0xf2, 0x91, 0x0a, 0x05, 0x00, 0x00,
+	// 850 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x73, 0xdb, 0x44,
+	0x14, 0x8e, 0x1a, 0xc7, 0x8d, 0xd7, 0x0e, 0x99, 0x88, 0x94, 0x8a, 0x64, 0x22, 0xa5, 0x62, 0x86,
+	0x49, 0x0f, 0x48, 0x56, 0x03, 0xc3, 0x50, 0x4e, 0x31, 0xe1, 0x50, 0xe8, 0x30, 0x19, 0x25, 0x03,
+	0x0c, 0x17, 0xcd, 0x5a, 0x7a, 0x95, 0x34, 0x95, 0xb4, 0x8b, 0x76, 0x95, 0xe0, 0xff, 0xa2, 0x47,
+	0xce, 0xfc, 0x27, 0xdc, 0x7a, 0xec, 0x91, 0xe1, 0x60, 0x98, 0xe4, 0xc6, 0x31, 0x47, 0x4e, 0xcc,
+	0xfe, 0xb0, 0x13, 0x27, 0xb4, 0x8e, 0x6f, 0xde, 0xa7, 0xef, 0xfb, 0xde, 0xdb, 0xf7, 0xde, 0x7e,
+	0x46, 0x1f, 0x13, 0x56, 0x12, 0x96, 0x33, 0x9f, 0x9f, 0x61, 0xea, 0x9f, 0x06, 0x43, 0xe0, 0x38,
+	0x90, 0x87, 0xa8, 0x86, 0x98, 0xd4, 0x89, 0x47, 0x6b, 0xc2, 0x89, 0xb9, 0xa9, 0x71, 0x9e, 0xf8,
+	0xe4, 0x69, 0xdc, 0xd6, 0x66, 0x4a, 0x52, 0x22, 0x01, 0xbe, 0xf8, 0xa5, 0xb0, 0x5b, 0x4e, 0x4a,
+	0x48, 0x5a, 0x80, 0x2f, 0x4f, 0xc3, 0xe6, 0x85, 0xcf, 0xf3, 0x12, 0x18, 0xc7, 0x25, 0xd5, 0x00,
+	0xfb, 0x26, 0x20, 0x69, 0x6a, 0xcc, 0x73, 0x52, 0xa9, 0xef, 0xee, 0xef, 0x6d, 0x84, 0x4e, 0xce,
+	0x30, 0x0d, 0x65, 0x05, 0xe6, 0x43, 0x74, 0x9f, 0x12, 0x52, 0x44, 0x79, 0x62, 0x19, 0xbb, 0xc6,
+	0x5e, 0x2b, 0x6c, 0x8b, 0xe3, 0xb3, 0xc4, 0x7c, 0x84, 0x7a, 0x98, 0x31, 0xe0, 0xfd, 0x28, 0x81,
+	0x8a, 0x94, 0xd6, 0xbd, 0x5d, 0x63, 0xaf, 0x13, 0x76, 0x55, 0xec, 0x50, 0x84, 0xa6, 0x90, 0x40,
+	0x43, 0x96, 0xaf, 0x41, 0x02, 0x05, 0x39, 0x40, 0xed, 0x0c, 0xf2, 0x34, 0xe3, 0x56, 0x6b, 0xd7,
+	0xd8, 0x5b, 0x1e, 0x3c, 0xfe, 0x67, 0xec, 0xac, 0xa9, 0xcb, 0x47, 0xea, 0xc3, 0xe5, 0xd8, 0xd9,
+	0x1c, 0xe1, 0xb2, 0x78, 0xea, 0xce, 0x84, 0xdd, 0x50, 0x13, 0xcd, 0xef, 0x50, 0x4b, 0xdc, 0xd1,
+	0x5a, 0xd9, 0x35, 0xf6, 0xba, 0x4f, 0xb6, 0x3c, 0x75, 0x3f, 0x6f, 0x72, 0x3f, 0xef, 0x64, 0xd2,
+	0x80, 0x81, 0xfd, 0x7a, 0xec, 0x2c, 0x5d, 0x8e, 0x1d, 0x73, 0x46, 0x4f, 0x90, 0xdd, 0x57, 0x7f,
+	0x39, 0x46, 0x28, 0x75, 0xcc, 0x23, 0x64, 0xd2, 0x7e, 0x54, 0x60, 0xc6, 0x23, 0x46, 0x09, 0x8f,
+	0x68, 0x9d, 0xc7, 0x60, 0xb5, 0x45, 0xed, 0x83, 0x8f, 0x84, 0xc2, 0x9f, 0x63, 0x67, 0x3b, 0x96,
+	0x23, 0x61, 0xc9, 0x4b, 0x2f, 0x27, 0x7e, 0x89, 0x79, 0xe6, 0x3d, 0x87, 0x14, 0xc7, 0xa3, 0x43,
+	0x88, 0xc3, 0x75, 0xda, 0x7f, 0x8e, 0x19, 0x3f, 0xa6, 0x84, 0x1f, 0x09, 0xae, 0x54, 0x0c, 0x6e,
+	0x29, 0xde, 0x5f, 0x44, 0x31, 0x98, 0x55, 0xcc, 0x90, 0x4d, 0xfb, 0x11, 0xae, 0x73, 0x9e, 0x95,
+	0xc0, 0xf3, 0x38, 0x92, 0x4b, 0x83, 0xe3, 0xb8, 0x29, 0x9b, 0x02, 0x73, 0x52, 0x5b, 0xab, 0x77,
+	0x57, 0xdf, 0xa6, 0xfd, 0x83, 0xa9, 0x92, 0x18, 0xfd, 0xc1, 0x95, 0x8e, 0xcc, 0x14, 0xbc, 0x33,
+	0x53, 0x67, 0x91, 0x4c, 0xc1, 0xdb, 0x33, 0x61, 0xb4, 0x95, 0x02, 0x29, 0x81, 0xd7, 0xff, 0x97,
+	0x05, 0xdd, 0x3d, 0x8b, 0x35, 0x95, 0xb9, 0x99, 0xe2, 0x05, 0x5a, 0x97, 0x53, 0x80, 0xba, 0x26,
+	0xb5, 0x1c, 0xbc, 0xd5, 0x9d, 0xbb, 0x35, 0xae, 0xde, 0x9a, 0x0f, 0xd4, 0xd6, 0xdc, 0x10, 0x50,
+	0x9b, 0xb3, 0x26, 0xa2, 0x5f, 0x8b, 0xa0, 0xe0, 0xb9, 0xff, 0x1a, 0xa8, 0x77, 0x54, 0x37, 0x55,
+	0x5e, 0xa5, 0xc7, 0x1c, 0x73, 0x30, 0x77, 0x10, 0xca, 0x59, 0x44, 0x55, 0x48, 0x3e, 0xa4, 0xd5,
+	0xb0, 0x93, 0x33, 0x8d, 0x31, 0x63, 0xf4, 0x9e, 0x94, 0x7d, 0x09, 0x94, 0xab, 0xb2, 0xee, 0xcd,
+	0x2d, 0xeb, 0x91, 0x2e, 0xeb, 0xc1, 0xb5, 0xb2, 0xa6, 0x7c, 0x55, 0x55, 0x4f, 0x04, 0xbf, 0x05,
+	0xca, 0x05, 0xcb, 0xfc, 0x12, 0xad, 0x69, 0xd0, 0x28, 0x62, 0x00, 0x95, 0x7c, 0x8e, 0xbd, 0xc1,
+	0xc3, 0xcb, 0xb1, 0xb3, 0x91, 0x00, 0xad, 0x21, 0xc6, 0x1c, 0x92, 0xa7, 0x2e, 0xaf, 0x1b, 0x70,
+	0x2d, 0x23, 0xec, 0x2a, 0xf6, 0xe8, 0x18, 0xa0, 0x32, 0x1f, 0xa3, 0x0d, 0xb5, 0xbf, 0x00, 0x55,
+	0x34, 0x31, 0x84, 0x96, 0x34, 0x04, 0x59, 0xba, 0x00, 0x1d, 0x49, 0x63, 0x70, 0x7f, 0x5b, 0x41,
+	0xed, 0xaf, 0x70, 0x95, 0x14, 0xf0, 0x76, 0xf3, 0xd8, 0x41, 0x68, 0x88, 0x19, 0xcc, 0x58, 0x47,
+	0x47, 0x44, 0x94, 0x2b, 0x38, 0xa8, 0xfb, 0x73, 0x43, 0x38, 0xcc, 0xf8, 0x06, 0x92, 0x21, 0x05,
+	0x08, 0xd1, 0x6a, 0x5e, 0x71, 0xa8, 0x4f, 0x71, 0x21, 0xab, 0xe8, 0x3e, 0xf9, 0xf0, 0x56, 0xab,
+	0x0e, 0xb5, 0xaf, 0x0d, 0xb6, 0x75, 0xa7, 0xd6, 0x55, 0xa7, 0x26, 0x44, 0xf7, 0x57, 0xd1, 0xa3,
+	0xa9, 0x8e, 0xf9, 0x23, 0x42, 0x8c, 0xe3, 0x5a, 0x0f, 0x60, 0xbe, 0x9b, 0xec, 0x68, 0xd9, 0x0d,
+	0x25, 0x7b, 0xc5, 0x55, 0xcd, 0xef, 0xc8, 0x80, 0xec, 0xfc, 0xe7, 0xa8, 0x45, 0x28, 0x54, 0x8b,
+	0x78, 0x88, 0x24, 0x08, 0x62, 0x96, 0xa7, 0xd9, 0x22, 0x56, 0x21, 0x09, 0xe6, 0x67, 0x68, 0xb9,
+	0x20, 0x67, 0x8b, 0x98, 0x80, 0xc0, 0x9b, 0x5f, 0xa0, 0x95, 0xb8, 0x20, 0x0c, 0x16, 0x79, 0xd3,
+	0x8a, 0x61, 0x9e, 0xa0, 0xae, 0x9c, 0xe8, 0x29, 0x29, 0x9a, 0x12, 0xf4, 0x73, 0xdd, 0xd7, 0x02,
+	0x0f, 0x6e, 0x0b, 0x3c, 0xab, 0xf8, 0x95, 0x13, 0x5f, 0x63, 0xba, 0xa1, 0xdc, 0x8c, 0xef, 0xe5,
+	0xc1, 0xfc, 0x01, 0xf5, 0xd4, 0x22, 0x68, 0xd9, 0xae, 0x94, 0xfd, 0x74, 0x9e, 0xec, 0xfb, 0x4a,
+	0xf6, 0x3a, 0xd5, 0x0d, 0xd5, 0x4a, 0x29, 0xe1, 0xc1, 0x37, 0xaf, 0xcf, 0x6d, 0xe3, 0xcd, 0xb9,
+	0x6d, 0xfc, 0x7d, 0x6e, 0x1b, 0xaf, 0x2e, 0xec, 0xa5, 0x37, 0x17, 0xf6, 0xd2, 0x1f, 0x17, 0xf6,
+	0xd2, 0x4f, 0xfd, 0x34, 0xe7, 0x59, 0x33, 0xf4, 0x62, 0x52, 0xfa, 0xfa, 0x7f, 0xf7, 0x93, 0x02,
+	0x0f, 0xd9, 0xe4, 0xe0, 0x9f, 0xee, 0x07, 0xfe, 0x2f, 0xea, 0x2f, 0x9b, 0x8f, 0x28, 0xb0, 0x61,
+	0x5b, 0x2e, 0xc7, 0xfe, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x21, 0x70, 0x61, 0x83, 0xcf, 0x07,
+	0x00, 0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -435,6 +538,124 @@ func (m *PruningState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QuoteVolume.Size()
+		i -= size
+		if _, err := m.QuoteVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.BaseVolume.Size()
+		i -= size
+		if _, err := m.BaseVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTwapRecord(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTwapRecord(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTwapRecord(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTwapRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTwapRecord(v)
 	base := offset
@@ -504,6 +725,42 @@ func (m *PruningState) Size() (n int) {
 	return n
 }
 
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTwapRecord(uint64(m.PoolId))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTwapRecord(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTwapRecord(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.BaseVolume.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.QuoteVolume.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	return n
+}
+
 func sovTwapRecord(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1054,6 +1311,409 @@ func (m *PruningState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTwapRecord(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,7 +1,10 @@
 package cli
 
 import (
+	"encoding/base64"
+
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v31/x/twap/client/queryproto"
@@ -21,6 +24,8 @@ func GetQueryCmd() *cobra.Command {
 	)
 
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdQueryBaseFee)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdQueryCurrentBaseFee)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateFee)
 
 	return cmd
 }
@@ -67,3 +72,46 @@ func GetCmdQueryBaseFee() (*osmocli.QueryDescriptor, *types.QueryEipBaseFeeReque
 		QueryFnName: "GetEipBaseFee",
 	}, &types.QueryEipBaseFeeRequest{}
 }
+
+func GetCmdQueryCurrentBaseFee() (*osmocli.QueryDescriptor, *types.QueryCurrentBaseFeeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "current-base-fee",
+		Short: "Query the eip base fee kept in consensus state.",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} current-base-fee`,
+		QueryFnName: "CurrentBaseFee",
+	}, &types.QueryCurrentBaseFeeRequest{}
+}
+
+func GetCmdEstimateFee() (*osmocli.QueryDescriptor, *types.QueryEstimateFeeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-fee",
+		Short: "Query the fee required for a tx wanting the given gas, in the base denom and every whitelisted fee token.",
+		Long: `{{.Short}}
+The gas wanted may be zero if --tx is set, to use the gas limit of the tx. The tx is base64 encoded, as returned by
+the tx encode command.{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-fee 250000 --tx $(osmosisd tx encode tx.json)`,
+		QueryFnName:        "EstimateFee",
+		Flags:              osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetTx()}},
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{"Tx": parseTxBytes},
+	}, &types.QueryEstimateFeeRequest{}
+}
+
+// FlagTx is the base64 encoded tx of the fee estimate.
+const FlagTx = "tx"
+
+// FlagSetTx returns the flag set of the tx flag.
+func FlagSetTx() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagTx, "", "The base64 encoded tx, accounting for its messages in the gas price")
+	return fs
+}
+
+func parseTxBytes(_ string, flags *flag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	flagValue, err := flags.GetString(FlagTx)
+	if err != nil || flagValue == "" {
+		return []byte(nil), osmocli.UsedFlag, err
+	}
+	txBytes, err := base64.StdEncoding.DecodeString(flagValue)
+	return txBytes, osmocli.UsedFlag, err
+}
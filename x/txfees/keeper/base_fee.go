@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	mempool1559 "github.com/osmosis-labs/osmosis/v31/x/txfees/keeper/mempool-1559"
+	"github.com/osmosis-labs/osmosis/v31/x/txfees/types"
+)
+
+// GetBaseFeeParams returns the parameters of the EIP-1559 base fee kept in consensus state.
+func (k Keeper) GetBaseFeeParams(ctx sdk.Context) (params types.BaseFeeParams) {
+	k.paramSpace.Get(ctx, types.KeyBaseFeeParams, &params)
+	return params
+}
+
+// GetConsensusBaseFee returns the EIP-1559 base fee kept in consensus state.
+// Returns the default base fee if it has not been computed yet.
+func (k Keeper) GetConsensusBaseFee(ctx sdk.Context) osmomath.Dec {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.KeyConsensusBaseFee) {
+		return k.GetBaseFeeParams(ctx).DefaultBaseFee
+	}
+	return osmoutils.MustGetDec(store, types.KeyConsensusBaseFee)
+}
+
+// SetConsensusBaseFee sets the EIP-1559 base fee kept in consensus state.
+func (k Keeper) SetConsensusBaseFee(ctx sdk.Context, baseFee osmomath.Dec) {
+	osmoutils.MustSetDec(ctx.KVStore(k.storeKey), types.KeyConsensusBaseFee, baseFee)
+}
+
+// getBlockGasWanted returns the gas wanted by the txs delivered so far in the block.
+func (k Keeper) getBlockGasWanted(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyConsensusBaseFeeBlockGasWanted)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// TrackConsensusBaseFeeGasWanted adds the gas wanted by a delivered tx to the gas wanted by the block,
+// if the consensus base fee is enabled.
+// It runs on an infinite gas meter, so that tracking the gas wanted does not change the gas consumed by the tx.
+func (k Keeper) TrackConsensusBaseFeeGasWanted(ctx sdk.Context, gasWanted uint64) {
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if !k.GetBaseFeeParams(ctx).Enabled {
+		return
+	}
+
+	blockGasWanted := k.getBlockGasWanted(ctx) + gasWanted
+	ctx.KVStore(k.storeKey).Set(types.KeyConsensusBaseFeeBlockGasWanted, sdk.Uint64ToBigEndian(blockGasWanted))
+}
+
+// UpdateConsensusBaseFee updates the EIP-1559 base fee kept in consensus state from the gas wanted by the txs of the block,
+// and resets the gas wanted for the next block. It runs in EndBlock.
+//
+// The base fee is reset to the default base fee every reset interval. It is left unchanged otherwise if blocks have no gas limit,
+// as there is no target gas then. If the consensus base fee is disabled, its state is cleared, so that it starts again from the
+// default base fee once re-enabled.
+func (k Keeper) UpdateConsensusBaseFee(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	params := k.GetBaseFeeParams(ctx)
+	if !params.Enabled {
+		store.Delete(types.KeyConsensusBaseFee)
+		store.Delete(types.KeyConsensusBaseFeeBlockGasWanted)
+		return nil
+	}
+
+	blockGasWanted := k.getBlockGasWanted(ctx)
+	store.Delete(types.KeyConsensusBaseFeeBlockGasWanted)
+
+	if ctx.BlockHeight()%params.ResetInterval == 0 {
+		k.SetConsensusBaseFee(ctx, params.DefaultBaseFee)
+		return nil
+	}
+
+	consParams, err := k.GetConsParams(ctx)
+	if err != nil {
+		return err
+	}
+	if consParams.Params == nil || consParams.Params.Block == nil || consParams.Params.Block.MaxGas <= 0 {
+		return nil
+	}
+	targetGas := params.TargetBlockSpacePercent.MulInt64(consParams.Params.Block.MaxGas).TruncateInt64()
+	if targetGas <= 0 {
+		return nil
+	}
+
+	baseFee := mempool1559.NextBaseFee(k.GetConsensusBaseFee(ctx), int64(blockGasWanted), targetGas, params.MaxBlockChangeRate, params.MinBaseFee, params.MaxBaseFee)
+	k.SetConsensusBaseFee(ctx, baseFee)
+	return nil
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/txfees/keeper"
+	mempool1559 "github.com/osmosis-labs/osmosis/v31/x/txfees/keeper/mempool-1559"
+	"github.com/osmosis-labs/osmosis/v31/x/txfees/types"
+)
+
+var testBaseFeeParams = types.BaseFeeParams{
+	Enabled:                 true,
+	DefaultBaseFee:          osmomath.MustNewDecFromStr("0.1"),
+	MinBaseFee:              osmomath.MustNewDecFromStr("0.05"),
+	MaxBaseFee:              osmomath.MustNewDecFromStr("0.2"),
+	MaxBlockChangeRate:      osmomath.MustNewDecFromStr("0.1"),
+	TargetBlockSpacePercent: osmomath.MustNewDecFromStr("0.5"),
+	ResetInterval:           1000,
+}
+
+func (s *KeeperTestSuite) TestUpdateConsensusBaseFee() {
+	tests := map[string]struct {
+		baseFee osmomath.Dec
+		// gas wanted by the block, in multiples of the target gas.
+		targetGasMultiple osmomath.Dec
+		blockHeight       int64
+
+		expectedBaseFee osmomath.Dec
+	}{
+		"gas wanted at the target gas": {
+			targetGasMultiple: osmomath.OneDec(),
+			expectedBaseFee:   osmomath.MustNewDecFromStr("0.1"),
+		},
+		"gas wanted at twice the target gas": {
+			targetGasMultiple: osmomath.NewDec(2),
+			expectedBaseFee:   osmomath.MustNewDecFromStr("0.11"),
+		},
+		"gas wanted at half the target gas": {
+			targetGasMultiple: osmomath.MustNewDecFromStr("0.5"),
+			expectedBaseFee:   osmomath.MustNewDecFromStr("0.095"),
+		},
+		"increase bounded by the max base fee": {
+			baseFee:           osmomath.MustNewDecFromStr("0.19"),
+			targetGasMultiple: osmomath.NewDec(2),
+			expectedBaseFee:   osmomath.MustNewDecFromStr("0.2"),
+		},
+		"decrease bounded by the min base fee": {
+			baseFee:           osmomath.MustNewDecFromStr("0.051"),
+			targetGasMultiple: osmomath.ZeroDec(),
+			expectedBaseFee:   osmomath.MustNewDecFromStr("0.05"),
+		},
+		"reset to the default base fee at the reset interval": {
+			baseFee:           osmomath.MustNewDecFromStr("0.2"),
+			targetGasMultiple: osmomath.NewDec(2),
+			blockHeight:       2000,
+			expectedBaseFee:   osmomath.MustNewDecFromStr("0.1"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest(false)
+			s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyBaseFeeParams, testBaseFeeParams)
+			if tc.blockHeight != 0 {
+				s.Ctx = s.Ctx.WithBlockHeight(tc.blockHeight)
+			}
+			if !tc.baseFee.IsNil() {
+				s.App.TxFeesKeeper.SetConsensusBaseFee(s.Ctx, tc.baseFee)
+			}
+
+			consParams, err := s.App.TxFeesKeeper.GetConsParams(s.Ctx)
+			s.Require().NoError(err)
+			targetGas := testBaseFeeParams.TargetBlockSpacePercent.MulInt64(consParams.Params.Block.MaxGas)
+			s.App.TxFeesKeeper.TrackConsensusBaseFeeGasWanted(s.Ctx, tc.targetGasMultiple.Mul(targetGas).TruncateInt().Uint64())
+
+			err = s.App.TxFeesKeeper.UpdateConsensusBaseFee(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedBaseFee, s.App.TxFeesKeeper.GetConsensusBaseFee(s.Ctx))
+
+			res, err := s.queryClient.CurrentBaseFee(s.Ctx, &types.QueryCurrentBaseFeeRequest{})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedBaseFee, res.BaseFee)
+
+			// The gas wanted by the block is reset for the next block.
+			err = s.App.TxFeesKeeper.UpdateConsensusBaseFee(s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1))
+			s.Require().NoError(err)
+			expectedNextBaseFee := mempool1559.NextBaseFee(tc.expectedBaseFee, 0, targetGas.TruncateInt64(), testBaseFeeParams.MaxBlockChangeRate, testBaseFeeParams.MinBaseFee, testBaseFeeParams.MaxBaseFee)
+			s.Require().Equal(expectedNextBaseFee, s.App.TxFeesKeeper.GetConsensusBaseFee(s.Ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestConsensusBaseFeeDisabled() {
+	s.SetupTest(false)
+	s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyBaseFeeParams, testBaseFeeParams)
+	s.App.TxFeesKeeper.SetConsensusBaseFee(s.Ctx, osmomath.MustNewDecFromStr("0.15"))
+
+	disabledParams := testBaseFeeParams
+	disabledParams.Enabled = false
+	s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyBaseFeeParams, disabledParams)
+
+	// The gas wanted by delivered txs is not tracked, and the base fee state is cleared.
+	s.App.TxFeesKeeper.TrackConsensusBaseFeeGasWanted(s.Ctx, 1_000_000_000)
+	err := s.App.TxFeesKeeper.UpdateConsensusBaseFee(s.Ctx)
+	s.Require().NoError(err)
+
+	_, err = s.queryClient.CurrentBaseFee(s.Ctx, &types.QueryCurrentBaseFeeRequest{})
+	s.Require().Error(err)
+
+	// Once re-enabled, the base fee starts again from the default base fee.
+	s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyBaseFeeParams, testBaseFeeParams)
+	s.Require().Equal(testBaseFeeParams.DefaultBaseFee, s.App.TxFeesKeeper.GetConsensusBaseFee(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestMinBaseGasPriceWithConsensusBaseFee() {
+	s.SetupTest(true)
+	s.Ctx = s.Ctx.WithIsCheckTx(true)
+	s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyBaseFeeParams, testBaseFeeParams)
+	baseDenom, err := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	s.Require().NoError(err)
+
+	mempoolFeeOpts := types.NewDefaultMempoolFeeOptions()
+	mempoolFeeOpts.Mempool1559Enabled = true
+	mfd := keeper.NewMempoolFeeDecorator(*s.App.TxFeesKeeper, mempoolFeeOpts)
+
+	tx, err := s.prepareTx(testdata.NewTestMsg(s.TestAccs[0]), sdk.NewCoins())
+	s.Require().NoError(err)
+	feeTx := tx.(sdk.FeeTx)
+
+	checkTxMinGasPrice := mfd.GetMinBaseGasPriceForTx(s.Ctx, baseDenom, feeTx)
+	s.Require().Equal(testBaseFeeParams.DefaultBaseFee, checkTxMinGasPrice)
+
+	recheckTxMinGasPrice := mfd.GetMinBaseGasPriceForTx(s.Ctx.WithIsReCheckTx(true), baseDenom, feeTx)
+	s.Require().True(recheckTxMinGasPrice.LT(checkTxMinGasPrice))
+}
@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -36,6 +38,7 @@ func NewMempoolFeeDecorator(txFeesKeeper Keeper, opts types.MempoolFeeOptions) M
 	if opts.Mempool1559Enabled {
 		mempool1559.CurEipState.BackupFilePath = filepath.Join(txFeesKeeper.dataDir, mempool1559.BackupFilename)
 	}
+	txFeesKeeper.SetMempoolFeeOptions(opts)
 
 	return MempoolFeeDecorator{
 		TxFeesKeeper: txFeesKeeper,
@@ -119,6 +122,7 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	// I want ctx.IsDeliverTx() but that doesn't exist.
 	if !ctx.IsCheckTx() && !ctx.IsReCheckTx() {
 		mempool1559.DeliverTxCode(ctx, feeTx)
+		mfd.TxFeesKeeper.TrackConsensusBaseFeeGasWanted(ctx, feeTx.GetGas())
 	}
 
 	baseDenom, err := mfd.TxFeesKeeper.GetBaseDenom(ctx)
@@ -167,6 +171,10 @@ func (mfd MempoolFeeDecorator) getMinBaseGasPrice(ctx sdk.Context, baseDenom str
 	// So we ensure that the provided fees meet a minimum threshold for the validator
 	if (ctx.IsCheckTx() || ctx.IsReCheckTx()) && !simulate {
 		minBaseGasPrice = osmomath.MaxDec(minBaseGasPrice, mfd.GetMinBaseGasPriceForTx(ctx, baseDenom, feeTx))
+
+		// Raise it by the fee multiplier of the governance set tx fee filter rules matching the tx.
+		txFeeFilterRules := mfd.TxFeesKeeper.GetTxFeeFilterRules(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+		minBaseGasPrice = minBaseGasPrice.Mul(txfee_filters.TxFeeMultiplier(feeTx, txFeeFilterRules))
 	}
 	// If we are in genesis or are simulating a tx, then we actually override all of the above, to set it to 0.
 	if ctx.BlockHeight() == 0 || simulate {
@@ -183,12 +191,7 @@ func (k Keeper) IsSufficientFee(ctx sdk.Context, minBaseGasPrice osmomath.Dec, g
 		return err
 	}
 
-	// Determine the required fees by multiplying the required minimum gas
-	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-	// note we mutate this one line below, to avoid extra heap allocations.
-	glDec := osmomath.NewDec(int64(gasRequested))
-	baseFeeAmt := glDec.MulMut(minBaseGasPrice).Ceil().RoundInt()
-	requiredBaseFee := sdk.Coin{Denom: baseDenom, Amount: baseFeeAmt}
+	requiredBaseFee := sdk.Coin{Denom: baseDenom, Amount: requiredBaseFeeAmount(minBaseGasPrice, gasRequested)}
 
 	convertedFee, err := k.ConvertToBaseToken(ctx, feeCoin)
 	if err != nil {
@@ -202,23 +205,108 @@ func (k Keeper) IsSufficientFee(ctx sdk.Context, minBaseGasPrice osmomath.Dec, g
 	return nil
 }
 
-func (mfd MempoolFeeDecorator) GetMinBaseGasPriceForTx(ctx sdk.Context, baseDenom string, tx sdk.FeeTx) osmomath.Dec {
-	var is1559enabled = mfd.Opts.Mempool1559Enabled
+// requiredBaseFeeAmount returns the fee in the base denom required from a tx wanting gasRequested gas at minBaseGasPrice.
+func requiredBaseFeeAmount(minBaseGasPrice osmomath.Dec, gasRequested uint64) osmomath.Int {
+	// Determine the required fees by multiplying the required minimum gas
+	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+	// note we mutate this one line below, to avoid extra heap allocations.
+	glDec := osmomath.NewDec(int64(gasRequested))
+	return glDec.MulMut(minBaseGasPrice).Ceil().RoundInt()
+}
+
+// EstimateFee returns the minimum gas price in the base denom required from a tx entering the mempool of the node,
+// and the fee required from the tx at that price. The gas price is computed by the mempool fee decorator as in CheckTx,
+// including the EIP-1559 base fee, be it kept in consensus state or local to the node.
+// The tx is optional. If it is given, the gas price accounts for the tx being an arbitrage tx and for the tx fee filter
+// rules matching its messages, and gasWanted, if zero, defaults to the gas limit of the tx.
+// The fee is returned in the base denom first, then in each whitelisted fee token converted at the spot price
+// IsSufficientFee uses, skipping the fee tokens whose spot price cannot be computed.
+func (k Keeper) EstimateFee(ctx sdk.Context, gasWanted uint64, txBytes []byte) (osmomath.Dec, []sdk.Coin, error) {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return osmomath.Dec{}, nil, err
+	}
 
+	txBuilder := k.txConfig.NewTxBuilder()
+	if len(txBytes) > 0 {
+		tx, err := k.txConfig.TxDecoder()(txBytes)
+		if err != nil {
+			return osmomath.Dec{}, nil, err
+		}
+		txBuilder, err = k.txConfig.WrapTxBuilder(tx)
+		if err != nil {
+			return osmomath.Dec{}, nil, err
+		}
+	}
+	if gasWanted > 0 {
+		txBuilder.SetGasLimit(gasWanted)
+	}
+	feeTx := txBuilder.GetTx()
+	if feeTx.GetGas() == 0 {
+		return osmomath.Dec{}, nil, fmt.Errorf("gas wanted must be positive")
+	}
+
+	mfd := MempoolFeeDecorator{TxFeesKeeper: k, Opts: *k.mempoolFeeOpts}
+	gasPrice := mfd.getMinBaseGasPrice(ctx.WithIsCheckTx(true), baseDenom, false, feeTx)
+	requiredBaseFee := requiredBaseFeeAmount(gasPrice, feeTx.GetGas())
+
+	fees := []sdk.Coin{sdk.NewCoin(baseDenom, requiredBaseFee)}
+	for _, feeToken := range k.GetFeeTokens(ctx) {
+		fee, err := k.ConvertFromBaseToken(ctx, requiredBaseFee, feeToken.Denom)
+		if err != nil {
+			continue
+		}
+		fees = append(fees, fee)
+	}
+
+	return gasPrice, fees, nil
+}
+
+// getNodeMinGasPrice returns the min gas price in the base denom configured by the node for a tx wanting gas gas,
+// raised to the min gas prices of the mempool fee options for high gas and arbitrage txs.
+func getNodeMinGasPrice(ctx sdk.Context, opts types.MempoolFeeOptions, baseDenom string, gas uint64, isArb bool) osmomath.Dec {
 	cfgMinGasPrice := ctx.MinGasPrices().AmountOf(baseDenom)
 	// the check below prevents tx gas from getting over HighGasTxThreshold which is default to 1_000_000
-	if tx.GetGas() >= mfd.Opts.HighGasTxThreshold {
-		cfgMinGasPrice = osmomath.MaxDec(cfgMinGasPrice, mfd.Opts.MinGasPriceForHighGasTx)
+	if gas >= opts.HighGasTxThreshold {
+		cfgMinGasPrice = osmomath.MaxDec(cfgMinGasPrice, opts.MinGasPriceForHighGasTx)
 	}
-	if txfee_filters.IsArbTxLoose(tx) {
-		cfgMinGasPrice = osmomath.MaxDec(cfgMinGasPrice, mfd.Opts.MinGasPriceForArbitrageTx)
+	if isArb {
+		cfgMinGasPrice = osmomath.MaxDec(cfgMinGasPrice, opts.MinGasPriceForArbitrageTx)
 	}
+	return cfgMinGasPrice
+}
+
+func (mfd MempoolFeeDecorator) GetMinBaseGasPriceForTx(ctx sdk.Context, baseDenom string, tx sdk.FeeTx) osmomath.Dec {
+	var is1559enabled = mfd.Opts.Mempool1559Enabled
+
+	cfgMinGasPrice := getNodeMinGasPrice(ctx, mfd.Opts, baseDenom, tx.GetGas(), txfee_filters.IsArbTxLoose(tx))
+	if !is1559enabled {
+		return cfgMinGasPrice
+	}
+
+	// If the base fee is kept in consensus state, it replaces the node local base fee,
+	// so that the minimum gas price is the same on every node.
+	// It is read on an infinite gas meter to not change the gas consumed by the tx.
+	infiniteGasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if mfd.TxFeesKeeper.GetBaseFeeParams(infiniteGasCtx).Enabled {
+		baseFee := mfd.TxFeesKeeper.GetConsensusBaseFee(infiniteGasCtx)
+		// Initial tx only, no recheck
+		if ctx.IsCheckTx() && !ctx.IsReCheckTx() {
+			cfgMinGasPrice = osmomath.MaxDec(cfgMinGasPrice, baseFee)
+		}
+		// RecheckTx only
+		if ctx.IsReCheckTx() {
+			cfgMinGasPrice = osmomath.MaxDec(cfgMinGasPrice, mempool1559.RecheckBaseFee(baseFee))
+		}
+		return cfgMinGasPrice
+	}
+
 	// Initial tx only, no recheck
-	if is1559enabled && ctx.IsCheckTx() && !ctx.IsReCheckTx() {
+	if ctx.IsCheckTx() && !ctx.IsReCheckTx() {
 		cfgMinGasPrice = osmomath.MaxDec(cfgMinGasPrice, mempool1559.CurEipState.GetCurBaseFee())
 	}
 	// RecheckTx only
-	if is1559enabled && ctx.IsReCheckTx() {
+	if ctx.IsReCheckTx() {
 		cfgMinGasPrice = osmomath.MaxDec(cfgMinGasPrice, mempool1559.CurEipState.GetCurRecheckBaseFee())
 	}
 	return cfgMinGasPrice
@@ -262,7 +350,7 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	}
 
 	// fee can be in any denom (checked for validity later)
-	fee := feeTx.GetFee()
+	fees := feeTx.GetFee()
 	feePayer := feeTx.FeePayer()
 	feeGranter := feeTx.FeeGranter()
 
@@ -274,7 +362,8 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		if dfd.feegrantKeeper == nil {
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee grants is not enabled")
 		} else if !bytes.Equal(feeGranter, feePayer) {
-			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, tx.GetMsgs())
+			fees = dfd.grantedFees(ctx, feeGranter, feePayer, fees)
+			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fees, tx.GetMsgs())
 			if err != nil {
 				return ctx, errorsmod.Wrapf(err, "%s not allowed to pay fees from %s", feeGranter, feePayer)
 			}
@@ -289,8 +378,6 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
 	}
 
-	fees := feeTx.GetFee()
-
 	// if we are simulating, set the fees to 1 uosmo as they don't matter.
 	// set it as coming from the burn addr
 	if simulate && fees.IsZero() {
@@ -318,6 +405,56 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	return next(ctx, tx, simulate)
 }
 
+// grantedFees returns the fees to charge to the fee allowance granted by the granter to the grantee.
+// If the spend limit of the allowance is not expressed in the denom of the fees, the fees are converted to the first
+// denom of the spend limit that is the base denom or a whitelisted fee token, so that allowances can be granted in any fee token.
+// The fees are returned unchanged otherwise, leaving it to the feegrant keeper to reject them if they exceed the allowance.
+func (dfd DeductFeeDecorator) grantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fees sdk.Coins) sdk.Coins {
+	if len(fees) != 1 {
+		return fees
+	}
+
+	allowance, err := dfd.feegrantKeeper.GetAllowance(ctx, granter, grantee)
+	if err != nil {
+		return fees
+	}
+	spendLimit := allowanceSpendLimit(allowance)
+	if spendLimit.IsZero() || spendLimit.AmountOf(fees[0].Denom).IsPositive() {
+		return fees
+	}
+
+	baseFee, err := dfd.txFeesKeeper.ConvertToBaseToken(ctx, fees[0])
+	if err != nil {
+		return fees
+	}
+	for _, limit := range spendLimit {
+		grantedFee, err := dfd.txFeesKeeper.ConvertFromBaseToken(ctx, baseFee.Amount, limit.Denom)
+		if err == nil {
+			return sdk.NewCoins(grantedFee)
+		}
+	}
+	return fees
+}
+
+// allowanceSpendLimit returns the spend limit of the fee allowance, or nil if it has none.
+// For a periodic allowance, it is the spend limit of each period.
+func allowanceSpendLimit(allowance feegrant.FeeAllowanceI) sdk.Coins {
+	switch allowance := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		return allowance.SpendLimit
+	case *feegrant.PeriodicAllowance:
+		return allowance.PeriodSpendLimit
+	case *feegrant.AllowedMsgAllowance:
+		innerAllowance, err := allowance.GetAllowance()
+		if err != nil {
+			return nil
+		}
+		return allowanceSpendLimit(innerAllowance)
+	default:
+		return nil
+	}
+}
+
 // DeductFees deducts fees from the given account and transfers them to the set module account.
 func DeductFees(txFeesKeeper types.TxFeesKeeper, bankKeeper types.BankKeeper, ctx sdk.Context, acc sdk.AccountI, fees sdk.Coins) error {
 	// Checks the validity of the fee tokens (sorted, have positive amount, valid and unique denomination)
@@ -2,13 +2,16 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -19,10 +22,14 @@ import (
 	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	appparams "github.com/osmosis-labs/osmosis/v31/app/params"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v31/x/txfees/keeper"
+	mempool1559 "github.com/osmosis-labs/osmosis/v31/x/txfees/keeper/mempool-1559"
 	"github.com/osmosis-labs/osmosis/v31/x/txfees/types"
 )
 
@@ -367,3 +374,293 @@ func (s *KeeperTestSuite) prepareTx(msg sdk.Msg, txFee sdk.Coins) (sdk.Tx, error
 func nextAnteHandler(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
 	return ctx, nil
 }
+
+func (s *KeeperTestSuite) TestEstimateFee() {
+	mempoolFeeOpts := types.NewDefaultMempoolFeeOptions()
+	mempoolFeeOpts.MinGasPriceForHighGasTx = osmomath.MustNewDecFromStr("0.05")
+	mempoolFeeOpts.MinGasPriceForArbitrageTx = osmomath.MustNewDecFromStr("0.1")
+
+	var (
+		swapMsgTypeUrl = sdk.MsgTypeURL(&poolmanagertypes.MsgSwapExactAmountIn{})
+
+		oneHopSwapMsg = &poolmanagertypes.MsgSwapExactAmountIn{
+			Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}},
+			TokenIn:           sdk.NewCoin("foo", osmomath.NewInt(100)),
+			TokenOutMinAmount: osmomath.OneInt(),
+		}
+		cyclicSwapMsg = &poolmanagertypes.MsgSwapExactAmountIn{
+			Routes: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: 1, TokenOutDenom: "bar"},
+				{PoolId: 2, TokenOutDenom: "foo"},
+			},
+			TokenIn:           sdk.NewCoin("foo", osmomath.NewInt(100)),
+			TokenOutMinAmount: osmomath.OneInt(),
+		}
+	)
+
+	originalNodeBaseFee := mempool1559.CurEipState.CurBaseFee
+	defer func() { mempool1559.CurEipState.CurBaseFee = originalNodeBaseFee }()
+
+	tests := map[string]struct {
+		baseFeeParams       types.BaseFeeParams
+		nodeBaseFee         osmomath.Dec
+		mempool1559Disabled bool
+		txFeeFilterRules    []types.TxFeeFilterRule
+		msgs                []sdk.Msg
+		txGasLimit          uint64
+		gasWanted           uint64
+		expectedGas         uint64
+		expectedGasPrice    osmomath.Dec
+	}{
+		"consensus min fee": {
+			baseFeeParams:    types.DefaultBaseFeeParams,
+			nodeBaseFee:      osmomath.ZeroDec(),
+			gasWanted:        250_000,
+			expectedGas:      250_000,
+			expectedGasPrice: types.ConsensusMinFee,
+		},
+		"consensus base fee above the consensus min fee": {
+			baseFeeParams:    testBaseFeeParams,
+			nodeBaseFee:      osmomath.ZeroDec(),
+			gasWanted:        250_001,
+			expectedGas:      250_001,
+			expectedGasPrice: testBaseFeeParams.DefaultBaseFee,
+		},
+		"consensus base fee replaces the node base fee": {
+			baseFeeParams:    testBaseFeeParams,
+			nodeBaseFee:      osmomath.MustNewDecFromStr("0.15"),
+			gasWanted:        250_000,
+			expectedGas:      250_000,
+			expectedGasPrice: testBaseFeeParams.DefaultBaseFee,
+		},
+		"node base fee above the consensus min fee": {
+			baseFeeParams:    types.DefaultBaseFeeParams,
+			nodeBaseFee:      osmomath.MustNewDecFromStr("0.04"),
+			gasWanted:        250_000,
+			expectedGas:      250_000,
+			expectedGasPrice: osmomath.MustNewDecFromStr("0.04"),
+		},
+		"node base fee ignored when the mempool 1559 is disabled": {
+			baseFeeParams:       types.DefaultBaseFeeParams,
+			nodeBaseFee:         osmomath.MustNewDecFromStr("0.04"),
+			mempool1559Disabled: true,
+			gasWanted:           250_000,
+			expectedGas:         250_000,
+			expectedGasPrice:    types.ConsensusMinFee,
+		},
+		"high gas tx: min gas price for high gas txs": {
+			baseFeeParams:    types.DefaultBaseFeeParams,
+			nodeBaseFee:      osmomath.ZeroDec(),
+			gasWanted:        mempoolFeeOpts.HighGasTxThreshold,
+			expectedGas:      mempoolFeeOpts.HighGasTxThreshold,
+			expectedGasPrice: mempoolFeeOpts.MinGasPriceForHighGasTx,
+		},
+		"arbitrage tx: min gas price for arbitrage txs": {
+			baseFeeParams:    types.DefaultBaseFeeParams,
+			nodeBaseFee:      osmomath.ZeroDec(),
+			msgs:             []sdk.Msg{cyclicSwapMsg},
+			gasWanted:        250_000,
+			expectedGas:      250_000,
+			expectedGasPrice: mempoolFeeOpts.MinGasPriceForArbitrageTx,
+		},
+		"tx matching a tx fee filter rule: gas price raised by the fee multiplier": {
+			baseFeeParams:    types.DefaultBaseFeeParams,
+			nodeBaseFee:      osmomath.MustNewDecFromStr("0.04"),
+			txFeeFilterRules: []types.TxFeeFilterRule{{Name: "swap", MsgTypeUrl: swapMsgTypeUrl, FeeMultiplier: osmomath.NewDec(2)}},
+			msgs:             []sdk.Msg{oneHopSwapMsg},
+			gasWanted:        250_000,
+			expectedGas:      250_000,
+			expectedGasPrice: osmomath.MustNewDecFromStr("0.08"),
+		},
+		"gas wanted defaults to the gas limit of the tx": {
+			baseFeeParams:    types.DefaultBaseFeeParams,
+			nodeBaseFee:      osmomath.ZeroDec(),
+			msgs:             []sdk.Msg{oneHopSwapMsg},
+			txGasLimit:       300_000,
+			expectedGas:      300_000,
+			expectedGasPrice: types.ConsensusMinFee,
+		},
+		"gas wanted overrides the gas limit of the tx": {
+			baseFeeParams:    types.DefaultBaseFeeParams,
+			nodeBaseFee:      osmomath.ZeroDec(),
+			msgs:             []sdk.Msg{oneHopSwapMsg},
+			txGasLimit:       300_000,
+			gasWanted:        mempoolFeeOpts.HighGasTxThreshold,
+			expectedGas:      mempoolFeeOpts.HighGasTxThreshold,
+			expectedGasPrice: mempoolFeeOpts.MinGasPriceForHighGasTx,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest(false)
+			opts := mempoolFeeOpts
+			opts.Mempool1559Enabled = !tc.mempool1559Disabled
+			s.App.TxFeesKeeper.SetMempoolFeeOptions(opts)
+			s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyBaseFeeParams, tc.baseFeeParams)
+			s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyTxFeeFilterRules, tc.txFeeFilterRules)
+			mempool1559.CurEipState.CurBaseFee = tc.nodeBaseFee
+			baseDenom, err := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+			s.Require().NoError(err)
+
+			// uion is worth 3 base denom.
+			uionPoolId := s.PrepareBalancerPoolWithCoins(
+				sdk.NewInt64Coin(baseDenom, 3_000_000_000),
+				sdk.NewInt64Coin("uion", 1_000_000_000),
+			)
+			err = s.ExecuteUpgradeFeeTokenProposal("uion", uionPoolId)
+			s.Require().NoError(err)
+
+			var txBytes []byte
+			if len(tc.msgs) > 0 {
+				txBuilder := s.App.GetTxConfig().NewTxBuilder()
+				s.Require().NoError(txBuilder.SetMsgs(tc.msgs...))
+				txBuilder.SetGasLimit(tc.txGasLimit)
+				txBytes, err = s.App.GetTxConfig().TxEncoder()(txBuilder.GetTx())
+				s.Require().NoError(err)
+			}
+
+			res, err := s.queryClient.EstimateFee(s.Ctx, &types.QueryEstimateFeeRequest{GasWanted: tc.gasWanted, Tx: txBytes})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedGasPrice, res.GasPrice)
+
+			// The fee is returned in the base denom and every whitelisted fee token.
+			feeTokens := s.App.TxFeesKeeper.GetFeeTokens(s.Ctx)
+			s.Require().NotEmpty(feeTokens)
+			s.Require().Len(res.Fees, len(feeTokens)+1)
+			s.Require().Equal(baseDenom, res.Fees[0].Denom)
+
+			// Each fee is the lowest amount accepted by the fee decorator.
+			for _, fee := range res.Fees {
+				err := s.App.TxFeesKeeper.IsSufficientFee(s.Ctx, res.GasPrice, tc.expectedGas, fee)
+				s.Require().NoError(err, fee.String())
+
+				err = s.App.TxFeesKeeper.IsSufficientFee(s.Ctx, res.GasPrice, tc.expectedGas, fee.SubAmount(osmomath.OneInt()))
+				s.Require().Error(err, fee.String())
+			}
+		})
+	}
+
+	s.Run("zero gas wanted", func() {
+		_, err := s.queryClient.EstimateFee(s.Ctx, &types.QueryEstimateFeeRequest{})
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("zero gas wanted and tx gas limit", func() {
+		txBuilder := s.App.GetTxConfig().NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(oneHopSwapMsg))
+		txBytes, err := s.App.GetTxConfig().TxEncoder()(txBuilder.GetTx())
+		s.Require().NoError(err)
+
+		_, err = s.queryClient.EstimateFee(s.Ctx, &types.QueryEstimateFeeRequest{Tx: txBytes})
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("invalid tx bytes", func() {
+		_, err := s.queryClient.EstimateFee(s.Ctx, &types.QueryEstimateFeeRequest{GasWanted: 250_000, Tx: []byte("invalid")})
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func (s *KeeperTestSuite) TestDeductFeeDecoratorWithFeeGrant() {
+	s.SetupTest(false)
+	baseDenom, err := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	s.Require().NoError(err)
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("uion", 1000))
+	allowedMsgAllowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.PeriodicAllowance{
+		Period:           time.Hour,
+		PeriodSpendLimit: spendLimit,
+		PeriodCanSpend:   spendLimit,
+	}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	s.Require().NoError(err)
+
+	tests := map[string]struct {
+		allowance feegrant.FeeAllowanceI
+		fee       sdk.Coin
+
+		expectedGrantedFee sdk.Coin
+		expectErr          bool
+	}{
+		"fee in the denom of the allowance": {
+			allowance:          &feegrant.BasicAllowance{SpendLimit: spendLimit},
+			fee:                sdk.NewInt64Coin("uion", 100),
+			expectedGrantedFee: sdk.NewInt64Coin("uion", 100),
+		},
+		"fee in the base denom converted to the fee token of the allowance": {
+			allowance:          &feegrant.BasicAllowance{SpendLimit: spendLimit},
+			fee:                sdk.NewInt64Coin(baseDenom, 300),
+			expectedGrantedFee: sdk.NewInt64Coin("uion", 100),
+		},
+		"converted fee rounded up": {
+			allowance:          &feegrant.BasicAllowance{SpendLimit: spendLimit},
+			fee:                sdk.NewInt64Coin(baseDenom, 301),
+			expectedGrantedFee: sdk.NewInt64Coin("uion", 101),
+		},
+		"fee converted for a periodic allowance nested in an allowed msg allowance": {
+			allowance:          allowedMsgAllowance,
+			fee:                sdk.NewInt64Coin(baseDenom, 300),
+			expectedGrantedFee: sdk.NewInt64Coin("uion", 100),
+		},
+		"converted fee exceeding the allowance": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: spendLimit},
+			fee:       sdk.NewInt64Coin(baseDenom, 3003),
+			expectErr: true,
+		},
+		"allowance in a denom that is not a fee token": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("ufoo", 1000))},
+			fee:       sdk.NewInt64Coin(baseDenom, 300),
+			expectErr: true,
+		},
+		"allowance without spend limit": {
+			allowance:          &feegrant.BasicAllowance{},
+			fee:                sdk.NewInt64Coin(baseDenom, 300),
+			expectedGrantedFee: sdk.NewInt64Coin(baseDenom, 300),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest(false)
+
+			// uion is worth 3 base denom.
+			uionPoolId := s.PrepareBalancerPoolWithCoins(
+				sdk.NewInt64Coin(baseDenom, 3_000_000_000),
+				sdk.NewInt64Coin("uion", 1_000_000_000),
+			)
+			err := s.ExecuteUpgradeFeeTokenProposal("uion", uionPoolId)
+			s.Require().NoError(err)
+
+			granter, grantee := s.TestAccs[0], s.TestAccs[1]
+			err = s.App.FeeGrantKeeper.GrantAllowance(s.Ctx, granter, grantee, tc.allowance)
+			s.Require().NoError(err)
+			granterBalances := s.App.BankKeeper.GetAllBalances(s.Ctx, granter)
+			granteeBalances := s.App.BankKeeper.GetAllBalances(s.Ctx, grantee)
+
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			err = txBuilder.SetMsgs(banktypes.NewMsgSend(grantee, granter, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1))))
+			s.Require().NoError(err)
+			txBuilder.SetFeeAmount(sdk.NewCoins(tc.fee))
+			txBuilder.SetGasLimit(200_000)
+			txBuilder.SetFeePayer(grantee)
+			txBuilder.SetFeeGranter(granter)
+
+			dfd := keeper.NewDeductFeeDecorator(*s.App.TxFeesKeeper, s.App.AccountKeeper, s.App.BankKeeper, s.App.FeeGrantKeeper)
+			_, err = dfd.AnteHandle(s.Ctx, txBuilder.GetTx(), false, nextAnteHandler)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The granted fee is paid by the granter, and charged to the allowance.
+			s.Require().Equal(granterBalances.Sub(tc.expectedGrantedFee), s.App.BankKeeper.GetAllBalances(s.Ctx, granter))
+			s.Require().Equal(granteeBalances, s.App.BankKeeper.GetAllBalances(s.Ctx, grantee))
+			if basicAllowance, ok := tc.allowance.(*feegrant.BasicAllowance); ok && !basicAllowance.SpendLimit.IsZero() {
+				allowance, err := s.App.FeeGrantKeeper.GetAllowance(s.Ctx, granter, grantee)
+				s.Require().NoError(err)
+				s.Require().Equal(spendLimit.Sub(tc.expectedGrantedFee), allowance.(*feegrant.BasicAllowance).SpendLimit)
+			}
+		})
+	}
+}
@@ -40,6 +40,39 @@ func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin
 	return sdk.NewCoin(baseDenom, spotPrice.Dec().MulIntMut(inputFee.Amount).RoundInt()), nil
 }
 
+// ConvertFromBaseToken converts a fee amount in the base fee token to the amount of a whitelisted fee token worth at least
+// as much at the spot price ConvertToBaseToken uses.
+func (k Keeper) ConvertFromBaseToken(ctx sdk.Context, baseFeeAmount osmomath.Int, denom string) (sdk.Coin, error) {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if denom == baseDenom {
+		return sdk.NewCoin(baseDenom, baseFeeAmount), nil
+	}
+
+	spotPrice, err := k.CalcFeeSpotPrice(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !spotPrice.Dec().IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidFeeToken, "spot price of fee token %s is not positive", denom)
+	}
+
+	// The fee token amount is rounded up, and bumped if the rounding of its conversion to the base denom
+	// still leaves it short of the base fee amount.
+	feeAmount := baseFeeAmount.ToLegacyDec().Quo(spotPrice.Dec()).Ceil().TruncateInt()
+	convertedFee, err := k.ConvertToBaseToken(ctx, sdk.NewCoin(denom, feeAmount))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if convertedFee.Amount.LT(baseFeeAmount) {
+		feeAmount = feeAmount.AddRaw(1)
+	}
+	return sdk.NewCoin(denom, feeAmount), nil
+}
+
 // CalcFeeSpotPrice converts the provided tx fees into their equivalent value in the base denomination.
 // Spot Price Calculation: spotPrice / (1 - spreadFactor),
 // where spotPrice is defined as:
@@ -18,6 +18,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		panic(err)
 	}
 	k.SetParams(ctx, genState.Params)
+	if !genState.ConsensusBaseFee.IsNil() && genState.ConsensusBaseFee.IsPositive() {
+		k.SetConsensusBaseFee(ctx, genState.ConsensusBaseFee)
+	}
 }
 
 // ExportGenesis returns the txfees module's exported genesis.
@@ -26,5 +29,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis.Basedenom, _ = k.GetBaseDenom(ctx)
 	genesis.Feetokens = k.GetFeeTokens(ctx)
 	genesis.Params = k.GetParams(ctx)
+	if genesis.Params.BaseFeeParams.Enabled {
+		genesis.ConsensusBaseFee = k.GetConsensusBaseFee(ctx)
+	}
 	return genesis
 }
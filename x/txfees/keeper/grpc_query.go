@@ -90,3 +90,30 @@ func (q Querier) GetEipBaseFee(_ context.Context, _ *types.QueryEipBaseFeeReques
 	response := mempool1559.CurEipState.GetCurBaseFee()
 	return &types.QueryEipBaseFeeResponse{BaseFee: response}, nil
 }
+
+func (q Querier) CurrentBaseFee(ctx context.Context, _ *types.QueryCurrentBaseFeeRequest) (*types.QueryCurrentBaseFeeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !q.Keeper.GetBaseFeeParams(sdkCtx).Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "the base fee is not kept in consensus state, query the node local base fee instead")
+	}
+
+	return &types.QueryCurrentBaseFeeResponse{BaseFee: q.Keeper.GetConsensusBaseFee(sdkCtx)}, nil
+}
+
+func (q Querier) EstimateFee(ctx context.Context, req *types.QueryEstimateFeeRequest) (*types.QueryEstimateFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.GasWanted == 0 && len(req.Tx) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "gas wanted must be positive")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	gasPrice, fees, err := q.Keeper.EstimateFee(sdkCtx, req.GasWanted, req.Tx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEstimateFeeResponse{GasPrice: gasPrice, Fees: fees}, nil
+}
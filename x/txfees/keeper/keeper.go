@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/log"
 
 	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v31/x/txfees/types"
@@ -26,6 +27,11 @@ type Keeper struct {
 	distributionKeeper types.DistributionKeeper
 	consensusKeeper    types.ConsensusKeeper
 	dataDir            string
+	txConfig           client.TxConfig
+
+	// mempoolFeeOpts are the mempool fee options of the node, shared by the copies of the keeper
+	// so that the queries see the options set by the mempool fee decorator.
+	mempoolFeeOpts *types.MempoolFeeOptions
 
 	paramSpace paramtypes.Subspace
 }
@@ -41,6 +47,7 @@ func NewKeeper(
 	distributionKeeper types.DistributionKeeper,
 	consensusKeeper types.ConsensusKeeper,
 	dataDir string,
+	txConfig client.TxConfig,
 	paramSpace paramtypes.Subspace,
 ) Keeper {
 	// set KeyTable if it has not already been set
@@ -48,6 +55,7 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
+	defaultMempoolFeeOpts := types.NewDefaultMempoolFeeOptions()
 	return Keeper{
 		accountKeeper:      accountKeeper,
 		bankKeeper:         bankKeeper,
@@ -57,10 +65,17 @@ func NewKeeper(
 		distributionKeeper: distributionKeeper,
 		consensusKeeper:    consensusKeeper,
 		dataDir:            dataDir,
+		txConfig:           txConfig,
+		mempoolFeeOpts:     &defaultMempoolFeeOpts,
 		paramSpace:         paramSpace,
 	}
 }
 
+// SetMempoolFeeOptions sets the mempool fee options of the node.
+func (k Keeper) SetMempoolFeeOptions(opts types.MempoolFeeOptions) {
+	*k.mempoolFeeOpts = opts
+}
+
 // GetParams returns the total set of txfees parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -72,6 +87,12 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
+// GetTxFeeFilterRules returns the rules raising the minimum gas price of the txs they match.
+func (k Keeper) GetTxFeeFilterRules(ctx sdk.Context) (rules []types.TxFeeFilterRule) {
+	k.paramSpace.Get(ctx, types.KeyTxFeeFilterRules, &rules)
+	return rules
+}
+
 // SetParam sets a specific txfees module's parameter with the provided parameter.
 func (k Keeper) SetParam(ctx sdk.Context, key []byte, value interface{}) {
 	k.paramSpace.Set(ctx, key, value)
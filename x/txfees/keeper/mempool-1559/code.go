@@ -157,24 +157,31 @@ func (e *EipState) updateBaseFee(height int64) {
 	// tx prior to the eip startBlock being called (which is a begin block call).
 	e.currentBlockHeight = height + 1
 
-	gasUsed := e.totalGasWantedThisBlock
-	gasDiff := gasUsed - TargetGas
+	e.CurBaseFee = NextBaseFee(e.CurBaseFee, e.totalGasWantedThisBlock, TargetGas, MaxBlockChangeRate, MinBaseFee, MaxBaseFee)
+
+	go e.Clone().tryPersist()
+}
+
+// NextBaseFee returns the base fee following a block whose txs wanted gasWanted gas, bounded by minBaseFee and maxBaseFee.
+// It is shared by the node local base fee and the base fee kept in consensus state.
+func NextBaseFee(baseFee osmomath.Dec, gasWanted, targetGas int64, maxBlockChangeRate, minBaseFee, maxBaseFee osmomath.Dec) osmomath.Dec {
+	gasDiff := gasWanted - targetGas
 	//  (gasUsed - targetGas) / targetGas * maxChangeRate
-	baseFeeIncrement := osmomath.NewDec(gasDiff).Quo(osmomath.NewDec(TargetGas)).Mul(MaxBlockChangeRate)
+	baseFeeIncrement := osmomath.NewDec(gasDiff).Quo(osmomath.NewDec(targetGas)).Mul(maxBlockChangeRate)
 	baseFeeMultiplier := osmomath.NewDec(1).Add(baseFeeIncrement)
-	e.CurBaseFee.MulMut(baseFeeMultiplier)
+	nextBaseFee := baseFee.Mul(baseFeeMultiplier)
 
-	// Enforce the minimum base fee by resetting the CurBaseFee is it drops below the MinBaseFee
-	if e.CurBaseFee.LT(MinBaseFee) {
-		e.CurBaseFee = MinBaseFee.Clone()
+	// Enforce the minimum base fee by resetting the base fee if it drops below the minBaseFee
+	if nextBaseFee.LT(minBaseFee) {
+		return minBaseFee.Clone()
 	}
 
-	// Enforce the maximum base fee by resetting the CurBaseFee is it goes above the MaxBaseFee
-	if e.CurBaseFee.GT(MaxBaseFee) {
-		e.CurBaseFee = MaxBaseFee.Clone()
+	// Enforce the maximum base fee by resetting the base fee if it goes above the maxBaseFee
+	if nextBaseFee.GT(maxBaseFee) {
+		return maxBaseFee.Clone()
 	}
 
-	go e.Clone().tryPersist()
+	return nextBaseFee
 }
 
 // GetCurBaseFee returns a clone of the CurBaseFee to avoid overwriting the initial value in
@@ -186,7 +193,12 @@ func (e *EipState) GetCurBaseFee() osmomath.Dec {
 // GetCurRecheckBaseFee returns a clone of the CurBaseFee / RecheckFeeConstant to account for
 // rechecked transactions in the feedecorator ante handler
 func (e *EipState) GetCurRecheckBaseFee() osmomath.Dec {
-	baseFee := e.CurBaseFee.Clone()
+	return RecheckBaseFee(e.CurBaseFee)
+}
+
+// RecheckBaseFee returns a clone of the base fee divided by the recheck fee constant applying to it.
+func RecheckBaseFee(baseFee osmomath.Dec) osmomath.Dec {
+	baseFee = baseFee.Clone()
 
 	// At higher base fees, we apply a smaller re-check factor.
 	// The reason for this is that the recheck factor forces the base fee to get at minimum
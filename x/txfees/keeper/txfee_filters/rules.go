@@ -0,0 +1,122 @@
+package txfee_filters
+
+import (
+	"encoding/json"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v31/x/txfees/types"
+)
+
+// TxFeeMultiplier returns the highest fee multiplier of the tx fee filter rules matching a msg of the tx,
+// or one if no rule matches it.
+func TxFeeMultiplier(tx sdk.Tx, rules []types.TxFeeFilterRule) osmomath.Dec {
+	multiplier := osmomath.OneDec()
+	for _, rule := range rules {
+		if !rule.FeeMultiplier.GT(multiplier) {
+			continue
+		}
+		for _, msg := range tx.GetMsgs() {
+			if matchesRule(msg, rule) {
+				multiplier = rule.FeeMultiplier
+				break
+			}
+		}
+	}
+	return multiplier
+}
+
+// matchesRule returns whether the msg, or a msg nested in it by an authz MsgExec, is matched by the rule.
+func matchesRule(msg sdk.Msg, rule types.TxFeeFilterRule) bool {
+	switch {
+	case rule.MsgTypeUrl != "":
+		if sdk.MsgTypeURL(msg) == rule.MsgTypeUrl {
+			return true
+		}
+	case rule.ContractAddress != "":
+		if matchesContract(msg, rule.ContractAddress, rule.ContractMsgKey) {
+			return true
+		}
+	case rule.SwapRouteShape != nil:
+		if matchesSwapRouteShape(msg, *rule.SwapRouteShape) {
+			return true
+		}
+	}
+
+	if authzMsg, ok := msg.(*authztypes.MsgExec); ok {
+		msgs, _ := authzMsg.GetMessages()
+		for _, m := range msgs {
+			if matchesRule(m, rule) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matchesContract returns whether the msg executes the contract, with a JSON object message having the msg key if set.
+func matchesContract(msg sdk.Msg, contractAddress, msgKey string) bool {
+	msgExecuteContract, ok := msg.(*wasmtypes.MsgExecuteContract)
+	if !ok || msgExecuteContract.Contract != contractAddress {
+		return false
+	}
+	if msgKey == "" {
+		return true
+	}
+
+	jsonObject := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(msgExecuteContract.GetMsg(), &jsonObject); err != nil {
+		return false
+	}
+	_, ok = jsonObject[msgKey]
+	return ok
+}
+
+// matchesSwapRouteShape returns whether the msg is a swap msg, or an affiliate swap contract msg,
+// with a route of the shape.
+func matchesSwapRouteShape(msg sdk.Msg, shape types.SwapRouteShape) bool {
+	if msgExecuteContract, ok := msg.(*wasmtypes.MsgExecuteContract); ok {
+		tokensIn := msgExecuteContract.GetFunds()
+		if len(tokensIn) != 1 || !isAffiliateSwapMsg(msgExecuteContract.GetMsg()) {
+			return false
+		}
+
+		var affiliateSwapMsg AffiliateSwapMsg
+		if err := json.Unmarshal(msgExecuteContract.GetMsg(), &affiliateSwapMsg); err != nil {
+			return false
+		}
+		affiliateSwapMsg.TokenIn = tokensIn[0].Denom
+		return swapRouteHasShape(affiliateSwapMsg, shape)
+	}
+
+	if multiSwapMsg, ok := msg.(poolmanagertypes.MultiSwapMsgRoute); ok {
+		for _, swapMsg := range multiSwapMsg.GetSwapMsgs() {
+			if swapRouteHasShape(swapMsg, shape) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if swapMsg, ok := msg.(poolmanagertypes.SwapMsgRoute); ok {
+		return swapRouteHasShape(swapMsg, shape)
+	}
+
+	return false
+}
+
+func swapRouteHasShape(swapMsg poolmanagertypes.SwapMsgRoute, shape types.SwapRouteShape) bool {
+	hops := uint64(len(swapMsg.TokenDenomsOnPath()) - 1)
+	if shape.MinHops != 0 && hops < shape.MinHops {
+		return false
+	}
+	if shape.Cyclic && swapMsg.TokenInDenom() != swapMsg.TokenOutDenom() {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,116 @@
+package txfee_filters_test
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v31/x/txfees/keeper/txfee_filters"
+	"github.com/osmosis-labs/osmosis/v31/x/txfees/types"
+)
+
+func (suite *KeeperTestSuite) TestTxFeeMultiplier() {
+	suite.Setup()
+
+	var (
+		sender          = suite.TestAccs[0].String()
+		contractAddress = suite.TestAccs[1].String()
+
+		swapMsgTypeUrl = sdk.MsgTypeURL(&poolmanagertypes.MsgSwapExactAmountIn{})
+
+		oneHopSwapMsg = &poolmanagertypes.MsgSwapExactAmountIn{
+			Sender:            sender,
+			Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}},
+			TokenIn:           sdk.NewCoin("foo", osmomath.NewInt(100)),
+			TokenOutMinAmount: osmomath.OneInt(),
+		}
+		cyclicSwapMsg = &poolmanagertypes.MsgSwapExactAmountIn{
+			Sender: sender,
+			Routes: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: 1, TokenOutDenom: "bar"},
+				{PoolId: 2, TokenOutDenom: "foo"},
+			},
+			TokenIn:           sdk.NewCoin("foo", osmomath.NewInt(100)),
+			TokenOutMinAmount: osmomath.OneInt(),
+		}
+		bankSendMsg     = banktypes.NewMsgSend(suite.TestAccs[0], suite.TestAccs[1], sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(100))))
+		executeOrderMsg = &wasmtypes.MsgExecuteContract{
+			Sender:   sender,
+			Contract: contractAddress,
+			Msg:      []byte(`{"place_limit":{"tick_id":1}}`),
+		}
+		authzSwapMsg = authztypes.NewMsgExec(suite.TestAccs[1], []sdk.Msg{oneHopSwapMsg})
+	)
+
+	swapMsgRule := types.TxFeeFilterRule{Name: "swap", MsgTypeUrl: swapMsgTypeUrl, FeeMultiplier: osmomath.NewDec(2)}
+	contractRule := types.TxFeeFilterRule{Name: "orderbook", ContractAddress: contractAddress, FeeMultiplier: osmomath.NewDec(3)}
+	contractMsgKeyRule := types.TxFeeFilterRule{Name: "orderbook cancel", ContractAddress: contractAddress, ContractMsgKey: "cancel_limit", FeeMultiplier: osmomath.NewDec(4)}
+	twoHopsRule := types.TxFeeFilterRule{Name: "two hops", SwapRouteShape: &types.SwapRouteShape{MinHops: 2}, FeeMultiplier: osmomath.NewDec(5)}
+	cyclicRule := types.TxFeeFilterRule{Name: "cyclic", SwapRouteShape: &types.SwapRouteShape{Cyclic: true}, FeeMultiplier: osmomath.NewDec(6)}
+
+	tests := map[string]struct {
+		msgs  []sdk.Msg
+		rules []types.TxFeeFilterRule
+
+		expectedMultiplier osmomath.Dec
+	}{
+		"no rules": {
+			msgs:               []sdk.Msg{oneHopSwapMsg},
+			expectedMultiplier: osmomath.OneDec(),
+		},
+		"no matching rule": {
+			msgs:               []sdk.Msg{bankSendMsg},
+			rules:              []types.TxFeeFilterRule{swapMsgRule, contractRule, twoHopsRule, cyclicRule},
+			expectedMultiplier: osmomath.OneDec(),
+		},
+		"msg type url match": {
+			msgs:               []sdk.Msg{bankSendMsg, oneHopSwapMsg},
+			rules:              []types.TxFeeFilterRule{swapMsgRule},
+			expectedMultiplier: osmomath.NewDec(2),
+		},
+		"msg type url match nested in authz exec": {
+			msgs:               []sdk.Msg{&authzSwapMsg},
+			rules:              []types.TxFeeFilterRule{swapMsgRule},
+			expectedMultiplier: osmomath.NewDec(2),
+		},
+		"contract address match": {
+			msgs:               []sdk.Msg{executeOrderMsg},
+			rules:              []types.TxFeeFilterRule{contractRule},
+			expectedMultiplier: osmomath.NewDec(3),
+		},
+		"contract address match without the contract msg key": {
+			msgs:               []sdk.Msg{executeOrderMsg},
+			rules:              []types.TxFeeFilterRule{contractMsgKeyRule},
+			expectedMultiplier: osmomath.OneDec(),
+		},
+		"swap route shorter than the min hops": {
+			msgs:               []sdk.Msg{oneHopSwapMsg},
+			rules:              []types.TxFeeFilterRule{twoHopsRule, cyclicRule},
+			expectedMultiplier: osmomath.OneDec(),
+		},
+		"cyclic swap route with the min hops": {
+			msgs:               []sdk.Msg{cyclicSwapMsg},
+			rules:              []types.TxFeeFilterRule{twoHopsRule},
+			expectedMultiplier: osmomath.NewDec(5),
+		},
+		"highest multiplier of the matching rules": {
+			msgs:               []sdk.Msg{cyclicSwapMsg},
+			rules:              []types.TxFeeFilterRule{swapMsgRule, cyclicRule, twoHopsRule, contractRule},
+			expectedMultiplier: osmomath.NewDec(6),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			txBuilder := suite.App.GetTxConfig().NewTxBuilder()
+			err := txBuilder.SetMsgs(tc.msgs...)
+			suite.Require().NoError(err)
+
+			multiplier := txfee_filters.TxFeeMultiplier(txBuilder.GetTx(), tc.rules)
+			suite.Require().Equal(tc.expectedMultiplier, multiplier)
+		})
+	}
+}
@@ -179,7 +179,7 @@ func (am AppModule) BeginBlock(context context.Context) error {
 func (am AppModule) EndBlock(context context.Context) error {
 	ctx := sdk.UnwrapSDKContext(context)
 	mempool1559.EndBlockCode(ctx)
-	return nil
+	return am.keeper.UpdateConsensusBaseFee(ctx)
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
@@ -1,6 +1,12 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
 
 // DefaultGenesis returns the default txfee genesis state.
 func DefaultGenesis() *GenesisState {
@@ -8,6 +14,8 @@ func DefaultGenesis() *GenesisState {
 		Basedenom: sdk.DefaultBondDenom,
 		Feetokens: []FeeToken{},
 		Params:    DefaultParams(),
+
+		ConsensusBaseFee: osmomath.ZeroDec(),
 	}
 }
 
@@ -31,5 +39,9 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if !gs.ConsensusBaseFee.IsNil() && gs.ConsensusBaseFee.IsNegative() {
+		return fmt.Errorf("consensus base fee must not be negative, was (%s)", gs.ConsensusBaseFee)
+	}
+
 	return nil
 }
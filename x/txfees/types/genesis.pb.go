@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -33,6 +34,9 @@ type GenesisState struct {
 	TxFeesTracker *TxFeesTracker `protobuf:"bytes,3,opt,name=txFeesTracker,proto3" json:"txFeesTracker,omitempty" deprecated:"true"` // Deprecated: Do not use.
 	// params is the container of txfees parameters.
 	Params Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	// consensus_base_fee is the EIP-1559 base fee kept in consensus state, zero
+	// if it is not kept.
+	ConsensusBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=consensus_base_fee,json=consensusBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"consensus_base_fee" yaml:"consensus_base_fee"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -159,36 +163,39 @@ func init() {
 }
 
 var fileDescriptor_4423c18e3d020b37 = []byte{
-	// 454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x6e, 0xd3, 0x40,
-	0x14, 0x87, 0x33, 0x49, 0x15, 0x94, 0x29, 0x5d, 0x60, 0x21, 0x30, 0x11, 0x38, 0x96, 0x69, 0x25,
-	0x6f, 0xea, 0x21, 0xe9, 0xae, 0x62, 0x83, 0x41, 0x61, 0xc3, 0x02, 0xb9, 0x59, 0xb1, 0x89, 0xc6,
-	0xf6, 0x8b, 0x63, 0xa5, 0xf6, 0x44, 0x33, 0x2f, 0x55, 0x7a, 0x0b, 0x56, 0x1c, 0x82, 0x93, 0x74,
-	0xd9, 0x25, 0xab, 0x80, 0x92, 0x1b, 0xf4, 0x04, 0xc8, 0xe3, 0x49, 0xda, 0x4a, 0x84, 0x95, 0xff,
-	0xbc, 0xef, 0x7d, 0xf3, 0x7b, 0x33, 0x43, 0x8f, 0x85, 0x2a, 0x84, 0xca, 0x15, 0xc3, 0xe5, 0x04,
-	0x40, 0xb1, 0xab, 0x7e, 0x0c, 0xc8, 0xfb, 0x2c, 0x83, 0x12, 0x54, 0xae, 0x82, 0xb9, 0x14, 0x28,
-	0xac, 0x17, 0x86, 0x0a, 0x6a, 0x2a, 0x30, 0x54, 0xf7, 0x79, 0x26, 0x32, 0xa1, 0x11, 0x56, 0xbd,
-	0xd5, 0x74, 0xf7, 0x64, 0x8f, 0x73, 0x02, 0x80, 0x62, 0x06, 0xa5, 0xc1, 0x9c, 0x44, 0x73, 0x2c,
-	0xe6, 0x0a, 0x76, 0x4c, 0x22, 0xf2, 0x6d, 0xfd, 0xed, 0x1e, 0xcd, 0x9c, 0x4b, 0x5e, 0x98, 0x64,
-	0xde, 0x8f, 0x26, 0x7d, 0xfa, 0xb9, 0xce, 0x7a, 0x81, 0x1c, 0xc1, 0x7a, 0x4d, 0x3b, 0x95, 0x30,
-	0x85, 0x52, 0x14, 0x36, 0x71, 0x89, 0xdf, 0x89, 0xee, 0x7f, 0x58, 0x9f, 0x68, 0x67, 0x9b, 0x42,
-	0xd9, 0x4d, 0xb7, 0xe5, 0x1f, 0x0e, 0xdc, 0xe0, 0xdf, 0xc3, 0x05, 0x43, 0x80, 0x51, 0x05, 0x86,
-	0x07, 0x37, 0xab, 0x5e, 0x23, 0xba, 0x6f, 0xb4, 0x52, 0x7a, 0x84, 0xcb, 0x21, 0x80, 0x1a, 0x49,
-	0x9e, 0xcc, 0x40, 0xda, 0x2d, 0x97, 0xf8, 0x87, 0x83, 0x93, 0x7d, 0xa6, 0xd1, 0x43, 0x38, 0x7c,
-	0x79, 0xb7, 0xea, 0x3d, 0x4b, 0x61, 0x2e, 0x21, 0xe1, 0x08, 0xe9, 0xb9, 0x87, 0x72, 0x01, 0x9e,
-	0x4d, 0xa2, 0xc7, 0x52, 0xeb, 0x3d, 0x6d, 0xd7, 0xa3, 0xda, 0x07, 0x5a, 0xef, 0xec, 0xd3, 0x7f,
-	0xd5, 0x94, 0x89, 0x69, 0x7a, 0xbc, 0x35, 0xa1, 0x47, 0x8f, 0xd6, 0xb5, 0x52, 0xfa, 0x04, 0x97,
-	0xe3, 0xaa, 0xd3, 0x26, 0x7a, 0xf2, 0x57, 0x41, 0x7d, 0x02, 0x41, 0xb5, 0x3f, 0x3b, 0xdb, 0x47,
-	0x91, 0x97, 0xe1, 0xbb, 0xca, 0xf5, 0xf3, 0x77, 0xcf, 0xcf, 0x72, 0x9c, 0x2e, 0xe2, 0x20, 0x11,
-	0x05, 0x33, 0xc7, 0x55, 0x3f, 0x4e, 0x55, 0x3a, 0x63, 0x78, 0x3d, 0x07, 0xa5, 0x1b, 0x54, 0xd4,
-	0xae, 0xc3, 0x5b, 0x33, 0xfa, 0x66, 0x0a, 0x79, 0x36, 0xc5, 0x31, 0x4f, 0x12, 0xb1, 0x28, 0x31,
-	0x2f, 0xb3, 0xb1, 0x42, 0x2e, 0x51, 0x8d, 0x27, 0x52, 0x14, 0x76, 0xd3, 0x25, 0x7e, 0x2b, 0xf4,
-	0xef, 0x56, 0xbd, 0xe3, 0x6b, 0x5e, 0x5c, 0x9e, 0x7b, 0xff, 0xc5, 0xbd, 0xa8, 0x5b, 0xd7, 0x3f,
-	0xec, 0xca, 0x17, 0xba, 0x3a, 0x94, 0xa2, 0x08, 0xbf, 0xdc, 0xac, 0x1d, 0x72, 0xbb, 0x76, 0xc8,
-	0x9f, 0xb5, 0x43, 0xbe, 0x6f, 0x9c, 0xc6, 0xed, 0xc6, 0x69, 0xfc, 0xda, 0x38, 0x8d, 0x6f, 0x83,
-	0x07, 0xc1, 0xcd, 0xb6, 0x9d, 0x5e, 0xf2, 0x58, 0x6d, 0x3f, 0xd8, 0xd5, 0x59, 0x9f, 0x2d, 0xb7,
-	0x57, 0x4b, 0x0f, 0x12, 0xb7, 0xf5, 0x95, 0x3a, 0xfb, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x49,
-	0x58, 0xcf, 0x14, 0x03, 0x00, 0x00,
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0xa6, 0x04, 0x65, 0x4b, 0x25, 0xb0, 0x10, 0xb8, 0x01, 0xec, 0xc8, 0xb4, 0x92,
+	0x2f, 0xdd, 0x25, 0xe9, 0xad, 0xe2, 0x00, 0xa6, 0x0a, 0x97, 0x1e, 0x90, 0x9b, 0x13, 0x97, 0x68,
+	0xb3, 0x9e, 0x38, 0x56, 0x6a, 0x6f, 0xe4, 0xd9, 0x54, 0xc9, 0x0b, 0x70, 0xe6, 0x39, 0x78, 0x92,
+	0x1e, 0x7b, 0x44, 0x1c, 0x02, 0x4a, 0xde, 0x20, 0x4f, 0x80, 0xec, 0x75, 0xd2, 0x56, 0x90, 0x9e,
+	0x6c, 0xef, 0x7c, 0xf3, 0xfb, 0xff, 0x67, 0x87, 0x1c, 0x4a, 0x4c, 0x24, 0xc6, 0xc8, 0xd4, 0x74,
+	0x00, 0x80, 0xec, 0xaa, 0xd5, 0x07, 0xc5, 0x5b, 0x2c, 0x82, 0x14, 0x30, 0x46, 0x3a, 0xce, 0xa4,
+	0x92, 0xe6, 0x8b, 0x92, 0xa2, 0x9a, 0xa2, 0x25, 0xd5, 0x78, 0x1e, 0xc9, 0x48, 0x16, 0x08, 0xcb,
+	0xdf, 0x34, 0xdd, 0x38, 0xda, 0xa2, 0x39, 0x00, 0x50, 0x72, 0x04, 0x69, 0x89, 0xd9, 0xa2, 0xe0,
+	0x58, 0x9f, 0x23, 0x6c, 0x18, 0x21, 0xe3, 0x75, 0xfd, 0xed, 0x16, 0x99, 0x31, 0xcf, 0x78, 0x52,
+	0x3a, 0x73, 0xbf, 0x55, 0xc9, 0x93, 0xcf, 0xda, 0xeb, 0x85, 0xe2, 0x0a, 0xcc, 0xd7, 0xa4, 0x9e,
+	0x0b, 0x86, 0x90, 0xca, 0xc4, 0x32, 0x9a, 0x86, 0x57, 0x0f, 0x6e, 0x0f, 0xcc, 0x33, 0x52, 0x5f,
+	0xbb, 0x40, 0x6b, 0xa7, 0x59, 0xf5, 0xf6, 0xda, 0x4d, 0xfa, 0xff, 0x70, 0xb4, 0x03, 0xd0, 0xcd,
+	0x41, 0x7f, 0xf7, 0x7a, 0xee, 0x54, 0x82, 0xdb, 0x46, 0x33, 0x24, 0xfb, 0x6a, 0xda, 0x01, 0xc0,
+	0x6e, 0xc6, 0xc5, 0x08, 0x32, 0xab, 0xda, 0x34, 0xbc, 0xbd, 0xf6, 0xd1, 0x36, 0xa5, 0xee, 0x5d,
+	0xd8, 0x7f, 0xb9, 0x9a, 0x3b, 0xcf, 0x42, 0x18, 0x67, 0x20, 0xb8, 0x82, 0xf0, 0xd4, 0x55, 0xd9,
+	0x04, 0x5c, 0xcb, 0x08, 0xee, 0x8b, 0x9a, 0xef, 0x49, 0x4d, 0x47, 0xb5, 0x76, 0x0b, 0x79, 0x7b,
+	0x9b, 0xfc, 0x97, 0x82, 0x2a, 0x6d, 0x96, 0x3d, 0x66, 0x4a, 0x4c, 0x21, 0x53, 0x84, 0x14, 0x27,
+	0xd8, 0xcb, 0x07, 0xd0, 0x1b, 0x00, 0x58, 0x8f, 0xf2, 0x81, 0xf8, 0x1f, 0x72, 0xf2, 0xd7, 0xdc,
+	0x79, 0xa5, 0x6f, 0x00, 0xc3, 0x11, 0x8d, 0x25, 0x4b, 0xb8, 0x1a, 0xd2, 0x73, 0x88, 0xb8, 0x98,
+	0x9d, 0x81, 0x58, 0xcd, 0x9d, 0x83, 0x19, 0x4f, 0x2e, 0x4f, 0xdd, 0x7f, 0x65, 0xdc, 0xe0, 0xe9,
+	0xe6, 0xd0, 0xe7, 0x08, 0x1d, 0x00, 0x77, 0x61, 0x90, 0xfd, 0x7b, 0x39, 0xcd, 0x90, 0x3c, 0x56,
+	0xd3, 0x1c, 0x47, 0xcb, 0x28, 0x26, 0x7d, 0x40, 0xf5, 0xff, 0x68, 0xae, 0xb3, 0x71, 0xff, 0x49,
+	0xc6, 0xa9, 0xff, 0x2e, 0x77, 0xf4, 0xe3, 0xb7, 0xe3, 0x45, 0xb1, 0x1a, 0x4e, 0xfa, 0x54, 0xc8,
+	0x84, 0x95, 0xeb, 0xa1, 0x1f, 0xc7, 0x18, 0x8e, 0x98, 0x9a, 0x8d, 0x01, 0x8b, 0x06, 0x0c, 0x6a,
+	0x7a, 0x58, 0xe6, 0x88, 0xbc, 0x19, 0x42, 0x1c, 0x0d, 0x55, 0x8f, 0x0b, 0x21, 0x27, 0xa9, 0x8a,
+	0xd3, 0xa8, 0x87, 0x8a, 0x67, 0x0a, 0x7b, 0x83, 0x4c, 0x26, 0xd6, 0x4e, 0xd3, 0xf0, 0xaa, 0xbe,
+	0xb7, 0x9a, 0x3b, 0x87, 0x3a, 0xcf, 0x83, 0xb8, 0x1b, 0x34, 0x74, 0xfd, 0xe3, 0xa6, 0x7c, 0x51,
+	0x54, 0x3b, 0x99, 0x4c, 0xfc, 0xf3, 0xeb, 0x85, 0x6d, 0xdc, 0x2c, 0x6c, 0xe3, 0xcf, 0xc2, 0x36,
+	0xbe, 0x2f, 0xed, 0xca, 0xcd, 0xd2, 0xae, 0xfc, 0x5c, 0xda, 0x95, 0xaf, 0xed, 0x3b, 0xc6, 0xcb,
+	0x6b, 0x3a, 0xbe, 0xe4, 0x7d, 0x5c, 0x7f, 0xb0, 0xab, 0x93, 0x16, 0x9b, 0xae, 0x57, 0xb9, 0x08,
+	0xd2, 0xaf, 0x15, 0x2b, 0x7c, 0xf2, 0x77, 0x00, 0xad, 0xc1, 0xab, 0x88, 0x84, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -211,6 +218,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ConsensusBaseFee.Size()
+		i -= size
+		if _, err := m.ConsensusBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -332,6 +349,8 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ConsensusBaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -523,6 +542,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -46,4 +46,6 @@ var (
 	FeeTokensStorePrefix               = []byte("fee_tokens")
 	KeyTxFeeProtorevTracker            = []byte("txfee_protorev_tracker")
 	KeyTxFeeProtorevTrackerStartHeight = []byte("txfee_protorev_tracker_start_height")
+	KeyConsensusBaseFee                = []byte("consensus_base_fee")
+	KeyConsensusBaseFeeBlockGasWanted  = []byte("consensus_base_fee_block_gas_wanted")
 )
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,17 +15,32 @@ import (
 var (
 	KeyWhitelistedFeeTokenSetters   = []byte("WhitelistedFeeTokenSetters")
 	KeyFeeSwapIntermediaryDenomList = []byte("FeeSwapIntermediaryDenomList")
+	KeyBaseFeeParams                = []byte("BaseFeeParams")
+	KeyTxFeeFilterRules             = []byte("TxFeeFilterRules")
 )
 
+// DefaultBaseFeeParams mirror the tunables of the node local EIP-1559 base fee, with the consensus base fee disabled.
+var DefaultBaseFeeParams = BaseFeeParams{
+	Enabled:                 false,
+	DefaultBaseFee:          ConsensusMinFee,
+	MinBaseFee:              ConsensusMinFee,
+	MaxBaseFee:              osmomath.NewDec(10),
+	MaxBlockChangeRate:      osmomath.NewDecWithPrec(1, 1),
+	TargetBlockSpacePercent: osmomath.NewDecWithPrec(625, 3),
+	ResetInterval:           30000,
+}
+
 // ParamTable for txfees module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(whitelistedFeeTokenSetters []string, feeSwapIntermediaryDenomList []string) Params {
+func NewParams(whitelistedFeeTokenSetters []string, feeSwapIntermediaryDenomList []string, baseFeeParams BaseFeeParams, txFeeFilterRules []TxFeeFilterRule) Params {
 	return Params{
 		WhitelistedFeeTokenSetters:   whitelistedFeeTokenSetters,
 		FeeSwapIntermediaryDenomList: feeSwapIntermediaryDenomList,
+		BaseFeeParams:                baseFeeParams,
+		TxFeeFilterRules:             txFeeFilterRules,
 	}
 }
 
@@ -32,6 +49,8 @@ func DefaultParams() Params {
 	return Params{
 		WhitelistedFeeTokenSetters:   []string{},
 		FeeSwapIntermediaryDenomList: []string{},
+		BaseFeeParams:                DefaultBaseFeeParams,
+		TxFeeFilterRules:             []TxFeeFilterRule{},
 	}
 }
 
@@ -45,6 +64,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateBaseFeeParams(p.BaseFeeParams); err != nil {
+		return err
+	}
+
+	if err := validateTxFeeFilterRules(p.TxFeeFilterRules); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -53,6 +80,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyWhitelistedFeeTokenSetters, &p.WhitelistedFeeTokenSetters, osmoutils.ValidateAddressList),
 		paramtypes.NewParamSetPair(KeyFeeSwapIntermediaryDenomList, &p.FeeSwapIntermediaryDenomList, validateFeeSwapIntermediaryDenomList),
+		paramtypes.NewParamSetPair(KeyBaseFeeParams, &p.BaseFeeParams, validateBaseFeeParams),
+		paramtypes.NewParamSetPair(KeyTxFeeFilterRules, &p.TxFeeFilterRules, validateTxFeeFilterRules),
 	}
 }
 
@@ -78,3 +107,58 @@ func validateFeeSwapIntermediaryDenomList(i interface{}) error {
 
 	return nil
 }
+
+// validateBaseFeeParams validates the consensus base fee parameters.
+// The base fees and rates are only validated when the consensus base fee is enabled, as they are unused otherwise.
+func validateBaseFeeParams(i interface{}) error {
+	v, ok := i.(BaseFeeParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.Enabled {
+		return nil
+	}
+
+	if v.MinBaseFee.IsNil() || !v.MinBaseFee.IsPositive() {
+		return fmt.Errorf("min base fee must be positive, was (%s)", v.MinBaseFee)
+	}
+	if v.MaxBaseFee.IsNil() || v.MaxBaseFee.LT(v.MinBaseFee) {
+		return fmt.Errorf("max base fee (%s) must be greater than or equal to the min base fee (%s)", v.MaxBaseFee, v.MinBaseFee)
+	}
+	if v.DefaultBaseFee.IsNil() || v.DefaultBaseFee.LT(v.MinBaseFee) || v.DefaultBaseFee.GT(v.MaxBaseFee) {
+		return fmt.Errorf("default base fee (%s) must be between the min base fee (%s) and the max base fee (%s)", v.DefaultBaseFee, v.MinBaseFee, v.MaxBaseFee)
+	}
+	if v.MaxBlockChangeRate.IsNil() || !v.MaxBlockChangeRate.IsPositive() || v.MaxBlockChangeRate.GT(osmomath.OneDec()) {
+		return fmt.Errorf("max block change rate must be in (0, 1], was (%s)", v.MaxBlockChangeRate)
+	}
+	if v.TargetBlockSpacePercent.IsNil() || !v.TargetBlockSpacePercent.IsPositive() || v.TargetBlockSpacePercent.GT(osmomath.OneDec()) {
+		return fmt.Errorf("target block space percent must be in (0, 1], was (%s)", v.TargetBlockSpacePercent)
+	}
+	if v.ResetInterval <= 0 {
+		return errors.New("reset interval must be positive")
+	}
+
+	return nil
+}
+
+// validateTxFeeFilterRules validates the tx fee filter rules, whose names must be unique.
+func validateTxFeeFilterRules(i interface{}) error {
+	v, ok := i.([]TxFeeFilterRule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	names := make(map[string]bool, len(v))
+	for _, rule := range v {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		if names[rule.Name] {
+			return fmt.Errorf("duplicate tx fee filter rule name (%s)", rule.Name)
+		}
+		names[rule.Name] = true
+	}
+
+	return nil
+}
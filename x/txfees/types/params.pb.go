@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -29,6 +30,13 @@ type Params struct {
 	// fee_swap_intermediary_denom_list is a list of denoms that can be used as
 	// intermediary denoms for multi-hop swaps when swapping non-native fee tokens
 	FeeSwapIntermediaryDenomList []string `protobuf:"bytes,2,rep,name=fee_swap_intermediary_denom_list,json=feeSwapIntermediaryDenomList,proto3" json:"fee_swap_intermediary_denom_list,omitempty" yaml:"fee_swap_intermediary_denom_list"`
+	// base_fee_params are the parameters of the EIP-1559 base fee kept in
+	// consensus state.
+	BaseFeeParams BaseFeeParams `protobuf:"bytes,3,opt,name=base_fee_params,json=baseFeeParams,proto3" json:"base_fee_params" yaml:"base_fee_params"`
+	// tx_fee_filter_rules are the rules raising the minimum gas price of the
+	// txs they match when entering the mempool, on top of the arbitrage tx
+	// filter.
+	TxFeeFilterRules []TxFeeFilterRule `protobuf:"bytes,4,rep,name=tx_fee_filter_rules,json=txFeeFilterRules,proto3" json:"tx_fee_filter_rules" yaml:"tx_fee_filter_rules"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -78,8 +86,246 @@ func (m *Params) GetFeeSwapIntermediaryDenomList() []string {
 	return nil
 }
 
+func (m *Params) GetBaseFeeParams() BaseFeeParams {
+	if m != nil {
+		return m.BaseFeeParams
+	}
+	return BaseFeeParams{}
+}
+
+func (m *Params) GetTxFeeFilterRules() []TxFeeFilterRule {
+	if m != nil {
+		return m.TxFeeFilterRules
+	}
+	return nil
+}
+
+// TxFeeFilterRule matches txs containing a msg, possibly nested in an authz
+// MsgExec, by exactly one of its msg type URL, its wasm contract and message,
+// or its swap route shape. The minimum gas price of matched txs is multiplied
+// by the fee multiplier of the rule when entering the mempool. If several
+// rules match a tx, the highest fee multiplier applies.
+type TxFeeFilterRule struct {
+	// name identifies the rule.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// msg_type_url matches the msgs of this type URL.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// contract_address matches the MsgExecuteContract msgs executing this
+	// contract.
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// contract_msg_key, if set along with the contract address, only matches
+	// the contract messages whose JSON object has this top level key.
+	ContractMsgKey string `protobuf:"bytes,4,opt,name=contract_msg_key,json=contractMsgKey,proto3" json:"contract_msg_key,omitempty" yaml:"contract_msg_key"`
+	// swap_route_shape matches the swap msgs whose route has this shape.
+	SwapRouteShape *SwapRouteShape `protobuf:"bytes,5,opt,name=swap_route_shape,json=swapRouteShape,proto3" json:"swap_route_shape,omitempty" yaml:"swap_route_shape"`
+	// fee_multiplier is the multiplier of the minimum gas price of matched txs,
+	// at least one.
+	FeeMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=fee_multiplier,json=feeMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_multiplier" yaml:"fee_multiplier"`
+}
+
+func (m *TxFeeFilterRule) Reset()         { *m = TxFeeFilterRule{} }
+func (m *TxFeeFilterRule) String() string { return proto.CompactTextString(m) }
+func (*TxFeeFilterRule) ProtoMessage()    {}
+func (*TxFeeFilterRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcbfbe8e37bb08e6, []int{1}
+}
+func (m *TxFeeFilterRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxFeeFilterRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxFeeFilterRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxFeeFilterRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFeeFilterRule.Merge(m, src)
+}
+func (m *TxFeeFilterRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxFeeFilterRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFeeFilterRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxFeeFilterRule proto.InternalMessageInfo
+
+func (m *TxFeeFilterRule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TxFeeFilterRule) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *TxFeeFilterRule) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *TxFeeFilterRule) GetContractMsgKey() string {
+	if m != nil {
+		return m.ContractMsgKey
+	}
+	return ""
+}
+
+func (m *TxFeeFilterRule) GetSwapRouteShape() *SwapRouteShape {
+	if m != nil {
+		return m.SwapRouteShape
+	}
+	return nil
+}
+
+// SwapRouteShape matches the swap msgs whose route meets all of its set
+// conditions.
+type SwapRouteShape struct {
+	// min_hops, if set, matches the routes going through at least this many
+	// pools.
+	MinHops uint64 `protobuf:"varint,1,opt,name=min_hops,json=minHops,proto3" json:"min_hops,omitempty" yaml:"min_hops"`
+	// cyclic, if set, matches the routes whose token out denom is their token in
+	// denom.
+	Cyclic bool `protobuf:"varint,2,opt,name=cyclic,proto3" json:"cyclic,omitempty" yaml:"cyclic"`
+}
+
+func (m *SwapRouteShape) Reset()         { *m = SwapRouteShape{} }
+func (m *SwapRouteShape) String() string { return proto.CompactTextString(m) }
+func (*SwapRouteShape) ProtoMessage()    {}
+func (*SwapRouteShape) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcbfbe8e37bb08e6, []int{2}
+}
+func (m *SwapRouteShape) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRouteShape) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRouteShape.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRouteShape) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRouteShape.Merge(m, src)
+}
+func (m *SwapRouteShape) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRouteShape) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRouteShape.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapRouteShape proto.InternalMessageInfo
+
+func (m *SwapRouteShape) GetMinHops() uint64 {
+	if m != nil {
+		return m.MinHops
+	}
+	return 0
+}
+
+func (m *SwapRouteShape) GetCyclic() bool {
+	if m != nil {
+		return m.Cyclic
+	}
+	return false
+}
+
+// BaseFeeParams are the parameters of the EIP-1559 base fee kept in consensus
+// state. When enabled, the base fee is updated in EndBlock from the gas wanted
+// by the txs of the block, and it replaces the node local EIP-1559 base fee as
+// the minimum gas price of CheckTx, so that it is the same on every node.
+type BaseFeeParams struct {
+	// enabled is whether the base fee is kept in consensus state.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// default_base_fee is the base fee at the first block and at every reset
+	// interval.
+	DefaultBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=default_base_fee,json=defaultBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"default_base_fee" yaml:"default_base_fee"`
+	// min_base_fee is the minimum base fee.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee" yaml:"min_base_fee"`
+	// max_base_fee is the maximum base fee.
+	MaxBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_fee" yaml:"max_base_fee"`
+	// max_block_change_rate is the change rate of the base fee when the gas
+	// wanted by a block is twice the target gas.
+	MaxBlockChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_block_change_rate,json=maxBlockChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_block_change_rate" yaml:"max_block_change_rate"`
+	// target_block_space_percent is the share of the block max gas targeted by
+	// the base fee.
+	TargetBlockSpacePercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=target_block_space_percent,json=targetBlockSpacePercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_block_space_percent" yaml:"target_block_space_percent"`
+	// reset_interval is the number of blocks after which the base fee is reset
+	// to the default base fee.
+	ResetInterval int64 `protobuf:"varint,7,opt,name=reset_interval,json=resetInterval,proto3" json:"reset_interval,omitempty" yaml:"reset_interval"`
+}
+
+func (m *BaseFeeParams) Reset()         { *m = BaseFeeParams{} }
+func (m *BaseFeeParams) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeParams) ProtoMessage()    {}
+func (*BaseFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcbfbe8e37bb08e6, []int{3}
+}
+func (m *BaseFeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeParams.Merge(m, src)
+}
+func (m *BaseFeeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeParams proto.InternalMessageInfo
+
+func (m *BaseFeeParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *BaseFeeParams) GetResetInterval() int64 {
+	if m != nil {
+		return m.ResetInterval
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.txfees.v1beta1.Params")
+	proto.RegisterType((*TxFeeFilterRule)(nil), "osmosis.txfees.v1beta1.TxFeeFilterRule")
+	proto.RegisterType((*SwapRouteShape)(nil), "osmosis.txfees.v1beta1.SwapRouteShape")
+	proto.RegisterType((*BaseFeeParams)(nil), "osmosis.txfees.v1beta1.BaseFeeParams")
 }
 
 func init() {
@@ -87,26 +333,62 @@ func init() {
 }
 
 var fileDescriptor_fcbfbe8e37bb08e6 = []byte{
-	// 291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xcd, 0x4a, 0xc3, 0x40,
-	0x14, 0x85, 0x13, 0x85, 0x82, 0x59, 0x16, 0x91, 0x52, 0x74, 0x5a, 0xa2, 0xa0, 0x0b, 0xcd, 0x50,
-	0xbb, 0x73, 0x59, 0x44, 0x10, 0xba, 0x90, 0xd6, 0x95, 0x9b, 0x61, 0x62, 0x6f, 0xd3, 0xc1, 0x4c,
-	0x26, 0xe4, 0x5e, 0x9b, 0xe6, 0x2d, 0x7c, 0xac, 0x2e, 0xbb, 0x74, 0x55, 0x24, 0x79, 0x83, 0x3e,
-	0x81, 0xe4, 0xa7, 0xd0, 0x95, 0xee, 0xee, 0xe5, 0x7c, 0x1f, 0x07, 0x8e, 0x73, 0x69, 0x50, 0x1b,
-	0x54, 0xc8, 0x69, 0x35, 0x07, 0x40, 0xbe, 0x1c, 0xf8, 0x40, 0x72, 0xc0, 0x63, 0x99, 0x48, 0x8d,
-	0x5e, 0x9c, 0x18, 0x32, 0xed, 0xb3, 0x06, 0xf2, 0x6a, 0xc8, 0x6b, 0xa0, 0xee, 0x69, 0x60, 0x02,
-	0x53, 0x21, 0xbc, 0xbc, 0x6a, 0xda, 0xdd, 0xd9, 0x4e, 0xeb, 0xa5, 0xd2, 0xdb, 0xc6, 0xb9, 0x48,
-	0x17, 0x8a, 0x20, 0x54, 0x48, 0x30, 0x13, 0x73, 0x00, 0x41, 0xe6, 0x03, 0x22, 0x81, 0x40, 0x04,
-	0x09, 0x76, 0xec, 0xfe, 0xf1, 0xcd, 0xc9, 0xe8, 0x76, 0xbd, 0xed, 0x59, 0xbb, 0x6d, 0xef, 0x2a,
-	0x93, 0x3a, 0x7c, 0x70, 0xff, 0x54, 0xdc, 0x49, 0xf7, 0x20, 0x7f, 0x02, 0x78, 0x2d, 0xd3, 0x69,
-	0x1d, 0xb6, 0x53, 0xa7, 0x5f, 0x1a, 0x98, 0xca, 0x58, 0xa8, 0x88, 0x20, 0xd1, 0x30, 0x53, 0x32,
-	0xc9, 0xc4, 0x0c, 0x22, 0xa3, 0x45, 0x29, 0x75, 0x8e, 0xaa, 0x4e, 0xde, 0x74, 0x5e, 0xd7, 0x9d,
-	0xff, 0x59, 0xee, 0xe4, 0x7c, 0x0e, 0x30, 0x4d, 0x65, 0xfc, 0x7c, 0x00, 0x3c, 0x96, 0xf9, 0x58,
-	0x21, 0x8d, 0xc6, 0xeb, 0x9c, 0xd9, 0x9b, 0x9c, 0xd9, 0x3f, 0x39, 0xb3, 0xbf, 0x0a, 0x66, 0x6d,
-	0x0a, 0x66, 0x7d, 0x17, 0xcc, 0x7a, 0xbb, 0x0f, 0x14, 0x2d, 0x3e, 0x7d, 0xef, 0xdd, 0x68, 0xde,
-	0xec, 0x78, 0x17, 0x4a, 0x1f, 0xf7, 0x0f, 0x5f, 0x0e, 0x07, 0x7c, 0xb5, 0xdf, 0x9f, 0xb2, 0x18,
-	0xd0, 0x6f, 0x55, 0x4b, 0x0e, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x67, 0x51, 0x62, 0x9e,
-	0x01, 0x00, 0x00,
+	// 877 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x8e, 0x6b, 0x37, 0x3f, 0x93, 0xda, 0x0e, 0x13, 0xda, 0x2c, 0x69, 0xf1, 0x9a, 0x29, 0x50,
+	0x23, 0x95, 0x5d, 0x25, 0xbd, 0xa2, 0x42, 0x08, 0xb6, 0xc5, 0x6a, 0x45, 0x2a, 0x55, 0x93, 0x70,
+	0x83, 0x90, 0x56, 0xe3, 0xf5, 0xf1, 0x7a, 0xe5, 0xfd, 0xd3, 0xce, 0x38, 0xb1, 0xb9, 0xe7, 0x9e,
+	0x97, 0xe0, 0x5d, 0x7a, 0xd9, 0x0b, 0x2e, 0x10, 0x12, 0x2b, 0x94, 0xbc, 0x81, 0x9f, 0x00, 0xcd,
+	0x8f, 0xf1, 0x8f, 0x1a, 0x88, 0xb8, 0xf3, 0x9c, 0xef, 0x3b, 0xdf, 0x77, 0x7c, 0xce, 0x99, 0x1d,
+	0xf4, 0x30, 0xe3, 0x49, 0xc6, 0x23, 0xee, 0x8a, 0xc9, 0x00, 0x80, 0xbb, 0xe7, 0x47, 0x3d, 0x10,
+	0xec, 0xc8, 0xcd, 0x59, 0xc1, 0x12, 0xee, 0xe4, 0x45, 0x26, 0x32, 0x7c, 0xcf, 0x90, 0x1c, 0x4d,
+	0x72, 0x0c, 0xe9, 0xf0, 0xfd, 0x30, 0x0b, 0x33, 0x45, 0x71, 0xe5, 0x2f, 0xcd, 0x26, 0x7f, 0x56,
+	0xd1, 0xe6, 0x6b, 0x95, 0x8e, 0x33, 0xf4, 0xe1, 0xc5, 0x30, 0x12, 0x10, 0x47, 0x5c, 0x40, 0xdf,
+	0x1f, 0x00, 0xf8, 0x22, 0x1b, 0x41, 0xea, 0x73, 0x10, 0x02, 0x0a, 0x6e, 0x55, 0xda, 0xd5, 0xce,
+	0x8e, 0xf7, 0xf8, 0x4d, 0x69, 0x6f, 0xcc, 0x4a, 0xfb, 0xe3, 0x29, 0x4b, 0xe2, 0xa7, 0xe4, 0x5f,
+	0x53, 0x08, 0x3d, 0x5c, 0xc2, 0xbb, 0x00, 0x67, 0x12, 0x3d, 0xd5, 0x20, 0xbe, 0x40, 0x6d, 0x99,
+	0xc1, 0x2f, 0x58, 0xee, 0x47, 0xa9, 0x80, 0x22, 0x81, 0x7e, 0xc4, 0x8a, 0xa9, 0xdf, 0x87, 0x34,
+	0x4b, 0x7c, 0x99, 0x64, 0xdd, 0x52, 0x9e, 0xae, 0xf1, 0x7c, 0xa4, 0x3d, 0xff, 0x2b, 0x8b, 0xd0,
+	0x07, 0x03, 0x80, 0xd3, 0x0b, 0x96, 0xbf, 0x5c, 0x22, 0x3c, 0x97, 0xf8, 0x49, 0xc4, 0x05, 0x4e,
+	0x50, 0xb3, 0xc7, 0x38, 0xa8, 0x7a, 0x75, 0xef, 0xac, 0x6a, 0xbb, 0xd2, 0xd9, 0x3d, 0xfe, 0xc4,
+	0x79, 0x77, 0xf3, 0x1c, 0x8f, 0x71, 0xe8, 0x02, 0xe8, 0x4e, 0x79, 0x2d, 0x53, 0xce, 0x3d, 0x5d,
+	0xce, 0x9a, 0x16, 0xa1, 0xf5, 0xde, 0x32, 0x1d, 0xff, 0x84, 0xf6, 0xc5, 0x44, 0x11, 0x06, 0x51,
+	0x2c, 0xa0, 0xf0, 0x8b, 0x71, 0x0c, 0xdc, 0xaa, 0xb5, 0xab, 0x9d, 0xdd, 0xe3, 0x47, 0xd7, 0x59,
+	0x9e, 0x4d, 0xba, 0x00, 0x5d, 0x95, 0x40, 0xc7, 0x31, 0x78, 0xc4, 0x98, 0x1e, 0x6a, 0xd3, 0x77,
+	0x28, 0x12, 0xba, 0x27, 0x56, 0x93, 0x38, 0xf9, 0xad, 0x8a, 0x9a, 0x6b, 0x4a, 0xf8, 0x21, 0xaa,
+	0xa5, 0x2c, 0x01, 0xab, 0xd2, 0xae, 0x74, 0x76, 0xbc, 0xe6, 0xac, 0xb4, 0x77, 0xb5, 0xa6, 0x8c,
+	0x12, 0xaa, 0x40, 0xfc, 0x05, 0xba, 0x93, 0xf0, 0xd0, 0x17, 0xd3, 0x1c, 0xfc, 0x71, 0x11, 0x5b,
+	0xb7, 0x14, 0xf9, 0x60, 0x56, 0xda, 0xfb, 0x9a, 0xbc, 0x8c, 0x12, 0x8a, 0x12, 0x1e, 0x9e, 0x4d,
+	0x73, 0xf8, 0xbe, 0x88, 0x71, 0x17, 0xed, 0x05, 0x59, 0x2a, 0x0a, 0x16, 0x08, 0x9f, 0xf5, 0xfb,
+	0x05, 0x70, 0xdd, 0xdf, 0x1d, 0xef, 0xfe, 0xac, 0xb4, 0x0f, 0x74, 0xfa, 0x3a, 0x83, 0xd0, 0xe6,
+	0x3c, 0xf4, 0x8d, 0x8e, 0xe0, 0x6f, 0x97, 0x74, 0xa4, 0xdb, 0x08, 0xa6, 0x56, 0xed, 0x5a, 0x1d,
+	0xc3, 0x20, 0xb4, 0x31, 0x0f, 0xbd, 0xe2, 0xe1, 0x77, 0x30, 0xc5, 0x09, 0xda, 0x53, 0xcb, 0x52,
+	0x64, 0x63, 0x01, 0x3e, 0x1f, 0xb2, 0x1c, 0xac, 0xdb, 0x6a, 0xdc, 0x9f, 0x5e, 0xd7, 0x7b, 0xb9,
+	0x3a, 0x54, 0xd2, 0x4f, 0x25, 0x7b, 0xd9, 0x6e, 0x5d, 0x89, 0xd0, 0x06, 0x5f, 0x21, 0xe3, 0x00,
+	0x35, 0xe4, 0x60, 0x92, 0x71, 0x2c, 0xa2, 0x3c, 0x8e, 0xa0, 0xb0, 0x36, 0x55, 0xcd, 0x5f, 0xca,
+	0xf9, 0xfd, 0x51, 0xda, 0xf7, 0x03, 0x65, 0xca, 0xfb, 0x23, 0x27, 0xca, 0xdc, 0x84, 0x89, 0xa1,
+	0x73, 0x02, 0x21, 0x0b, 0xa6, 0xcf, 0x21, 0x98, 0x95, 0xf6, 0xdd, 0xc5, 0x8a, 0x2f, 0x24, 0x08,
+	0xad, 0x0f, 0x00, 0x5e, 0x2d, 0xce, 0x23, 0xd4, 0x58, 0xad, 0x11, 0x3b, 0x68, 0x3b, 0x89, 0x52,
+	0x7f, 0x98, 0xe5, 0x5c, 0x0d, 0xb6, 0xe6, 0xed, 0xcf, 0x4a, 0xbb, 0x69, 0x66, 0x65, 0x10, 0x42,
+	0xb7, 0x92, 0x28, 0x7d, 0x91, 0xe5, 0x1c, 0x7f, 0x86, 0x36, 0x83, 0x69, 0x10, 0x47, 0x81, 0x9a,
+	0xec, 0xb6, 0xf7, 0xde, 0xac, 0xb4, 0xeb, 0xa6, 0xa5, 0x2a, 0x4e, 0xa8, 0x21, 0x90, 0x5f, 0x6f,
+	0xa3, 0xfa, 0xca, 0x05, 0xc0, 0x8f, 0xd1, 0x16, 0xa4, 0xac, 0x17, 0x43, 0x5f, 0x79, 0x6d, 0x7b,
+	0x78, 0x56, 0xda, 0x0d, 0x9d, 0x6d, 0x00, 0x42, 0xe7, 0x14, 0x3c, 0x44, 0x7b, 0x7d, 0x18, 0xb0,
+	0x71, 0x2c, 0xfc, 0xf9, 0x55, 0x31, 0xeb, 0xf4, 0xd5, 0xcd, 0x7a, 0x62, 0x7a, 0xbf, 0x2e, 0x42,
+	0x68, 0xc3, 0x84, 0x4c, 0x75, 0xf8, 0x47, 0x74, 0x47, 0xfe, 0xd5, 0x7f, 0x5c, 0xf4, 0xd6, 0x3d,
+	0xbd, 0x99, 0xcb, 0xfe, 0xa2, 0x57, 0x0b, 0x07, 0x94, 0x44, 0xe9, 0xb2, 0x3a, 0x9b, 0x2c, 0xd4,
+	0x6b, 0xff, 0x47, 0x9d, 0x4d, 0x56, 0xd4, 0xd9, 0x64, 0xae, 0x7e, 0x8e, 0xee, 0x2a, 0x30, 0xce,
+	0x82, 0x91, 0x1f, 0x0c, 0x59, 0x1a, 0x82, 0x5f, 0x30, 0xa1, 0x77, 0x75, 0xc7, 0x7b, 0x76, 0x33,
+	0x9b, 0x07, 0x4b, 0x36, 0xeb, 0x4a, 0x84, 0x62, 0xe9, 0x27, 0xc3, 0xcf, 0x54, 0x94, 0x32, 0x01,
+	0xf8, 0xe7, 0x0a, 0x3a, 0x14, 0xac, 0x08, 0x41, 0x98, 0x0c, 0x9e, 0xb3, 0x00, 0xfc, 0x1c, 0x8a,
+	0x00, 0x52, 0x61, 0x96, 0xf7, 0xc5, 0xcd, 0xdc, 0x3f, 0xd2, 0xee, 0xd7, 0xcb, 0x11, 0x7a, 0xa0,
+	0x41, 0x55, 0xc5, 0xa9, 0x84, 0x5e, 0x6b, 0x04, 0x7f, 0x8d, 0x1a, 0x05, 0x70, 0x10, 0xfa, 0xa3,
+	0x7e, 0xce, 0x62, 0x6b, 0xab, 0x5d, 0xe9, 0x54, 0xbd, 0x0f, 0x16, 0x97, 0x62, 0x15, 0x27, 0xb4,
+	0xae, 0x02, 0x2f, 0xcd, 0xd9, 0x3b, 0x79, 0x73, 0xd9, 0xaa, 0xbc, 0xbd, 0x6c, 0x55, 0xfe, 0xba,
+	0x6c, 0x55, 0x7e, 0xb9, 0x6a, 0x6d, 0xbc, 0xbd, 0x6a, 0x6d, 0xfc, 0x7e, 0xd5, 0xda, 0xf8, 0xe1,
+	0x38, 0x8c, 0xc4, 0x70, 0xdc, 0x73, 0x82, 0x2c, 0x71, 0xcd, 0x95, 0xff, 0x3c, 0x66, 0x3d, 0x3e,
+	0x3f, 0xb8, 0xe7, 0x4f, 0x8e, 0xdc, 0xc9, 0xfc, 0x59, 0x95, 0x5f, 0x35, 0xde, 0xdb, 0x54, 0x0f,
+	0xe4, 0x93, 0xbf, 0x07, 0x00, 0xc8, 0x96, 0xfb, 0x23, 0x75, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -129,6 +411,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TxFeeFilterRules) > 0 {
+		for iNdEx := len(m.TxFeeFilterRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxFeeFilterRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.BaseFeeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.FeeSwapIntermediaryDenomList) > 0 {
 		for iNdEx := len(m.FeeSwapIntermediaryDenomList) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.FeeSwapIntermediaryDenomList[iNdEx])
@@ -150,6 +456,205 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TxFeeFilterRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxFeeFilterRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxFeeFilterRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeMultiplier.Size()
+		i -= size
+		if _, err := m.FeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.SwapRouteShape != nil {
+		{
+			size, err := m.SwapRouteShape.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ContractMsgKey) > 0 {
+		i -= len(m.ContractMsgKey)
+		copy(dAtA[i:], m.ContractMsgKey)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ContractMsgKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapRouteShape) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapRouteShape) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRouteShape) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cyclic {
+		i--
+		if m.Cyclic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinHops != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinHops))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResetInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ResetInterval))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.TargetBlockSpacePercent.Size()
+		i -= size
+		if _, err := m.TargetBlockSpacePercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxBlockChangeRate.Size()
+		i -= size
+		if _, err := m.MaxBlockChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DefaultBaseFee.Size()
+		i -= size
+		if _, err := m.DefaultBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -179,6 +684,85 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = m.BaseFeeParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.TxFeeFilterRules) > 0 {
+		for _, e := range m.TxFeeFilterRules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxFeeFilterRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.ContractMsgKey)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.SwapRouteShape != nil {
+		l = m.SwapRouteShape.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.FeeMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *SwapRouteShape) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinHops != 0 {
+		n += 1 + sovParams(uint64(m.MinHops))
+	}
+	if m.Cyclic {
+		n += 2
+	}
+	return n
+}
+
+func (m *BaseFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.DefaultBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxBlockChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TargetBlockSpacePercent.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ResetInterval != 0 {
+		n += 1 + sovParams(uint64(m.ResetInterval))
+	}
 	return n
 }
 
@@ -281,6 +865,669 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.FeeSwapIntermediaryDenomList = append(m.FeeSwapIntermediaryDenomList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFeeFilterRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxFeeFilterRules = append(m.TxFeeFilterRules, TxFeeFilterRule{})
+			if err := m.TxFeeFilterRules[len(m.TxFeeFilterRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxFeeFilterRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxFeeFilterRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxFeeFilterRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMsgKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractMsgKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRouteShape", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwapRouteShape == nil {
+				m.SwapRouteShape = &SwapRouteShape{}
+			}
+			if err := m.SwapRouteShape.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapRouteShape) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapRouteShape: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapRouteShape: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHops", wireType)
+			}
+			m.MinHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cyclic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cyclic = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBlockChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockSpacePercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBlockSpacePercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetInterval", wireType)
+			}
+			m.ResetInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1,10 +1,14 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 	appParams "github.com/osmosis-labs/osmosis/v31/app/params"
 	"github.com/osmosis-labs/osmosis/v31/x/txfees/types"
 )
@@ -17,6 +21,7 @@ const (
 
 func TestParamsValidate(t *testing.T) {
 	appParams.SetAddressPrefixes()
+	validContractAddress := sdk.AccAddress(bytes.Repeat([]byte{1}, 32)).String()
 
 	testCases := map[string]struct {
 		params    types.Params
@@ -51,6 +56,59 @@ func TestParamsValidate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		"valid tx fee filter rules": {
+			params: types.Params{
+				TxFeeFilterRules: []types.TxFeeFilterRule{
+					{Name: "msg", MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", FeeMultiplier: osmomath.NewDec(2)},
+					{Name: "contract", ContractAddress: validContractAddress, ContractMsgKey: "swap", FeeMultiplier: osmomath.NewDec(3)},
+					{Name: "route", SwapRouteShape: &types.SwapRouteShape{MinHops: 3}, FeeMultiplier: osmomath.OneDec()},
+				},
+			},
+			expectErr: false,
+		},
+		"duplicate tx fee filter rule name": {
+			params: types.Params{
+				TxFeeFilterRules: []types.TxFeeFilterRule{
+					{Name: "msg", MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", FeeMultiplier: osmomath.NewDec(2)},
+					{Name: "msg", SwapRouteShape: &types.SwapRouteShape{Cyclic: true}, FeeMultiplier: osmomath.NewDec(2)},
+				},
+			},
+			expectErr: true,
+		},
+		"tx fee filter rule without matcher": {
+			params: types.Params{
+				TxFeeFilterRules: []types.TxFeeFilterRule{{Name: "none", FeeMultiplier: osmomath.NewDec(2)}},
+			},
+			expectErr: true,
+		},
+		"tx fee filter rule with two matchers": {
+			params: types.Params{
+				TxFeeFilterRules: []types.TxFeeFilterRule{
+					{Name: "both", MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", ContractAddress: validContractAddress, FeeMultiplier: osmomath.NewDec(2)},
+				},
+			},
+			expectErr: true,
+		},
+		"tx fee filter rule with contract msg key only": {
+			params: types.Params{
+				TxFeeFilterRules: []types.TxFeeFilterRule{{Name: "key", ContractMsgKey: "swap", FeeMultiplier: osmomath.NewDec(2)}},
+			},
+			expectErr: true,
+		},
+		"tx fee filter rule with empty swap route shape": {
+			params: types.Params{
+				TxFeeFilterRules: []types.TxFeeFilterRule{{Name: "route", SwapRouteShape: &types.SwapRouteShape{}, FeeMultiplier: osmomath.NewDec(2)}},
+			},
+			expectErr: true,
+		},
+		"tx fee filter rule lowering the fee": {
+			params: types.Params{
+				TxFeeFilterRules: []types.TxFeeFilterRule{
+					{Name: "msg", MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", FeeMultiplier: osmomath.MustNewDecFromStr("0.5")},
+				},
+			},
+			expectErr: true,
+		},
 	}
 
 	for name, tc := range testCases {
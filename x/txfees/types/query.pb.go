@@ -7,6 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -445,6 +446,187 @@ func (m *QueryEipBaseFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEipBaseFeeResponse proto.InternalMessageInfo
 
+type QueryCurrentBaseFeeRequest struct {
+}
+
+func (m *QueryCurrentBaseFeeRequest) Reset()         { *m = QueryCurrentBaseFeeRequest{} }
+func (m *QueryCurrentBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentBaseFeeRequest) ProtoMessage()    {}
+func (*QueryCurrentBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{10}
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentBaseFeeRequest proto.InternalMessageInfo
+
+type QueryCurrentBaseFeeResponse struct {
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee" yaml:"base_fee"`
+}
+
+func (m *QueryCurrentBaseFeeResponse) Reset()         { *m = QueryCurrentBaseFeeResponse{} }
+func (m *QueryCurrentBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentBaseFeeResponse) ProtoMessage()    {}
+func (*QueryCurrentBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{11}
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentBaseFeeResponse proto.InternalMessageInfo
+
+type QueryEstimateFeeRequest struct {
+	// gas_wanted is the gas wanted by the tx. It defaults to the gas limit of tx
+	// if it is set.
+	GasWanted uint64 `protobuf:"varint,1,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty" yaml:"gas_wanted"`
+	// tx is the optional encoded tx, such as a simulated one. If it is set, the
+	// gas price accounts for the tx being an arbitrage tx and for the tx fee
+	// filter rules matching its messages.
+	Tx []byte `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty" yaml:"tx"`
+}
+
+func (m *QueryEstimateFeeRequest) Reset()         { *m = QueryEstimateFeeRequest{} }
+func (m *QueryEstimateFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateFeeRequest) ProtoMessage()    {}
+func (*QueryEstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{12}
+}
+func (m *QueryEstimateFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateFeeRequest.Merge(m, src)
+}
+func (m *QueryEstimateFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateFeeRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateFeeRequest) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *QueryEstimateFeeRequest) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+type QueryEstimateFeeResponse struct {
+	// gas_price is the minimum gas price in the base denom, computed as the
+	// mempool fee decorator does in CheckTx: the highest of the consensus min
+	// fee, the min gas price of the node for the tx and the EIP-1559 base fee,
+	// raised by the multiplier of the tx fee filter rules matching the tx.
+	GasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gas_price" yaml:"gas_price"`
+	// fees are the required fee in the base denom, followed by the required fee
+	// in each whitelisted fee token whose spot price can be computed.
+	Fees []types.Coin `protobuf:"bytes,2,rep,name=fees,proto3" json:"fees" yaml:"fees"`
+}
+
+func (m *QueryEstimateFeeResponse) Reset()         { *m = QueryEstimateFeeResponse{} }
+func (m *QueryEstimateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateFeeResponse) ProtoMessage()    {}
+func (*QueryEstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{13}
+}
+func (m *QueryEstimateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateFeeResponse.Merge(m, src)
+}
+func (m *QueryEstimateFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateFeeResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateFeeResponse) GetFees() []types.Coin {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryFeeTokensRequest)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensRequest")
 	proto.RegisterType((*QueryFeeTokensResponse)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensResponse")
@@ -456,6 +638,10 @@ func init() {
 	proto.RegisterType((*QueryBaseDenomResponse)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomResponse")
 	proto.RegisterType((*QueryEipBaseFeeRequest)(nil), "osmosis.txfees.v1beta1.QueryEipBaseFeeRequest")
 	proto.RegisterType((*QueryEipBaseFeeResponse)(nil), "osmosis.txfees.v1beta1.QueryEipBaseFeeResponse")
+	proto.RegisterType((*QueryCurrentBaseFeeRequest)(nil), "osmosis.txfees.v1beta1.QueryCurrentBaseFeeRequest")
+	proto.RegisterType((*QueryCurrentBaseFeeResponse)(nil), "osmosis.txfees.v1beta1.QueryCurrentBaseFeeResponse")
+	proto.RegisterType((*QueryEstimateFeeRequest)(nil), "osmosis.txfees.v1beta1.QueryEstimateFeeRequest")
+	proto.RegisterType((*QueryEstimateFeeResponse)(nil), "osmosis.txfees.v1beta1.QueryEstimateFeeResponse")
 }
 
 func init() {
@@ -463,51 +649,64 @@ func init() {
 }
 
 var fileDescriptor_6cbc1b48c44dfdd6 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x6a, 0x13, 0x51,
-	0x14, 0xcd, 0x54, 0x5b, 0xcd, 0xab, 0x56, 0x7d, 0xd8, 0x36, 0x4e, 0x65, 0x52, 0x1e, 0x2a, 0xa5,
-	0x92, 0x79, 0x6d, 0xa2, 0x22, 0xee, 0x0c, 0xb1, 0x22, 0x14, 0x69, 0x47, 0x41, 0xe8, 0x66, 0x98,
-	0x49, 0xee, 0xa4, 0x43, 0x93, 0xbc, 0x69, 0xde, 0x4b, 0x69, 0x10, 0x37, 0x7e, 0x81, 0x20, 0xf8,
-	0x01, 0xdd, 0xb8, 0x73, 0xef, 0x1f, 0x74, 0x59, 0x70, 0x23, 0x2e, 0x82, 0xb4, 0x7e, 0x41, 0xbf,
-	0x40, 0xe6, 0xcd, 0x9b, 0x4c, 0xda, 0x26, 0x4d, 0xb2, 0xcb, 0xcc, 0xbd, 0xf7, 0x9c, 0x73, 0xe7,
-	0x9e, 0x43, 0x10, 0x61, 0xbc, 0xce, 0xb8, 0xcf, 0xa9, 0xd8, 0xf7, 0x00, 0x38, 0xdd, 0x5b, 0x75,
-	0x41, 0x38, 0xab, 0x74, 0xb7, 0x05, 0xcd, 0xb6, 0x19, 0x34, 0x99, 0x60, 0x78, 0x4e, 0xf5, 0x98,
-	0x51, 0x8f, 0xa9, 0x7a, 0xf4, 0xbb, 0x55, 0x56, 0x65, 0xb2, 0x85, 0x86, 0xbf, 0xa2, 0x6e, 0xfd,
-	0x7e, 0x95, 0xb1, 0x6a, 0x0d, 0xa8, 0x13, 0xf8, 0xd4, 0x69, 0x34, 0x98, 0x70, 0x84, 0xcf, 0x1a,
-	0x5c, 0x55, 0x0d, 0x55, 0x95, 0x4f, 0x6e, 0xcb, 0xa3, 0x95, 0x56, 0x53, 0x36, 0xa8, 0xfa, 0xc3,
-	0x01, 0x7a, 0x3c, 0x00, 0xc1, 0x76, 0x40, 0xb5, 0x91, 0x79, 0x34, 0xbb, 0x19, 0x2a, 0x5c, 0x03,
-	0x78, 0x1f, 0xbe, 0xe6, 0x16, 0xec, 0xb6, 0x80, 0x0b, 0x22, 0xd0, 0xdc, 0xf9, 0x02, 0x0f, 0x58,
-	0x83, 0x03, 0xde, 0x42, 0xc8, 0x03, 0xb0, 0x25, 0x0a, 0xcf, 0x68, 0x8b, 0x57, 0x96, 0xa6, 0xf3,
-	0x8b, 0x66, 0xff, 0xd5, 0xcc, 0x78, 0xbc, 0x78, 0xef, 0xb0, 0x93, 0x4d, 0x9d, 0x76, 0xb2, 0x77,
-	0xda, 0x4e, 0xbd, 0xf6, 0x82, 0x24, 0x08, 0xc4, 0x4a, 0x7b, 0x31, 0x07, 0x29, 0x21, 0x5d, 0xb2,
-	0x96, 0xa0, 0xc1, 0xea, 0xef, 0x02, 0x26, 0x36, 0x9a, 0x7e, 0x19, 0x94, 0x26, 0xfc, 0x08, 0x4d,
-	0x56, 0xc2, 0x42, 0x46, 0x5b, 0xd4, 0x96, 0xd2, 0xc5, 0xdb, 0xa7, 0x9d, 0xec, 0x8d, 0x08, 0x4e,
-	0xbe, 0x26, 0x56, 0x54, 0x26, 0x07, 0x1a, 0x5a, 0xe8, 0x0b, 0xa3, 0x36, 0x58, 0x46, 0x53, 0x01,
-	0x63, 0xb5, 0x37, 0x25, 0x09, 0x74, 0xb5, 0x88, 0x4f, 0x3b, 0xd9, 0x99, 0x08, 0x28, 0x7c, 0x6f,
-	0xfb, 0x15, 0x62, 0xa9, 0x0e, 0xfc, 0x01, 0x21, 0x1e, 0x30, 0x61, 0x07, 0x21, 0x42, 0x66, 0x42,
-	0x12, 0x3f, 0x0f, 0x77, 0xf9, 0xd3, 0xc9, 0x2e, 0x94, 0xe5, 0xd6, 0xbc, 0xb2, 0x63, 0xfa, 0x8c,
-	0xd6, 0x1d, 0xb1, 0x6d, 0xae, 0x43, 0xd5, 0x29, 0xb7, 0x4b, 0x50, 0x4e, 0x56, 0x4d, 0xc6, 0x89,
-	0x95, 0xe6, 0xb1, 0x18, 0xf2, 0x12, 0xcd, 0x27, 0x1a, 0x37, 0x42, 0xb2, 0xca, 0xb8, 0x7b, 0xae,
-	0xa1, 0xcc, 0x45, 0x88, 0xf1, 0x77, 0xec, 0x9a, 0xa0, 0xe8, 0x70, 0x90, 0x58, 0xb1, 0x09, 0xde,
-	0x2a, 0x13, 0xf4, 0x14, 0x14, 0xfc, 0x13, 0x84, 0x5c, 0x87, 0x83, 0xdd, 0xab, 0x73, 0x36, 0xd9,
-	0x39, 0xa9, 0x11, 0x2b, 0xed, 0xc6, 0xd3, 0x24, 0xa3, 0xf0, 0x5e, 0xf9, 0x41, 0x08, 0xb9, 0x06,
-	0xf1, 0x69, 0x49, 0x4d, 0x7d, 0x8d, 0xde, 0x8a, 0xa2, 0xda, 0x44, 0xd7, 0x25, 0x9c, 0x07, 0xa0,
-	0x88, 0x9e, 0x8d, 0xf6, 0xfd, 0x6f, 0xf5, 0x68, 0xf1, 0x00, 0x88, 0x75, 0xcd, 0x8d, 0xa0, 0xf3,
-	0x3f, 0xa7, 0xd0, 0xa4, 0xa4, 0xc3, 0xdf, 0x34, 0x94, 0xee, 0x5a, 0x1c, 0xe7, 0x06, 0xd9, 0xb8,
-	0x6f, 0x46, 0x74, 0x73, 0xd4, 0xf6, 0x68, 0x13, 0xb2, 0xfc, 0xf9, 0xd7, 0xbf, 0xaf, 0x13, 0x0f,
-	0x30, 0xa1, 0x83, 0xc3, 0xa9, 0x52, 0x81, 0x7f, 0x68, 0x68, 0xe6, 0xac, 0x7d, 0x71, 0xfe, 0x52,
-	0xba, 0xbe, 0x91, 0xd1, 0x0b, 0x63, 0xcd, 0x28, 0x9d, 0x05, 0xa9, 0x33, 0x87, 0x1f, 0x0f, 0xd2,
-	0x99, 0x58, 0xda, 0x76, 0xdb, 0xd1, 0x9d, 0xf1, 0x77, 0x0d, 0x4d, 0xf7, 0x18, 0x11, 0xd3, 0xe1,
-	0xcc, 0x67, 0x5c, 0xaf, 0xaf, 0x8c, 0x3e, 0xa0, 0x74, 0x3e, 0x95, 0x3a, 0x29, 0xce, 0x0d, 0xd2,
-	0x29, 0x95, 0xd9, 0xca, 0xef, 0xf4, 0xa3, 0x7c, 0xfc, 0x24, 0x6f, 0xde, 0x75, 0xf4, 0x90, 0x9b,
-	0x9f, 0x8f, 0xc4, 0x90, 0x9b, 0x5f, 0x08, 0xca, 0xf0, 0x9b, 0x27, 0x51, 0xc1, 0x07, 0x1a, 0xba,
-	0xf9, 0x1a, 0x44, 0x92, 0x01, 0x7c, 0x39, 0xdb, 0x85, 0x18, 0xe9, 0x74, 0xe4, 0x7e, 0x25, 0x6f,
-	0x45, 0xca, 0x5b, 0xc6, 0x4b, 0x83, 0xe4, 0x95, 0x5b, 0x4d, 0x1b, 0xfc, 0xc0, 0x8e, 0x53, 0x54,
-	0x5c, 0x3f, 0x3c, 0x36, 0xb4, 0xa3, 0x63, 0x43, 0xfb, 0x7b, 0x6c, 0x68, 0x5f, 0x4e, 0x8c, 0xd4,
-	0xd1, 0x89, 0x91, 0xfa, 0x7d, 0x62, 0xa4, 0xb6, 0xf2, 0x55, 0x5f, 0x6c, 0xb7, 0x5c, 0xb3, 0xcc,
-	0xea, 0x31, 0x5a, 0xae, 0xe6, 0xb8, 0xbc, 0x0b, 0xbd, 0x57, 0x58, 0xa5, 0xfb, 0x31, 0x81, 0x68,
-	0x07, 0xc0, 0xdd, 0x29, 0xf9, 0x37, 0x54, 0xf8, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x65, 0xfc, 0xc9,
-	0xd0, 0x3f, 0x07, 0x00, 0x00,
+	// 906 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0xc7, 0xe3, 0xd0, 0x96, 0x7a, 0xd2, 0x2e, 0x65, 0xa0, 0x6d, 0x9a, 0x96, 0x64, 0x35, 0x2a,
+	0xd5, 0x6a, 0x21, 0x76, 0x37, 0xe1, 0x97, 0xb8, 0xe1, 0x86, 0x45, 0x48, 0x15, 0x6a, 0x4d, 0xa5,
+	0x4a, 0xbd, 0x58, 0x76, 0xf2, 0xe2, 0x5a, 0x4d, 0x3c, 0xde, 0xcc, 0x64, 0x49, 0x84, 0xb8, 0x70,
+	0xe2, 0x88, 0x84, 0xc4, 0x91, 0xc3, 0x5e, 0x38, 0x20, 0x71, 0xe2, 0x8f, 0xd8, 0xe3, 0x4a, 0x5c,
+	0x10, 0x87, 0x08, 0xed, 0xf2, 0x17, 0xe4, 0x2f, 0x40, 0x33, 0x1e, 0xc7, 0xce, 0xaf, 0x75, 0xf6,
+	0xc0, 0xcd, 0xf6, 0x7b, 0xef, 0xfb, 0xbe, 0x6f, 0xe6, 0xe5, 0xa3, 0x20, 0x42, 0x59, 0x9f, 0xb2,
+	0x80, 0x99, 0x7c, 0xd4, 0x05, 0x60, 0xe6, 0xe1, 0x9e, 0x07, 0xdc, 0xdd, 0x33, 0x0f, 0x86, 0x30,
+	0x18, 0x1b, 0xd1, 0x80, 0x72, 0x8a, 0x6f, 0xa9, 0x1c, 0x23, 0xce, 0x31, 0x54, 0x4e, 0xe5, 0x6d,
+	0x9f, 0xfa, 0x54, 0xa6, 0x98, 0xe2, 0x29, 0xce, 0xae, 0xdc, 0xf3, 0x29, 0xf5, 0x7b, 0x60, 0xba,
+	0x51, 0x60, 0xba, 0x61, 0x48, 0xb9, 0xcb, 0x03, 0x1a, 0x32, 0x15, 0xad, 0xaa, 0xa8, 0x7c, 0xf3,
+	0x86, 0x5d, 0xb3, 0x33, 0x1c, 0xc8, 0x84, 0x24, 0xde, 0x96, 0xcd, 0x4c, 0xcf, 0x65, 0x30, 0x33,
+	0xd3, 0xa6, 0x41, 0x12, 0x7f, 0x77, 0x8d, 0xdf, 0x2e, 0x00, 0xa7, 0xaf, 0x40, 0xa5, 0x91, 0xdb,
+	0xe8, 0xe6, 0x53, 0x31, 0xc1, 0x3e, 0xc0, 0x33, 0xf1, 0x99, 0xd9, 0x70, 0x30, 0x04, 0xc6, 0x09,
+	0x47, 0xb7, 0x16, 0x03, 0x2c, 0xa2, 0x21, 0x03, 0xfc, 0x02, 0xa1, 0x2e, 0x80, 0x23, 0x55, 0x58,
+	0x59, 0xdb, 0x7e, 0x6d, 0xa7, 0xd4, 0xd8, 0x36, 0x56, 0x8f, 0x6e, 0x24, 0xe5, 0xd6, 0x9d, 0xe3,
+	0x49, 0xad, 0x30, 0x9d, 0xd4, 0xde, 0x1c, 0xbb, 0xfd, 0xde, 0xa7, 0x24, 0x55, 0x20, 0xb6, 0xde,
+	0x4d, 0x7a, 0x90, 0x16, 0xaa, 0xc8, 0xae, 0x2d, 0x08, 0x69, 0xff, 0xeb, 0x88, 0xf2, 0x27, 0x83,
+	0xa0, 0x0d, 0xca, 0x13, 0x7e, 0x80, 0x2e, 0x77, 0x44, 0xa0, 0xac, 0x6d, 0x6b, 0x3b, 0xba, 0x75,
+	0x63, 0x3a, 0xa9, 0x5d, 0x8b, 0xe5, 0xe4, 0x67, 0x62, 0xc7, 0x61, 0x72, 0xa4, 0xa1, 0xbb, 0x2b,
+	0x65, 0xd4, 0x04, 0xbb, 0xe8, 0x4a, 0x44, 0x69, 0xef, 0xcb, 0x96, 0x14, 0xba, 0x64, 0xe1, 0xe9,
+	0xa4, 0xb6, 0x15, 0x0b, 0x89, 0xef, 0x4e, 0xd0, 0x21, 0xb6, 0xca, 0xc0, 0xcf, 0x11, 0x62, 0x11,
+	0xe5, 0x4e, 0x24, 0x14, 0xca, 0x45, 0xd9, 0xf8, 0x13, 0x31, 0xcb, 0xdf, 0x93, 0xda, 0xdd, 0xf8,
+	0x0e, 0x58, 0xe7, 0x95, 0x11, 0x50, 0xb3, 0xef, 0xf2, 0x97, 0xc6, 0x63, 0xf0, 0xdd, 0xf6, 0xb8,
+	0x05, 0xed, 0x74, 0xd4, 0xb4, 0x9c, 0xd8, 0x3a, 0x4b, 0xcc, 0x90, 0xcf, 0xd0, 0xed, 0xd4, 0xe3,
+	0x13, 0xd1, 0xac, 0x73, 0xd1, 0x39, 0xf7, 0x51, 0x79, 0x59, 0xe2, 0xe2, 0x33, 0xce, 0x96, 0xc0,
+	0x72, 0x19, 0x48, 0xad, 0x64, 0x09, 0xbe, 0x52, 0x4b, 0x90, 0x09, 0x28, 0xf9, 0x0f, 0x10, 0x12,
+	0x9b, 0xe7, 0x64, 0x7d, 0xde, 0x4c, 0x67, 0x4e, 0x63, 0xc4, 0xd6, 0xbd, 0xa4, 0x9a, 0x94, 0x95,
+	0xde, 0xe7, 0x41, 0x24, 0x24, 0xf7, 0x21, 0xb9, 0x5a, 0xd2, 0x53, 0xa7, 0x91, 0x8d, 0xa8, 0x56,
+	0x4f, 0xd1, 0x55, 0x29, 0xd7, 0x05, 0x50, 0x8d, 0x3e, 0xda, 0xec, 0xfc, 0xdf, 0xc8, 0x78, 0xe9,
+	0x02, 0x10, 0xfb, 0x75, 0x2f, 0x96, 0x26, 0xf7, 0xd4, 0x9a, 0x3d, 0x1a, 0x0e, 0x06, 0x10, 0xf2,
+	0x05, 0x2f, 0x91, 0xda, 0x9e, 0xc5, 0xe8, 0xff, 0xe7, 0x27, 0x4c, 0xa6, 0x67, 0x3c, 0xe8, 0xbb,
+	0x3c, 0x63, 0x46, 0x1c, 0xb4, 0xef, 0x32, 0xe7, 0x1b, 0x37, 0xe4, 0xd0, 0x51, 0x77, 0x99, 0x39,
+	0xe8, 0x34, 0x46, 0x6c, 0xdd, 0x77, 0xd9, 0x73, 0xf9, 0x8c, 0xdf, 0x41, 0x45, 0x3e, 0x92, 0xdb,
+	0x7a, 0xcd, 0xba, 0x3e, 0x9d, 0xd4, 0xf4, 0x38, 0x9b, 0x8f, 0x88, 0x5d, 0xe4, 0x23, 0xf2, 0x87,
+	0xa6, 0x36, 0x67, 0xae, 0xa1, 0x9a, 0xef, 0x19, 0x12, 0x42, 0x6a, 0xe1, 0xe3, 0x01, 0x3f, 0xde,
+	0x6c, 0xc0, 0x1b, 0xa9, 0x27, 0xb5, 0xef, 0x57, 0x7d, 0x97, 0xc9, 0x75, 0xc7, 0x16, 0xba, 0x24,
+	0xc0, 0x50, 0x2e, 0x4a, 0x5e, 0xdc, 0x31, 0x62, 0x25, 0x43, 0x9c, 0xc0, 0x0c, 0x16, 0x8f, 0x68,
+	0x10, 0x5a, 0x6f, 0x29, 0x50, 0x94, 0x66, 0xa0, 0x60, 0xc4, 0x96, 0xb5, 0x8d, 0x1f, 0x74, 0x74,
+	0x59, 0xda, 0xc6, 0x3f, 0x6b, 0x48, 0x9f, 0x91, 0x09, 0xd7, 0xd7, 0xd1, 0x67, 0x25, 0xda, 0x2a,
+	0xc6, 0xa6, 0xe9, 0xf1, 0x81, 0x90, 0xdd, 0xef, 0xff, 0xfc, 0xf7, 0xa7, 0xe2, 0x7d, 0x4c, 0xcc,
+	0xf5, 0x4c, 0x55, 0x30, 0xc3, 0xbf, 0x6b, 0x68, 0x6b, 0x9e, 0x3a, 0xb8, 0x71, 0x6e, 0xbb, 0x95,
+	0xa4, 0xab, 0x34, 0x2f, 0x54, 0xa3, 0x7c, 0x36, 0xa5, 0xcf, 0x3a, 0x7e, 0x6f, 0x9d, 0xcf, 0x94,
+	0x44, 0x8e, 0x37, 0x8e, 0x7f, 0x9e, 0xf8, 0x57, 0x0d, 0x95, 0x32, 0xfc, 0xc0, 0x66, 0x7e, 0xe7,
+	0x39, 0x58, 0x55, 0x1e, 0x6e, 0x5e, 0xa0, 0x7c, 0x7e, 0x28, 0x7d, 0x9a, 0xb8, 0xbe, 0xce, 0xa7,
+	0x74, 0xe6, 0x28, 0x4c, 0x99, 0xdf, 0xca, 0xd7, 0xef, 0xe4, 0x9d, 0xcf, 0x40, 0x94, 0x73, 0xe7,
+	0x8b, 0x24, 0xcb, 0xb9, 0xf3, 0x25, 0xbe, 0xe5, 0xdf, 0x79, 0x4a, 0x38, 0x7c, 0xa4, 0xa1, 0xeb,
+	0x5f, 0x00, 0x4f, 0xd1, 0x85, 0xcf, 0xef, 0xb6, 0x44, 0xbf, 0x8a, 0xb9, 0x71, 0xbe, 0xb2, 0xf7,
+	0x50, 0xda, 0xdb, 0xc5, 0x3b, 0xeb, 0xec, 0xb5, 0x87, 0x03, 0x07, 0x82, 0xc8, 0x49, 0x60, 0x83,
+	0x7f, 0xd3, 0xd0, 0xd6, 0x3c, 0xd0, 0x72, 0x16, 0x73, 0x25, 0x1b, 0x73, 0x16, 0x73, 0x35, 0x31,
+	0x37, 0x72, 0x2b, 0xea, 0x52, 0xb7, 0xbf, 0x68, 0xa8, 0x94, 0x61, 0x53, 0xce, 0x56, 0x2e, 0x63,
+	0x33, 0x67, 0x2b, 0x57, 0x60, 0x8f, 0xbc, 0x2f, 0x4d, 0x3e, 0xc0, 0xf7, 0xd7, 0x99, 0x04, 0x55,
+	0x24, 0x0c, 0x5a, 0x8f, 0x8f, 0x4f, 0xab, 0xda, 0xc9, 0x69, 0x55, 0xfb, 0xe7, 0xb4, 0xaa, 0xfd,
+	0x78, 0x56, 0x2d, 0x9c, 0x9c, 0x55, 0x0b, 0x7f, 0x9d, 0x55, 0x0b, 0x2f, 0x1a, 0x7e, 0xc0, 0x5f,
+	0x0e, 0x3d, 0xa3, 0x4d, 0xfb, 0x89, 0x52, 0xbd, 0xe7, 0x7a, 0x6c, 0x26, 0x7b, 0xd8, 0xdc, 0x33,
+	0x47, 0x89, 0x38, 0x1f, 0x47, 0xc0, 0xbc, 0x2b, 0xf2, 0xcf, 0x58, 0xf3, 0xbf, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xfb, 0xfb, 0x7f, 0xeb, 0x65, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -534,6 +733,14 @@ type QueryClient interface {
 	BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error)
 	// Returns a list of all base denom tokens and their corresponding pools.
 	GetEipBaseFee(ctx context.Context, in *QueryEipBaseFeeRequest, opts ...grpc.CallOption) (*QueryEipBaseFeeResponse, error)
+	// CurrentBaseFee returns the EIP-1559 base fee kept in consensus state,
+	// which is the minimum gas price of txs entering the mempool when the
+	// consensus base fee is enabled.
+	CurrentBaseFee(ctx context.Context, in *QueryCurrentBaseFeeRequest, opts ...grpc.CallOption) (*QueryCurrentBaseFeeResponse, error)
+	// EstimateFee returns the fee required for a tx wanting the given gas, in
+	// the base denom and in every whitelisted fee token, as checked when the tx
+	// enters the mempool of the queried node.
+	EstimateFee(ctx context.Context, in *QueryEstimateFeeRequest, opts ...grpc.CallOption) (*QueryEstimateFeeResponse, error)
 }
 
 type queryClient struct {
@@ -589,6 +796,24 @@ func (c *queryClient) GetEipBaseFee(ctx context.Context, in *QueryEipBaseFeeRequ
 	return out, nil
 }
 
+func (c *queryClient) CurrentBaseFee(ctx context.Context, in *QueryCurrentBaseFeeRequest, opts ...grpc.CallOption) (*QueryCurrentBaseFeeResponse, error) {
+	out := new(QueryCurrentBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/CurrentBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateFee(ctx context.Context, in *QueryEstimateFeeRequest, opts ...grpc.CallOption) (*QueryEstimateFeeResponse, error) {
+	out := new(QueryEstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// FeeTokens returns a list of all the whitelisted fee tokens and their
@@ -603,6 +828,14 @@ type QueryServer interface {
 	BaseDenom(context.Context, *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error)
 	// Returns a list of all base denom tokens and their corresponding pools.
 	GetEipBaseFee(context.Context, *QueryEipBaseFeeRequest) (*QueryEipBaseFeeResponse, error)
+	// CurrentBaseFee returns the EIP-1559 base fee kept in consensus state,
+	// which is the minimum gas price of txs entering the mempool when the
+	// consensus base fee is enabled.
+	CurrentBaseFee(context.Context, *QueryCurrentBaseFeeRequest) (*QueryCurrentBaseFeeResponse, error)
+	// EstimateFee returns the fee required for a tx wanting the given gas, in
+	// the base denom and in every whitelisted fee token, as checked when the tx
+	// enters the mempool of the queried node.
+	EstimateFee(context.Context, *QueryEstimateFeeRequest) (*QueryEstimateFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -624,6 +857,12 @@ func (*UnimplementedQueryServer) BaseDenom(ctx context.Context, req *QueryBaseDe
 func (*UnimplementedQueryServer) GetEipBaseFee(ctx context.Context, req *QueryEipBaseFeeRequest) (*QueryEipBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEipBaseFee not implemented")
 }
+func (*UnimplementedQueryServer) CurrentBaseFee(ctx context.Context, req *QueryCurrentBaseFeeRequest) (*QueryCurrentBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentBaseFee not implemented")
+}
+func (*UnimplementedQueryServer) EstimateFee(ctx context.Context, req *QueryEstimateFeeRequest) (*QueryEstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -719,6 +958,43 @@ func _Query_GetEipBaseFee_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CurrentBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/CurrentBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentBaseFee(ctx, req.(*QueryCurrentBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateFee(ctx, req.(*QueryEstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.txfees.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -743,6 +1019,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEipBaseFee",
 			Handler:    _Query_GetEipBaseFee_Handler,
 		},
+		{
+			MethodName: "CurrentBaseFee",
+			Handler:    _Query_CurrentBaseFee_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Query_EstimateFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/txfees/v1beta1/query.proto",
@@ -1043,84 +1327,222 @@ func (m *QueryEipBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCurrentBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryFeeTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryCurrentBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeeTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCurrentBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.FeeTokens) > 0 {
-		for _, e := range m.FeeTokens {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomSpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEstimateFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDenomSpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovQuery(uint64(m.PoolID))
-	}
-	l = m.SpotPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryEstimateFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomPoolIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEstimateFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.GasWanted != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomPoolIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEstimateFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.GasPrice.Size()
+		i -= size
+		if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFeeTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomSpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomSpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovQuery(uint64(m.PoolID))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomPoolIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomPoolIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1172,6 +1594,59 @@ func (m *QueryEipBaseFeeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCurrentBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasWanted != 0 {
+		n += 1 + sovQuery(uint64(m.GasWanted))
+	}
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1914,6 +2389,361 @@ func (m *QueryEipBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCurrentBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
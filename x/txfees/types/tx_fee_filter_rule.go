@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// Validate checks that the rule is named, matches txs by exactly one of its msg type URL, its wasm contract
+// and its swap route shape, and does not lower the minimum gas price of the txs it matches.
+func (r TxFeeFilterRule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("tx fee filter rule name must not be empty")
+	}
+
+	matchers := 0
+	if r.MsgTypeUrl != "" {
+		matchers++
+		if !strings.HasPrefix(r.MsgTypeUrl, "/") {
+			return fmt.Errorf("tx fee filter rule (%s) msg type url must start with '/', was (%s)", r.Name, r.MsgTypeUrl)
+		}
+	}
+	if r.ContractAddress != "" {
+		matchers++
+		if _, err := sdk.AccAddressFromBech32(r.ContractAddress); err != nil {
+			return fmt.Errorf("tx fee filter rule (%s) contract address is invalid: %w", r.Name, err)
+		}
+	} else if r.ContractMsgKey != "" {
+		return fmt.Errorf("tx fee filter rule (%s) contract msg key requires a contract address", r.Name)
+	}
+	if r.SwapRouteShape != nil {
+		matchers++
+		if r.SwapRouteShape.MinHops == 0 && !r.SwapRouteShape.Cyclic {
+			return fmt.Errorf("tx fee filter rule (%s) swap route shape must set min hops or cyclic", r.Name)
+		}
+	}
+	if matchers != 1 {
+		return fmt.Errorf("tx fee filter rule (%s) must set exactly one of msg type url, contract address and swap route shape, set %d", r.Name, matchers)
+	}
+
+	if r.FeeMultiplier.IsNil() || r.FeeMultiplier.LT(osmomath.OneDec()) {
+		return fmt.Errorf("tx fee filter rule (%s) fee multiplier must be at least 1, was (%s)", r.Name, r.FeeMultiplier)
+	}
+
+	return nil
+}